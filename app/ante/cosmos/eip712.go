@@ -177,14 +177,23 @@ func VerifySignature(
 			return errorsmod.Wrap(errortypes.ErrNoSignatures, "tx doesn't contain any msgs to verify signature")
 		}
 
+		// the fee payer and granter are signed as set in the tx, the same way as
+		// the SIGN_MODE_LEGACY_AMINO_JSON sign mode handler
+		protoTx, ok := tx.(protoTxProvider)
+		if !ok {
+			return errorsmod.Wrapf(errortypes.ErrInvalidType, "tx %T doesn't implement the protoTxProvider interface", tx)
+		}
+
 		txBytes := legacytx.StdSignBytes(
 			signerData.ChainID,
 			signerData.AccountNumber,
 			signerData.Sequence,
 			tx.GetTimeoutHeight(),
 			legacytx.StdFee{
-				Amount: tx.GetFee(),
-				Gas:    tx.GetGas(),
+				Amount:  tx.GetFee(),
+				Gas:     tx.GetGas(),
+				Payer:   protoTx.GetProtoTx().AuthInfo.Fee.Payer,
+				Granter: protoTx.GetProtoTx().AuthInfo.Fee.Granter,
 			},
 			msgs, tx.GetMemo(), tx.GetTip(),
 		)
@@ -224,7 +233,7 @@ func VerifySignature(
 			FeePayer: feePayer,
 		}
 
-		typedData, err := eip712.LegacyWrapTxToTypedData(tabiCodec, extOpt.TypedDataChainID, msgs[0], txBytes, feeDelegation)
+		typedData, err := eip712.LegacyWrapTxToTypedData(tabiCodec, extOpt.TypedDataChainID, msgs, txBytes, feeDelegation)
 		if err != nil {
 			return errorsmod.Wrap(err, "failed to create EIP-712 typed data from tx")
 		}
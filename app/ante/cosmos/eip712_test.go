@@ -0,0 +1,149 @@
+package cosmos_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+
+	cosmosante "github.com/tabilabs/tabi/app/ante/cosmos"
+	"github.com/tabilabs/tabi/testutil"
+	utiltx "github.com/tabilabs/tabi/testutil/tx"
+	"github.com/tabilabs/tabi/utils"
+)
+
+// TestLegacyEip712SigVerificationDecorator signs every Msg of the Tabi modules registered in the
+// interface registry with the legacy EIP-712 typed data and extension, and verifies the tx through
+// the LegacyEip712SigVerificationDecorator.
+func (suite *AnteTestSuite) TestLegacyEip712SigVerificationDecorator() {
+	from := sdk.AccAddress(suite.priv.PubKey().Address())
+
+	// the decorator expects the signer pubkey to be set by the ante handler
+	acc := suite.app.AccountKeeper.GetAccount(suite.ctx, from)
+	suite.Require().NoError(acc.SetPubKey(suite.priv.PubKey()))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	msgs, err := utiltx.NewTabiMsgs(suite.app.InterfaceRegistry(), from)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(msgs)
+
+	dec := cosmosante.NewLegacyEip712SigVerificationDecorator(suite.app.AccountKeeper, suite.clientCtx.TxConfig.SignModeHandler())
+
+	for _, msg := range msgs {
+		suite.Run(sdk.MsgTypeURL(msg), func() {
+			tx, err := utiltx.CreateEIP712CosmosTx(suite.ctx, suite.app, utiltx.EIP712TxArgs{
+				CosmosTxArgs: utiltx.CosmosTxArgs{
+					TxCfg:   suite.clientCtx.TxConfig,
+					Priv:    suite.priv,
+					ChainID: suite.ctx.ChainID(),
+					Gas:     TestGasLimit,
+					Fees:    sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewIntFromUint64(TestGasLimit))),
+					Msgs:    []sdk.Msg{msg},
+				},
+				UseLegacyExtension: true,
+				UseLegacyTypedData: true,
+			})
+			suite.Require().NoError(err)
+
+			_, err = dec.AnteHandle(suite.ctx, tx, false, testutil.NextFn)
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+// TestLegacyEip712AnteHandler runs the txs signed with the legacy EIP-712 typed data and
+// extension through the ante handler.
+func (suite *AnteTestSuite) TestLegacyEip712AnteHandler() {
+	var (
+		to, _       = utiltx.NewAccAddressAndKey()
+		fgAddr, _   = utiltx.NewAccAddressAndKey()
+		initBalance = sdk.NewInt(1e18)
+		gas         = uint64(200_000)
+		fees        = sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewIntFromUint64(gas).MulRaw(1e10)))
+	)
+
+	testCases := []struct {
+		name       string
+		msgs       func(from sdk.AccAddress) []sdk.Msg
+		feeGranter sdk.AccAddress
+		malleate   func(from sdk.AccAddress)
+	}{
+		{
+			name: "pass - msgs of a single type",
+			msgs: func(from sdk.AccAddress) []sdk.Msg {
+				coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.OneInt()))
+				return []sdk.Msg{
+					banktypes.NewMsgSend(from, to, coins),
+					banktypes.NewMsgSend(from, to, coins),
+				}
+			},
+		},
+		{
+			name: "pass - msgs of different types",
+			msgs: func(from sdk.AccAddress) []sdk.Msg {
+				coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.OneInt()))
+				return []sdk.Msg{
+					banktypes.NewMsgSend(from, to, coins),
+					distrtypes.NewMsgSetWithdrawAddress(from, to),
+					banktypes.NewMsgSend(from, to, coins),
+				}
+			},
+		},
+		{
+			name: "pass - fees paid by a fee granter",
+			msgs: func(from sdk.AccAddress) []sdk.Msg {
+				return []sdk.Msg{distrtypes.NewMsgSetWithdrawAddress(from, to)}
+			},
+			feeGranter: fgAddr,
+			malleate: func(from sdk.AccAddress) {
+				err := testutil.FundAccountWithBaseDenom(suite.ctx, suite.app.BankKeeper, fgAddr, initBalance.Int64())
+				suite.Require().NoError(err)
+				err = suite.app.FeeGrantKeeper.GrantAllowance(suite.ctx, fgAddr, from, &feegrant.BasicAllowance{
+					SpendLimit: sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, initBalance)),
+				})
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.ctx = suite.ctx.WithBlockGasMeter(sdk.NewGasMeter(1e18))
+			from := sdk.AccAddress(suite.priv.PubKey().Address())
+
+			if tc.malleate != nil {
+				tc.malleate(from)
+			}
+
+			tx, err := utiltx.CreateEIP712CosmosTx(suite.ctx, suite.app, utiltx.EIP712TxArgs{
+				CosmosTxArgs: utiltx.CosmosTxArgs{
+					TxCfg:      suite.clientCtx.TxConfig,
+					Priv:       suite.priv,
+					ChainID:    suite.ctx.ChainID(),
+					Gas:        gas,
+					Fees:       fees,
+					FeeGranter: tc.feeGranter,
+					Msgs:       tc.msgs(from),
+				},
+				UseLegacyExtension: true,
+				UseLegacyTypedData: true,
+			})
+			suite.Require().NoError(err)
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, from, utils.BaseDenom)
+
+			_, err = suite.anteHandler(suite.ctx, tx, false)
+			suite.Require().NoError(err)
+
+			if tc.feeGranter != nil {
+				// the fees are deducted from the fee granter
+				suite.Require().Equal(balance, suite.app.BankKeeper.GetBalance(suite.ctx, from, utils.BaseDenom))
+				suite.Require().Equal(
+					initBalance.Sub(fees.AmountOf(utils.BaseDenom)),
+					suite.app.BankKeeper.GetBalance(suite.ctx, fgAddr, utils.BaseDenom).Amount,
+				)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package cosmos
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+)
 
 // BankKeeper defines the exposed interface for using functionality of the bank keeper
 // in the context of the cosmos AnteHandler package.
@@ -9,3 +12,7 @@ type BankKeeper interface {
 	SendCoins(ctx sdk.Context, from, to sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+type protoTxProvider interface {
+	GetProtoTx() *tx.Tx
+}
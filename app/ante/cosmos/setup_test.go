@@ -49,7 +49,7 @@ type AnteTestSuite struct {
 
 const TestGasLimit uint64 = 100000
 
-var chainID = utils.TestnetChainID + "-1"
+var chainID = "tabi_9789-1"
 
 func (suite *AnteTestSuite) StateDB() *statedb.StateDB {
 	return statedb.New(suite.ctx, suite.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(suite.ctx.HeaderHash().Bytes())))
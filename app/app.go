@@ -74,6 +74,12 @@ import (
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	// ibc
+	icacontroller "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icahost "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host"
+	icahostkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
 	"github.com/cosmos/ibc-go/v6/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v6/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
@@ -98,12 +104,19 @@ import (
 	captainnodetypes "github.com/tabilabs/tabi/x/captains/types"
 	claimskeeper "github.com/tabilabs/tabi/x/claims/keeper"
 	claimstypes "github.com/tabilabs/tabi/x/claims/types"
+	erc20keeper "github.com/tabilabs/tabi/x/erc20/keeper"
+	erc20types "github.com/tabilabs/tabi/x/erc20/types"
 	evmkeeper "github.com/tabilabs/tabi/x/evm/keeper"
 	evmtypes "github.com/tabilabs/tabi/x/evm/types"
 	feemarketkeeper "github.com/tabilabs/tabi/x/feemarket/keeper"
 	feemarkettypes "github.com/tabilabs/tabi/x/feemarket/types"
 	limiterkeeper "github.com/tabilabs/tabi/x/limiter/keeper"
 	limitertypes "github.com/tabilabs/tabi/x/limiter/types"
+	"github.com/tabilabs/tabi/x/ratelimit"
+	ratelimitkeeper "github.com/tabilabs/tabi/x/ratelimit/keeper"
+	ratelimittypes "github.com/tabilabs/tabi/x/ratelimit/types"
+	revenuekeeper "github.com/tabilabs/tabi/x/revenue/keeper"
+	revenuetypes "github.com/tabilabs/tabi/x/revenue/types"
 	tokenconvertkeeper "github.com/tabilabs/tabi/x/token-convert/keeper"
 	tokenconverttypes "github.com/tabilabs/tabi/x/token-convert/types"
 
@@ -176,9 +189,14 @@ type Tabi struct {
 	EvidenceKeeper   evidencekeeper.Keeper
 	TransferKeeper   ibctransferkeeper.Keeper
 
+	ICAControllerKeeper icacontrollerkeeper.Keeper
+	ICAHostKeeper       icahostkeeper.Keeper
+
 	// make scoped keepers public for test purposes
-	ScopedIBCKeeper      capabilitykeeper.ScopedKeeper
-	ScopedTransferKeeper capabilitykeeper.ScopedKeeper
+	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
+	ScopedTransferKeeper      capabilitykeeper.ScopedKeeper
+	ScopedICAControllerKeeper capabilitykeeper.ScopedKeeper
+	ScopedICAHostKeeper       capabilitykeeper.ScopedKeeper
 
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
@@ -189,10 +207,16 @@ type Tabi struct {
 	CaptainsKeeper     captainskeeper.Keeper
 	TokenConvertKeeper tokenconvertkeeper.Keeper
 	LimiterKeeper      limiterkeeper.Keeper
+	RevenueKeeper      revenuekeeper.Keeper
+	Erc20Keeper        erc20keeper.Keeper
+	RateLimitKeeper    ratelimitkeeper.Keeper
 
 	// the module manager
 	mm *module.Manager
 
+	// simulation manager
+	sm *module.SimulationManager
+
 	transferModule transfer.AppModule
 
 	// the configurator
@@ -241,6 +265,7 @@ func NewTabi(
 		feegrant.StoreKey, authzkeeper.StoreKey,
 		// ibc keys
 		ibchost.StoreKey, ibctransfertypes.StoreKey,
+		icacontrollertypes.StoreKey, icahosttypes.StoreKey,
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
 
@@ -249,6 +274,9 @@ func NewTabi(
 		captainnodetypes.StoreKey,
 		tokenconverttypes.StoreKey,
 		limitertypes.StoreKey,
+		revenuetypes.StoreKey,
+		erc20types.StoreKey,
+		ratelimittypes.StoreKey,
 	)
 
 	// Add the EVM transient store key
@@ -282,6 +310,8 @@ func NewTabi(
 
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibchost.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
+	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
 	// their scoped modules in `NewApp` with `ScopeToModule`
@@ -370,6 +400,8 @@ func NewTabi(
 		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		keys[feemarkettypes.StoreKey],
 		tkeys[feemarkettypes.TransientKey],
+		app.BankKeeper,
+		&app.CaptainsKeeper,
 		app.GetSubspace(feemarkettypes.ModuleName),
 	)
 
@@ -395,12 +427,21 @@ func NewTabi(
 		keys[claimstypes.StoreKey],
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.DistrKeeper,
 		app.CaptainsKeeper,
 	)
 
+	// the claims fund the community pool with the emission confiscated from misbehaving nodes
+	app.CaptainsKeeper = *app.CaptainsKeeper.SetHooks(
+		captainnodetypes.NewMultiCaptainsHooks(
+			app.ClaimsKeeper.Hooks(),
+		),
+	)
+
 	app.TokenConvertKeeper = tokenconvertkeeper.NewKeeper(
 		appCodec,
 		keys[tokenconverttypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
 		app.AccountKeeper,
 		app.BankKeeper,
 		tokenconverttypes.StrategyInstant,
@@ -412,6 +453,23 @@ func NewTabi(
 		authtypes.NewModuleAddress(govtypes.ModuleName),
 	)
 
+	app.RevenueKeeper = revenuekeeper.NewKeeper(
+		appCodec,
+		keys[revenuetypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper,
+		app.EvmKeeper,
+	)
+
+	app.Erc20Keeper = erc20keeper.NewKeeper(
+		appCodec,
+		keys[erc20types.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.EvmKeeper,
+	)
+
 	// Create IBC Keeper
 	app.IBCKeeper = ibckeeper.NewKeeper(
 		appCodec,
@@ -454,20 +512,30 @@ func NewTabi(
 		stakingtypes.NewMultiStakingHooks(
 			app.DistrKeeper.Hooks(),
 			app.SlashingKeeper.Hooks(),
+			app.CaptainsKeeper.Hooks(),
 		),
 	)
 
-	//app.EvmKeeper = app.EvmKeeper.SetHooks(
-	//	evmkeeper.NewMultiEvmHooks(
-	//		app.IncentivesKeeper.Hooks(),
-	//	),
-	//)
+	app.EvmKeeper = app.EvmKeeper.SetHooks(
+		evmkeeper.NewMultiEvmHooks(
+			app.RevenueKeeper.Hooks(),
+			app.Erc20Keeper.Hooks(),
+		),
+	)
+
+	// the ratelimit keeper checks the outflow of the transfers before sending the packets
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		appCodec,
+		keys[ratelimittypes.StoreKey],
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.IBCKeeper.ChannelKeeper,
+	)
 
 	app.TransferKeeper = ibctransferkeeper.NewKeeper(
 		appCodec,
 		keys[ibctransfertypes.StoreKey],
 		app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper,
+		app.RateLimitKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
@@ -483,10 +551,40 @@ func NewTabi(
 	// create IBC module from top to bottom of stack
 	var transferStack porttypes.IBCModule
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = ratelimit.NewIBCMiddleware(transferStack, app.RateLimitKeeper)
+
+	// the interchain accounts are controlled with the messages of the controller
+	// msg server, so the controller middleware wraps no application
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec,
+		keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		app.MsgServiceRouter(),
+	)
+	icaControllerStack := icacontroller.NewIBCMiddleware(nil, app.ICAControllerKeeper)
 
-	// Create static IBC router, add transfer route, then set and seal it
+	app.ICAHostKeeper = icahostkeeper.NewKeeper(
+		appCodec,
+		keys[icahosttypes.StoreKey],
+		app.GetSubspace(icahosttypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.AccountKeeper,
+		scopedICAHostKeeper,
+		app.MsgServiceRouter(),
+	)
+	icaHostStack := icahost.NewIBCModule(app.ICAHostKeeper)
+
+	// Create static IBC router, add transfer and interchain accounts routes, then set and seal it
 	ibcRouter := porttypes.NewRouter()
-	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
+		AddRoute(icahosttypes.SubModuleName, icaHostStack)
 	app.IBCKeeper.SetRouter(ibcRouter)
 
 	// create evidence keeper with router
@@ -529,6 +627,10 @@ func NewTabi(
 	app.configurator = module.NewConfigurator(app.appCodec, app.MsgServiceRouter(), app.GRPCQueryRouter())
 	app.mm.RegisterServices(app.configurator)
 
+	// create the simulation manager and define the order of the modules for deterministic simulations
+	app.sm = module.NewSimulationManager(simulationModules(app, encodingConfig)...)
+	app.sm.RegisterStoreDecoders()
+
 	// add test gRPC service for testing gRPC queries in isolation
 	// testdata.RegisterTestServiceServer(app.GRPCQueryRouter(), testdata.TestServiceImpl{})
 
@@ -558,6 +660,8 @@ func NewTabi(
 
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	app.ScopedICAHostKeeper = scopedICAHostKeeper
 
 	// Finally start the tpsCounter.
 	app.tpsCounter = newTPSCounter(logger)
@@ -710,6 +814,11 @@ func (app *Tabi) InterfaceRegistry() types.InterfaceRegistry {
 	return app.interfaceRegistry
 }
 
+// SimulationManager implements the SimulationApp interface
+func (app *Tabi) SimulationManager() *module.SimulationManager {
+	return app.sm
+}
+
 // GetKey returns the KVStoreKey for the provided store key.
 //
 // NOTE: This is solely to be used for testing purposes.
@@ -851,6 +960,8 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(crisistypes.ModuleName)
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibchost.ModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
+	paramsKeeper.Subspace(icahosttypes.SubModuleName)
 	// ethermint subspaces
 	paramsKeeper.Subspace(evmtypes.ModuleName).WithKeyTable(evmtypes.ParamKeyTable()) //nolint: staticcheck
 	paramsKeeper.Subspace(feemarkettypes.ModuleName).WithKeyTable(feemarkettypes.ParamKeyTable())
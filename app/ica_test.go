@@ -0,0 +1,140 @@
+package app_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+
+	"github.com/tabilabs/tabi/app/upgrades"
+	"github.com/tabilabs/tabi/testutil"
+	ibctestutil "github.com/tabilabs/tabi/testutil/ibc"
+	tabitypes "github.com/tabilabs/tabi/types"
+	tokenconverttypes "github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+type ICATestSuite struct {
+	suite.Suite
+
+	coord  *ibctesting.Coordinator
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+
+	// icaAddress is the interchain account on chain B controlled by the sender of chain A
+	icaAddress sdk.AccAddress
+}
+
+func TestICATestSuite(t *testing.T) {
+	suite.Run(t, new(ICATestSuite))
+}
+
+// SetupTest registers an interchain account on chain B controlled by the
+// sender of chain A through the controller messages.
+func (suite *ICATestSuite) SetupTest() {
+	suite.coord = ibctestutil.NewCoordinator(suite.T())
+	suite.chainA = suite.coord.GetChain(ibctestutil.ChainIDA)
+	suite.chainB = suite.coord.GetChain(ibctestutil.ChainIDB)
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coord.SetupConnections(suite.path)
+
+	owner := suite.chainA.SenderAccount.GetAddress().String()
+	version := icatypes.NewDefaultMetadataString(suite.path.EndpointA.ConnectionID, suite.path.EndpointB.ConnectionID)
+	portID, err := icatypes.NewControllerPortID(owner)
+	suite.Require().NoError(err)
+
+	channelSequence := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+	_, err = suite.chainA.SendMsgs(icacontrollertypes.NewMsgRegisterInterchainAccount(suite.path.EndpointA.ConnectionID, owner, version))
+	suite.Require().NoError(err)
+
+	for _, endpoint := range []*ibctesting.Endpoint{suite.path.EndpointA, suite.path.EndpointB} {
+		endpoint.ChannelConfig.Order = channeltypes.ORDERED
+		endpoint.ChannelConfig.Version = version
+	}
+	suite.path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(channelSequence)
+	suite.path.EndpointA.ChannelConfig.PortID = portID
+	suite.path.EndpointB.ChannelConfig.PortID = icatypes.HostPortID
+
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(suite.path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(suite.path.EndpointB.ChanOpenConfirm())
+
+	address, found := ibctestutil.GetTabiApp(suite.chainB).ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), suite.path.EndpointB.ConnectionID, portID)
+	suite.Require().True(found)
+	suite.icaAddress = sdk.MustAccAddressFromBech32(address)
+}
+
+// sendTx executes the messages with the interchain account and relays the
+// packet and its acknowledgement.
+func (suite *ICATestSuite) sendTx(msgs ...proto.Message) {
+	data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, msgs)
+	suite.Require().NoError(err)
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+	msg := icacontrollertypes.NewMsgSendTx(
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.path.EndpointA.ConnectionID,
+		uint64(time.Hour.Nanoseconds()),
+		packetData,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+}
+
+func (suite *ICATestSuite) fundICA(coins sdk.Coins) {
+	tabiApp := ibctestutil.GetTabiApp(suite.chainB)
+	suite.Require().NoError(testutil.FundAccount(suite.chainB.GetContext(), tabiApp.BankKeeper, suite.icaAddress, coins))
+	suite.coord.CommitBlock(suite.chainB)
+}
+
+func (suite *ICATestSuite) balance(denom string) sdk.Int {
+	tabiApp := ibctestutil.GetTabiApp(suite.chainB)
+	return tabiApp.BankKeeper.GetBalance(suite.chainB.GetContext(), suite.icaAddress, denom).Amount
+}
+
+func (suite *ICATestSuite) TestHostAllowMessages() {
+	params := ibctestutil.GetTabiApp(suite.chainB).ICAHostKeeper.GetParams(suite.chainB.GetContext())
+	suite.Require().True(params.HostEnabled)
+	suite.Require().Equal(upgrades.ICAHostAllowMessages, params.AllowMessages)
+}
+
+func (suite *ICATestSuite) TestExecuteAllowedMessage() {
+	suite.fundICA(sdk.NewCoins(sdk.NewInt64Coin(tabitypes.AttoTabi, 1000)))
+
+	suite.sendTx(&tokenconverttypes.MsgConvertTabi{
+		Coin:   sdk.NewInt64Coin(tabitypes.AttoTabi, 400),
+		Sender: suite.icaAddress.String(),
+	})
+
+	suite.Require().Equal(sdk.NewInt(600), suite.balance(tabitypes.AttoTabi))
+	suite.Require().Equal(sdk.NewInt(400), suite.balance(tabitypes.AttoVeTabi))
+}
+
+func (suite *ICATestSuite) TestExecuteDisallowedMessage() {
+	suite.fundICA(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)))
+
+	validators := ibctestutil.GetTabiApp(suite.chainB).StakingKeeper.GetAllValidators(suite.chainB.GetContext())
+	suite.Require().NotEmpty(validators)
+
+	// the acknowledgement is an error as delegations are not allow-listed
+	suite.sendTx(stakingtypes.NewMsgDelegate(suite.icaAddress, validators[0].GetOperator(), sdk.NewInt64Coin(sdk.DefaultBondDenom, 400)))
+
+	suite.Require().Equal(sdk.NewInt(1000), suite.balance(sdk.DefaultBondDenom))
+}
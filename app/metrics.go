@@ -0,0 +1,24 @@
+package app
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+// metricsNamespace is the Prometheus namespace of the metrics served by the
+// observability exporter.
+const metricsNamespace = "tabid"
+
+var (
+	metricTransactions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Name:      "transactions_processed_total",
+		Help:      "The transactions processed by status",
+	}, []string{"status"})
+
+	metricTPS = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Name:      "transactions_per_second",
+		Help:      "The transactions per second over the last report period",
+	})
+)
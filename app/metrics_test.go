@@ -0,0 +1,55 @@
+package app
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tabilabs/tabi/utils"
+	captainstypes "github.com/tabilabs/tabi/x/captains/types"
+)
+
+func TestObservabilityMetrics(t *testing.T) {
+	// the module blockers are timed through the SDK telemetry
+	_, err := telemetry.New(telemetry.Config{
+		ServiceName:             metricsNamespace,
+		Enabled:                 true,
+		PrometheusRetentionTime: 60,
+	})
+	require.NoError(t, err)
+
+	app := Setup(false, nil)
+
+	header := tmproto.Header{ChainID: utils.MainnetChainID + "-1", Height: app.LastBlockHeight() + 1}
+	app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	app.EndBlock(abci.RequestEndBlock{Height: header.Height})
+	app.Commit()
+
+	families, err := prometheus.DefaultGatherer.Gather()
+	require.NoError(t, err)
+
+	gathered := make(map[string]bool)
+	endBlockers := make(map[string]bool)
+	for _, family := range families {
+		gathered[family.GetName()] = true
+		if family.GetName() != "tabid_end_blocker" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			for _, label := range metric.GetLabel() {
+				if label.GetName() == telemetry.MetricLabelNameModule {
+					endBlockers[label.GetValue()] = true
+				}
+			}
+		}
+	}
+
+	require.True(t, endBlockers[captainstypes.ModuleName], "captains EndBlocker timing not recorded")
+	require.True(t, endBlockers["staking"], "staking EndBlocker timing not recorded")
+	require.True(t, gathered["tabid_captains_epoch"])
+	require.True(t, gathered["tabid_captains_epoch_phase"])
+}
@@ -1,6 +1,9 @@
 package app
 
 import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
@@ -42,22 +45,32 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	ica "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts"
+	icagenesistypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/genesis/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
 	ibctransfer "github.com/cosmos/ibc-go/v6/modules/apps/transfer"
 	ibctransfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
 	ibc "github.com/cosmos/ibc-go/v6/modules/core"
 	ibcclientclient "github.com/cosmos/ibc-go/v6/modules/core/02-client/client"
 	ibchost "github.com/cosmos/ibc-go/v6/modules/core/24-host"
 
+	"github.com/tabilabs/tabi/app/upgrades"
 	"github.com/tabilabs/tabi/x/captains"
 	captainstypes "github.com/tabilabs/tabi/x/captains/types"
 	"github.com/tabilabs/tabi/x/claims"
 	claimstypes "github.com/tabilabs/tabi/x/claims/types"
+	"github.com/tabilabs/tabi/x/erc20"
+	erc20types "github.com/tabilabs/tabi/x/erc20/types"
 	"github.com/tabilabs/tabi/x/evm"
 	evmtypes "github.com/tabilabs/tabi/x/evm/types"
 	"github.com/tabilabs/tabi/x/feemarket"
 	feemarkettypes "github.com/tabilabs/tabi/x/feemarket/types"
 	"github.com/tabilabs/tabi/x/limiter"
 	limitertypes "github.com/tabilabs/tabi/x/limiter/types"
+	"github.com/tabilabs/tabi/x/ratelimit"
+	ratelimittypes "github.com/tabilabs/tabi/x/ratelimit/types"
+	"github.com/tabilabs/tabi/x/revenue"
+	revenuetypes "github.com/tabilabs/tabi/x/revenue/types"
 	tokenconvert "github.com/tabilabs/tabi/x/token-convert"
 	tokenconverttypes "github.com/tabilabs/tabi/x/token-convert/types"
 )
@@ -80,6 +93,20 @@ func getGovProposalHandlers() []govclient.ProposalHandler {
 	return govProposalHandlers
 }
 
+// icaModuleBasic restricts the messages executed by the interchain accounts
+// hosted on Tabi in the default genesis of the interchain accounts module.
+type icaModuleBasic struct {
+	ica.AppModuleBasic
+}
+
+// DefaultGenesis returns the default genesis of the interchain accounts module
+// with the host allow-list.
+func (icaModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	genesis := icagenesistypes.DefaultGenesis()
+	genesis.HostGenesisState.Params.AllowMessages = upgrades.ICAHostAllowMessages
+	return cdc.MustMarshalJSON(genesis)
+}
+
 var (
 	// DefaultNodeHome default home directories for the application daemon
 	DefaultNodeHome string
@@ -105,6 +132,7 @@ var (
 		upgrade.AppModuleBasic{},
 		evidence.AppModuleBasic{},
 		ibctransfer.AppModuleBasic{},
+		icaModuleBasic{},
 		vesting.AppModuleBasic{},
 
 		// Ethermint app modules
@@ -116,6 +144,9 @@ var (
 		captains.AppModule{},
 		tokenconvert.AppModuleBasic{},
 		limiter.AppModuleBasic{},
+		revenue.AppModuleBasic{},
+		erc20.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -127,9 +158,12 @@ var (
 		stakingtypes.NotBondedPoolName: {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
+		icatypes.ModuleName:            nil,
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
 		claimstypes.ModuleName:         {authtypes.Minter},
 		tokenconverttypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		feemarkettypes.ModuleName:      {authtypes.Burner},
+		erc20types.ModuleName:          nil,
 	}
 
 	// module accounts that are allowed to receive tokens
@@ -168,14 +202,33 @@ func appModules(
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
 		app.transferModule,
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		// Ethermint app modules
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
 		feemarket.NewAppModule(app.FeeMarketKeeper, app.GetSubspace(feemarkettypes.ModuleName)),
 		// Tabi app modules
 		claims.NewAppModule(appCodec, app.ClaimsKeeper),
-		captains.NewAppModule(appCodec, app.CaptainsKeeper),
+		captains.NewAppModule(appCodec, app.CaptainsKeeper, app.AccountKeeper, app.BankKeeper),
 		tokenconvert.NewAppModule(appCodec, app.TokenConvertKeeper, app.AccountKeeper, app.BankKeeper),
 		limiter.NewAppModule(appCodec, app.LimiterKeeper),
+		revenue.NewAppModule(appCodec, app.RevenueKeeper),
+		erc20.NewAppModule(appCodec, app.Erc20Keeper),
+		ratelimit.NewAppModule(appCodec, app.RateLimitKeeper),
+	}
+}
+
+// simulationModules returns the modules that support the simulation manager.
+func simulationModules(
+	app *Tabi,
+	encodingConfig simappparams.EncodingConfig,
+) []module.AppModuleSimulation {
+	appCodec := encodingConfig.Codec
+	return []module.AppModuleSimulation{
+		auth.NewAppModule(appCodec, app.AccountKeeper, authsims.RandomGenesisAccounts),
+		bank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper),
+		staking.NewAppModule(appCodec, app.StakingKeeper, app.AccountKeeper, app.BankKeeper),
+		// Tabi app modules
+		captains.NewAppModule(appCodec, app.CaptainsKeeper, app.AccountKeeper, app.BankKeeper),
 	}
 }
 
@@ -206,6 +259,7 @@ func orderBeginBlockers() []string {
 		govtypes.ModuleName,
 		crisistypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		ibchost.ModuleName,
 		genutiltypes.ModuleName,
 		authz.ModuleName,
@@ -218,6 +272,9 @@ func orderBeginBlockers() []string {
 		captainstypes.ModuleName,
 		tokenconverttypes.ModuleName,
 		limitertypes.ModuleName,
+		revenuetypes.ModuleName,
+		erc20types.ModuleName,
+		ratelimittypes.ModuleName,
 	}
 }
 
@@ -237,6 +294,7 @@ func orderEndBlockers() []string {
 		evmtypes.ModuleName,
 		feemarkettypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		ibchost.ModuleName,
 		capabilitytypes.ModuleName,
 		authtypes.ModuleName,
@@ -257,6 +315,9 @@ func orderEndBlockers() []string {
 		captainstypes.ModuleName,
 		tokenconverttypes.ModuleName,
 		limitertypes.ModuleName,
+		revenuetypes.ModuleName,
+		erc20types.ModuleName,
+		ratelimittypes.ModuleName,
 	}
 }
 
@@ -279,6 +340,7 @@ func orderInitBlockers() []string {
 		slashingtypes.ModuleName,
 		minttypes.ModuleName,
 		ibctransfertypes.ModuleName,
+		icatypes.ModuleName,
 		ibchost.ModuleName,
 		authz.ModuleName,
 		feegrant.ModuleName,
@@ -297,6 +359,9 @@ func orderInitBlockers() []string {
 		captainstypes.ModuleName,
 		tokenconverttypes.ModuleName,
 		limitertypes.ModuleName,
+		revenuetypes.ModuleName,
+		erc20types.ModuleName,
+		ratelimittypes.ModuleName,
 
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/tabilabs/tabi/crypto/ethsecp256k1"
+	feemarkettypes "github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+// StateFn returns the initial application state using a genesis or the simulation parameters.
+// It builds on the Tabi default genesis, so modules without simulation support keep their defaults.
+func StateFn(cdc codec.JSONCodec, simManager *module.SimulationManager) simtypes.AppStateFn {
+	genesisState := NewDefaultGenesisState()
+
+	// simulated txs pay random fees in random denoms, so the base fee must be disabled
+	var feemarketGenesis feemarkettypes.GenesisState
+	cdc.MustUnmarshalJSON(genesisState[feemarkettypes.ModuleName], &feemarketGenesis)
+	feemarketGenesis.Params.NoBaseFee = true
+	genesisState[feemarkettypes.ModuleName] = cdc.MustMarshalJSON(&feemarketGenesis)
+
+	return simapp.AppStateFnWithExtendedCb(cdc, simManager, genesisState, nil)
+}
+
+// RandomAccounts generates n random accounts with Ethereum keys, since the ante handler
+// only accepts eth_secp256k1 signatures.
+func RandomAccounts(r *rand.Rand, n int) []simtypes.Account {
+	accs := make([]simtypes.Account, n)
+
+	for i := 0; i < n; i++ {
+		// don't need that much entropy for simulation
+		privkeySeed := make([]byte, 15)
+		_, _ = r.Read(privkeySeed)
+
+		accs[i].PrivKey = &ethsecp256k1.PrivKey{
+			Key: secp256k1.GenPrivKeyFromSecret(privkeySeed).Bytes(),
+		}
+		accs[i].PubKey = accs[i].PrivKey.PubKey()
+		accs[i].Address = sdk.AccAddress(accs[i].PubKey.Address())
+
+		accs[i].ConsKey = ed25519.GenPrivKeyFromSecret(privkeySeed)
+	}
+
+	return accs
+}
@@ -8,28 +8,8 @@ import (
 	"time"
 
 	"github.com/tendermint/tendermint/libs/log"
-	"go.opencensus.io/stats"
-	"go.opencensus.io/stats/view"
-	"go.opencensus.io/tag"
 )
 
-var (
-	tagKeyStatus     = tag.MustNewKey("status")
-	mTransactions    = stats.Int64("transactions", "the number of transactions after .EndBlocker", "1")
-	viewTransactions = &view.View{
-		Name:        "transactions_processed",
-		Measure:     mTransactions,
-		Description: "The transactions processed",
-		TagKeys:     []tag.Key{tagKeyStatus},
-		Aggregation: view.Count(),
-	}
-)
-
-func ObservabilityViews() (views []*view.View) {
-	views = append(views, viewTransactions)
-	return views
-}
-
 type tpsCounter struct {
 	nSuccessful, NFailed uint64
 	reportPeriod         time.Duration
@@ -71,22 +51,14 @@ func (tpc *tpsCounter) start(ctx context.Context) error {
 			latestNFailed := atomic.LoadUint64(&tpc.NFailed)
 
 			var nTxn int64
-			nSuccess, err := tpc.recordValue(ctx, latestNSuccessful, lastNSuccessful, statusSuccess)
-			if err == nil {
-				nTxn += nSuccess
-			} else {
-				panic(err)
-			}
-			nFailed, err := tpc.recordValue(ctx, latestNFailed, lastNFailed, statusFailure)
-			if err == nil {
-				nTxn += nFailed
-			} else {
-				panic(err)
-			}
+			nTxn += tpc.recordValue(latestNSuccessful, lastNSuccessful, statusSuccess)
+			nTxn += tpc.recordValue(latestNFailed, lastNFailed, statusFailure)
+
+			secs := float64(tpsReportPeriod) / float64(time.Second)
+			metricTPS.Set(float64(nTxn) / secs)
 
 			if nTxn != 0 {
 				// Record to our logger for easy examination in the logs.
-				secs := float64(tpsReportPeriod) / float64(time.Second)
 				tpc.logger.Info("Transactions per second", "tps", float64(nTxn)/secs)
 			}
 
@@ -103,27 +75,23 @@ const (
 	statusFailure = "failure"
 )
 
-func (tpc *tpsCounter) recordValue(ctx context.Context, latest, previous uint64, status status) (int64, error) {
+func (tpc *tpsCounter) recordValue(latest, previous uint64, status status) int64 {
 	if latest < previous {
-		return 0, nil
+		return 0
 	}
 
 	n := int64(latest - previous)
 	if n < 0 {
 		// Perhaps we exceeded the uint64 limits then wrapped around, for the latest value.
 		// TODO: Perhaps log this?
-		return 0, nil
+		return 0
 	}
 
 	statusValue := "OK"
 	if status == statusFailure {
 		statusValue = "ERR"
 	}
-	ctx, err := tag.New(ctx, tag.Upsert(tagKeyStatus, statusValue))
-	if err != nil {
-		return 0, err
-	}
 
-	stats.Record(ctx, mTransactions.M(n))
-	return n, nil
+	metricTransactions.WithLabelValues(statusValue).Add(float64(n))
+	return n
 }
@@ -4,10 +4,13 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 	upgrades "github.com/tabilabs/tabi/app/upgrades"
+	v2 "github.com/tabilabs/tabi/app/upgrades/v2"
 )
 
 var (
-	plans = []upgrades.Upgrade{}
+	plans = []upgrades.Upgrade{
+		v2.Upgrade,
+	}
 )
 
 // RegisterUpgradePlans register a handler of upgrade plan
@@ -26,14 +29,21 @@ func (app *Tabi) RegisterUpgradePlans() {
 
 func (app *Tabi) appKeepers() upgrades.AppKeepers {
 	return upgrades.AppKeepers{
-		AppCodec:        app.AppCodec(),
-		BankKeeper:      app.BankKeeper,
-		AccountKeeper:   app.AccountKeeper,
-		GetKey:          app.GetKey,
-		ModuleManager:   app.mm,
-		EvmKeeper:       app.EvmKeeper,
-		FeeMarketKeeper: app.FeeMarketKeeper,
-		ReaderWriter:    app,
+		AppCodec:           app.AppCodec(),
+		BankKeeper:         app.BankKeeper,
+		AccountKeeper:      app.AccountKeeper,
+		GetKey:             app.GetKey,
+		ModuleManager:      app.mm,
+		EvmKeeper:          app.EvmKeeper,
+		FeeMarketKeeper:    app.FeeMarketKeeper,
+		CaptainsKeeper:     app.CaptainsKeeper,
+		ClaimsKeeper:       app.ClaimsKeeper,
+		TokenConvertKeeper: app.TokenConvertKeeper,
+		LimiterKeeper:      app.LimiterKeeper,
+		RevenueKeeper:      app.RevenueKeeper,
+		Erc20Keeper:        app.Erc20Keeper,
+		ICAHostKeeper:      app.ICAHostKeeper,
+		ReaderWriter:       app,
 	}
 }
 
@@ -0,0 +1,133 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/tabilabs/tabi/app/upgrades"
+	v2 "github.com/tabilabs/tabi/app/upgrades/v2"
+	tabitypes "github.com/tabilabs/tabi/types"
+	captainstypes "github.com/tabilabs/tabi/x/captains/types"
+	erc20types "github.com/tabilabs/tabi/x/erc20/types"
+	ratelimittypes "github.com/tabilabs/tabi/x/ratelimit/types"
+	revenuetypes "github.com/tabilabs/tabi/x/revenue/types"
+	tokenconverttypes "github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+// legacyVoucherBytes drops the strategy snapshot fields from an encoded voucher,
+// as they were not written before consensus version 2.
+func legacyVoucherBytes(t *testing.T, bz []byte) []byte {
+	var legacy []byte
+	for len(bz) > 0 {
+		num, _, n := protowire.ConsumeField(bz)
+		require.GreaterOrEqual(t, n, 0)
+		if num != 6 && num != 7 {
+			legacy = append(legacy, bz[:n]...)
+		}
+		bz = bz[n:]
+	}
+	return legacy
+}
+
+func TestV2Upgrade(t *testing.T) {
+	const (
+		upgradeHeight  = 5
+		voucherID      = "legacy-voucher"
+		claimablePower = 500
+	)
+
+	owner := sdk.AccAddress("legacy_voucher_owner")
+
+	// the revenue, erc20, ratelimit and interchain accounts modules are added by the upgrade
+	addedModules := []string{revenuetypes.ModuleName, erc20types.ModuleName, ratelimittypes.ModuleName, icatypes.ModuleName}
+
+	h := NewUpgradeTestHarness(t, v2.Upgrade, upgradeHeight, addedModules, func(ctx sdk.Context, app *Tabi) {
+		vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+		vm[tokenconverttypes.ModuleName] = 1
+		vm[captainstypes.ModuleName] = 1
+		app.UpgradeKeeper.SetModuleVersionMap(ctx, vm)
+
+		// claimable computing power committed before the committed totals were tracked
+		captainsStore := ctx.KVStore(app.GetKey(captainstypes.StoreKey))
+		captainsStore.Set(captainstypes.ClaimableComputingPowerStoreKey(owner), sdk.Uint64ToBigEndian(claimablePower))
+		captainsStore.Delete(captainstypes.GlobalCommittedComputingPowerKey)
+		captainsStore.Delete(captainstypes.GlobalClaimedComputingPowerKey)
+
+		// the shanghai and cancun forks were set at genesis without any effect
+		evmParams := app.EvmKeeper.GetParams(ctx)
+		genesisBlock := sdk.ZeroInt()
+		evmParams.ChainConfig.ShanghaiBlock = &genesisBlock
+		evmParams.ChainConfig.CancunBlock = &genesisBlock
+		require.NoError(t, app.EvmKeeper.SetParams(ctx, evmParams))
+
+		// vouchers created at consensus version 1 carry no strategy snapshot
+		voucher := tokenconverttypes.Voucher{
+			Id:          voucherID,
+			Owner:       owner.String(),
+			Amount:      tabitypes.NewVeTabiCoinInt64(100),
+			CreatedTime: ctx.BlockTime().Unix(),
+			Strategy:    tokenconverttypes.Strategy90Days,
+		}
+		store := ctx.KVStore(app.GetKey(tokenconverttypes.StoreKey))
+		store.Set(tokenconverttypes.VoucherStoreKey(voucherID), legacyVoucherBytes(t, app.AppCodec().MustMarshal(&voucher)))
+		// and are indexed by the bech32 owner string
+		store.Set(tokenconverttypes.VoucherByOwnerStoreKey([]byte(voucher.Owner), voucherID), tokenconverttypes.PlaceHolder)
+	})
+
+	ctx := h.RunUpgrade()
+	app := h.App
+
+	vm := app.UpgradeKeeper.GetModuleVersionMap(ctx)
+	require.Equal(t, app.mm.GetVersionMap(), vm)
+	require.Equal(t, uint64(2), vm[tokenconverttypes.ModuleName])
+	require.Equal(t, uint64(2), vm[captainstypes.ModuleName])
+	require.Equal(t, uint64(claimablePower), app.CaptainsKeeper.GetGlobalCommittedComputingPower(ctx))
+	require.Equal(t, uint64(0), app.CaptainsKeeper.GetGlobalClaimedComputingPower(ctx))
+	require.Contains(t, v2.Upgrade.StoreUpgrades.Added, revenuetypes.StoreKey)
+	require.Equal(t, revenuetypes.DefaultParams(), app.RevenueKeeper.GetParams(ctx))
+	require.Contains(t, v2.Upgrade.StoreUpgrades.Added, erc20types.StoreKey)
+	require.Equal(t, erc20types.DefaultParams(), app.Erc20Keeper.GetParams(ctx))
+	require.Contains(t, v2.Upgrade.StoreUpgrades.Added, ratelimittypes.StoreKey)
+	require.True(t, app.RateLimitKeeper.IsDenomBlocked(ctx, "channel-0", tabitypes.AttoVeTabi))
+	require.Contains(t, v2.Upgrade.StoreUpgrades.Added, icacontrollertypes.StoreKey)
+	require.Contains(t, v2.Upgrade.StoreUpgrades.Added, icahosttypes.StoreKey)
+	require.Equal(t, icahosttypes.NewParams(true, upgrades.ICAHostAllowMessages), app.ICAHostKeeper.GetParams(ctx))
+
+	chainConfig := app.EvmKeeper.GetParams(ctx).ChainConfig
+	require.Equal(t, sdk.NewInt(upgradeHeight), *chainConfig.ShanghaiBlock)
+	require.Equal(t, sdk.NewInt(upgradeHeight), *chainConfig.CancunBlock)
+
+	tabiPair, found := app.Erc20Keeper.GetTokenPairByDenom(ctx, tabitypes.AttoTabi)
+	require.True(t, found)
+	require.True(t, tabiPair.Enabled)
+	require.True(t, tabiPair.Transferable)
+
+	veTabiPair, found := app.Erc20Keeper.GetTokenPairByDenom(ctx, tabitypes.AttoVeTabi)
+	require.True(t, found)
+	require.True(t, veTabiPair.Enabled)
+	require.False(t, veTabiPair.Transferable)
+	require.NotEqual(t, tabiPair.Erc20Address, veTabiPair.Erc20Address)
+
+	_, found = app.UpgradeKeeper.GetUpgradePlan(ctx)
+	require.False(t, found)
+	require.Equal(t, int64(upgradeHeight), app.UpgradeKeeper.GetDoneHeight(ctx, v2.UpgradeName))
+
+	strategy, found := app.TokenConvertKeeper.GetStrategy(ctx, tokenconverttypes.Strategy90Days)
+	require.True(t, found)
+
+	voucher, found := app.TokenConvertKeeper.GetVoucher(ctx, voucherID)
+	require.True(t, found)
+	require.Equal(t, owner.String(), voucher.Owner)
+	require.Equal(t, strategy.Period, voucher.Period)
+	require.Equal(t, strategy.ConversionRate, voucher.ConversionRate)
+
+	tokenConvertStore := ctx.KVStore(app.GetKey(tokenconverttypes.StoreKey))
+	require.False(t, tokenConvertStore.Has(tokenconverttypes.VoucherByOwnerStoreKey([]byte(owner.String()), voucherID)))
+	require.True(t, app.TokenConvertKeeper.HasVoucherByOwner(ctx, owner, voucherID))
+}
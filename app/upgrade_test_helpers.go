@@ -0,0 +1,221 @@
+package app
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/store"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/cosmos/ibc-go/v6/testing/mock"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/tabilabs/tabi/app/upgrades"
+	"github.com/tabilabs/tabi/encoding"
+	"github.com/tabilabs/tabi/utils"
+)
+
+// UpgradeTestHarness runs a Tabi application up to the block before a
+// scheduled upgrade and restarts it the way a node does once the upgraded
+// binary is installed, so that upgrade handlers and store upgrades can be
+// tested end to end.
+type UpgradeTestHarness struct {
+	// App is the running application. It is replaced by a restarted instance
+	// when the upgrade is run.
+	App           *Tabi
+	Upgrade       upgrades.Upgrade
+	UpgradeHeight int64
+	// AddedModules are the modules added by the upgrade, which are left out
+	// of the application before the upgrade.
+	AddedModules []string
+
+	t        testing.TB
+	db       dbm.DB
+	home     string
+	chainID  string
+	time     time.Time
+	proposer []byte
+}
+
+// NewUpgradeTestHarness initializes a chain from the default genesis and
+// commits blocks up to upgradeHeight-1, the way the binary released before the
+// upgrade does: the stores added by the store upgrades are not mounted and the
+// added modules are neither initialized nor part of the module version map.
+// The setupOldState callback runs in the first block to write the pre-upgrade
+// state, e.g. by lowering module versions and storing data in its legacy
+// layout. The upgrade plan is scheduled at upgradeHeight in the same block.
+func NewUpgradeTestHarness(
+	t testing.TB,
+	upgrade upgrades.Upgrade,
+	upgradeHeight int64,
+	addedModules []string,
+	setupOldState func(ctx sdk.Context, app *Tabi),
+) *UpgradeTestHarness {
+	require.Greater(t, upgradeHeight, int64(1), "upgrade height must be after the first block")
+
+	h := &UpgradeTestHarness{
+		Upgrade:       upgrade,
+		UpgradeHeight: upgradeHeight,
+		AddedModules:  addedModules,
+		t:             t,
+		db:            dbm.NewMemDB(),
+		home:          t.TempDir(),
+		chainID:       utils.MainnetChainID + "-1",
+		time:          time.Now().UTC(),
+	}
+
+	h.App = h.newPreUpgradeApp()
+	require.NoError(t, h.App.LoadLatestVersion())
+	h.initChain()
+
+	for height := int64(1); height < upgradeHeight; height++ {
+		header := h.header(height)
+		h.App.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+		if height == 1 {
+			ctx := h.App.BaseApp.NewContext(false, header)
+			if setupOldState != nil {
+				setupOldState(ctx, h.App)
+			}
+
+			err := h.App.UpgradeKeeper.ScheduleUpgrade(ctx, upgradetypes.Plan{
+				Name:   upgrade.UpgradeName,
+				Height: upgradeHeight,
+			})
+			require.NoError(t, err)
+		}
+
+		h.App.EndBlock(abci.RequestEndBlock{Height: height})
+		h.App.Commit()
+	}
+
+	return h
+}
+
+// RunUpgrade restarts the application with the upgrade handler and the store
+// loader of the plan registered, then runs BeginBlock at the upgrade height.
+// It returns the context of the upgrade block.
+func (h *UpgradeTestHarness) RunUpgrade() sdk.Context {
+	plan, found := h.App.UpgradeKeeper.GetUpgradePlan(h.App.BaseApp.NewUncachedContext(false, h.header(h.App.LastBlockHeight())))
+	require.True(h.t, found, "upgrade plan not scheduled")
+
+	// the node dumps the upgrade info to disk when it halts at the upgrade height
+	require.NoError(h.t, h.App.UpgradeKeeper.DumpUpgradeInfoToDisk(plan.Height, plan))
+
+	h.App = h.newApp()
+	h.App.registerUpgradeHandler(
+		h.Upgrade.UpgradeName,
+		h.Upgrade.StoreUpgrades,
+		h.Upgrade.UpgradeHandlerConstructor(h.App.mm, h.App.configurator, h.App.appKeepers()),
+	)
+	require.NoError(h.t, h.App.LoadLatestVersion())
+	require.Equal(h.t, h.UpgradeHeight-1, h.App.LastBlockHeight())
+
+	header := h.header(h.UpgradeHeight)
+	h.App.BeginBlock(abci.RequestBeginBlock{Header: header})
+
+	return h.App.BaseApp.NewContext(false, header)
+}
+
+func (h *UpgradeTestHarness) newApp(baseAppOptions ...func(*baseapp.BaseApp)) *Tabi {
+	return NewTabi(
+		log.NewNopLogger(), h.db, nil, false, map[int64]bool{}, h.home, 5,
+		encoding.MakeConfig(ModuleBasics), simapp.EmptyAppOptions{},
+		baseAppOptions...,
+	)
+}
+
+// newPreUpgradeApp returns an application without the stores and the modules
+// added by the upgrade.
+func (h *UpgradeTestHarness) newPreUpgradeApp() *Tabi {
+	added := make(map[string]bool)
+	if h.Upgrade.StoreUpgrades != nil {
+		for _, name := range h.Upgrade.StoreUpgrades.Added {
+			added[name] = true
+		}
+	}
+
+	app := h.newApp(func(bapp *baseapp.BaseApp) {
+		bapp.SetCMS(preUpgradeStore{
+			CommitMultiStore: store.NewCommitMultiStore(h.db),
+			added:            added,
+		})
+	})
+
+	// the module manager skips the modules which are not registered
+	for _, name := range h.AddedModules {
+		delete(app.mm.Modules, name)
+	}
+
+	return app
+}
+
+// preUpgradeStore is the multistore of the application before the upgrade,
+// which does not mount the stores added by the upgrade.
+type preUpgradeStore struct {
+	storetypes.CommitMultiStore
+
+	added map[string]bool
+}
+
+func (s preUpgradeStore) MountStoreWithDB(key storetypes.StoreKey, typ storetypes.StoreType, db dbm.DB) {
+	if s.added[key.Name()] {
+		return
+	}
+	s.CommitMultiStore.MountStoreWithDB(key, typ, db)
+}
+
+func (h *UpgradeTestHarness) header(height int64) tmproto.Header {
+	return tmproto.Header{
+		ChainID:         h.chainID,
+		Height:          height,
+		Time:            h.time.Add(time.Duration(height) * time.Second),
+		ProposerAddress: h.proposer,
+	}
+}
+
+func (h *UpgradeTestHarness) initChain() {
+	privVal := mock.NewPV()
+	pubKey, err := privVal.GetPubKey()
+	require.NoError(h.t, err)
+
+	validator := tmtypes.NewValidator(pubKey, 1)
+	valSet := tmtypes.NewValidatorSet([]*tmtypes.Validator{validator})
+	// the EVM resolves the coinbase from the block proposer
+	h.proposer = validator.Address
+
+	senderPrivKey := secp256k1.GenPrivKey()
+	acc := authtypes.NewBaseAccount(senderPrivKey.PubKey().Address().Bytes(), senderPrivKey.PubKey(), 0, 0)
+	balance := banktypes.Balance{
+		Address: acc.GetAddress().String(),
+		Coins:   sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(100000000000000))),
+	}
+
+	genesisState := NewDefaultGenesisState()
+	for _, name := range h.AddedModules {
+		delete(genesisState, name)
+	}
+	genesisState = GenesisStateWithValSet(h.App, genesisState, valSet, []authtypes.GenesisAccount{acc}, balance)
+	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
+	require.NoError(h.t, err)
+
+	h.App.InitChain(abci.RequestInitChain{
+		ChainId:         h.chainID,
+		Time:            h.time,
+		Validators:      []abci.ValidatorUpdate{},
+		ConsensusParams: DefaultConsensusParams,
+		AppStateBytes:   stateBytes,
+	})
+}
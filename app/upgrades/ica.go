@@ -0,0 +1,36 @@
+package upgrades
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	captainstypes "github.com/tabilabs/tabi/x/captains/types"
+	claimstypes "github.com/tabilabs/tabi/x/claims/types"
+	tokenconverttypes "github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+// ICAHostAllowMessages are the messages the interchain accounts controlled
+// from other chains can execute, so that the captain nodes, their claims and
+// the vetabi conversions can be managed remotely. The governance messages are
+// left out.
+var ICAHostAllowMessages = []string{
+	sdk.MsgTypeURL(&banktypes.MsgSend{}),
+
+	sdk.MsgTypeURL(&captainstypes.MsgCreateCaptainNode{}),
+	sdk.MsgTypeURL(&captainstypes.MsgCommitReport{}),
+	sdk.MsgTypeURL(&captainstypes.MsgAddAuthorizedMembers{}),
+	sdk.MsgTypeURL(&captainstypes.MsgRemoveAuthorizedMembers{}),
+	sdk.MsgTypeURL(&captainstypes.MsgUpdateSaleLevel{}),
+	sdk.MsgTypeURL(&captainstypes.MsgCommitComputingPower{}),
+	sdk.MsgTypeURL(&captainstypes.MsgClaimComputingPower{}),
+	sdk.MsgTypeURL(&captainstypes.MsgSubmitNodeMisbehavior{}),
+	sdk.MsgTypeURL(&captainstypes.MsgUnjailNode{}),
+
+	sdk.MsgTypeURL(&claimstypes.MsgClaims{}),
+
+	sdk.MsgTypeURL(&tokenconverttypes.MsgConvertTabi{}),
+	sdk.MsgTypeURL(&tokenconverttypes.MsgConvertVetabi{}),
+	sdk.MsgTypeURL(&tokenconverttypes.MsgWithdrawTabi{}),
+	sdk.MsgTypeURL(&tokenconverttypes.MsgCancelConvert{}),
+	sdk.MsgTypeURL(&tokenconverttypes.MsgTransferVoucher{}),
+	sdk.MsgTypeURL(&tokenconverttypes.MsgSetAutoSettle{}),
+}
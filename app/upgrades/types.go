@@ -9,8 +9,15 @@ import (
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahostkeeper "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/keeper"
+	captainskeeper "github.com/tabilabs/tabi/x/captains/keeper"
+	claimskeeper "github.com/tabilabs/tabi/x/claims/keeper"
+	erc20keeper "github.com/tabilabs/tabi/x/erc20/keeper"
 	evmkeeper "github.com/tabilabs/tabi/x/evm/keeper"
 	feemarketkeeper "github.com/tabilabs/tabi/x/feemarket/keeper"
+	limiterkeeper "github.com/tabilabs/tabi/x/limiter/keeper"
+	revenuekeeper "github.com/tabilabs/tabi/x/revenue/keeper"
+	tokenconvertkeeper "github.com/tabilabs/tabi/x/token-convert/keeper"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -35,13 +42,20 @@ type ConsensusParamsReaderWriter interface {
 }
 
 type AppKeepers struct {
-	AppCodec        codec.Codec
-	AccountKeeper   authkeeper.AccountKeeper
-	BankKeeper      bankkeeper.Keeper
-	GetKey          func(moduleName string) *storetypes.KVStoreKey
-	ModuleManager   *module.Manager
-	EvmKeeper       *evmkeeper.Keeper
-	FeeMarketKeeper feemarketkeeper.Keeper
+	AppCodec           codec.Codec
+	AccountKeeper      authkeeper.AccountKeeper
+	BankKeeper         bankkeeper.Keeper
+	GetKey             func(moduleName string) *storetypes.KVStoreKey
+	ModuleManager      *module.Manager
+	EvmKeeper          *evmkeeper.Keeper
+	FeeMarketKeeper    feemarketkeeper.Keeper
+	CaptainsKeeper     captainskeeper.Keeper
+	ClaimsKeeper       claimskeeper.Keeper
+	TokenConvertKeeper tokenconvertkeeper.Keeper
+	LimiterKeeper      limiterkeeper.Keeper
+	RevenueKeeper      revenuekeeper.Keeper
+	Erc20Keeper        erc20keeper.Keeper
+	ICAHostKeeper      icahostkeeper.Keeper
 
 	ReaderWriter ConsensusParamsReaderWriter
 }
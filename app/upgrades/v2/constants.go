@@ -0,0 +1,32 @@
+package v2
+
+import (
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/controller/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+
+	"github.com/tabilabs/tabi/app/upgrades"
+	erc20types "github.com/tabilabs/tabi/x/erc20/types"
+	ratelimittypes "github.com/tabilabs/tabi/x/ratelimit/types"
+	revenuetypes "github.com/tabilabs/tabi/x/revenue/types"
+)
+
+// UpgradeName defines the on-chain upgrade name for the Tabi v2 upgrade.
+const UpgradeName = "v2"
+
+// Upgrade defines the Tabi v2 upgrade. It adds the revenue, erc20, ratelimit and
+// interchain accounts stores and runs the module migrations, see the CHANGELOG
+// for the changes it ships.
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:               UpgradeName,
+	UpgradeHandlerConstructor: CreateUpgradeHandler,
+	StoreUpgrades: &store.StoreUpgrades{
+		Added: []string{
+			revenuetypes.StoreKey,
+			erc20types.StoreKey,
+			ratelimittypes.StoreKey,
+			icacontrollertypes.StoreKey,
+			icahosttypes.StoreKey,
+		},
+	},
+}
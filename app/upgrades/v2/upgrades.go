@@ -0,0 +1,68 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icahosttypes "github.com/cosmos/ibc-go/v6/modules/apps/27-interchain-accounts/host/types"
+
+	"github.com/tabilabs/tabi/app/upgrades"
+	tabitypes "github.com/tabilabs/tabi/types"
+	erc20keeper "github.com/tabilabs/tabi/x/erc20/keeper"
+	evmkeeper "github.com/tabilabs/tabi/x/evm/keeper"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v2
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		logger.Debug("running module migrations ...")
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return nil, err
+		}
+
+		logger.Debug("registering the ERC-20 representations of the native coins ...")
+		if err := registerNativeCoins(ctx, keepers.Erc20Keeper); err != nil {
+			return nil, err
+		}
+
+		logger.Debug("activating the shanghai and cancun forks of the evm ...")
+		if err := activateForks(ctx, keepers.EvmKeeper); err != nil {
+			return nil, err
+		}
+
+		// the module migrations initialize the host allowing all the messages
+		logger.Debug("restricting the interchain accounts host messages ...")
+		keepers.ICAHostKeeper.SetParams(ctx, icahosttypes.NewParams(true, upgrades.ICAHostAllowMessages))
+
+		return vm, nil
+	}
+}
+
+// registerNativeCoins deploys the ERC-20 representations of tabi and vetabi.
+// vetabi tokens are not transferable, so that they can only be converted back
+// to the locked coin.
+func registerNativeCoins(ctx sdk.Context, k erc20keeper.Keeper) error {
+	if _, err := k.RegisterCoin(ctx, tabitypes.AttoTabi, "Tabi", "TABI", tabitypes.BaseDenomUnit, true); err != nil {
+		return err
+	}
+
+	_, err := k.RegisterCoin(ctx, tabitypes.AttoVeTabi, "veTabi", "VETABI", tabitypes.BaseDenomUnit, false)
+	return err
+}
+
+// activateForks schedules the Shanghai and Cancun forks of the EVM at the
+// upgrade height, which enables the PUSH0, TLOAD, TSTORE and MCOPY opcodes.
+func activateForks(ctx sdk.Context, k *evmkeeper.Keeper) error {
+	params := k.GetParams(ctx)
+	forkBlock := sdk.NewInt(ctx.BlockHeight())
+	params.ChainConfig.ShanghaiBlock = &forkBlock
+	params.ChainConfig.CancunBlock = &forkBlock
+	return k.SetParams(ctx, params)
+}
@@ -158,7 +158,7 @@ func LegacyEIP712Cmd() *cobra.Command {
 				return errors.Wrap(err, "invalid chain ID passed as argument")
 			}
 
-			td, err := eip712.LegacyWrapTxToTypedData(clientCtx.Codec, chainID.Uint64(), stdTx.GetMsgs()[0], txBytes, nil)
+			td, err := eip712.LegacyWrapTxToTypedData(clientCtx.Codec, chainID.Uint64(), stdTx.GetMsgs(), txBytes, nil)
 			if err != nil {
 				return errors.Wrap(err, "wrap tx to typed data")
 			}
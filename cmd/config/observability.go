@@ -1,38 +1,44 @@
-
 package config
 
-func EnableObservability() error {
-	return nil
-	// if true {
-	// Temporarily disabling this until we can configure out port reuse
-	// fast enough or enabling observability through the config.
-	// return nil
-	// }
-
-	// pe, err := prometheus.NewExporter(prometheus.Options{
-	// 	Namespace: "tabid",
-	// })
-	// if err != nil {
-	// 	return fmt.Errorf("cmd/config: failed to create the OpenCensus Prometheus exporter: %w", err)
-	// }
-
-	// views := app.ObservabilityViews()
-	// if err := view.Register(views...); err != nil {
-	// 	return fmt.Errorf("cmd/config: failed to register OpenCensus views: %w", err)
-	// }
-	// view.RegisterExporter(pe)
-
-	// mux := http.NewServeMux()
-	// mux.Handle("/metrics", pe)
-
-	// // TODO: Derive the Prometheus observability exporter from the Tabi config.
-	// addr := ":8877"
-	// go func() {
-	// 	println("Serving the Prometheus observability exporter at", addr)
-	// 	if err := http.ListenAndServe(addr, mux); err != nil {
-	// 		panic(err)
-	// 	}
-	// }()
-
-	// return nil
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// ObservabilityMetricsPath is the path the Prometheus observability exporter serves the metrics on.
+const ObservabilityMetricsPath = "/metrics"
+
+// EnableObservability starts the Prometheus observability exporter at the given
+// address, serving the metrics registered in the Prometheus default registry.
+// The address is bound before returning, so that a port already in use is
+// reported as an error instead of failing in the background.
+func EnableObservability(addr string, logger log.Logger) (*http.Server, error) {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("cmd/config: failed to listen on the observability address %s: %w", addr, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(ObservabilityMetricsPath, promhttp.HandlerFor(prometheus.DefaultGatherer, promhttp.HandlerOpts{}))
+
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		logger.Info("serving the Prometheus observability exporter", "address", ln.Addr().String())
+		if err := srv.Serve(ln); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			logger.Error("observability exporter stopped", "error", err.Error())
+		}
+	}()
+
+	return srv, nil
 }
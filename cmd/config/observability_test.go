@@ -0,0 +1,46 @@
+package config
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+func TestEnableObservability(t *testing.T) {
+	gauge := promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "tabid",
+		Name:      "observability_test",
+		Help:      "A gauge registered by the observability test",
+	})
+	gauge.Set(42)
+
+	// reserve a free port
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	srv, err := EnableObservability(addr, log.NewNopLogger())
+	require.NoError(t, err)
+	t.Cleanup(func() { srv.Close() })
+
+	resp, err := http.Get("http://" + addr + ObservabilityMetricsPath)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.Contains(t, string(body), "tabid_observability_test 42")
+
+	// a second exporter on the same address must fail cleanly
+	_, err = EnableObservability(addr, log.NewNopLogger())
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "failed to listen on the observability address")
+}
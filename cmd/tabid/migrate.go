@@ -2,6 +2,7 @@
 package main
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -18,12 +19,79 @@ import (
 	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
 
 	"github.com/tabilabs/tabi/utils"
+	evmv5 "github.com/tabilabs/tabi/x/evm/migrations/v5"
+	evmtypes "github.com/tabilabs/tabi/x/evm/types"
+	feemarketv5 "github.com/tabilabs/tabi/x/feemarket/migrations/v5"
+	feemarkettypes "github.com/tabilabs/tabi/x/feemarket/types"
 )
 
 // FlagGenesisTime defines the genesis time in string format
 const FlagGenesisTime = "genesis-time"
 
-var migrationMap = genutiltypes.MigrationMap{}
+// Genesis versions understood by the migrate command. A version is named after
+// the x/evm consensus version whose genesis layout it matches.
+const (
+	GenesisVersionV4 = "v4"
+	GenesisVersionV5 = "v5"
+)
+
+// migrationMap holds the genesis migrations keyed by target version. Testnet
+// migrations are prefixed with "t".
+var migrationMap = genutiltypes.MigrationMap{
+	GenesisVersionV5:       migrateV5,
+	"t" + GenesisVersionV5: migrateV5,
+}
+
+// migrateV5 migrates a v4 genesis app state to v5, flattening the x/evm extra
+// EIPs params and setting the x/feemarket min gas price controller and fee
+// split params.
+func migrateV5(appState genutiltypes.AppMap, clientCtx client.Context) genutiltypes.AppMap {
+	if appState[evmtypes.ModuleName] != nil {
+		newEvmState, err := evmv5.MigrateJSON(clientCtx.Codec, appState[evmtypes.ModuleName])
+		if err != nil {
+			panic(fmt.Errorf("failed to migrate %s genesis state: %w", evmtypes.ModuleName, err))
+		}
+
+		appState[evmtypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(newEvmState)
+	}
+
+	if appState[feemarkettypes.ModuleName] != nil {
+		newFeeMarketState, err := feemarketv5.MigrateJSON(clientCtx.Codec, appState[feemarkettypes.ModuleName])
+		if err != nil {
+			panic(fmt.Errorf("failed to migrate %s genesis state: %w", feemarkettypes.ModuleName, err))
+		}
+
+		appState[feemarkettypes.ModuleName] = clientCtx.Codec.MustMarshalJSON(newFeeMarketState)
+	}
+
+	return appState
+}
+
+// DetectGenesisVersion infers the version of a genesis app state from the
+// layout of the module states that changed across versions.
+func DetectGenesisVersion(appState genutiltypes.AppMap) (string, error) {
+	evmState, ok := appState[evmtypes.ModuleName]
+	if !ok {
+		return "", fmt.Errorf("genesis app state has no %s module state", evmtypes.ModuleName)
+	}
+
+	var genState struct {
+		Params struct {
+			ExtraEIPs json.RawMessage `json:"extra_eips"`
+		} `json:"params"`
+	}
+	if err := json.Unmarshal(evmState, &genState); err != nil {
+		return "", fmt.Errorf("failed to JSON unmarshal %s genesis state: %w", evmtypes.ModuleName, err)
+	}
+
+	// v4 wraps the extra EIPs into an object while v5 stores them as a list
+	extraEIPs := bytes.TrimSpace(genState.Params.ExtraEIPs)
+	if len(extraEIPs) > 0 && extraEIPs[0] == '{' {
+		return GenesisVersionV4, nil
+	}
+
+	return GenesisVersionV5, nil
+}
 
 // GetMigrationCallback returns a MigrationCallback for a given version.
 func GetMigrationCallback(version, chainID string) genutiltypes.MigrationCallback {
@@ -41,7 +109,7 @@ func MigrateGenesisCmd() *cobra.Command {
 		Short: "Migrate genesis to a specified target version",
 		Long:  "Migrate the source genesis into the target version and print to STDOUT.",
 		Example: fmt.Sprintf(
-			"%s migrate v3 /path/to/genesis.json --chain-id=tabi_9788-2 --genesis-time=2022-04-01T17:00:00Z",
+			"%s migrate v5 /path/to/genesis.json --chain-id=tabi_10123-1 --genesis-time=2022-04-01T17:00:00Z",
 			version.AppName,
 		),
 		Args: cobra.ExactArgs(2),
@@ -66,11 +134,20 @@ func MigrateGenesisCmd() *cobra.Command {
 				genDoc.ChainID = chainID
 			}
 
-			migrationFn := GetMigrationCallback(target, chainID)
+			migrationFn := GetMigrationCallback(target, genDoc.ChainID)
 			if migrationFn == nil {
 				return fmt.Errorf("unknown migration function for version: %s", target)
 			}
 
+			source, err := DetectGenesisVersion(initialState)
+			if err != nil {
+				return fmt.Errorf("failed to detect genesis version: %w", err)
+			}
+
+			if source == target {
+				return fmt.Errorf("genesis is already at version %s", target)
+			}
+
 			newGenState := migrationFn(initialState, clientCtx)
 
 			appState, err := json.Marshal(newGenState)
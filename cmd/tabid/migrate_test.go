@@ -0,0 +1,191 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	genutiltypes "github.com/cosmos/cosmos-sdk/x/genutil/types"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/tabilabs/tabi/app"
+	"github.com/tabilabs/tabi/encoding"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of the migrate command")
+
+func executeMigrateCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	clientCtx := client.Context{}.
+		WithCodec(encCfg.Codec).
+		WithInterfaceRegistry(encCfg.InterfaceRegistry).
+		WithTxConfig(encCfg.TxConfig).
+		WithLegacyAmino(encCfg.Amino)
+
+	out := new(bytes.Buffer)
+	cmd := MigrateGenesisCmd()
+	cmd.SetOut(out)
+	cmd.SetErr(out)
+	cmd.SetArgs(args)
+
+	ctx := context.WithValue(context.Background(), client.ClientContextKey, &clientCtx)
+	err := cmd.ExecuteContext(ctx)
+
+	return out.String(), err
+}
+
+func TestMigrateGenesisGolden(t *testing.T) {
+	testCases := []struct {
+		name    string
+		input   string
+		chainID string
+		golden  string
+	}{
+		{
+			name:   "mainnet v4 to v5",
+			input:  "genesis_v4_mainnet.json",
+			golden: "genesis_v5_mainnet.golden",
+		},
+		{
+			name:   "testnet v4 to tv5",
+			input:  "genesis_v4_testnet.json",
+			golden: "genesis_v5_testnet.golden",
+		},
+		{
+			name:    "chain-id flag overrides the genesis chain-id",
+			input:   "genesis_v4_mainnet.json",
+			chainID: "tabi_10124-2",
+			golden:  "genesis_v5_chain_id_override.golden",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := []string{GenesisVersionV5, filepath.Join("testdata", tc.input)}
+			if tc.chainID != "" {
+				args = append(args, "--chain-id", tc.chainID)
+			}
+
+			out, err := executeMigrateCmd(t, args...)
+			require.NoError(t, err)
+
+			goldenPath := filepath.Join("testdata", tc.golden)
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(goldenPath, []byte(out), 0o600))
+			}
+
+			expected, err := os.ReadFile(goldenPath)
+			require.NoError(t, err)
+			require.Equal(t, string(expected), out)
+
+			requireValidGenesis(t, out)
+		})
+	}
+}
+
+// requireValidGenesis checks the migrated module states pass the genesis
+// validation of the app, the modules missing from the genesis get their
+// default state.
+func requireValidGenesis(t *testing.T, genesis string) {
+	t.Helper()
+
+	genDoc, err := tmtypes.GenesisDocFromJSON([]byte(genesis))
+	require.NoError(t, err)
+
+	var migrated genutiltypes.AppMap
+	require.NoError(t, json.Unmarshal(genDoc.AppState, &migrated))
+
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	appState := app.ModuleBasics.DefaultGenesis(encCfg.Codec)
+	for module, state := range migrated {
+		appState[module] = state
+	}
+
+	require.NoError(t, app.ModuleBasics.ValidateGenesis(encCfg.Codec, encCfg.TxConfig, appState))
+}
+
+func TestMigrateGenesisErrors(t *testing.T) {
+	testCases := []struct {
+		name   string
+		args   []string
+		errMsg string
+	}{
+		{
+			name:   "unknown target version",
+			args:   []string{"v9", filepath.Join("testdata", "genesis_v4_mainnet.json")},
+			errMsg: "unknown migration function for version: v9",
+		},
+		{
+			name:   "genesis already migrated",
+			args:   []string{GenesisVersionV5, filepath.Join("testdata", "genesis_v5_mainnet.golden")},
+			errMsg: "genesis is already at version v5",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := executeMigrateCmd(t, tc.args...)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), tc.errMsg)
+		})
+	}
+}
+
+func TestDetectGenesisVersion(t *testing.T) {
+	testCases := []struct {
+		name     string
+		evmState string
+		expPass  bool
+		expected string
+	}{
+		{
+			name:     "v4 wrapped extra eips",
+			evmState: `{"accounts":[],"params":{"extra_eips":{"eips":["3855"]}}}`,
+			expPass:  true,
+			expected: GenesisVersionV4,
+		},
+		{
+			name:     "v5 extra eips list",
+			evmState: `{"accounts":[],"params":{"extra_eips":["3855"]}}`,
+			expPass:  true,
+			expected: GenesisVersionV5,
+		},
+		{
+			name:     "v5 omitted extra eips",
+			evmState: `{"accounts":[],"params":{}}`,
+			expPass:  true,
+			expected: GenesisVersionV5,
+		},
+		{
+			name:     "missing evm state",
+			evmState: "",
+			expPass:  false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			appState := genutiltypes.AppMap{}
+			if tc.evmState != "" {
+				appState["evm"] = json.RawMessage(tc.evmState)
+			}
+
+			version, err := DetectGenesisVersion(appState)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, version)
+		})
+	}
+}
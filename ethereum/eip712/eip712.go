@@ -11,12 +11,11 @@ func WrapTxToTypedData(
 	data []byte,
 ) (apitypes.TypedData, error) {
 	messagePayload, err := createEIP712MessagePayload(data)
-	message := messagePayload.message
 	if err != nil {
 		return apitypes.TypedData{}, err
 	}
 
-	types, err := createEIP712Types(messagePayload)
+	types, message, err := createEIP712Types(messagePayload)
 	if err != nil {
 		return apitypes.TypedData{}, err
 	}
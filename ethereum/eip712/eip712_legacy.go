@@ -33,7 +33,7 @@ const (
 func LegacyWrapTxToTypedData(
 	cdc codectypes.AnyUnpacker,
 	chainID uint64,
-	msg sdk.Msg,
+	msgs []sdk.Msg,
 	data []byte,
 	feeDelegation *FeeDelegationOptions,
 ) (apitypes.TypedData, error) {
@@ -51,7 +51,7 @@ func LegacyWrapTxToTypedData(
 		Salt:              "0x3132333435363738313233343536373831323334353637383132333435363738",
 	}
 
-	msgTypes, err := extractMsgTypes(cdc, "MsgValue", msg)
+	msgTypes, err := extractTxMsgTypes(cdc, msgs, txData)
 	if err != nil {
 		return apitypes.TypedData{}, err
 	}
@@ -70,6 +70,13 @@ func LegacyWrapTxToTypedData(
 			{Name: "amount", Type: "Coin[]"},
 			{Name: "gas", Type: "string"},
 		}
+
+		// the fee payer and granter are part of the sign doc when the fees are delegated
+		for _, fieldName := range []string{feeGranterField, feePayerField} {
+			if _, ok := feeInfo[fieldName]; ok {
+				msgTypes["Fee"] = append(msgTypes["Fee"], apitypes.Type{Name: fieldName, Type: "string"})
+			}
+		}
 	}
 
 	typedData := apitypes.TypedData{
@@ -82,8 +89,113 @@ func LegacyWrapTxToTypedData(
 	return typedData, nil
 }
 
-func extractMsgTypes(cdc codectypes.AnyUnpacker, msgTypeName string, msg sdk.Msg) (apitypes.Types, error) {
-	rootTypes := apitypes.Types{
+// extractTxMsgTypes returns the EIP-712 types of the tx msgs. The msgs of a single type
+// share the MsgValue type of the msgs array. Since the elements of an EIP-712 array share
+// a single type, the msgs of different types are flattened into msg{i} fields instead,
+// each with its own indexed types.
+func extractTxMsgTypes(cdc codectypes.AnyUnpacker, msgs []sdk.Msg, txData map[string]interface{}) (apitypes.Types, error) {
+	msgsData, ok := txData[payloadMsgsField].([]interface{})
+	if !ok || len(msgs) == 0 || len(msgsData) != len(msgs) {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidType, "cannot parse msgs from tx data")
+	}
+
+	if !legacyHasMixedMsgTypes(msgsData) {
+		return extractMsgTypes(cdc, "MsgValue", msgs[0], legacyMsgValue(msgsData[0]))
+	}
+
+	rootTypes := legacyRootTypes()
+
+	var txTypes []apitypes.Type
+	for _, field := range rootTypes[txField] {
+		if field.Name != payloadMsgsField {
+			txTypes = append(txTypes, field)
+			continue
+		}
+
+		for i, msg := range msgs {
+			msgTypes, err := extractMsgTypes(cdc, "MsgValue", msg, legacyMsgValue(msgsData[i]))
+			if err != nil {
+				return nil, err
+			}
+
+			// the types of the msg are indexed, so that they do not collide with the
+			// types of the other msgs
+			for typeDef, types := range msgTypes {
+				if _, isRootType := rootTypes[typeDef]; isRootType {
+					continue
+				}
+
+				indexedTypes := make([]apitypes.Type, len(types))
+				for j, typ := range types {
+					typeName := strings.TrimSuffix(typ.Type, "[]")
+					if _, isMsgType := msgTypes[typeName]; isMsgType {
+						if _, isRootType := rootTypes[typeName]; !isRootType {
+							typ.Type = typeDefWithIndex(typeName, i) + strings.TrimPrefix(typ.Type, typeName)
+						}
+					}
+					indexedTypes[j] = typ
+				}
+
+				rootTypes[typeDefWithIndex(typeDef, i)] = indexedTypes
+			}
+
+			txTypes = append(txTypes, apitypes.Type{Name: msgFieldForIndex(i), Type: typeDefWithIndex("Msg", i)})
+			txData[msgFieldForIndex(i)] = msgsData[i]
+		}
+	}
+
+	rootTypes[txField] = txTypes
+	delete(txData, payloadMsgsField)
+
+	return rootTypes, nil
+}
+
+// legacyHasMixedMsgTypes returns whether the msgs of the tx data are of different types.
+func legacyHasMixedMsgTypes(msgsData []interface{}) bool {
+	var msgType interface{}
+	for i, msgData := range msgsData {
+		msg, _ := msgData.(map[string]interface{})
+		if i > 0 && msg[msgTypeField] != msgType {
+			return true
+		}
+		msgType = msg[msgTypeField]
+	}
+
+	return false
+}
+
+// legacyMsgValue returns the value of a msg of the tx data, or nil when it can
+// not be found.
+func legacyMsgValue(msgData interface{}) map[string]interface{} {
+	msg, ok := msgData.(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	value, _ := msg["value"].(map[string]interface{})
+	return value
+}
+
+// extractMsgTypes returns the EIP-712 types of the msg, the message is its
+// decoded value within the EIP-712 message.
+func extractMsgTypes(cdc codectypes.AnyUnpacker, msgTypeName string, msg sdk.Msg, message map[string]interface{}) (apitypes.Types, error) {
+	rootTypes := legacyRootTypes()
+	rootTypes["Msg"] = []apitypes.Type{
+		{Name: "type", Type: "string"},
+		{Name: "value", Type: msgTypeName},
+	}
+	rootTypes[msgTypeName] = []apitypes.Type{}
+
+	if err := walkFields(cdc, rootTypes, msgTypeName, msg, message); err != nil {
+		return nil, err
+	}
+
+	return rootTypes, nil
+}
+
+// legacyRootTypes returns the EIP-712 types of the tx, but for its msgs.
+func legacyRootTypes() apitypes.Types {
+	return apitypes.Types{
 		"EIP712Domain": {
 			{
 				Name: "name",
@@ -124,10 +236,6 @@ func extractMsgTypes(cdc codectypes.AnyUnpacker, msgTypeName string, msg sdk.Msg
 			{Name: "denom", Type: "string"},
 			{Name: "amount", Type: "string"},
 		},
-		"Msg": {
-			{Name: "type", Type: "string"},
-			{Name: "value", Type: msgTypeName},
-		},
 		"Content": {
 			{Name: "type", Type: "string"},
 			{Name: "value", Type: "TextProposal"},
@@ -136,17 +244,10 @@ func extractMsgTypes(cdc codectypes.AnyUnpacker, msgTypeName string, msg sdk.Msg
 			{Name: "title", Type: "string"},
 			{Name: "description", Type: "string"},
 		},
-		msgTypeName: {},
-	}
-
-	if err := walkFields(cdc, rootTypes, msgTypeName, msg); err != nil {
-		return nil, err
 	}
-
-	return rootTypes, nil
 }
 
-func walkFields(cdc codectypes.AnyUnpacker, typeMap apitypes.Types, rootType string, in interface{}) (err error) {
+func walkFields(cdc codectypes.AnyUnpacker, typeMap apitypes.Types, rootType string, in interface{}, message map[string]interface{}) (err error) {
 	defer doRecover(&err)
 
 	t := reflect.TypeOf(in)
@@ -164,7 +265,7 @@ func walkFields(cdc codectypes.AnyUnpacker, typeMap apitypes.Types, rootType str
 		break
 	}
 
-	return legacyTraverseFields(cdc, typeMap, rootType, typeDefPrefix, t, v)
+	return legacyTraverseFields(cdc, typeMap, rootType, typeDefPrefix, t, v, message)
 }
 
 type cosmosAnyWrapper struct {
@@ -179,6 +280,7 @@ func legacyTraverseFields(
 	prefix string,
 	t reflect.Type,
 	v reflect.Value,
+	message map[string]interface{},
 ) error {
 	n := t.NumField()
 
@@ -213,8 +315,33 @@ func legacyTraverseFields(
 			}
 		}
 
-		// If field is an empty value, do not include in types, since it will not be present in the object
-		if field.IsZero() {
+		fieldMessage, found := message[fieldName]
+
+		// If field is an empty value, do not include in types, since it will not be present in the object,
+		// unless Amino JSON encodes it, which is the case of the non-nullable fields.
+		if field.IsZero() && !found {
+			continue
+		}
+
+		// Amino JSON encodes the unset non-nullable repeated fields as null,
+		// which has no EIP-712 type. They are typed and encoded as empty arrays,
+		// the other null fields are left out.
+		if found && fieldMessage == nil {
+			if !isRepeatedField(t.Field(i)) {
+				delete(message, fieldName)
+				continue
+			}
+
+			typeDef := rootType
+			if prefix != typeDefPrefix {
+				typeDef = sanitizeTypedef(prefix)
+			}
+			typeMap[typeDef] = append(typeMap[typeDef], apitypes.Type{
+				Name: fieldName,
+				Type: emptyArrayType,
+			})
+			message[fieldName] = []interface{}{}
+
 			continue
 		}
 
@@ -253,6 +380,10 @@ func legacyTraverseFields(
 			field = field.Index(0)
 			isCollection = true
 
+			if elems, ok := fieldMessage.([]interface{}); ok && len(elems) > 0 {
+				fieldMessage = elems[0]
+			}
+
 			if fieldType == cosmosAnyType {
 				if fieldType, field, err = unpackAny(cdc, field); err != nil {
 					return err
@@ -332,7 +463,13 @@ func legacyTraverseFields(
 				})
 			}
 
-			if err := legacyTraverseFields(cdc, typeMap, rootType, fieldPrefix, fieldType, field); err != nil {
+			// an object without any encoded field still needs its type to be defined
+			if _, defined := typeMap[sanitizeTypedef(fieldPrefix)]; !defined {
+				typeMap[sanitizeTypedef(fieldPrefix)] = []apitypes.Type{}
+			}
+
+			subMessage, _ := fieldMessage.(map[string]interface{})
+			if err := legacyTraverseFields(cdc, typeMap, rootType, fieldPrefix, fieldType, field, subMessage); err != nil {
 				return err
 			}
 
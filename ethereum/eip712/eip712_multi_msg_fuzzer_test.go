@@ -0,0 +1,125 @@
+package eip712_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	rand "github.com/tendermint/tendermint/libs/rand"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/tabilabs/tabi/cmd/config"
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/utils"
+	claimstypes "github.com/tabilabs/tabi/x/claims/types"
+	tokenconverttypes "github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+const (
+	multiMsgFuzzTestName = "MultiMsg"
+	maxNumMsgsPerTx      = 6
+	numRandomMsgTypes    = 7
+)
+
+// TestRandomMultiMessageTypedData generates random single-signer transactions mixing different
+// message types, optionally with delegated fees, and ensures that their EIP-712 representation
+// can be signed and verified in both sign modes.
+func (suite *EIP712TestSuite) TestRandomMultiMessageTypedData() {
+	suite.SetupTest()
+
+	// Re-seed rand generator
+	rand.Seed(rand.Int64())
+
+	signModes := []signing.SignMode{
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	}
+
+	for i := 0; i < params.numTestObjects; i++ {
+		suite.Run(fmt.Sprintf("%v%d", multiMsgFuzzTestName, i), func() {
+			privKey, pubKey := suite.createTestKeyPair()
+			signer := sdk.AccAddress(pubKey.Address())
+
+			numMsgs := suite.createRandomIntInRange(1, maxNumMsgsPerTx+1)
+			msgs := make([]sdk.Msg, numMsgs)
+			for j := range msgs {
+				msgs[j] = suite.createRandomMsg(signer)
+			}
+
+			txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+			txBuilder.SetGasLimit(uint64(suite.createRandomIntInRange(20000, 2000000)))
+			txBuilder.SetFeeAmount(suite.makeCoins(suite.denom, math.NewInt(int64(suite.createRandomIntInRange(1, 1000000)))))
+			if suite.createRandomBoolean() {
+				txBuilder.SetFeeGranter(suite.createTestAddress())
+			}
+			if suite.createRandomBoolean() {
+				txBuilder.SetFeePayer(signer)
+			}
+
+			err := txBuilder.SetMsgs(msgs...)
+			suite.Require().NoError(err)
+
+			signMode := signModes[rand.Intn(len(signModes))]
+			sequence := uint64(rand.Intn(1000))
+			txSig := signing.SignatureV2{
+				PubKey: pubKey,
+				Data: &signing.SingleSignatureData{
+					SignMode:  signMode,
+					Signature: nil,
+				},
+				Sequence: sequence,
+			}
+			err = txBuilder.SetSignatures(txSig)
+			suite.Require().NoError(err)
+
+			signerData := authsigning.SignerData{
+				ChainID:       utils.TestnetChainID + "-1",
+				AccountNumber: uint64(rand.Intn(1000)),
+				Sequence:      sequence,
+				PubKey:        pubKey,
+				Address:       sdk.MustBech32ifyAddressBytes(config.Bech32Prefix, pubKey.Bytes()),
+			}
+
+			bz, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+				signMode,
+				signerData,
+				txBuilder.GetTx(),
+			)
+			suite.Require().NoError(err)
+
+			suite.verifyEIP712SignatureVerification(true, *privKey, *pubKey, bz)
+
+			if signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+				suite.verifySignDocFlattening(bz)
+				suite.verifyBasicTypedData(bz)
+			}
+		})
+	}
+}
+
+// createRandomMsg creates a message of a random type signed by signer. All the fields are set to
+// non-zero values, so that messages of the same type share the same schema.
+func (suite *EIP712TestSuite) createRandomMsg(signer sdk.AccAddress) sdk.Msg {
+	amount := int64(suite.createRandomIntInRange(1, 1000000))
+
+	switch rand.Intn(numRandomMsgTypes) {
+	case 0:
+		return banktypes.NewMsgSend(signer, suite.createTestAddress(), suite.makeCoins(suite.denom, math.NewInt(amount)))
+	case 1:
+		return govtypes.NewMsgVote(signer, uint64(amount), govtypes.OptionYes)
+	case 2:
+		return claimstypes.NewMsgClaims(signer, suite.createTestAddress())
+	case 3:
+		return tokenconverttypes.NewMsgConvertTabi(tabitypes.NewTabiCoinInt64(amount), signer)
+	case 4:
+		return tokenconverttypes.NewMsgConvertVetabi(tabitypes.NewVeTabiCoinInt64(amount), signer, tokenconverttypes.Strategy90Days)
+	case 5:
+		return tokenconverttypes.NewMsgWithdrawTabi(suite.createRandomString(), signer)
+	default:
+		return tokenconverttypes.NewMsgTransferVoucher(suite.createRandomString(), signer, suite.createTestAddress())
+	}
+}
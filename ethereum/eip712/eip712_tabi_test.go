@@ -0,0 +1,88 @@
+package eip712_test
+
+import (
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/tabilabs/tabi/cmd/config"
+	"github.com/tabilabs/tabi/testutil/tx"
+	"github.com/tabilabs/tabi/utils"
+)
+
+// TestEIP712TabiMsgs signs every Msg of the Tabi modules registered in the interface registry through
+// its EIP-712 representation and verifies the signature against the sign bytes the same way the ante
+// handler does.
+func (suite *EIP712TestSuite) TestEIP712TabiMsgs() {
+	suite.SetupTest()
+
+	signModes := []signing.SignMode{
+		signing.SignMode_SIGN_MODE_DIRECT,
+		signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON,
+	}
+
+	params := EIP712TestParams{
+		fee: txtypes.Fee{
+			Amount:   suite.makeCoins(suite.denom, math.NewInt(2000)),
+			GasLimit: 20000,
+		},
+		accountNumber: 25,
+		sequence:      78,
+	}
+
+	authority := suite.createTestAddress()
+
+	msgs, err := tx.NewTabiMsgs(suite.config.InterfaceRegistry, authority)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(msgs)
+
+	for _, msg := range msgs {
+		for _, signMode := range signModes {
+			suite.Run(sdk.MsgTypeURL(msg), func() {
+				privKey, pubKey := suite.createTestKeyPair()
+
+				txBuilder := suite.clientCtx.TxConfig.NewTxBuilder()
+				txBuilder.SetGasLimit(params.fee.GasLimit)
+				txBuilder.SetFeeAmount(params.fee.Amount)
+
+				err := txBuilder.SetMsgs(msg)
+				suite.Require().NoError(err)
+
+				txSig := signing.SignatureV2{
+					PubKey: pubKey,
+					Data: &signing.SingleSignatureData{
+						SignMode:  signMode,
+						Signature: nil,
+					},
+					Sequence: params.sequence,
+				}
+				err = txBuilder.SetSignatures(txSig)
+				suite.Require().NoError(err)
+
+				signerData := authsigning.SignerData{
+					ChainID:       utils.TestnetChainID + "-1",
+					AccountNumber: params.accountNumber,
+					Sequence:      params.sequence,
+					PubKey:        pubKey,
+					Address:       sdk.MustBech32ifyAddressBytes(config.Bech32Prefix, pubKey.Bytes()),
+				}
+
+				bz, err := suite.clientCtx.TxConfig.SignModeHandler().GetSignBytes(
+					signMode,
+					signerData,
+					txBuilder.GetTx(),
+				)
+				suite.Require().NoError(err)
+
+				suite.verifyEIP712SignatureVerification(true, *privKey, *pubKey, bz)
+
+				if signMode == signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON {
+					suite.verifySignDocFlattening(bz)
+				}
+			})
+		}
+	}
+}
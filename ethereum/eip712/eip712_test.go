@@ -26,7 +26,10 @@ import (
 	"github.com/tabilabs/tabi/app"
 	"github.com/tabilabs/tabi/cmd/config"
 	"github.com/tabilabs/tabi/encoding"
+	tabitypes "github.com/tabilabs/tabi/types"
 	"github.com/tabilabs/tabi/utils"
+	claimstypes "github.com/tabilabs/tabi/x/claims/types"
+	tokenconverttypes "github.com/tabilabs/tabi/x/token-convert/types"
 
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
@@ -135,6 +138,7 @@ func (suite *EIP712TestSuite) TestEIP712() {
 		chainID       string
 		msgs          []sdk.Msg
 		timeoutHeight uint64
+		feeGranter    sdk.AccAddress
 		expectSuccess bool
 	}{
 		{
@@ -222,7 +226,35 @@ func (suite *EIP712TestSuite) TestEIP712() {
 					suite.makeCoins(suite.denom, math.NewInt(50)),
 				),
 			},
-			expectSuccess: !suite.useLegacyEIP712TypedData,
+			expectSuccess: true,
+		},
+		{
+			title: "Succeeds - Single-Signer MsgClaims + MsgConvertVetabi with Fee Granter",
+			msgs: []sdk.Msg{
+				claimstypes.NewMsgClaims(
+					params.address,
+					params.address,
+				),
+				tokenconverttypes.NewMsgConvertVetabi(
+					tabitypes.NewVeTabiCoinInt64(50),
+					params.address,
+					tokenconverttypes.Strategy90Days,
+				),
+			},
+			feeGranter:    suite.createTestAddress(),
+			expectSuccess: true,
+		},
+		{
+			title: "Succeeds - Standard MsgSend with Fee Granter",
+			msgs: []sdk.Msg{
+				banktypes.NewMsgSend(
+					params.address,
+					suite.createTestAddress(),
+					suite.makeCoins(suite.denom, math.NewInt(50)),
+				),
+			},
+			feeGranter:    suite.createTestAddress(),
+			expectSuccess: true,
 		},
 		{
 			title: "Succeeds - Single-Signer 2x MsgVoteV1 with Different Schemas",
@@ -326,6 +358,7 @@ func (suite *EIP712TestSuite) TestEIP712() {
 
 				txBuilder.SetGasLimit(params.fee.GasLimit)
 				txBuilder.SetFeeAmount(params.fee.Amount)
+				txBuilder.SetFeeGranter(tc.feeGranter)
 
 				err := txBuilder.SetMsgs(tc.msgs...)
 				suite.Require().NoError(err)
@@ -613,6 +646,69 @@ func (suite *EIP712TestSuite) TestTypedDataEdgeCases() {
 	suite.Require().Equal(len(types), 0)
 }
 
+// TestTypedDataNullFields tests that the null fields of the Amino JSON msgs are typed
+// according to their protobuf field descriptors.
+func (suite *EIP712TestSuite) TestTypedDataNullFields() {
+	suite.SetupTest()
+
+	addr := suite.createTestAddress().String()
+	signDoc := fmt.Sprintf(`{
+		"account_number": "25",
+		"chain_id": "%s",
+		"fee": { "amount": [], "gas": "20000" },
+		"memo": "",
+		"msgs": [
+			{
+				"type": "cosmos-sdk/MsgSoftwareUpgrade",
+				"value": {
+					"authority": "%s",
+					"plan": { "height": "10", "info": "", "name": "v2", "time": "0001-01-01T00:00:00Z", "upgraded_client_state": null }
+				}
+			},
+			{
+				"type": "cosmos-sdk/MsgSend",
+				"value": { "amount": null, "from_address": "%s", "to_address": "%s" }
+			}
+		],
+		"sequence": "78"
+	}`, utils.TestnetChainID+"-1", addr, addr, addr)
+
+	typedData, err := eip712.WrapTxToTypedData(0, []byte(signDoc))
+	suite.Require().NoError(err)
+
+	// fieldTypes returns the types of the fields of the object at the given path from the Tx
+	fieldTypes := func(path ...string) map[string]string {
+		typeName := "Tx"
+		for _, name := range path {
+			for _, typ := range typedData.Types[typeName] {
+				if typ.Name == name {
+					typeName = typ.Type
+				}
+			}
+		}
+
+		types := make(map[string]string)
+		for _, typ := range typedData.Types[typeName] {
+			types[typ.Name] = typ.Type
+		}
+		return types
+	}
+
+	// the unset nullable plan field is left out
+	suite.Require().Contains(fieldTypes("msg0", "value", "plan"), "name")
+	suite.Require().NotContains(fieldTypes("msg0", "value", "plan"), "upgraded_client_state")
+	plan := typedData.Message["msg0"].(map[string]interface{})["value"].(map[string]interface{})["plan"]
+	suite.Require().NotContains(plan, "upgraded_client_state")
+
+	// the unset repeated amount field is an empty array
+	suite.Require().Equal("string[]", fieldTypes("msg1", "value")["amount"])
+	send := typedData.Message["msg1"].(map[string]interface{})["value"]
+	suite.Require().Equal([]interface{}{}, send.(map[string]interface{})["amount"])
+
+	_, _, err = apitypes.TypedDataAndHash(typedData)
+	suite.Require().NoError(err)
+}
+
 // TestTypedDataGeneration tests certain qualities about the output Types representation.
 func (suite *EIP712TestSuite) TestTypedDataGeneration() {
 	// Multiple messages with the same schema should share one type
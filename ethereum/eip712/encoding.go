@@ -169,8 +169,10 @@ func decodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 	}
 
 	stdFee := &legacytx.StdFee{
-		Amount: authInfo.Fee.Amount,
-		Gas:    authInfo.Fee.GasLimit,
+		Amount:  authInfo.Fee.Amount,
+		Gas:     authInfo.Fee.GasLimit,
+		Payer:   authInfo.Fee.Payer,
+		Granter: authInfo.Fee.Granter,
 	}
 
 	tip := authInfo.Tip
@@ -209,7 +211,8 @@ func validateCodecInit() error {
 }
 
 // validatePayloadMessages ensures that the transaction messages can be represented in an EIP-712
-// encoding by checking that messages exist and share a single signer.
+// encoding by checking that messages exist and share a single signer. Messages of different types
+// are supported, since each message is flattened into its own field with its own schema.
 func validatePayloadMessages(msgs []sdk.Msg) error {
 	if len(msgs) == 0 {
 		return errors.New("unable to build EIP-712 payload: transaction does contain any messages")
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
 
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txTypes "github.com/cosmos/cosmos-sdk/types/tx"
 
@@ -77,6 +78,11 @@ func legacyDecodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		if err := aminoCodec.UnmarshalJSON(jsonMsg, &m); err != nil {
 			return apitypes.TypedData{}, fmt.Errorf("failed to unmarshal sign doc message: %w", err)
 		}
+		// Amino leaves the Any fields of an interface value packed, so they
+		// must be resolved before the types can be extracted from the message.
+		if err := codectypes.UnpackInterfaces(m, codectypes.AminoJSONUnpacker{Cdc: aminoCodec.Amino}); err != nil {
+			return apitypes.TypedData{}, fmt.Errorf("failed to unpack sign doc message: %w", err)
+		}
 		msgs[i] = m
 	}
 
@@ -84,11 +90,8 @@ func legacyDecodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 		return apitypes.TypedData{}, err
 	}
 
-	// Use first message for fee payer and type inference
-	msg := msgs[0]
-
 	// By convention, the fee payer is the first address in the list of signers.
-	feePayer := msg.GetSigners()[0]
+	feePayer := msgs[0].GetSigners()[0]
 	feeDelegation := &FeeDelegationOptions{
 		FeePayer: feePayer,
 	}
@@ -101,7 +104,7 @@ func legacyDecodeAminoSignDoc(signDocBytes []byte) (apitypes.TypedData, error) {
 	typedData, err := LegacyWrapTxToTypedData(
 		protoCodec,
 		chainID.Uint64(),
-		msg,
+		msgs,
 		signDocBytes,
 		feeDelegation,
 	)
@@ -158,9 +161,6 @@ func legacyDecodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error
 		return apitypes.TypedData{}, err
 	}
 
-	// Use first message for fee payer and type inference
-	msg := msgs[0]
-
 	signerInfo := authInfo.SignerInfos[0]
 
 	chainID, err := tabi.ParseChainID(signDoc.ChainId)
@@ -169,11 +169,13 @@ func legacyDecodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error
 	}
 
 	stdFee := &legacytx.StdFee{
-		Amount: authInfo.Fee.Amount,
-		Gas:    authInfo.Fee.GasLimit,
+		Amount:  authInfo.Fee.Amount,
+		Gas:     authInfo.Fee.GasLimit,
+		Payer:   authInfo.Fee.Payer,
+		Granter: authInfo.Fee.Granter,
 	}
 
-	feePayer := msg.GetSigners()[0]
+	feePayer := msgs[0].GetSigners()[0]
 	feeDelegation := &FeeDelegationOptions{
 		FeePayer: feePayer,
 	}
@@ -195,7 +197,7 @@ func legacyDecodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error
 	typedData, err := LegacyWrapTxToTypedData(
 		protoCodec,
 		chainID.Uint64(),
-		msg,
+		msgs,
 		signBytes,
 		feeDelegation,
 	)
@@ -206,19 +208,18 @@ func legacyDecodeProtobufSignDoc(signDocBytes []byte) (apitypes.TypedData, error
 	return typedData, nil
 }
 
-// validatePayloadMessages ensures that the transaction messages can be represented in an EIP-712
-// encoding by checking that messages exist, are of the same type, and share a single signer.
+// legacyValidatePayloadMessages ensures that the transaction messages can be represented in an EIP-712
+// encoding by checking that messages exist and share a single signer. The messages of different types
+// are flattened by LegacyWrapTxToTypedData.
 func legacyValidatePayloadMessages(msgs []sdk.Msg) error {
 	if len(msgs) == 0 {
 		return errors.New("unable to build EIP-712 payload: transaction does contain any messages")
 	}
 
-	var msgType string
 	var msgSigner sdk.AccAddress
 
 	for i, m := range msgs {
-		t, err := getMsgType(m)
-		if err != nil {
+		if _, err := getMsgType(m); err != nil {
 			return err
 		}
 
@@ -227,15 +228,10 @@ func legacyValidatePayloadMessages(msgs []sdk.Msg) error {
 		}
 
 		if i == 0 {
-			msgType = t
 			msgSigner = m.GetSigners()[0]
 			continue
 		}
 
-		if t != msgType {
-			return errors.New("unable to build EIP-712 payload: different types of messages detected")
-		}
-
 		if !msgSigner.Equals(m.GetSigners()[0]) {
 			return errors.New("unable to build EIP-712 payload: multiple signers detected")
 		}
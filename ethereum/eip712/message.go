@@ -2,6 +2,7 @@ package eip712
 
 import (
 	"fmt"
+	"reflect"
 
 	errorsmod "cosmossdk.io/errors"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
@@ -18,6 +19,7 @@ type eip712MessagePayload struct {
 
 const (
 	payloadMsgsField = "msgs"
+	payloadFeeField  = "fee"
 )
 
 // createEIP712MessagePayload generates the EIP-712 message payload
@@ -145,3 +147,34 @@ func payloadWithoutMsgsField(payload gjson.Result) (gjson.Result, error) {
 
 	return gjson.Parse(newRaw), nil
 }
+
+// typedMessage returns a copy of the decoded Amino JSON value of Go type t, typed
+// the same way as recursivelyAddTypesToRoot: the null repeated fields are encoded
+// as empty arrays and the other null fields are left out.
+func typedMessage(value interface{}, t reflect.Type) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		typed := make(map[string]interface{}, len(v))
+		for name, fieldValue := range v {
+			field := aminoJSONField(t, name)
+
+			if fieldValue == nil {
+				if isRepeatedField(field) {
+					typed[name] = []interface{}{}
+				}
+				continue
+			}
+
+			typed[name] = typedMessage(fieldValue, field.Type)
+		}
+		return typed
+	case []interface{}:
+		typed := make([]interface{}, len(v))
+		for i, elem := range v {
+			typed[i] = typedMessage(elem, sliceElemType(t))
+		}
+		return typed
+	default:
+		return value
+	}
+}
@@ -3,6 +3,7 @@ package eip712
 import (
 	"bytes"
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -10,6 +11,7 @@ import (
 	"golang.org/x/text/language"
 
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
@@ -21,18 +23,25 @@ const (
 	typePrefix = ""
 
 	txField   = "Tx"
+	feeField  = "Fee"
 	ethBool   = "bool"
 	ethInt64  = "int64"
 	ethString = "string"
 
+	// emptyArrayType is the type of the empty arrays, whose element type is unknown.
+	emptyArrayType = "string[]"
+
 	msgTypeField = "type"
 
+	feePayerField   = "payer"
+	feeGranterField = "granter"
+
 	maxDuplicateTypeDefs = 1000
 )
 
-// getEIP712Types creates and returns the EIP-712 types
-// for the given message payload.
-func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, error) {
+// createEIP712Types creates and returns the EIP-712 types for the given
+// message payload, along with the message typed accordingly.
+func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, map[string]interface{}, error) {
 	eip712Types := apitypes.Types{
 		"EIP712Domain": {
 			{
@@ -74,21 +83,48 @@ func createEIP712Types(messagePayload eip712MessagePayload) (apitypes.Types, err
 		},
 	}
 
+	addFeeDelegationTypesToRoot(eip712Types, messagePayload.payload)
+
+	message := make(map[string]interface{}, len(messagePayload.message))
+	for field, value := range messagePayload.message {
+		message[field] = value
+	}
+
 	for i := 0; i < messagePayload.numPayloadMsgs; i++ {
 		field := msgFieldForIndex(i)
 		msg := messagePayload.payload.Get(field)
 
-		if err := addMsgTypesToRoot(eip712Types, field, msg); err != nil {
-			return nil, err
+		msgMessage, ok := messagePayload.message[field].(map[string]interface{})
+		if !ok {
+			return nil, nil, errorsmod.Wrapf(errortypes.ErrInvalidType, "failed to parse %s as map", field)
+		}
+
+		msgType := aminoMsgType(msg)
+		if err := addMsgTypesToRoot(eip712Types, field, msg, msgType); err != nil {
+			return nil, nil, err
 		}
+
+		message[field] = typedMessage(msgMessage, msgType)
 	}
 
-	return eip712Types, nil
+	return eip712Types, message, nil
+}
+
+// addFeeDelegationTypesToRoot adds the fee payer and fee granter fields
+// to the Fee schema when they are set on the payload, e.g. when the
+// transaction fees are paid through x/feegrant.
+func addFeeDelegationTypesToRoot(eip712Types apitypes.Types, payload gjson.Result) {
+	for _, fieldName := range []string{feeGranterField, feePayerField} {
+		if payload.Get(prefixForSubField(payloadFeeField, fieldName)).Exists() {
+			eip712Types[feeField] = appendedTypesList(eip712Types[feeField], fieldName, ethString)
+		}
+	}
 }
 
 // addMsgTypesToRoot adds all types for the given message
 // to eip712Types, recursively handling object sub-fields.
-func addMsgTypesToRoot(eip712Types apitypes.Types, msgField string, msg gjson.Result) (err error) {
+// The msgType is the Go type of msg, nil if unknown, see aminoMsgType.
+func addMsgTypesToRoot(eip712Types apitypes.Types, msgField string, msg gjson.Result, msgType reflect.Type) (err error) {
 	defer doRecover(&err)
 
 	if !msg.IsObject() {
@@ -100,7 +136,7 @@ func addMsgTypesToRoot(eip712Types apitypes.Types, msgField string, msg gjson.Re
 		return err
 	}
 
-	msgTypeDef, err := recursivelyAddTypesToRoot(eip712Types, msgRootType, rootPrefix, msg)
+	msgTypeDef, err := recursivelyAddTypesToRoot(eip712Types, msgRootType, rootPrefix, msg, msgType)
 	if err != nil {
 		return err
 	}
@@ -140,11 +176,13 @@ func addMsgTypeDefToTxSchema(eip712Types apitypes.Types, msgField, msgTypeDef st
 // and recursively adds sub-maps as new types when necessary.
 // It adds all type definitions to typeMap, then returns a key
 // to the json object's type definition within the map.
+// The goType is the Go type of the json object, nil if unknown.
 func recursivelyAddTypesToRoot(
 	typeMap apitypes.Types,
 	rootType string,
 	prefix string,
 	payload gjson.Result,
+	goType reflect.Type,
 ) (string, error) {
 	typesToAdd := []apitypes.Type{}
 
@@ -162,6 +200,20 @@ func recursivelyAddTypesToRoot(
 			continue
 		}
 
+		goField := aminoJSONField(goType, fieldName)
+		fieldGoType := goField.Type
+
+		// Amino JSON encodes the unset non-nullable repeated fields as null,
+		// which has no EIP-712 type. They are typed as empty arrays, the
+		// other null fields are left out, see typedMessage.
+		if field.Type == gjson.Null {
+			if isRepeatedField(goField) {
+				typesToAdd = appendedTypesList(typesToAdd, fieldName, emptyArrayType)
+			}
+
+			continue
+		}
+
 		// Handle array type by unwrapping the first element.
 		// Note that arrays with multiple types are not supported
 		// using EIP-712, so we can ignore that case.
@@ -172,13 +224,13 @@ func recursivelyAddTypesToRoot(
 			if len(fieldAsArray) == 0 {
 				// Arbitrarily add string[] type to handle empty arrays,
 				// since we cannot access the underlying object.
-				emptyArrayType := "string[]"
 				typesToAdd = appendedTypesList(typesToAdd, fieldName, emptyArrayType)
 
 				continue
 			}
 
 			field = fieldAsArray[0]
+			fieldGoType = sliceElemType(fieldGoType)
 			isCollection = true
 		}
 
@@ -200,7 +252,7 @@ func recursivelyAddTypesToRoot(
 		if field.IsObject() {
 			fieldPrefix := prefixForSubField(prefix, fieldName)
 
-			fieldTypeDef, err := recursivelyAddTypesToRoot(typeMap, rootType, fieldPrefix, field)
+			fieldTypeDef, err := recursivelyAddTypesToRoot(typeMap, rootType, fieldPrefix, field, fieldGoType)
 			if err != nil {
 				return "", err
 			}
@@ -359,6 +411,71 @@ func sanitizeTypedef(str string) string {
 	return buf.String()
 }
 
+// aminoMsgType returns the Go type of the Amino JSON msg, that is its value wrapped
+// with its Amino name. It returns nil if the msg cannot be decoded with the codec
+// set by SetEncodingConfig.
+func aminoMsgType(msg gjson.Result) reflect.Type {
+	if aminoCodec == nil {
+		return nil
+	}
+
+	var m sdk.Msg
+	if err := aminoCodec.UnmarshalJSON([]byte(msg.Raw), &m); err != nil {
+		return nil
+	}
+
+	return reflect.StructOf([]reflect.StructField{
+		{Name: "Type", Type: reflect.TypeOf(""), Tag: `json:"type"`},
+		{Name: "Value", Type: reflect.TypeOf(m), Tag: `json:"value"`},
+	})
+}
+
+// aminoJSONField returns the field of the struct type t encoded under the given
+// Amino JSON name. The field has a nil type if t is nil or has no such field.
+func aminoJSONField(t reflect.Type, name string) reflect.StructField {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Struct {
+		return reflect.StructField{}
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		if jsonNameFromTag(t.Field(i).Tag) == name {
+			return t.Field(i)
+		}
+	}
+
+	return reflect.StructField{}
+}
+
+// isRepeatedField returns true if the protobuf field descriptor of the struct
+// field is a repeated one.
+func isRepeatedField(field reflect.StructField) bool {
+	for _, opt := range strings.Split(field.Tag.Get("protobuf"), ",") {
+		if opt == "rep" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sliceElemType returns the element type of the slice type t, or nil if t is
+// not a slice.
+func sliceElemType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	if t == nil || t.Kind() != reflect.Slice {
+		return nil
+	}
+
+	return t.Elem()
+}
+
 // getEthTypeForJSON converts a JSON type to an Ethereum type.
 // It returns an empty string for Objects, Arrays, or Null.
 // See https://github.com/ethereum/EIPs/blob/master/EIPS/eip-712.md for more.
@@ -0,0 +1,122 @@
+package reporter
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// nodesPageLimit is the page size used to list the nodes.
+const nodesPageLimit = 1000
+
+// Chain is the view of the chain the reporter works against.
+type Chain interface {
+	// CurrentEpoch returns the current epoch.
+	CurrentEpoch(ctx context.Context) (uint64, error)
+	// ReportProgress returns the reports committed on an epoch.
+	ReportProgress(ctx context.Context, epochID uint64) (*types.QueryReportProgressResponse, error)
+	// Nodes returns all the captain nodes.
+	Nodes(ctx context.Context) ([]types.Node, error)
+	// CommitReport commits a report and returns once it is included in a block.
+	CommitReport(ctx context.Context, reportType types.ReportType, report any) error
+}
+
+var _ Chain = &clientChain{}
+
+// clientChain implements Chain over a node, the reports are signed with the
+// key of the client context which must be an authorized member.
+type clientChain struct {
+	clientCtx   client.Context
+	txf         tx.Factory
+	queryClient types.QueryClient
+}
+
+// NewChain creates a Chain querying and broadcasting through the client context.
+func NewChain(clientCtx client.Context, txf tx.Factory) Chain {
+	return &clientChain{
+		clientCtx:   clientCtx,
+		txf:         txf,
+		queryClient: types.NewQueryClient(clientCtx),
+	}
+}
+
+// CurrentEpoch implements Chain
+func (c *clientChain) CurrentEpoch(ctx context.Context) (uint64, error) {
+	res, err := c.queryClient.CurrentEpoch(ctx, &types.QueryCurrentEpochRequest{})
+	if err != nil {
+		return 0, err
+	}
+	return res.Epoch, nil
+}
+
+// ReportProgress implements Chain
+func (c *clientChain) ReportProgress(ctx context.Context, epochID uint64) (*types.QueryReportProgressResponse, error) {
+	return c.queryClient.ReportProgress(ctx, &types.QueryReportProgressRequest{Epoch: epochID})
+}
+
+// Nodes implements Chain
+func (c *clientChain) Nodes(ctx context.Context) ([]types.Node, error) {
+	var nodes []types.Node
+	pageReq := &query.PageRequest{Limit: nodesPageLimit}
+	for {
+		res, err := c.queryClient.Nodes(ctx, &types.QueryNodesRequest{Pagination: pageReq})
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, res.Nodes...)
+		if res.Pagination == nil || len(res.Pagination.NextKey) == 0 {
+			return nodes, nil
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: nodesPageLimit}
+	}
+}
+
+// CommitReport implements Chain
+func (c *clientChain) CommitReport(_ context.Context, reportType types.ReportType, report any) error {
+	msg, err := types.NewMsgCommitReport(c.clientCtx.GetFromAddress().String(), reportType, report)
+	if err != nil {
+		return err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	// the account sequence is fetched again for every report.
+	txf, err := c.txf.Prepare(c.clientCtx)
+	if err != nil {
+		return err
+	}
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(c.clientCtx, txf, msg)
+		if err != nil {
+			return err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	builder, err := txf.BuildUnsignedTx(msg)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(txf, c.clientCtx.GetFromName(), builder, true); err != nil {
+		return err
+	}
+	txBytes, err := c.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := c.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package reporter
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	"github.com/tendermint/tendermint/libs/log"
+	coretypes "github.com/tendermint/tendermint/rpc/core/types"
+	rpcclient "github.com/tendermint/tendermint/rpc/jsonrpc/client"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// subscribeTimeout bounds the subscription, which blocks until the websocket is connected.
+const subscribeTimeout = 30 * time.Second
+
+// EpochPhaseQuery matches the blocks emitting an epoch phase event.
+var EpochPhaseQuery = "tm.event='NewBlock' AND " + proto.MessageName(&types.EventEpochPhase{}) + ".phase EXISTS"
+
+// SubscribeEpochPhases subscribes to the epoch phase events over the Tendermint
+// websocket. The returned channel is closed once the context is done or the
+// websocket client stops.
+func SubscribeEpochPhases(ctx context.Context, ws *rpcclient.WSClient, logger log.Logger) (<-chan types.EventEpochPhase, error) {
+	subCtx, cancel := context.WithTimeout(ctx, subscribeTimeout)
+	defer cancel()
+	if err := ws.Subscribe(subCtx, EpochPhaseQuery); err != nil {
+		return nil, err
+	}
+
+	phases := make(chan types.EventEpochPhase)
+	go func() {
+		defer close(phases)
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case rpcResp, ok := <-ws.ResponsesCh:
+				if !ok {
+					return
+				}
+				if rpcResp.Error != nil {
+					logger.Error("epoch phase subscription failed", "error", rpcResp.Error.Error())
+					continue
+				}
+
+				var ev coretypes.ResultEvent
+				if err := tmjson.Unmarshal(rpcResp.Result, &ev); err != nil {
+					logger.Error("failed to decode epoch phase event", "error", err.Error())
+					continue
+				}
+
+				for _, phase := range ParseEpochPhases(ev.Data) {
+					select {
+					case phases <- phase:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return phases, nil
+}
+
+// ParseEpochPhases returns the epoch phase events emitted by a new block.
+func ParseEpochPhases(data tmtypes.TMEventData) []types.EventEpochPhase {
+	block, ok := data.(tmtypes.EventDataNewBlock)
+	if !ok {
+		return nil
+	}
+
+	var events []abci.Event
+	events = append(events, block.ResultBeginBlock.Events...)
+	events = append(events, block.ResultEndBlock.Events...)
+
+	var phases []types.EventEpochPhase
+	for _, event := range events {
+		if event.Type != proto.MessageName(&types.EventEpochPhase{}) {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if phase, ok := msg.(*types.EventEpochPhase); ok {
+			phases = append(phases, *phase)
+		}
+	}
+	return phases
+}
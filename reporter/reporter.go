@@ -0,0 +1,362 @@
+package reporter
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// Config holds the reporter settings
+type Config struct {
+	// MaxNodesPerBatch is the maximum number of nodes in an emission batch.
+	MaxNodesPerBatch uint64
+	// Retries is the number of times a failed report is committed again.
+	Retries uint64
+	// RetryInterval is the wait between two attempts to commit a report.
+	RetryInterval time.Duration
+	// PollInterval is the wait between two checks of the epoch when no epoch
+	// phase event shows up.
+	PollInterval time.Duration
+}
+
+// DefaultConfig returns the default reporter settings
+func DefaultConfig() Config {
+	return Config{
+		MaxNodesPerBatch: 100,
+		Retries:          3,
+		RetryInterval:    5 * time.Second,
+		PollInterval:     30 * time.Second,
+	}
+}
+
+// Validate checks the reporter settings
+func (c Config) Validate() error {
+	if c.MaxNodesPerBatch == 0 {
+		return fmt.Errorf("maximum nodes per batch must be positive")
+	}
+	if c.PollInterval <= 0 {
+		return fmt.Errorf("poll interval must be positive")
+	}
+	return nil
+}
+
+// Reporter drives the report of the captains epochs: it commits the digest
+// while the epoch stands by, then the emission batches and the end report
+// once the epoch is busy. Every step starts over from the reports committed
+// on chain, so the reporter resumes where it left off after a restart.
+type Reporter struct {
+	chain  Chain
+	source Source
+	cfg    Config
+	logger log.Logger
+
+	// snapshots are the nodes and power-on ratios the digest of an epoch is
+	// built from, the emission batches of the epoch are shared between them.
+	snapshots map[uint64]*epochSnapshot
+}
+
+// epochSnapshot is the node set and the power-on ratios of an epoch.
+type epochSnapshot struct {
+	nodes  []types.Node
+	ratios map[string]sdk.Dec
+}
+
+// NewReporter creates the Reporter
+func NewReporter(chain Chain, source Source, cfg Config, logger log.Logger) *Reporter {
+	return &Reporter{
+		chain:  chain,
+		source: source,
+		cfg:    cfg,
+		logger: logger,
+
+		snapshots: make(map[uint64]*epochSnapshot),
+	}
+}
+
+// Run steps the epoch report on every epoch phase event and on every poll
+// interval, until the context is done.
+func (r *Reporter) Run(ctx context.Context, phases <-chan types.EventEpochPhase) error {
+	ticker := time.NewTicker(r.cfg.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Step(ctx); err != nil {
+			r.logger.Error("failed to report epoch", "error", err.Error())
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case phase, ok := <-phases:
+			if !ok {
+				// keep polling when the subscription is gone.
+				phases = nil
+				continue
+			}
+			r.logger.Debug("epoch phase", "epoch", phase.EpochId, "phase", phase.Phase.String())
+		case <-ticker.C:
+		}
+	}
+}
+
+// Step commits the reports missing on the current epoch.
+func (r *Reporter) Step(ctx context.Context) error {
+	epochID, err := r.chain.CurrentEpoch(ctx)
+	if err != nil {
+		return err
+	}
+	progress, err := r.chain.ReportProgress(ctx, epochID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case progress.Ended:
+		// the next epoch starts on the next block.
+		return nil
+	case progress.Digest == nil:
+		return r.reportDigest(ctx, epochID)
+	case progress.StandBy:
+		// the digest is executed at the end of the block.
+		return nil
+	}
+	return r.reportEmission(ctx, epochID, progress)
+}
+
+// reportDigest commits the digest of the epoch and keeps the snapshot it is
+// built from for the emission batches.
+func (r *Reporter) reportDigest(ctx context.Context, epochID uint64) error {
+	snapshot, err := r.snapshot(ctx, epochID)
+	if err != nil {
+		return err
+	}
+	digest, err := BuildDigest(epochID, snapshot.nodes, snapshot.ratios, r.cfg.MaxNodesPerBatch)
+	if err != nil {
+		return err
+	}
+
+	// the snapshot is kept before committing, a digest included despite a
+	// failed broadcast is then still backed by it.
+	for id := range r.snapshots {
+		if id < epochID {
+			delete(r.snapshots, id)
+		}
+	}
+	r.snapshots[epochID] = snapshot
+
+	r.logger.Info("committing digest", "epoch", epochID, "nodes", digest.TotalNodeCount, "batches", digest.TotalBatchCount)
+	return r.commit(ctx, types.ReportType_REPORT_TYPE_DIGEST, digest)
+}
+
+// reportEmission commits the emission batches left on the epoch, then the end report.
+func (r *Reporter) reportEmission(ctx context.Context, epochID uint64, progress *types.QueryReportProgressResponse) error {
+	snapshot, found := r.snapshots[epochID]
+	if !found {
+		// the digest was committed before a restart, the node set is taken
+		// again and must still match the digest.
+		r.logger.Info("no snapshot of the digest, taking the nodes again", "epoch", epochID)
+		var err error
+		if snapshot, err = r.snapshot(ctx, epochID); err != nil {
+			return err
+		}
+	}
+
+	// the batches are sized by the digest so they line up with the batches
+	// committed before a restart.
+	emissions := NodeEmissions(progress.EpochEmission, snapshot.nodes, snapshot.ratios)
+	batches := BuildEmissionBatches(epochID, emissions, progress.Digest.MaximumNodeCountPerBatch)
+	if err := CheckEmissionBatches(progress.Digest, progress.Batches, batches); err != nil {
+		return err
+	}
+
+	committed := make(map[uint64]bool, len(progress.Batches))
+	for _, batch := range progress.Batches {
+		committed[batch.BatchId] = true
+	}
+
+	for _, batch := range batches {
+		if committed[batch.BatchId] {
+			continue
+		}
+
+		r.logger.Info("committing emission batch", "epoch", epochID, "batch", batch.BatchId, "nodes", batch.NodeCount)
+		if err := r.commit(ctx, types.ReportType_REPORT_TYPE_EMISSION, batch); err != nil {
+			return err
+		}
+	}
+
+	r.logger.Info("committing end", "epoch", epochID)
+	if err := r.commit(ctx, types.ReportType_REPORT_TYPE_END, &types.ReportEnd{EpochId: epochID}); err != nil {
+		return err
+	}
+
+	delete(r.snapshots, epochID)
+	return nil
+}
+
+// snapshot returns the nodes and their power-on ratios on the epoch.
+func (r *Reporter) snapshot(ctx context.Context, epochID uint64) (*epochSnapshot, error) {
+	nodes, err := r.chain.Nodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, err := r.source.PowerOnRatios(ctx, epochID)
+	if err != nil {
+		return nil, err
+	}
+
+	ratios := make(map[string]sdk.Dec, len(list))
+	for _, ratio := range list {
+		ratios[ratio.NodeId] = ratio.OnOperationRatio
+	}
+	return &epochSnapshot{nodes: nodes, ratios: ratios}, nil
+}
+
+// commit commits a report, trying again on failure up to the configured retries.
+func (r *Reporter) commit(ctx context.Context, reportType types.ReportType, report any) error {
+	var err error
+	for attempt := uint64(0); attempt <= r.cfg.Retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(r.cfg.RetryInterval):
+			}
+		}
+
+		if err = r.chain.CommitReport(ctx, reportType, report); err == nil {
+			return nil
+		}
+		r.logger.Error("failed to commit report", "type", reportType.String(), "attempt", attempt+1, "error", err.Error())
+	}
+	return err
+}
+
+// BuildDigest builds the digest of the epoch. The global on-operation ratio is
+// the average of the power-on ratios weighted by the node computing power.
+func BuildDigest(epochID uint64, nodes []types.Node, ratios map[string]sdk.Dec, maxNodesPerBatch uint64) (*types.ReportDigest, error) {
+	if len(nodes) == 0 {
+		return nil, fmt.Errorf("no node to report on epoch %d", epochID)
+	}
+
+	totalPower := sdk.ZeroDec()
+	operatingPower := sdk.ZeroDec()
+	for _, node := range nodes {
+		power := sdk.NewDecFromInt(sdk.NewIntFromUint64(node.ComputingPower))
+		totalPower = totalPower.Add(power)
+		operatingPower = operatingPower.Add(power.Mul(powerOnRatio(ratios, node.Id)))
+	}
+	if !operatingPower.IsPositive() {
+		return nil, fmt.Errorf("no computing power in operation on epoch %d", epochID)
+	}
+
+	nodeCount := uint64(len(nodes))
+	return &types.ReportDigest{
+		EpochId:                  epochID,
+		TotalBatchCount:          (nodeCount + maxNodesPerBatch - 1) / maxNodesPerBatch,
+		TotalNodeCount:           nodeCount,
+		MaximumNodeCountPerBatch: maxNodesPerBatch,
+		GlobalOnOperationRatio:   operatingPower.Quo(totalPower),
+	}, nil
+}
+
+// NodeEmissions shares the epoch emission between the nodes in proportion to
+// their computing power times their power-on ratio. The result is sorted by
+// node id, so the batches built from it are the same on every run.
+func NodeEmissions(epochEmission sdk.Dec, nodes []types.Node, ratios map[string]sdk.Dec) []types.NodeEpochEmission {
+	weights := make(map[string]sdk.Dec, len(nodes))
+	totalWeight := sdk.ZeroDec()
+	for _, node := range nodes {
+		weight := sdk.NewDecFromInt(sdk.NewIntFromUint64(node.ComputingPower)).Mul(powerOnRatio(ratios, node.Id))
+		weights[node.Id] = weight
+		totalWeight = totalWeight.Add(weight)
+	}
+
+	emissions := make([]types.NodeEpochEmission, 0, len(nodes))
+	for _, node := range nodes {
+		amount := sdk.ZeroDec()
+		if totalWeight.IsPositive() {
+			amount = epochEmission.Mul(weights[node.Id]).Quo(totalWeight)
+		}
+		emissions = append(emissions, types.NodeEpochEmission{
+			NodeId:       node.Id,
+			NodeEmission: sdk.NewDecCoinFromDec(tabitypes.AttoVeTabi, amount),
+		})
+	}
+
+	sort.Slice(emissions, func(i, j int) bool {
+		return emissions[i].NodeId < emissions[j].NodeId
+	})
+	return emissions
+}
+
+// BuildEmissionBatches splits the node emissions in batches of at most
+// maxNodesPerBatch nodes, numbered from 1.
+func BuildEmissionBatches(epochID uint64, emissions []types.NodeEpochEmission, maxNodesPerBatch uint64) []*types.ReportEmission {
+	var batches []*types.ReportEmission
+	for start := uint64(0); start < uint64(len(emissions)); start += maxNodesPerBatch {
+		end := start + maxNodesPerBatch
+		if end > uint64(len(emissions)) {
+			end = uint64(len(emissions))
+		}
+		batches = append(batches, &types.ReportEmission{
+			EpochId:   epochID,
+			BatchId:   uint64(len(batches)) + 1,
+			NodeCount: end - start,
+			Nodes:     emissions[start:end],
+		})
+	}
+	return batches
+}
+
+// CheckEmissionBatches checks the emission batches of an epoch add up to the
+// batch and node counts of its digest, and line up with the batches already
+// committed, so that the end report is not committed on a partial epoch.
+func CheckEmissionBatches(digest *types.ReportDigest, committed []types.BatchBase, batches []*types.ReportEmission) error {
+	if uint64(len(batches)) != digest.TotalBatchCount {
+		return fmt.Errorf(
+			"epoch %d has %d emission batches, the digest expects %d",
+			digest.EpochId, len(batches), digest.TotalBatchCount,
+		)
+	}
+
+	nodeCounts := make(map[uint64]uint64, len(batches))
+	nodeCount := uint64(0)
+	for _, batch := range batches {
+		nodeCounts[batch.BatchId] = batch.NodeCount
+		nodeCount += batch.NodeCount
+	}
+	if nodeCount != digest.TotalNodeCount {
+		return fmt.Errorf(
+			"epoch %d has %d nodes, the digest expects %d",
+			digest.EpochId, nodeCount, digest.TotalNodeCount,
+		)
+	}
+
+	for _, batch := range committed {
+		if count, found := nodeCounts[batch.BatchId]; !found || count != batch.Count {
+			return fmt.Errorf(
+				"committed batch %d of epoch %d has %d nodes, the node set has %d",
+				batch.BatchId, digest.EpochId, batch.Count, count,
+			)
+		}
+	}
+	return nil
+}
+
+// powerOnRatio returns the power-on ratio of a node, nodes missing from the
+// source are not in operation.
+func powerOnRatio(ratios map[string]sdk.Dec, nodeID string) sdk.Dec {
+	ratio, found := ratios[nodeID]
+	if !found {
+		return sdk.ZeroDec()
+	}
+	return ratio
+}
@@ -0,0 +1,304 @@
+package reporter_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmlog "github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/tabilabs/tabi/reporter"
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// mockChain keeps the reports of a single epoch, the digest is executed as
+// soon as it is committed.
+type mockChain struct {
+	epoch    uint64
+	nodes    []types.Node
+	progress types.QueryReportProgressResponse
+	reports  []types.ReportType
+	failures int
+}
+
+func newMockChain(nodes []types.Node) *mockChain {
+	return &mockChain{
+		epoch: 1,
+		nodes: nodes,
+		progress: types.QueryReportProgressResponse{
+			Epoch:         1,
+			StandBy:       true,
+			EpochEmission: sdk.ZeroDec(),
+		},
+	}
+}
+
+func (c *mockChain) CurrentEpoch(_ context.Context) (uint64, error) {
+	return c.epoch, nil
+}
+
+func (c *mockChain) ReportProgress(_ context.Context, _ uint64) (*types.QueryReportProgressResponse, error) {
+	progress := c.progress
+	return &progress, nil
+}
+
+func (c *mockChain) Nodes(_ context.Context) ([]types.Node, error) {
+	return c.nodes, nil
+}
+
+func (c *mockChain) CommitReport(_ context.Context, reportType types.ReportType, report any) error {
+	if c.failures > 0 {
+		c.failures--
+		return errors.New("broadcast failed")
+	}
+
+	c.reports = append(c.reports, reportType)
+	switch report := report.(type) {
+	case *types.ReportDigest:
+		c.progress.Digest = report
+		c.progress.StandBy = false
+		c.progress.EpochEmission = sdk.NewDec(1000)
+	case *types.ReportEmission:
+		c.progress.Batches = append(c.progress.Batches, types.BatchBase{BatchId: report.BatchId, Count: report.NodeCount})
+	case *types.ReportEnd:
+		c.progress.Ended = true
+	}
+	return nil
+}
+
+type mockSource []types.NodePowerOnRatio
+
+func (s mockSource) PowerOnRatios(_ context.Context, _ uint64) ([]types.NodePowerOnRatio, error) {
+	return s, nil
+}
+
+var (
+	testNodes = []types.Node{
+		{Id: "node-c", ComputingPower: 100},
+		{Id: "node-a", ComputingPower: 300},
+		{Id: "node-b", ComputingPower: 100},
+	}
+	testRatios = map[string]sdk.Dec{
+		"node-a": sdk.NewDecWithPrec(5, 1),
+		"node-b": sdk.OneDec(),
+	}
+)
+
+func testSource() mockSource {
+	return mockSource{
+		{NodeId: "node-a", OnOperationRatio: testRatios["node-a"]},
+		{NodeId: "node-b", OnOperationRatio: testRatios["node-b"]},
+	}
+}
+
+func testConfig() reporter.Config {
+	cfg := reporter.DefaultConfig()
+	cfg.MaxNodesPerBatch = 2
+	cfg.RetryInterval = 0
+	return cfg
+}
+
+func TestBuildDigest(t *testing.T) {
+	digest, err := reporter.BuildDigest(1, testNodes, testRatios, 2)
+	require.NoError(t, err)
+	require.NoError(t, digest.ValidateBasic())
+	require.Equal(t, uint64(3), digest.TotalNodeCount)
+	require.Equal(t, uint64(2), digest.TotalBatchCount)
+	require.Equal(t, uint64(2), digest.MaximumNodeCountPerBatch)
+	// (300 * 0.5 + 100 * 1) / 500
+	require.Equal(t, sdk.NewDecWithPrec(5, 1), digest.GlobalOnOperationRatio)
+
+	_, err = reporter.BuildDigest(1, nil, testRatios, 2)
+	require.Error(t, err)
+
+	_, err = reporter.BuildDigest(1, testNodes, nil, 2)
+	require.Error(t, err)
+}
+
+func TestNodeEmissions(t *testing.T) {
+	emissions := reporter.NodeEmissions(sdk.NewDec(1000), testNodes, testRatios)
+	require.Len(t, emissions, 3)
+
+	expected := []struct {
+		nodeID string
+		amount sdk.Dec
+	}{
+		{"node-a", sdk.NewDec(600)},
+		{"node-b", sdk.NewDec(400)},
+		{"node-c", sdk.ZeroDec()},
+	}
+	for i, exp := range expected {
+		require.Equal(t, exp.nodeID, emissions[i].NodeId)
+		require.Equal(t, exp.amount, emissions[i].NodeEmission.Amount)
+	}
+}
+
+func TestBuildEmissionBatches(t *testing.T) {
+	emissions := reporter.NodeEmissions(sdk.NewDec(1000), testNodes, testRatios)
+
+	batches := reporter.BuildEmissionBatches(1, emissions, 2)
+	require.Len(t, batches, 2)
+	for i, batch := range batches {
+		require.NoError(t, batch.ValidateBasic())
+		require.Equal(t, uint64(i+1), batch.BatchId)
+		require.Equal(t, uint64(len(batch.Nodes)), batch.NodeCount)
+	}
+	require.Equal(t, uint64(2), batches[0].NodeCount)
+	require.Equal(t, uint64(1), batches[1].NodeCount)
+
+	require.Len(t, reporter.BuildEmissionBatches(1, emissions, 3), 1)
+	require.Empty(t, reporter.BuildEmissionBatches(1, nil, 3))
+}
+
+func TestReporterStep(t *testing.T) {
+	testCases := []struct {
+		name       string
+		malleate   func(chain *mockChain)
+		expReports []types.ReportType
+	}{
+		{
+			name:     "full epoch",
+			malleate: func(*mockChain) {},
+			expReports: []types.ReportType{
+				types.ReportType_REPORT_TYPE_DIGEST,
+				types.ReportType_REPORT_TYPE_EMISSION,
+				types.ReportType_REPORT_TYPE_EMISSION,
+				types.ReportType_REPORT_TYPE_END,
+			},
+		},
+		{
+			name: "resume after the first emission batch",
+			malleate: func(chain *mockChain) {
+				digest, err := reporter.BuildDigest(1, testNodes, testRatios, 2)
+				require.NoError(t, err)
+				chain.progress.Digest = digest
+				chain.progress.StandBy = false
+				chain.progress.EpochEmission = sdk.NewDec(1000)
+				chain.progress.Batches = []types.BatchBase{{BatchId: 1, Count: 2}}
+			},
+			expReports: []types.ReportType{
+				types.ReportType_REPORT_TYPE_EMISSION,
+				types.ReportType_REPORT_TYPE_END,
+			},
+		},
+		{
+			name: "retry failed reports",
+			malleate: func(chain *mockChain) {
+				chain.failures = 2
+			},
+			expReports: []types.ReportType{
+				types.ReportType_REPORT_TYPE_DIGEST,
+				types.ReportType_REPORT_TYPE_EMISSION,
+				types.ReportType_REPORT_TYPE_EMISSION,
+				types.ReportType_REPORT_TYPE_END,
+			},
+		},
+		{
+			name: "epoch already ended",
+			malleate: func(chain *mockChain) {
+				chain.progress.Ended = true
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			chain := newMockChain(testNodes)
+			tc.malleate(chain)
+
+			r := reporter.NewReporter(chain, testSource(), testConfig(), tmlog.NewNopLogger())
+			// one step for the digest, one for the emission and the end.
+			for i := 0; i < 3; i++ {
+				require.NoError(t, r.Step(context.Background()))
+			}
+			require.Equal(t, tc.expReports, chain.reports)
+		})
+	}
+}
+
+func TestReporterStepRetriesExhausted(t *testing.T) {
+	chain := newMockChain(testNodes)
+	chain.failures = 2
+
+	cfg := testConfig()
+	cfg.Retries = 1
+	r := reporter.NewReporter(chain, testSource(), cfg, tmlog.NewNopLogger())
+
+	require.Error(t, r.Step(context.Background()))
+	require.Empty(t, chain.reports)
+
+	// the next step picks the digest up again.
+	require.NoError(t, r.Step(context.Background()))
+	require.Equal(t, []types.ReportType{types.ReportType_REPORT_TYPE_DIGEST}, chain.reports)
+}
+
+func TestReporterStepFrozenSnapshot(t *testing.T) {
+	chain := newMockChain(testNodes)
+	r := reporter.NewReporter(chain, testSource(), testConfig(), tmlog.NewNopLogger())
+
+	require.NoError(t, r.Step(context.Background()))
+	require.Equal(t, []types.ReportType{types.ReportType_REPORT_TYPE_DIGEST}, chain.reports)
+
+	// a node created after the digest is left out of the epoch.
+	chain.nodes = append(append([]types.Node{}, testNodes...), types.Node{Id: "node-d", ComputingPower: 100})
+	require.NoError(t, r.Step(context.Background()))
+	require.Equal(t, []types.ReportType{
+		types.ReportType_REPORT_TYPE_DIGEST,
+		types.ReportType_REPORT_TYPE_EMISSION,
+		types.ReportType_REPORT_TYPE_EMISSION,
+		types.ReportType_REPORT_TYPE_END,
+	}, chain.reports)
+	require.Equal(t, []types.BatchBase{{BatchId: 1, Count: 2}, {BatchId: 2, Count: 1}}, chain.progress.Batches)
+}
+
+func TestReporterStepNodeSetChanged(t *testing.T) {
+	digest, err := reporter.BuildDigest(1, testNodes, testRatios, 2)
+	require.NoError(t, err)
+
+	// the reporter restarts after the digest and a node was created since.
+	chain := newMockChain(append(append([]types.Node{}, testNodes...), types.Node{Id: "node-d", ComputingPower: 100}))
+	chain.progress.Digest = digest
+	chain.progress.StandBy = false
+	chain.progress.EpochEmission = sdk.NewDec(1000)
+
+	r := reporter.NewReporter(chain, testSource(), testConfig(), tmlog.NewNopLogger())
+	require.Error(t, r.Step(context.Background()))
+	require.Empty(t, chain.reports)
+}
+
+func TestCheckEmissionBatches(t *testing.T) {
+	digest, err := reporter.BuildDigest(1, testNodes, testRatios, 2)
+	require.NoError(t, err)
+	batches := reporter.BuildEmissionBatches(1, reporter.NodeEmissions(sdk.NewDec(1000), testNodes, testRatios), 2)
+
+	require.NoError(t, reporter.CheckEmissionBatches(digest, nil, batches))
+	require.NoError(t, reporter.CheckEmissionBatches(digest, []types.BatchBase{{BatchId: 1, Count: 2}}, batches))
+
+	// a batch missing from the node set
+	require.Error(t, reporter.CheckEmissionBatches(digest, nil, batches[:1]))
+	// a committed batch of a different size
+	require.Error(t, reporter.CheckEmissionBatches(digest, []types.BatchBase{{BatchId: 1, Count: 1}}, batches))
+	// a committed batch unknown to the node set
+	require.Error(t, reporter.CheckEmissionBatches(digest, []types.BatchBase{{BatchId: 3, Count: 1}}, batches))
+
+	// the node count differs with the same batch count
+	digest.TotalNodeCount = 4
+	require.Error(t, reporter.CheckEmissionBatches(digest, nil, batches))
+}
+
+func TestParseEpochPhases(t *testing.T) {
+	busy := types.EventEpochPhase{EpochId: 2, Phase: types.EpochPhase_EPOCH_PHASE_BUSY}
+	event, err := sdk.TypedEventToEvent(&busy)
+	require.NoError(t, err)
+
+	data := tmtypes.EventDataNewBlock{
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: []abci.Event{{Type: "transfer"}}},
+		ResultEndBlock:   abci.ResponseEndBlock{Events: []abci.Event{abci.Event(event)}},
+	}
+	require.Equal(t, []types.EventEpochPhase{busy}, reporter.ParseEpochPhases(data))
+	require.Empty(t, reporter.ParseEpochPhases(tmtypes.EventDataTx{}))
+}
@@ -0,0 +1,130 @@
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// Source provides the power-on ratios of the captain nodes measured off-chain.
+type Source interface {
+	// PowerOnRatios returns the power-on ratio of the nodes on an epoch. Nodes
+	// left out are reported with a zero ratio.
+	PowerOnRatios(ctx context.Context, epochID uint64) ([]types.NodePowerOnRatio, error)
+}
+
+// NewSource returns an HTTPSource for http(s) URLs and a FileSource otherwise.
+func NewSource(location string) Source {
+	if strings.HasPrefix(location, "http://") || strings.HasPrefix(location, "https://") {
+		return NewHTTPSource(location)
+	}
+	return NewFileSource(location)
+}
+
+var _ Source = &FileSource{}
+
+// FileSource reads the power-on ratios from a JSON file, e.g.
+//
+//	[{"node_id": "...", "on_operation_ratio": "0.95"}]
+//
+// The file is read again on every epoch so it can be refreshed in place.
+type FileSource struct {
+	path string
+}
+
+// NewFileSource creates the FileSource
+func NewFileSource(path string) *FileSource {
+	return &FileSource{path: path}
+}
+
+// PowerOnRatios implements Source
+func (s *FileSource) PowerOnRatios(_ context.Context, _ uint64) ([]types.NodePowerOnRatio, error) {
+	bz, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	return decodePowerOnRatios(bz)
+}
+
+var _ Source = &HTTPSource{}
+
+// HTTPSource fetches the power-on ratios from "<url>?epoch=<epoch>", the
+// response body has the same layout as the FileSource.
+type HTTPSource struct {
+	url    string
+	client *http.Client
+}
+
+// NewHTTPSource creates the HTTPSource
+func NewHTTPSource(url string) *HTTPSource {
+	return &HTTPSource{
+		url:    url,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// PowerOnRatios implements Source
+func (s *HTTPSource) PowerOnRatios(ctx context.Context, epochID uint64) ([]types.NodePowerOnRatio, error) {
+	u, err := url.Parse(s.url)
+	if err != nil {
+		return nil, err
+	}
+	query := u.Query()
+	query.Set("epoch", strconv.FormatUint(epochID, 10))
+	u.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("power-on ratio source responded %s", resp.Status)
+	}
+
+	bz, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return decodePowerOnRatios(bz)
+}
+
+// decodePowerOnRatios decodes and checks a JSON list of power-on ratios.
+func decodePowerOnRatios(bz []byte) ([]types.NodePowerOnRatio, error) {
+	var ratios []types.NodePowerOnRatio
+	if err := json.Unmarshal(bz, &ratios); err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool, len(ratios))
+	for _, ratio := range ratios {
+		if ratio.NodeId == "" {
+			return nil, fmt.Errorf("node id is empty")
+		}
+		if seen[ratio.NodeId] {
+			return nil, fmt.Errorf("duplicate node id %s", ratio.NodeId)
+		}
+		if ratio.OnOperationRatio.IsNil() || ratio.OnOperationRatio.IsNegative() || ratio.OnOperationRatio.GT(sdk.OneDec()) {
+			return nil, fmt.Errorf("node %s power-on ratio must be within [0, 1]", ratio.NodeId)
+		}
+		seen[ratio.NodeId] = true
+	}
+
+	return ratios, nil
+}
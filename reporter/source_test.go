@@ -0,0 +1,89 @@
+package reporter_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tabilabs/tabi/reporter"
+)
+
+func TestFileSource(t *testing.T) {
+	testCases := []struct {
+		name      string
+		contents  string
+		expectErr bool
+	}{
+		{
+			name:     "success",
+			contents: `[{"node_id": "node-a", "on_operation_ratio": "0.5"}, {"node_id": "node-b", "on_operation_ratio": "1"}]`,
+		},
+		{
+			name:      "fail: ratio above one",
+			contents:  `[{"node_id": "node-a", "on_operation_ratio": "1.5"}]`,
+			expectErr: true,
+		},
+		{
+			name:      "fail: duplicate node",
+			contents:  `[{"node_id": "node-a", "on_operation_ratio": "0.5"}, {"node_id": "node-a", "on_operation_ratio": "1"}]`,
+			expectErr: true,
+		},
+		{
+			name:      "fail: missing node id",
+			contents:  `[{"on_operation_ratio": "0.5"}]`,
+			expectErr: true,
+		},
+		{
+			name:      "fail: not a list",
+			contents:  `{}`,
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "ratios.json")
+			require.NoError(t, os.WriteFile(path, []byte(tc.contents), 0o600))
+
+			ratios, err := reporter.NewSource(path).PowerOnRatios(context.Background(), 1)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, ratios, 2)
+			require.Equal(t, "node-a", ratios[0].NodeId)
+			require.Equal(t, sdk.NewDecWithPrec(5, 1), ratios[0].OnOperationRatio)
+		})
+	}
+
+	_, err := reporter.NewFileSource(filepath.Join(t.TempDir(), "missing.json")).PowerOnRatios(context.Background(), 1)
+	require.Error(t, err)
+}
+
+func TestHTTPSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("epoch") != "7" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`[{"node_id": "node-a", "on_operation_ratio": "0.25"}]`))
+	}))
+	defer server.Close()
+
+	source := reporter.NewSource(server.URL + "/ratios")
+
+	ratios, err := source.PowerOnRatios(context.Background(), 7)
+	require.NoError(t, err)
+	require.Len(t, ratios, 1)
+	require.Equal(t, sdk.NewDecWithPrec(25, 2), ratios[0].OnOperationRatio)
+
+	_, err = source.PowerOnRatios(context.Background(), 8)
+	require.Error(t, err)
+}
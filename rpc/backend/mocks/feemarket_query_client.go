@@ -77,6 +77,66 @@ func (_m *FeeMarketQueryClient) BlockGas(ctx context.Context, in *types.QueryBlo
 	return r0, r1
 }
 
+// FeeDistribution provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) FeeDistribution(ctx context.Context, in *types.QueryFeeDistributionRequest, opts ...grpc.CallOption) (*types.QueryFeeDistributionResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryFeeDistributionResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryFeeDistributionRequest, ...grpc.CallOption) *types.QueryFeeDistributionResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryFeeDistributionResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryFeeDistributionRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MinGasPriceController provides a mock function with given fields: ctx, in, opts
+func (_m *FeeMarketQueryClient) MinGasPriceController(ctx context.Context, in *types.QueryMinGasPriceControllerRequest, opts ...grpc.CallOption) (*types.QueryMinGasPriceControllerResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryMinGasPriceControllerResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryMinGasPriceControllerRequest, ...grpc.CallOption) *types.QueryMinGasPriceControllerResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryMinGasPriceControllerResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryMinGasPriceControllerRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Params provides a mock function with given fields: ctx, in, opts
 func (_m *FeeMarketQueryClient) Params(ctx context.Context, in *types.QueryParamsRequest, opts ...grpc.CallOption) (*types.QueryParamsResponse, error) {
 	_va := make([]interface{}, len(opts))
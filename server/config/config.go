@@ -3,6 +3,7 @@ package config
 import (
 	"errors"
 	"fmt"
+	"net"
 	"path"
 	"time"
 
@@ -87,6 +88,9 @@ const (
 
 	// DefaultFeeHistoryLifetime is cache fee history lifetime.
 	DefaultFeeHistoryLifetime = 10 * 60
+
+	// DefaultObservabilityAddress is the default address the Prometheus observability exporter binds to.
+	DefaultObservabilityAddress = "127.0.0.1:8877"
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -100,6 +104,8 @@ type Config struct {
 	JSONRPC JSONRPCConfig `mapstructure:"json-rpc"`
 	TLS     TLSConfig     `mapstructure:"tls"`
 	CACHE   CacheConfig   `mapstructure:"cache"`
+
+	Observability ObservabilityConfig `mapstructure:"observability"`
 }
 
 // EVMConfig defines the application configuration values for the EVM.
@@ -172,6 +178,14 @@ type CacheConfig struct {
 	FeeHistoryLifetime int64 `mapstructure:"fee-history-lifetime"`
 }
 
+// ObservabilityConfig defines the configuration of the Prometheus observability exporter.
+type ObservabilityConfig struct {
+	// Enable defines if the observability exporter should be enabled.
+	Enable bool `mapstructure:"enable"`
+	// Address defines the observability exporter server to listen on
+	Address string `mapstructure:"address"`
+}
+
 // AppConfig helps to override default appConfig template and configs.
 // return "", nil if no custom configuration is required for the application.
 func AppConfig(denom string) (string, interface{}) {
@@ -201,6 +215,8 @@ func AppConfig(denom string) (string, interface{}) {
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
 		CACHE:   *DefaultCache(),
+
+		Observability: *DefaultObservabilityConfig(),
 	}
 
 	customAppTemplate := config.DefaultConfigTemplate + DefaultConfigTemplate
@@ -216,6 +232,8 @@ func DefaultConfig() *Config {
 		JSONRPC: *DefaultJSONRPCConfig(),
 		TLS:     *DefaultTLSConfig(),
 		CACHE:   *DefaultCache(),
+
+		Observability: *DefaultObservabilityConfig(),
 	}
 }
 
@@ -384,6 +402,27 @@ func (c CacheConfig) Validate() error {
 	return nil
 }
 
+// DefaultObservabilityConfig returns the default observability configuration
+func DefaultObservabilityConfig() *ObservabilityConfig {
+	return &ObservabilityConfig{
+		Enable:  false,
+		Address: DefaultObservabilityAddress,
+	}
+}
+
+// Validate returns an error if the observability exporter address is invalid.
+func (c ObservabilityConfig) Validate() error {
+	if !c.Enable {
+		return nil
+	}
+
+	if _, _, err := net.SplitHostPort(c.Address); err != nil {
+		return fmt.Errorf("invalid observability address %s: %w", c.Address, err)
+	}
+
+	return nil
+}
+
 // GetConfig returns a fully parsed Config object.
 func GetConfig(v *viper.Viper) (Config, error) {
 	cfg, err := config.GetConfig(v)
@@ -430,6 +469,10 @@ func GetConfig(v *viper.Viper) (Config, error) {
 			FeeHistoryMaxSize:  v.GetInt("cache.fee-history-max-size"),
 			FeeHistoryLifetime: v.GetInt64("cache.fee-history-lifetime"),
 		},
+		Observability: ObservabilityConfig{
+			Enable:  v.GetBool("observability.enable"),
+			Address: v.GetString("observability.address"),
+		},
 	}, nil
 }
 
@@ -460,5 +503,9 @@ func (c Config) ValidateBasic() error {
 		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid cache config value: %s", err.Error())
 	}
 
+	if err := c.Observability.Validate(); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrAppConfig, "invalid observability config value: %s", err.Error())
+	}
+
 	return c.Config.ValidateBasic()
 }
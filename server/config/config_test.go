@@ -11,4 +11,32 @@ func TestDefaultConfig(t *testing.T) {
 	require.True(t, cfg.JSONRPC.Enable)
 	require.Equal(t, cfg.JSONRPC.Address, DefaultJSONRPCAddress)
 	require.Equal(t, cfg.JSONRPC.WsAddress, DefaultJSONRPCWsAddress)
+	require.False(t, cfg.Observability.Enable)
+	require.Equal(t, cfg.Observability.Address, DefaultObservabilityAddress)
+}
+
+func TestObservabilityConfigValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		cfg     ObservabilityConfig
+		expPass bool
+	}{
+		{"default", *DefaultObservabilityConfig(), true},
+		{"enabled", ObservabilityConfig{Enable: true, Address: DefaultObservabilityAddress}, true},
+		{"enabled on all interfaces", ObservabilityConfig{Enable: true, Address: ":8877"}, true},
+		{"disabled with invalid address", ObservabilityConfig{Enable: false, Address: "invalid"}, true},
+		{"enabled with invalid address", ObservabilityConfig{Enable: true, Address: "invalid"}, false},
+		{"enabled with empty address", ObservabilityConfig{Enable: true}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.cfg.Validate()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
 }
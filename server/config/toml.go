@@ -112,4 +112,18 @@ block-results-lifetime = {{ .CACHE.BlockResultsLifetime }}
 fee-history-max-size = {{ .CACHE.FeeHistoryMaxSize }}
 # Cache fee history lifetime(unit seconds).
 fee-history-lifetime = {{ .CACHE.FeeHistoryLifetime }}
+
+###############################################################################
+###                         Observability Configuration                     ###
+###############################################################################
+
+[observability]
+
+# Enable defines if the Prometheus observability exporter should be enabled. It serves the
+# transactions per second, the EndBlocker timings per module and the captains epoch gauges.
+enable = {{ .Observability.Enable }}
+
+# Address defines the observability exporter address to bind to.
+# Prometheus metrics path: /metrics
+address = "{{ .Observability.Address }}"
 `
@@ -0,0 +1,99 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/tabilabs/tabi/reporter"
+)
+
+const (
+	flagMaxNodesPerBatch = "max-nodes-per-batch"
+	flagRetries          = "retries"
+	flagRetryInterval    = "retry-interval"
+	flagPollInterval     = "poll-interval"
+)
+
+func NewReporterCmd() *cobra.Command {
+	defaultCfg := reporter.DefaultConfig()
+
+	cmd := &cobra.Command{
+		Use:   "reporter [power-on-ratio-source]",
+		Short: "Run the captains epoch reporter",
+		Long: `Run the captains epoch reporter, it commits the reports of every epoch with the key of an authorized member:
+		- digest: committed while the epoch stands by, with the nodes split in batches of --max-nodes-per-batch nodes.
+		- emission: the batches of node emissions, committed once the epoch is busy.
+		- end: committed after the last emission batch.
+
+		The power-on ratios of the nodes are read from the source, either a JSON file or an http(s) URL queried with the epoch.
+		The reporter follows the epoch phase events of the node, the reports already committed on chain are skipped so it can be restarted at any time.
+		`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			// the reports are committed one by one, waiting for each of them to be
+			// included keeps the account sequence in line.
+			clientCtx = clientCtx.WithBroadcastMode(flags.BroadcastBlock).WithSkipConfirmation(true)
+
+			cfg := reporter.DefaultConfig()
+			if cfg.MaxNodesPerBatch, err = cmd.Flags().GetUint64(flagMaxNodesPerBatch); err != nil {
+				return err
+			}
+			if cfg.Retries, err = cmd.Flags().GetUint64(flagRetries); err != nil {
+				return err
+			}
+			if cfg.RetryInterval, err = cmd.Flags().GetDuration(flagRetryInterval); err != nil {
+				return err
+			}
+			if cfg.PollInterval, err = cmd.Flags().GetDuration(flagPollInterval); err != nil {
+				return err
+			}
+			if err := cfg.Validate(); err != nil {
+				return err
+			}
+
+			logger := serverCtx.Logger.With("module", "reporter")
+			ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			defer cancel()
+
+			ws := ConnectTmWS(clientCtx.NodeURI, "/websocket", logger)
+			if ws == nil {
+				return fmt.Errorf("failed to create the tendermint websocket client for %s", clientCtx.NodeURI)
+			}
+			defer func() {
+				if err := ws.Stop(); err != nil {
+					logger.Error("failed to stop the tendermint websocket client", "error", err.Error())
+				}
+			}()
+
+			phases, err := reporter.SubscribeEpochPhases(ctx, ws, logger)
+			if err != nil {
+				return fmt.Errorf("failed to subscribe to the epoch phases at %s: %w", clientCtx.NodeURI, err)
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			r := reporter.NewReporter(reporter.NewChain(clientCtx, txf), reporter.NewSource(args[0]), cfg, logger)
+			return r.Run(ctx, phases)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagMaxNodesPerBatch, defaultCfg.MaxNodesPerBatch, "Maximum number of nodes in an emission batch")
+	cmd.Flags().Uint64(flagRetries, defaultCfg.Retries, "Number of times a failed report is committed again")
+	cmd.Flags().Duration(flagRetryInterval, defaultCfg.RetryInterval, "Wait between two attempts to commit a report")
+	cmd.Flags().Duration(flagPollInterval, defaultCfg.PollInterval, "Wait between two checks of the epoch without epoch phase event")
+	return cmd
+}
@@ -47,6 +47,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/server/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	cmdcfg "github.com/tabilabs/tabi/cmd/config"
 	"github.com/tabilabs/tabi/indexer"
 	ethdebug "github.com/tabilabs/tabi/rpc/namespaces/ethereum/debug"
 	"github.com/tabilabs/tabi/server/config"
@@ -411,6 +412,19 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 		return err
 	}
 
+	if config.Observability.Enable {
+		obsSrv, err := cmdcfg.EnableObservability(config.Observability.Address, logger)
+		if err != nil {
+			logger.Error("failed to start the observability exporter", "error", err.Error())
+			return err
+		}
+		defer func() {
+			if err := obsSrv.Close(); err != nil {
+				logger.Error("observability exporter shutdown produced a warning", "error", err.Error())
+			}
+		}()
+	}
+
 	// Enable metrics if JSONRPC is enabled and --metrics is passed
 	// Flag not added in config to avoid user enabling in config without passing in CLI
 	if config.JSONRPC.Enable && ctx.Viper.GetBool(srvflags.JSONRPCEnableMetrics) {
@@ -54,6 +54,9 @@ func AddCommands(
 
 		// custom tx indexer command
 		NewIndexTxCmd(),
+
+		// captains epoch reporter command
+		NewReporterCmd(),
 	)
 }
 
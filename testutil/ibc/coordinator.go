@@ -0,0 +1,97 @@
+package ibc
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tabilabs/tabi/app"
+	"github.com/tabilabs/tabi/crypto/ethsecp256k1"
+	"github.com/tabilabs/tabi/testutil"
+	feemarkettypes "github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+// Chain identifiers of the test chains, the EVM only supports the Tabi chain
+// identifiers.
+const (
+	ChainIDA = "tabi_9788-1"
+	ChainIDB = "tabi_9789-1"
+)
+
+// SenderBalance is the balance of the sender account of each test chain.
+var SenderBalance = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000_000_000))
+
+// SetupTestingApp returns a Tabi app for the ibctesting chains. The base fee
+// is disabled as the ibctesting transactions do not pay fees.
+func SetupTestingApp() (ibctesting.TestingApp, map[string]json.RawMessage) {
+	testingApp, genesis := app.SetupTestingApp()
+
+	feemarketGenesis := feemarkettypes.DefaultGenesisState()
+	feemarketGenesis.Params.NoBaseFee = true
+	genesis[feemarkettypes.ModuleName] = testingApp.AppCodec().MustMarshalJSON(feemarketGenesis)
+
+	return testingApp, genesis
+}
+
+// NewCoordinator returns a coordinator of two Tabi chains, ChainIDA and
+// ChainIDB. The sender account of each chain is replaced by a funded
+// eth_secp256k1 account, the only key type accepted by the ante handler.
+func NewCoordinator(t *testing.T) *ibctesting.Coordinator {
+	ibctesting.DefaultTestingAppInit = SetupTestingApp
+
+	coord := &ibctesting.Coordinator{
+		T:           t,
+		CurrentTime: time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+		Chains:      make(map[string]*ibctesting.TestChain),
+	}
+
+	for _, chainID := range []string{ChainIDA, ChainIDB} {
+		chain := ibctesting.NewTestChain(t, coord, chainID)
+		setupSender(t, chain)
+		coord.Chains[chainID] = chain
+		coord.CommitBlock(chain)
+	}
+
+	return coord
+}
+
+// NewTransferPath returns a path between the transfer ports of the chains.
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+// GetTabiApp returns the Tabi app of the chain.
+func GetTabiApp(chain *ibctesting.TestChain) *app.Tabi {
+	tabiApp, ok := chain.App.(*app.Tabi)
+	require.True(chain.T, ok)
+
+	return tabiApp
+}
+
+func setupSender(t *testing.T, chain *ibctesting.TestChain) {
+	privKey, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+
+	tabiApp := GetTabiApp(chain)
+	ctx := chain.GetContext()
+	addr := sdk.AccAddress(privKey.PubKey().Address())
+
+	acc := tabiApp.AccountKeeper.NewAccountWithAddress(ctx, addr)
+	require.NoError(t, acc.SetPubKey(privKey.PubKey()))
+	tabiApp.AccountKeeper.SetAccount(ctx, acc)
+	require.NoError(t, testutil.FundAccount(ctx, tabiApp.BankKeeper, addr, SenderBalance))
+
+	chain.SenderPrivKey = privKey
+	chain.SenderAccount = tabiApp.AccountKeeper.GetAccount(ctx, addr)
+}
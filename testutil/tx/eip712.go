@@ -30,7 +30,7 @@ type typedDataArgs struct {
 	chainID        uint64
 	data           []byte
 	legacyFeePayer sdk.AccAddress
-	legacyMsg      sdk.Msg
+	legacyMsgs     []sdk.Msg
 }
 
 type signatureV2Args struct {
@@ -86,6 +86,9 @@ func PrepareEIP712CosmosTx(
 	}
 
 	fee := legacytx.NewStdFee(txArgs.Gas, txArgs.Fees) //nolint: staticcheck
+	if txArgs.FeeGranter != nil {
+		fee.Granter = txArgs.FeeGranter.String()
+	}
 
 	msgs := txArgs.Msgs
 	data := legacytx.StdSignBytes(ctx.ChainID(), accNumber, nonce, 0, fee, msgs, "", nil)
@@ -94,7 +97,7 @@ func PrepareEIP712CosmosTx(
 		chainID:        chainIDNum,
 		data:           data,
 		legacyFeePayer: from,
-		legacyMsg:      msgs[0],
+		legacyMsgs:     msgs,
 	}
 	typedData, err := createTypedData(typedDataArgs, args.UseLegacyTypedData)
 	if err != nil {
@@ -108,6 +111,7 @@ func PrepareEIP712CosmosTx(
 	}
 
 	builder.SetFeeAmount(fee.Amount)
+	builder.SetFeeGranter(txArgs.FeeGranter)
 	builder.SetGasLimit(txArgs.Gas)
 
 	err = builder.SetMsgs(txArgs.Msgs...)
@@ -200,7 +204,7 @@ func createTypedData(args typedDataArgs, useLegacy bool) (apitypes.TypedData, er
 		return eip712.LegacyWrapTxToTypedData(
 			evmosCodec,
 			args.chainID,
-			args.legacyMsg,
+			args.legacyMsgs,
 			args.data,
 			feeDelegation,
 		)
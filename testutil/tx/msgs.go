@@ -0,0 +1,158 @@
+package tx
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/tabilabs/tabi/types"
+	captainstypes "github.com/tabilabs/tabi/x/captains/types"
+	erc20types "github.com/tabilabs/tabi/x/erc20/types"
+	tokenconverttypes "github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+// tabiMsgTypeURLPrefix is the type URL prefix of the Msgs of the Tabi modules.
+const tabiMsgTypeURLPrefix = "/tabi."
+
+// NewTabiMsgs returns the messages of every Msg of the Tabi modules registered in
+// the interface registry which can be signed with Amino JSON, sorted by type URL.
+// The string fields of the messages are set to the signer address, so that it
+// is the single signer of every message, the other fields are left unset, as
+// decoded from a tx. The Msgs which can not be encoded unset are replaced by
+// samples.
+func NewTabiMsgs(registry codectypes.InterfaceRegistry, signer sdk.AccAddress) ([]sdk.Msg, error) {
+	samples, err := newTabiMsgSamples(signer)
+	if err != nil {
+		return nil, err
+	}
+
+	typeURLs := registry.ListImplementations(sdk.MsgInterfaceProtoName)
+	sort.Strings(typeURLs)
+
+	var msgs []sdk.Msg
+	for _, typeURL := range typeURLs {
+		if !strings.HasPrefix(typeURL, tabiMsgTypeURLPrefix) {
+			continue
+		}
+
+		if sample, found := samples[typeURL]; found {
+			msgs = append(msgs, sample...)
+			continue
+		}
+
+		resolved, err := registry.Resolve(typeURL)
+		if err != nil {
+			return nil, err
+		}
+
+		msg, ok := resolved.(legacytx.LegacyMsg)
+		if !ok {
+			continue
+		}
+
+		value := reflect.ValueOf(msg).Elem()
+		if value.Kind() != reflect.Struct {
+			return nil, fmt.Errorf("unexpected Msg kind %s for %s", value.Kind(), typeURL)
+		}
+		for i := 0; i < value.NumField(); i++ {
+			if field := value.Field(i); field.Kind() == reflect.String && field.CanSet() {
+				field.SetString(signer.String())
+			}
+		}
+
+		// round trip the message through its proto encoding, so that the unset
+		// fields match the ones of a message decoded from a tx
+		bz, err := proto.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		if err := proto.Unmarshal(bz, msg); err != nil {
+			return nil, err
+		}
+
+		msgs = append(msgs, msg)
+	}
+
+	return msgs, nil
+}
+
+// newTabiMsgSamples returns the samples of the Msgs which can not be encoded unset,
+// by type URL. The report of MsgCommitReport is an Any, which can not be encoded
+// unset, the sender of MsgConvertERC20 is a hex address and the legacy EIP-712
+// typed data can not encode a coin without denom.
+func newTabiMsgSamples(signer sdk.AccAddress) (map[string][]sdk.Msg, error) {
+	reports := []struct {
+		reportType captainstypes.ReportType
+		report     any
+	}{
+		{
+			reportType: captainstypes.ReportType_REPORT_TYPE_DIGEST,
+			report: &captainstypes.ReportDigest{
+				EpochId:                  1,
+				TotalBatchCount:          2,
+				TotalNodeCount:           3,
+				MaximumNodeCountPerBatch: 2,
+				GlobalOnOperationRatio:   sdk.NewDecWithPrec(5, 1),
+			},
+		},
+		{
+			reportType: captainstypes.ReportType_REPORT_TYPE_BATCH,
+			report: &captainstypes.ReportBatch{
+				EpochId:   1,
+				BatchId:   1,
+				NodeCount: 2,
+				Nodes: []captainstypes.NodePowerOnRatio{
+					{NodeId: "captain-node-1", OnOperationRatio: sdk.OneDec()},
+					{NodeId: "captain-node-2", OnOperationRatio: sdk.NewDecWithPrec(5, 1)},
+				},
+			},
+		},
+		{
+			reportType: captainstypes.ReportType_REPORT_TYPE_EMISSION,
+			report: &captainstypes.ReportEmission{
+				EpochId:   1,
+				BatchId:   1,
+				NodeCount: 1,
+				Nodes: []captainstypes.NodeEpochEmission{
+					{NodeId: "captain-node-1", NodeEmission: sdk.NewDecCoin(types.AttoVeTabi, sdk.NewInt(10))},
+				},
+			},
+		},
+		{
+			reportType: captainstypes.ReportType_REPORT_TYPE_END,
+			report:     &captainstypes.ReportEnd{EpochId: 1},
+		},
+	}
+
+	commitReports := make([]sdk.Msg, 0, len(reports))
+	for _, report := range reports {
+		msg, err := captainstypes.NewMsgCommitReport(signer.String(), report.reportType, report.report)
+		if err != nil {
+			return nil, err
+		}
+		commitReports = append(commitReports, msg)
+	}
+
+	return map[string][]sdk.Msg{
+		sdk.MsgTypeURL(&captainstypes.MsgCommitReport{}): commitReports,
+		sdk.MsgTypeURL(&erc20types.MsgConvertCoin{}): {
+			erc20types.NewMsgConvertCoin(types.NewTabiCoinInt64(100), common.BytesToAddress(signer), signer),
+		},
+		sdk.MsgTypeURL(&erc20types.MsgConvertERC20{}): {
+			erc20types.NewMsgConvertERC20(sdk.NewInt(100), signer, common.BytesToAddress(signer), common.BytesToAddress(signer)),
+		},
+		sdk.MsgTypeURL(&tokenconverttypes.MsgConvertTabi{}): {
+			tokenconverttypes.NewMsgConvertTabi(types.NewTabiCoinInt64(100), signer),
+		},
+		sdk.MsgTypeURL(&tokenconverttypes.MsgConvertVetabi{}): {
+			tokenconverttypes.NewMsgConvertVetabi(types.NewVeTabiCoinInt64(100), signer, tokenconverttypes.Strategy90Days),
+		},
+	}, nil
+}
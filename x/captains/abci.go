@@ -1,20 +1,28 @@
 package captains
 
 import (
+	"time"
+
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/tabilabs/tabi/x/captains/keeper"
+	"github.com/tabilabs/tabi/x/captains/types"
 )
 
 // BeginBlocker runs at the start of each block
 func BeginBlocker(ctx sdk.Context, _ abci.RequestBeginBlock, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
 	k.BeginBlocker(ctx)
 }
 
 // EndBlocker runs at the end of each block
 func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k keeper.Keeper) []abci.ValidatorUpdate {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
 	k.EndBlocker(ctx)
 	return []abci.ValidatorUpdate{}
 }
@@ -3,6 +3,7 @@ package cli
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -31,8 +32,12 @@ func GetQueryCmd() *cobra.Command {
 		GetDivisionsCmd(),
 		GetNodeCmd(),
 		GetNodesCmd(),
+		GetNodeDivisionHistoryCmd(),
 		GetSaleLevelCmd(),
 		GetAuthorizedMembersCmd(),
+		GetScheduleCmd(),
+		GetEmissionForecastCmd(),
+		GetReportProgressCmd(),
 	)
 	return captionNodeQueryCmd
 }
@@ -150,6 +155,48 @@ $ %s query %s nodes --owner <owner>
 	return cmd
 }
 
+// GetNodeDivisionHistoryCmd returns the command to query the division history of a node
+func GetNodeDivisionHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "node-division-history [node-id]",
+		Short: "Query the division history of a node",
+		Long: fmt.Sprintf(`Query the division history of a node
+
+Example:
+$ %s query %s node-division-history <node-id>
+`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.NodeDivisionHistory(context.Background(),
+				&types.QueryNodeDivisionHistoryRequest{
+					NodeId:     args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "node-division-history")
+	return cmd
+}
+
 // GetDivisionCmd returns the command to query a division
 func GetDivisionCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -310,3 +357,136 @@ func GetAuthorizedMembersCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetScheduleCmd returns the command to query the sale level and halving era timeline
+func GetScheduleCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Query the sale level and halving era timeline",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Schedule(context.Background(), &types.QueryScheduleRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetEmissionForecastCmd returns the command to project the emission of a node
+func GetEmissionForecastCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "emission-forecast [node-id]",
+		Short: "Project the emission of a node under hypothetical inputs",
+		Long: fmt.Sprintf(`Project the computing power, emission share and rewards of a node on the next epochs.
+The projection never changes the state.
+
+Example:
+$ %s query %s emission-forecast <node-id> --power-on-ratio=0.9 --pledge-amount=1000000000000000000 --epochs=10
+`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			powerOnRatio, err := cmd.Flags().GetString(FlagPowerOnRatio)
+			if err != nil {
+				return err
+			}
+			pledgeAmount, err := cmd.Flags().GetString(FlagPledgeAmount)
+			if err != nil {
+				return err
+			}
+			extraComputingPower, err := cmd.Flags().GetUint64(FlagExtraComputingPower)
+			if err != nil {
+				return err
+			}
+			saleLevel, err := cmd.Flags().GetUint64(FlagSaleLevel)
+			if err != nil {
+				return err
+			}
+			globalOnOperationRatio, err := cmd.Flags().GetString(FlagGlobalOnOperationRatio)
+			if err != nil {
+				return err
+			}
+			epochs, err := cmd.Flags().GetUint64(FlagEpochs)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EmissionForecast(context.Background(), &types.QueryEmissionForecastRequest{
+				NodeId:                 args[0],
+				PowerOnRatio:           powerOnRatio,
+				PledgeAmount:           pledgeAmount,
+				ExtraComputingPower:    extraComputingPower,
+				SaleLevel:              saleLevel,
+				GlobalOnOperationRatio: globalOnOperationRatio,
+				Epochs:                 epochs,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagPowerOnRatio, "", "The hypothetical power-on ratio of the node, defaults to 1")
+	cmd.Flags().String(FlagPledgeAmount, "", "The hypothetical pledge of the node owner, defaults to the sampled pledge")
+	cmd.Flags().Uint64(FlagExtraComputingPower, 0, "The hypothetical computing power added to the node")
+	cmd.Flags().Uint64(FlagSaleLevel, 0, "The hypothetical sale level, defaults to the current sale level")
+	cmd.Flags().String(FlagGlobalOnOperationRatio, "", "The hypothetical global on-operation ratio, defaults to 1")
+	cmd.Flags().Uint64(FlagEpochs, 1, "The number of epochs to forecast")
+	return cmd
+}
+
+// GetReportProgressCmd returns the command to query the reports committed on an epoch
+func GetReportProgressCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report-progress [epoch]",
+		Short: "Query the reports committed on an epoch",
+		Long: fmt.Sprintf(`Query the digest, the emission batches and the end report committed on an epoch
+
+Example:
+$ %s query %s report-progress 1
+`, version.AppName, types.ModuleName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			epoch, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ReportProgress(context.Background(), &types.QueryReportProgressRequest{Epoch: epoch})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
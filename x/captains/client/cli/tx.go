@@ -38,6 +38,8 @@ func GetTxCmd() *cobra.Command {
 		NewTxCmdCommitComputingPower(),
 		NewTxCmdClaimComputingPower(),
 		NewTxCmdDraftReport(),
+		NewTxCmdSubmitNodeMisbehavior(),
+		NewTxCmdUnjailNode(),
 	)
 
 	return captionNodeTxCmd
@@ -334,3 +336,95 @@ func NewTxCmdDraftReport() *cobra.Command {
 	}
 	return cmd
 }
+
+// NewTxCmdSubmitNodeMisbehavior returns a command to submit the misbehavior of a node
+func NewTxCmdSubmitNodeMisbehavior() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "submit-node-misbehavior [node-id] [downtime,fraud] [evidence] --from [sender]",
+		Args:  cobra.ExactArgs(3),
+		Short: "Submit the misbehavior of a node and penalize it",
+		Long: strings.TrimSpace(fmt.Sprintf(`
+			$ %s tx %s submit-node-misbehavior <node-id> fraud <evidence> --penalty-epochs=3 --slash-fraction=0.5 --jail --from <sender> --chain-id <chain-id>`, version.AppName, types.ModuleName),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+
+			misbehaviorType, err := parseMisbehaviorType(args[1])
+			if err != nil {
+				return err
+			}
+
+			penaltyEpochs, err := cmd.Flags().GetUint64(FlagPenaltyEpochs)
+			if err != nil {
+				return err
+			}
+
+			slashFractionStr, err := cmd.Flags().GetString(FlagSlashFraction)
+			if err != nil {
+				return err
+			}
+			slashFraction, err := sdk.NewDecFromStr(slashFractionStr)
+			if err != nil {
+				return err
+			}
+
+			jail, err := cmd.Flags().GetBool(FlagJail)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitNodeMisbehavior(
+				sender,
+				strings.TrimSpace(args[0]),
+				misbehaviorType,
+				args[2],
+				penaltyEpochs,
+				slashFraction,
+				jail,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(FlagPenaltyEpochs, 0, "The number of epochs the node earns no emission")
+	cmd.Flags().String(FlagSlashFraction, "0", "The fraction of unclaimed emission to confiscate")
+	cmd.Flags().Bool(FlagJail, false, "Exclude the node from power calculation until it is unjailed")
+	return cmd
+}
+
+// NewTxCmdUnjailNode returns a command to unjail a node
+func NewTxCmdUnjailNode() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unjail-node [node-id] --from [sender]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Release a jailed node",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+
+			msg := types.NewMsgUnjailNode(sender, strings.TrimSpace(args[0]))
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
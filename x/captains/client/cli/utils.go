@@ -15,6 +15,17 @@ const (
 	FlagOwner      = "owner"
 	FlagReportType = "report-type"
 
+	FlagPowerOnRatio           = "power-on-ratio"
+	FlagPledgeAmount           = "pledge-amount"
+	FlagExtraComputingPower    = "extra-computing-power"
+	FlagSaleLevel              = "sale-level"
+	FlagGlobalOnOperationRatio = "global-on-operation-ratio"
+	FlagEpochs                 = "epochs"
+
+	FlagPenaltyEpochs = "penalty-epochs"
+	FlagSlashFraction = "slash-fraction"
+	FlagJail          = "jail"
+
 	ReportTypeDigest   = "digest"
 	ReportTypeBatch    = "batch"
 	ReportTypeEmission = "emission"
@@ -119,3 +130,14 @@ func draftReport(reportType string) error {
 	}
 	return fmt.Errorf("report type %s is not supported\n", reportType)
 }
+
+func parseMisbehaviorType(misbehavior string) (types.MisbehaviorType, error) {
+	switch strings.ToLower(strings.TrimSpace(misbehavior)) {
+	case "downtime":
+		return types.MisbehaviorType_MISBEHAVIOR_TYPE_DOWNTIME, nil
+	case "fraud":
+		return types.MisbehaviorType_MISBEHAVIOR_TYPE_FRAUD, nil
+	default:
+		return types.MisbehaviorType_MISBEHAVIOR_TYPE_UNSPECIFIED, fmt.Errorf("invalid misbehavior type: %s", misbehavior)
+	}
+}
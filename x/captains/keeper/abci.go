@@ -21,6 +21,7 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 		k.delReportDigest(ctx, epoch)
 		k.delEndEpoch(ctx, epoch)
 		k.delReportBatches(ctx, epoch)
+		k.delEpochFeePoolEmission(ctx, epoch)
 
 		// Let's enter new epoch!
 		k.incrEpoch(ctx)
@@ -31,12 +32,18 @@ func (k Keeper) BeginBlocker(ctx sdk.Context) {
 			sdk.NewEvent(
 				types.EventTypeBeginBlock,
 				sdk.NewAttribute(types.AttributeKeyEpochID, fmt.Sprintf("%d", epoch)),
-				sdk.NewAttribute(types.EventTypeEpochPhase, "into_stand_by"),
+				sdk.NewAttribute(types.AttributeKeyEpochPhase, "into_stand_by"),
 			),
 		})
+		k.emitEpochPhase(ctx, epoch+1, types.EpochPhase_EPOCH_PHASE_STAND_BY)
 
-		// TODO: add telemetry
+		recordPhaseTransition(ctx)
 	}
+
+	k.recordEpochMetrics(ctx)
+
+	// unlock sale levels and enter halving eras as scheduled.
+	k.ApplySchedule(ctx)
 }
 
 // EndBlocker called every block, process the report digest if exists.
@@ -56,11 +63,14 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 				sdk.NewEvent(
 					types.EventTypeEndBlock,
 					sdk.NewAttribute(types.AttributeKeyEpochID, fmt.Sprintf("%d", epoch)),
-					sdk.NewAttribute(types.EventTypeEpochPhase, "fail_into_busy"),
+					sdk.NewAttribute(types.AttributeKeyEpochPhase, "fail_into_busy"),
 				),
 			})
+			k.emitEpochPhase(ctx, epoch, types.EpochPhase_EPOCH_PHASE_BUSY_FAILED)
 		}
 
+		wasStandBy := k.IsStandByPhase(ctx)
+
 		// TODO: once we enter in busy phrase, we won't go back until
 		// report ends. Considering if we need way to go back manually.
 		// FIXME: this should exec only once in each report though executing
@@ -70,8 +80,25 @@ func (k Keeper) EndBlocker(ctx sdk.Context) {
 			sdk.NewEvent(
 				types.EventTypeEndBlock,
 				sdk.NewAttribute(types.AttributeKeyEpochID, fmt.Sprintf("%d", epoch)),
-				sdk.NewAttribute(types.EventTypeEpochPhase, "into_busy"),
+				sdk.NewAttribute(types.AttributeKeyEpochPhase, "into_busy"),
 			),
 		})
+
+		// the digest is kept until the epoch ends, only the first run enters the busy phase.
+		if wasStandBy {
+			recordPhaseTransition(ctx)
+			k.emitEpochPhase(ctx, epoch, types.EpochPhase_EPOCH_PHASE_BUSY)
+		}
+		k.recordEpochMetrics(ctx)
+	}
+}
+
+// emitEpochPhase emits the typed event of an epoch phase transition.
+func (k Keeper) emitEpochPhase(ctx sdk.Context, epochID uint64, phase types.EpochPhase) {
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventEpochPhase{
+		EpochId: epochID,
+		Phase:   phase,
+	}); err != nil {
+		k.Logger(ctx).Error("failed to emit epoch phase event", "epoch", epochID, "error", err.Error())
 	}
 }
@@ -0,0 +1,13 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// afterNodeMisbehavior delegate the call to the hooks. If no hook has been registered, this function returns with a `nil` error
+func (k Keeper) afterNodeMisbehavior(ctx sdk.Context, nodeID string, confiscated sdk.Dec, jailed bool) error {
+	if k.hooks == nil {
+		return nil
+	}
+	return k.hooks.AfterNodeMisbehavior(ctx, nodeID, confiscated, jailed)
+}
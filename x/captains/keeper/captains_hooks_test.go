@@ -0,0 +1,49 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+var _ types.CaptainsHooks = &MockCaptainsHooks{}
+
+// MockCaptainsHooks records the hook calls.
+type MockCaptainsHooks struct {
+	calls []string
+}
+
+func (h *MockCaptainsHooks) AfterNodeMisbehavior(_ sdk.Context, nodeID string, confiscated sdk.Dec, jailed bool) error {
+	h.calls = append(h.calls, fmt.Sprintf("node_misbehavior:%s:%s:%t", nodeID, confiscated, jailed))
+	return nil
+}
+
+func (suite *IntegrationTestSuite) TestCaptainsHooksMisbehavior() {
+	hooks := &MockCaptainsHooks{}
+	member := accounts[0].String()
+	nodeID := suite.utilsCreateCaptainNode(accounts[1].String(), 1)
+	suite.Keeper.CleanHooks().SetHooks(types.NewMultiCaptainsHooks(hooks))
+
+	// move to epoch 2 with some unclaimed emission of epoch 1.
+	baseState := suite.Keeper.GetBaseState(suite.Ctx)
+	baseState.EpochId = 2
+	suite.Keeper.SetBaseState(suite.Ctx, &baseState)
+	suite.Keeper.SetNodeEmissionByEpoch(suite.Ctx, 1, nodeID, "1000")
+
+	_, err := suite.MsgServer.SubmitNodeMisbehavior(suite.Ctx, types.NewMsgSubmitNodeMisbehavior(
+		member, nodeID, types.MisbehaviorType_MISBEHAVIOR_TYPE_FRAUD,
+		"evidence", 2, sdk.NewDecWithPrec(5, 1), true,
+	))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{fmt.Sprintf("node_misbehavior:%s:%s:true", nodeID, sdk.NewDec(500))}, hooks.calls)
+
+	// a failed submission fires no hook
+	_, err = suite.MsgServer.SubmitNodeMisbehavior(suite.Ctx, types.NewMsgSubmitNodeMisbehavior(
+		member, "unknown", types.MisbehaviorType_MISBEHAVIOR_TYPE_FRAUD,
+		"evidence", 2, sdk.NewDecWithPrec(5, 1), true,
+	))
+	suite.Require().Error(err)
+	suite.Require().Len(hooks.calls, 1)
+}
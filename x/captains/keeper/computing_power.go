@@ -123,10 +123,47 @@ func (k Keeper) CommitComputingPower(ctx sdk.Context, amount uint64, owner sdk.A
 	if after < before || after < 0 {
 		return 0, 0, errorsmod.Wrap(types.ErrTypeOverflow, "")
 	}
+	committed := k.GetGlobalCommittedComputingPower(ctx)
+	if committed+amount < committed {
+		return 0, 0, errorsmod.Wrap(types.ErrTypeOverflow, "")
+	}
 	k.setClaimableComputingPower(ctx, after, owner)
+	k.SetGlobalCommittedComputingPower(ctx, committed+amount)
 	return before, after, nil
 }
 
+// GetGlobalCommittedComputingPower returns the computing power committed to the owners.
+func (k Keeper) GetGlobalCommittedComputingPower(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalCommittedComputingPowerKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetGlobalCommittedComputingPower sets the computing power committed to the owners.
+func (k Keeper) SetGlobalCommittedComputingPower(ctx sdk.Context, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GlobalCommittedComputingPowerKey, sdk.Uint64ToBigEndian(amount))
+}
+
+// GetGlobalClaimedComputingPower returns the committed computing power claimed into nodes.
+func (k Keeper) GetGlobalClaimedComputingPower(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GlobalClaimedComputingPowerKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetGlobalClaimedComputingPower sets the committed computing power claimed into nodes.
+func (k Keeper) SetGlobalClaimedComputingPower(ctx sdk.Context, amount uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GlobalClaimedComputingPowerKey, sdk.Uint64ToBigEndian(amount))
+}
+
 // decrClaimableComputingPower decrements the claimable computing power of an owner.
 func (k Keeper) decrClaimableComputingPower(ctx sdk.Context, amount uint64, owner sdk.AccAddress) {
 	power := k.GetClaimableComputingPower(ctx, owner)
@@ -1,6 +1,9 @@
 package keeper
 
 import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	errorsmod "cosmossdk.io/errors"
@@ -87,3 +90,89 @@ func (k Keeper) decrDivisionTotalCount(ctx sdk.Context, division types.Division)
 		k.setDivision(ctx, division)
 	}
 }
+
+// upgradeNodeDivision moves the node into the division matching its computing power.
+// NOTE: the node stays where it is if no division covers its computing power.
+func (k Keeper) upgradeNodeDivision(ctx sdk.Context, node *types.Node, currDivision types.Division) {
+	nextDivision := k.DecideDivision(ctx, node.ComputingPower)
+	if nextDivision.Id == "" || nextDivision.Id == currDivision.Id {
+		return
+	}
+
+	k.decrDivisionTotalCount(ctx, currDivision)
+	k.incrDivisionTotalCount(ctx, nextDivision)
+
+	node.DivisionId = nextDivision.Id
+	k.appendNodeDivisionChange(ctx, *node, currDivision.Id)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDivisionUpgraded,
+		sdk.NewAttribute(types.AttributeKeyNodeID, node.Id),
+		sdk.NewAttribute(types.AttributeKeyDivisionBefore, currDivision.Id),
+		sdk.NewAttribute(types.AttributeKeyDivisionAfter, nextDivision.Id),
+		sdk.NewAttribute(types.AttributeKeyComputingPower, fmt.Sprintf("%d", node.ComputingPower)),
+	))
+}
+
+// appendNodeDivisionChange records the node entering its current division.
+func (k Keeper) appendNodeDivisionChange(ctx sdk.Context, node types.Node, fromDivisionID string) {
+	k.setNodeDivisionChange(ctx, types.NodeDivisionChange{
+		NodeId:         node.Id,
+		Sequence:       k.getNextNodeDivisionChangeSequence(ctx, node.Id),
+		FromDivisionId: fromDivisionID,
+		ToDivisionId:   node.DivisionId,
+		ComputingPower: node.ComputingPower,
+		EpochId:        k.GetCurrentEpoch(ctx),
+		Height:         ctx.BlockHeight(),
+	})
+}
+
+// getNextNodeDivisionChangeSequence returns the sequence of the next division change of the node.
+func (k Keeper) getNextNodeDivisionChangeSequence(ctx sdk.Context, nodeID string) uint64 {
+	iterator := k.getNodeDivisionHistoryPrefixStore(ctx, nodeID).ReverseIterator(nil, nil)
+	defer iterator.Close()
+	if !iterator.Valid() {
+		return 1
+	}
+	return sdk.BigEndianToUint64(iterator.Key()) + 1
+}
+
+// setNodeDivisionChange sets a division change of the node.
+func (k Keeper) setNodeDivisionChange(ctx sdk.Context, change types.NodeDivisionChange) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&change)
+	store.Set(types.NodeDivisionHistoryStoreKey(change.NodeId, change.Sequence), bz)
+}
+
+// GetNodeDivisionHistory returns the division changes of the node in order.
+func (k Keeper) GetNodeDivisionHistory(ctx sdk.Context, nodeID string) (history []types.NodeDivisionChange) {
+	iterator := k.getNodeDivisionHistoryPrefixStore(ctx, nodeID).Iterator(nil, nil)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.NodeDivisionChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		history = append(history, change)
+	}
+	return history
+}
+
+// getNodeDivisionHistoryPrefixStore returns the store for the division changes of the node.
+func (k Keeper) getNodeDivisionHistoryPrefixStore(ctx sdk.Context, nodeID string) prefix.Store {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, types.NodeDivisionHistoryPrefixStoreKey(nodeID))
+}
+
+// Genesis Export/Import Helpers
+
+// GetNodesDivisionHistory returns the division changes of all nodes.
+func (k Keeper) GetNodesDivisionHistory(ctx sdk.Context) (history []types.NodeDivisionChange) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.NodeDivisionHistoryKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var change types.NodeDivisionChange
+		k.cdc.MustUnmarshal(iterator.Value(), &change)
+		history = append(history, change)
+	}
+	return history
+}
@@ -110,3 +110,80 @@ func (suite *IntegrationTestSuite) TestDecideDivision() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestUpgradeNodeDivision() {
+	owner := accounts[1].String()
+	divisions := suite.utilsGetDivisions()
+
+	testCases := []struct {
+		name          string
+		claimAmount   uint64
+		expectLevel   uint64
+		expectHistory int
+	}{
+		{
+			name:          "success - stay within division",
+			claimAmount:   1000,
+			expectLevel:   1,
+			expectHistory: 1,
+		},
+		{
+			name:          "success - upgrade to next division",
+			claimAmount:   10000,
+			expectLevel:   2,
+			expectHistory: 2,
+		},
+		{
+			name:          "success - upgrade across divisions",
+			claimAmount:   200000,
+			expectLevel:   4,
+			expectHistory: 2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			nodeID := suite.utilsCreateCaptainNode(owner, 1)
+			suite.utilsCommitPower(owner, tc.claimAmount)
+
+			before1, _ := suite.Keeper.GetDivision(suite.Ctx, divisions[1])
+			beforeN, _ := suite.Keeper.GetDivision(suite.Ctx, divisions[tc.expectLevel])
+
+			_, err := suite.MsgServer.ClaimComputingPower(suite.Ctx, &types.MsgClaimComputingPower{
+				Sender:               accounts[0].String(),
+				ComputingPowerAmount: tc.claimAmount,
+				NodeId:               nodeID,
+			})
+			suite.Require().NoError(err)
+
+			node, found := suite.Keeper.GetNode(suite.Ctx, nodeID)
+			suite.Require().True(found)
+			suite.Require().Equal(divisions[tc.expectLevel], node.DivisionId)
+
+			history := suite.Keeper.GetNodeDivisionHistory(suite.Ctx, nodeID)
+			suite.Require().Len(history, tc.expectHistory)
+			suite.Require().Equal(node.DivisionId, history[len(history)-1].ToDivisionId)
+
+			after1, _ := suite.Keeper.GetDivision(suite.Ctx, divisions[1])
+			afterN, _ := suite.Keeper.GetDivision(suite.Ctx, divisions[tc.expectLevel])
+			if tc.expectLevel == 1 {
+				suite.Require().Equal(before1.TotalCount, after1.TotalCount)
+				return
+			}
+
+			suite.Require().Equal(before1.TotalCount-1, after1.TotalCount)
+			suite.Require().Equal(beforeN.TotalCount+1, afterN.TotalCount)
+			// sold count tracks sales and is kept as is.
+			suite.Require().Equal(before1.SoldCount, after1.SoldCount)
+			suite.Require().Equal(beforeN.SoldCount, afterN.SoldCount)
+
+			upgraded := false
+			for _, event := range suite.Ctx.EventManager().Events() {
+				if event.Type == types.EventTypeDivisionUpgraded {
+					upgraded = true
+				}
+			}
+			suite.Require().True(upgraded)
+		})
+	}
+}
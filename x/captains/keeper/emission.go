@@ -41,6 +41,53 @@ func (k Keeper) CalcEpochEmission(ctx sdk.Context, epochID uint64, globalOperati
 	return base.Mul(pledgeRatio).Mul(globalOperationRatio)
 }
 
+// GetFeePoolEmission returns the fees redirected by the fee market pending to
+// top up the emission of the next epoch.
+func (k Keeper) GetFeePoolEmission(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.FeePoolEmissionKey)
+	if len(bz) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.MustNewDecFromStr(string(bz))
+}
+
+// SetFeePoolEmission sets the fees redirected by the fee market pending to top
+// up the emission of the next epoch.
+func (k Keeper) SetFeePoolEmission(ctx sdk.Context, amount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.FeePoolEmissionKey, []byte(amount.String()))
+}
+
+// IncrFeePoolEmission increases the fee pool emission by amount.
+func (k Keeper) IncrFeePoolEmission(ctx sdk.Context, amount sdk.Dec) sdk.Dec {
+	emission := k.GetFeePoolEmission(ctx).Add(amount)
+	k.SetFeePoolEmission(ctx, emission)
+	return emission
+}
+
+// getEpochFeePoolEmission returns the fee pool emission topping up the emission of an epoch.
+func (k Keeper) getEpochFeePoolEmission(ctx sdk.Context, epochID uint64) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.EpochFeePoolEmissionStoreKey(epochID))
+	if len(bz) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.MustNewDecFromStr(string(bz))
+}
+
+// setEpochFeePoolEmission sets the fee pool emission topping up the emission of an epoch.
+func (k Keeper) setEpochFeePoolEmission(ctx sdk.Context, epochID uint64, amount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.EpochFeePoolEmissionStoreKey(epochID), []byte(amount.String()))
+}
+
+// delEpochFeePoolEmission deletes the fee pool emission topping up the emission of an epoch.
+func (k Keeper) delEpochFeePoolEmission(ctx sdk.Context, epochID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.EpochFeePoolEmissionStoreKey(epochID))
+}
+
 // setEpochEmission sets the emission sum for an epoch.
 func (k Keeper) setEpochEmission(ctx sdk.Context, epochID uint64, amount sdk.Dec) {
 	store := ctx.KVStore(k.storeKey)
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// ForecastNodeEmission projects the emission of a node on the next epochs under hypothetical inputs.
+// NOTE: the projection runs in a cache context and never writes to the state.
+func (k Keeper) ForecastNodeEmission(
+	ctx sdk.Context,
+	input types.EmissionForecastInput,
+) ([]types.EpochEmissionForecast, sdk.Dec, error) {
+	total := sdk.ZeroDec()
+	cacheCtx, _ := ctx.CacheContext()
+
+	node, found := k.GetNode(cacheCtx, input.NodeID)
+	if !found {
+		return nil, total, types.ErrNodeNotExists.Wrapf("not found node: %s", input.NodeID)
+	}
+	owner, _ := k.GetNodeOwner(cacheCtx, node.Id)
+
+	if input.ExtraComputingPower > 0 {
+		node.ComputingPower += input.ExtraComputingPower
+		if node.ComputingPower < input.ExtraComputingPower {
+			return nil, total, types.ErrTypeOverflow.Wrapf("node computing power overflow: %s", node.Id)
+		}
+		if err := k.setNode(cacheCtx, node); err != nil {
+			return nil, total, err
+		}
+	}
+
+	if input.SaleLevel != 0 {
+		params := k.GetParams(cacheCtx)
+		params.CurrentSaleLevel = input.SaleLevel
+		if err := k.SetParams(cacheCtx, params); err != nil {
+			return nil, total, err
+		}
+	}
+
+	epoch := k.GetCurrentEpoch(cacheCtx)
+
+	// the power of other nodes is taken from the latest reported epoch.
+	otherPower := k.GetGlobalComputingPowerOnEpoch(cacheCtx, epoch-1).
+		Sub(k.GetNodeComputingPowerOnEpoch(cacheCtx, epoch-1, node.Id))
+	if otherPower.IsNegative() {
+		otherPower = sdk.ZeroDec()
+	}
+
+	// the pledge is taken from the latest sampled epoch.
+	pledgeEpoch := epoch + 1
+	if !k.HasGlobalPledge(cacheCtx, pledgeEpoch) {
+		pledgeEpoch = epoch
+	}
+	ownerPledge := k.GetOwnerPledge(cacheCtx, owner, pledgeEpoch)
+	globalPledge := k.GetGlobalPledge(cacheCtx, pledgeEpoch)
+	if input.PledgeAmount != nil {
+		globalPledge = globalPledge.Sub(ownerPledge).Add(*input.PledgeAmount)
+		if globalPledge.IsNegative() {
+			globalPledge = sdk.ZeroDec()
+		}
+		ownerPledge = *input.PledgeAmount
+	}
+
+	forecasts := make([]types.EpochEmissionForecast, 0, input.Epochs)
+	for i := uint64(1); i <= input.Epochs; i++ {
+		epochID := epoch + i
+
+		k.setEpoch(cacheCtx, epochID)
+		k.ApplySchedule(cacheCtx)
+		k.SetOwnerPledge(cacheCtx, owner, epochID, ownerPledge)
+		k.SetGlobalPledge(cacheCtx, epochID, globalPledge)
+
+		power := k.CalcNodeComputingPowerOnEpoch(cacheCtx, epochID, node.Id, input.PowerOnRatio)
+		globalPower := otherPower.Add(power)
+		share := sdk.ZeroDec()
+		if globalPower.IsPositive() {
+			share = power.Quo(globalPower)
+		}
+
+		emission := k.CalcEpochEmission(cacheCtx, epochID, input.GlobalOnOperationRatio)
+		nodeEmission := emission.Mul(share)
+		total = total.Add(nodeEmission)
+
+		forecasts = append(forecasts, types.EpochEmissionForecast{
+			EpochId:              epochID,
+			NodeComputingPower:   power,
+			GlobalComputingPower: globalPower,
+			EmissionShare:        share,
+			EpochEmission:        emission,
+			NodeEmission:         nodeEmission,
+		})
+	}
+
+	return forecasts, total, nil
+}
@@ -59,6 +59,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	for _, cp := range data.OwnersClaimableComputingPower {
 		k.setClaimableComputingPower(ctx, cp.Amount, sdk.MustAccAddressFromBech32(cp.Owner))
 	}
+	// genesis without the committed totals accounts the claimable power as committed.
+	if data.BaseState.GlobalCommittedComputingPower == 0 && data.BaseState.GlobalClaimedComputingPower == 0 {
+		k.SetGlobalCommittedComputingPower(ctx, types.SumClaimableComputingPower(data.OwnersClaimableComputingPower))
+	}
 	for _, gcp := range data.GlobalsComputingPower {
 		k.setGlobalComputingPowerOnEpoch(ctx, gcp.EpochId, gcp.Amount)
 	}
@@ -70,6 +74,28 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 	for _, batch := range data.Batches {
 		k.setReportBatch(ctx, data.BaseState.EpochId, batch.BatchId, batch.Count)
 	}
+
+	// set division history
+	for _, change := range data.NodesDivisionHistory {
+		k.setNodeDivisionChange(ctx, change)
+	}
+
+	// set slash info
+	for _, info := range data.NodesSlashInfo {
+		k.setNodeSlashInfo(ctx, info)
+	}
+
+	// set pledge checkpoints
+	for _, cp := range data.OwnersPledgeCheckpoint {
+		k.setOwnerPledgeCheckpoint(ctx, cp)
+	}
+	for _, dp := range data.OwnersDelegationPledge {
+		valAddr, err := sdk.ValAddressFromBech32(dp.Validator)
+		if err != nil {
+			panic(fmt.Errorf("failed to set owner delegation pledge: %s", err.Error()))
+		}
+		k.setOwnerDelegationPledge(ctx, sdk.MustAccAddressFromBech32(dp.Owner), valAddr, dp.Amount)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -88,6 +114,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		GlobalsComputingPower:         k.GetGlobalsComputingPower(ctx),
 		NodesComputingPower:           k.GetNodesComputingPower(ctx),
 		Batches:                       k.GetReportBatches(ctx, k.GetCurrentEpoch(ctx)),
+		NodesDivisionHistory:          k.GetNodesDivisionHistory(ctx),
+		NodesSlashInfo:                k.GetNodesSlashInfo(ctx),
+		OwnersPledgeCheckpoint:        k.GetOwnersPledgeCheckpoint(ctx),
+		OwnersDelegationPledge:        k.GetOwnersDelegationPledge(ctx),
 	}
 }
 
@@ -112,6 +142,13 @@ func (k Keeper) GetBaseState(ctx sdk.Context) types.BaseState {
 		baseState.IsStandBy = true
 	}
 
+	baseState.LastHalvingEraEpoch = k.GetLastHalvingEraEpoch(ctx)
+	baseState.CommunityPoolEmission = k.GetCommunityPoolEmission(ctx)
+	baseState.FeePoolEmission = k.GetFeePoolEmission(ctx)
+	baseState.EpochFeePoolEmission = k.getEpochFeePoolEmission(ctx, epochId)
+	baseState.GlobalCommittedComputingPower = k.GetGlobalCommittedComputingPower(ctx)
+	baseState.GlobalClaimedComputingPower = k.GetGlobalClaimedComputingPower(ctx)
+
 	return baseState
 }
 
@@ -131,4 +168,22 @@ func (k Keeper) SetBaseState(ctx sdk.Context, bs *types.BaseState) {
 	if !bs.IsStandBy {
 		k.setStandByOverFlag(ctx)
 	}
+	if bs.LastHalvingEraEpoch != 0 {
+		k.setLastHalvingEraEpoch(ctx, bs.LastHalvingEraEpoch)
+	}
+	if !bs.CommunityPoolEmission.IsNil() && !bs.CommunityPoolEmission.IsZero() {
+		k.SetCommunityPoolEmission(ctx, bs.CommunityPoolEmission)
+	}
+	if !bs.FeePoolEmission.IsNil() && !bs.FeePoolEmission.IsZero() {
+		k.SetFeePoolEmission(ctx, bs.FeePoolEmission)
+	}
+	if !bs.EpochFeePoolEmission.IsNil() && !bs.EpochFeePoolEmission.IsZero() {
+		k.setEpochFeePoolEmission(ctx, bs.EpochId, bs.EpochFeePoolEmission)
+	}
+	if bs.GlobalCommittedComputingPower != 0 {
+		k.SetGlobalCommittedComputingPower(ctx, bs.GlobalCommittedComputingPower)
+	}
+	if bs.GlobalClaimedComputingPower != 0 {
+		k.SetGlobalClaimedComputingPower(ctx, bs.GlobalClaimedComputingPower)
+	}
 }
@@ -133,6 +133,36 @@ func (q Querier) NodeLastEpochInfo(
 	}, nil
 }
 
+// NodeDivisionHistory queries the division changes of a node
+func (q Querier) NodeDivisionHistory(
+	goCtx context.Context,
+	request *types.QueryNodeDivisionHistoryRequest,
+) (*types.QueryNodeDivisionHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !q.HasNode(ctx, request.NodeId) {
+		return nil, types.ErrNodeNotExists.Wrapf("not found node: %s", request.NodeId)
+	}
+
+	var history []types.NodeDivisionChange
+	pageRes, err := query.Paginate(q.getNodeDivisionHistoryPrefixStore(ctx, request.NodeId), request.Pagination,
+		func(_ []byte, value []byte) error {
+			var change types.NodeDivisionChange
+			if err := q.cdc.Unmarshal(value, &change); err != nil {
+				return err
+			}
+			history = append(history, change)
+			return nil
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryNodeDivisionHistoryResponse{
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Division queries an node division by its ID
 func (q Querier) Division(
 	goCtx context.Context,
@@ -248,6 +278,68 @@ func (q Querier) EpochStatus(
 	}, nil
 }
 
+// ReportProgress queries the reports committed on an epoch.
+func (q Querier) ReportProgress(
+	goCtx context.Context,
+	request *types.QueryReportProgressRequest,
+) (*types.QueryReportProgressResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	digest, _ := q.Keeper.GetReportDigest(ctx, request.Epoch)
+
+	return &types.QueryReportProgressResponse{
+		Epoch:         request.Epoch,
+		Digest:        digest,
+		StandBy:       request.Epoch == q.Keeper.GetCurrentEpoch(ctx) && q.Keeper.IsStandByPhase(ctx),
+		EpochEmission: q.Keeper.GetEpochEmission(ctx, request.Epoch),
+		Batches:       q.Keeper.GetReportBatches(ctx, request.Epoch),
+		Ended:         q.Keeper.HasEndEpoch(ctx, request.Epoch),
+	}, nil
+}
+
+// Schedule queries the sale level and halving era timeline.
+func (q Querier) Schedule(
+	goCtx context.Context,
+	_ *types.QueryScheduleRequest,
+) (*types.QueryScheduleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.GetParams(ctx)
+
+	return &types.QueryScheduleResponse{
+		CurrentEpoch:                 q.GetCurrentEpoch(ctx),
+		CurrentSaleLevel:             params.CurrentSaleLevel,
+		CurrentHalvingEraCoefficient: params.HalvingEraCoefficient,
+		SaleLevels:                   q.GetSaleLevelTimeline(ctx),
+		HalvingEras:                  q.GetHalvingEraTimeline(ctx),
+	}, nil
+}
+
+// EmissionForecast projects the emission of a node under hypothetical inputs.
+func (q Querier) EmissionForecast(
+	goCtx context.Context,
+	request *types.QueryEmissionForecastRequest,
+) (*types.QueryEmissionForecastResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	input, err := types.NewEmissionForecastInput(request)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	forecasts, total, err := q.ForecastNodeEmission(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryEmissionForecastResponse{
+		Forecasts:         forecasts,
+		TotalNodeEmission: total,
+	}, nil
+}
+
 func (q Querier) ClaimableComputingPower(
 	goCtx context.Context,
 	request *types.QueryClaimableComputingPowerRequest,
@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gogo/protobuf/proto"
+
 	"github.com/tabilabs/tabi/x/captains/types"
 )
 
@@ -394,3 +398,210 @@ func (suite *IntegrationTestSuite) TestCurrentEpoch() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestReportProgress() {
+	nodes := suite.utilsBatchCreateCaptainNode(accounts[1].String(), 1, 3)
+	epoch := suite.Keeper.GetCurrentEpoch(suite.Ctx)
+
+	commit := func(reportType types.ReportType, report proto.Message) {
+		anyVal, err := cdctypes.NewAnyWithValue(report)
+		suite.Require().NoError(err)
+		_, err = suite.MsgServer.CommitReport(suite.Ctx, &types.MsgCommitReport{
+			Authority:  accounts[0].String(),
+			ReportType: reportType,
+			Report:     anyVal,
+		})
+		suite.Require().NoError(err)
+		suite.Keeper.EndBlocker(suite.Ctx)
+	}
+
+	resp, err := suite.QueryClient.ReportProgress(suite.Ctx, &types.QueryReportProgressRequest{Epoch: epoch})
+	suite.Require().NoError(err)
+	suite.Require().Nil(resp.Digest)
+	suite.Require().True(resp.StandBy)
+	suite.Require().Empty(resp.Batches)
+
+	digest := types.ReportDigest{
+		EpochId:                  epoch,
+		TotalBatchCount:          2,
+		TotalNodeCount:           3,
+		MaximumNodeCountPerBatch: 2,
+		GlobalOnOperationRatio:   sdk.OneDec(),
+	}
+	commit(types.ReportType_REPORT_TYPE_DIGEST, &digest)
+
+	// the second batch is committed first, it is keyed by its own id.
+	commit(types.ReportType_REPORT_TYPE_EMISSION, &types.ReportEmission{
+		EpochId:   epoch,
+		BatchId:   2,
+		NodeCount: 1,
+		Nodes: []types.NodeEpochEmission{
+			{NodeId: nodes[2], NodeEmission: sdk.NewDecCoin("avetabi", sdk.NewInt(100))},
+		},
+	})
+
+	resp, err = suite.QueryClient.ReportProgress(suite.Ctx, &types.QueryReportProgressRequest{Epoch: epoch})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&digest, resp.Digest)
+	suite.Require().False(resp.StandBy)
+	suite.Require().True(resp.EpochEmission.IsPositive())
+	suite.Require().Equal([]types.BatchBase{{BatchId: 2, Count: 1}}, resp.Batches)
+	suite.Require().False(resp.Ended)
+
+	commit(types.ReportType_REPORT_TYPE_END, &types.ReportEnd{EpochId: epoch})
+
+	resp, err = suite.QueryClient.ReportProgress(suite.Ctx, &types.QueryReportProgressRequest{Epoch: epoch})
+	suite.Require().NoError(err)
+	suite.Require().True(resp.Ended)
+}
+
+func (suite *IntegrationTestSuite) TestQueryNodeDivisionHistory() {
+	owner := accounts[1].String()
+	divisions := suite.utilsGetDivisions()
+
+	nodeID := suite.utilsCreateCaptainNode(owner, 1)
+	suite.utilsCommitPower(owner, 10000)
+	_, err := suite.MsgServer.ClaimComputingPower(suite.Ctx, &types.MsgClaimComputingPower{
+		Sender:               accounts[0].String(),
+		ComputingPowerAmount: 10000,
+		NodeId:               nodeID,
+	})
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name      string
+		req       *types.QueryNodeDivisionHistoryRequest
+		expectErr bool
+	}{
+		{
+			name: "success: node exist",
+			req: &types.QueryNodeDivisionHistoryRequest{
+				NodeId: nodeID,
+			},
+			expectErr: false,
+		},
+		{
+			name: "failure: node does not exist",
+			req: &types.QueryNodeDivisionHistoryRequest{
+				NodeId: "unknown-node-id",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp, err := suite.QueryClient.NodeDivisionHistory(suite.Ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				suite.Require().Len(resp.History, 2)
+				suite.Require().Equal("", resp.History[0].FromDivisionId)
+				suite.Require().Equal(divisions[1], resp.History[0].ToDivisionId)
+				suite.Require().Equal(divisions[1], resp.History[1].FromDivisionId)
+				suite.Require().Equal(divisions[2], resp.History[1].ToDivisionId)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestQueryEmissionForecast() {
+	nodeID := suite.utilsCreateCaptainNode(accounts[1].String(), 1)
+	node, _ := suite.Keeper.GetNode(suite.Ctx, nodeID)
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.HalvingEraSchedule = []types.HalvingEra{{EpochId: 3, Coefficient: sdk.NewDecWithPrec(5, 1)}}
+	suite.Require().NoError(suite.Keeper.SetParams(suite.Ctx, params))
+
+	testCases := []struct {
+		name      string
+		req       *types.QueryEmissionForecastRequest
+		expectLen int
+		expectErr bool
+	}{
+		{
+			name: "success: default inputs",
+			req: &types.QueryEmissionForecastRequest{
+				NodeId: nodeID,
+			},
+			expectLen: 1,
+			expectErr: false,
+		},
+		{
+			name: "success: hypothetical inputs",
+			req: &types.QueryEmissionForecastRequest{
+				NodeId:              nodeID,
+				PowerOnRatio:        "0.5",
+				PledgeAmount:        "1000",
+				ExtraComputingPower: 10000,
+				SaleLevel:           2,
+				Epochs:              3,
+			},
+			expectLen: 3,
+			expectErr: false,
+		},
+		{
+			name: "failure: invalid power on ratio",
+			req: &types.QueryEmissionForecastRequest{
+				NodeId:       nodeID,
+				PowerOnRatio: "1.5",
+			},
+			expectErr: true,
+		},
+		{
+			name: "failure: too many epochs",
+			req: &types.QueryEmissionForecastRequest{
+				NodeId: nodeID,
+				Epochs: types.MaxEmissionForecastEpochs + 1,
+			},
+			expectErr: true,
+		},
+		{
+			name: "failure: node does not exist",
+			req: &types.QueryEmissionForecastRequest{
+				NodeId: "unknown-node-id",
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			resp, err := suite.QueryClient.EmissionForecast(suite.Ctx, tc.req)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Len(resp.Forecasts, tc.expectLen)
+			total := sdk.ZeroDec()
+			for _, forecast := range resp.Forecasts {
+				suite.Require().Equal(sdk.OneDec(), forecast.EmissionShare)
+				suite.Require().True(forecast.NodeEmission.IsPositive())
+				total = total.Add(forecast.NodeEmission)
+			}
+			suite.Require().Equal(total, resp.TotalNodeEmission)
+
+			// the state stays untouched.
+			after, _ := suite.Keeper.GetNode(suite.Ctx, nodeID)
+			suite.Require().Equal(node, after)
+			suite.Require().Equal(uint64(1), suite.Keeper.GetCurrentEpoch(suite.Ctx))
+			suite.Require().Equal(uint64(1), suite.Keeper.GetParams(suite.Ctx).CurrentSaleLevel)
+			suite.Require().Equal(uint64(0), suite.Keeper.GetLastHalvingEraEpoch(suite.Ctx))
+		})
+	}
+
+	// the halving era applies from epoch 3.
+	resp, err := suite.QueryClient.EmissionForecast(suite.Ctx, &types.QueryEmissionForecastRequest{
+		NodeId: nodeID,
+		Epochs: 2,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), resp.Forecasts[1].EpochId)
+	suite.Require().Equal(
+		resp.Forecasts[0].EpochEmission.Quo(sdk.NewDec(2)),
+		resp.Forecasts[1].EpochEmission,
+	)
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ stakingtypes.StakingHooks = Hooks{}
+
+// Hooks wrapper struct for captains keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the staking hooks which keep the owners pledge checkpoint up to date.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterDelegationModified updates the owner pledge on the validator.
+func (h Hooks) AfterDelegationModified(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if !h.k.HasOwnerPledgeCheckpoint(ctx, delAddr) {
+		return nil
+	}
+
+	delegation, found := h.k.stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return nil
+	}
+	validator, found := h.k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil
+	}
+
+	h.k.updateOwnerDelegationPledge(ctx, delAddr, valAddr, validator.TokensFromShares(delegation.GetShares()))
+	return nil
+}
+
+// BeforeDelegationRemoved removes the owner pledge on the validator.
+func (h Hooks) BeforeDelegationRemoved(ctx sdk.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error {
+	if !h.k.HasOwnerPledgeCheckpoint(ctx, delAddr) {
+		return nil
+	}
+
+	h.k.updateOwnerDelegationPledge(ctx, delAddr, valAddr, sdk.ZeroDec())
+	return nil
+}
+
+// BeforeValidatorSlashed reduces the pledge of all owners delegated to the validator by the slash fraction.
+func (h Hooks) BeforeValidatorSlashed(ctx sdk.Context, valAddr sdk.ValAddress, fraction sdk.Dec) error {
+	for _, pledge := range h.k.GetOwnersDelegationPledgeByValidator(ctx, valAddr) {
+		owner := sdk.MustAccAddressFromBech32(pledge.Owner)
+		amount := pledge.Amount.Sub(pledge.Amount.Mul(fraction))
+		h.k.updateOwnerDelegationPledge(ctx, owner, valAddr, amount)
+	}
+	return nil
+}
+
+func (h Hooks) AfterValidatorCreated(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeValidatorModified(_ sdk.Context, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorRemoved(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBonded(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) AfterValidatorBeginUnbonding(_ sdk.Context, _ sdk.ConsAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationCreated(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
+
+func (h Hooks) BeforeDelegationSharesModified(_ sdk.Context, _ sdk.AccAddress, _ sdk.ValAddress) error {
+	return nil
+}
@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// RegisterInvariants registers the captains module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "claimed-emission", ClaimedEmissionInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "division-count", DivisionCountInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "claimable-computing-power", ClaimableComputingPowerInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "node-emission", NodeEmissionInvariant(k))
+}
+
+// AllInvariants runs all invariants of the captains module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, inv := range []sdk.Invariant{
+			ClaimedEmissionInvariant(k),
+			DivisionCountInvariant(k),
+			ClaimableComputingPowerInvariant(k),
+			NodeEmissionInvariant(k),
+		} {
+			res, stop := inv(ctx)
+			if stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// ClaimedEmissionInvariant checks that the sum of nodes claimed emission equals the global claimed
+// emission plus the emission confiscated to the community pool.
+func ClaimedEmissionInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		nodesClaimed := sdk.ZeroDec()
+		for _, nce := range k.GetNodesClaimedEmission(ctx) {
+			nodesClaimed = nodesClaimed.Add(nce.Emission)
+		}
+
+		confiscated := sdk.ZeroDec()
+		for _, info := range k.GetNodesSlashInfo(ctx) {
+			confiscated = confiscated.Add(info.ConfiscatedEmission)
+		}
+
+		expected := k.GetGlobalClaimedEmission(ctx).Add(confiscated)
+		broken := !nodesClaimed.Equal(expected)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "claimed-emission",
+			fmt.Sprintf(
+				"\tsum of nodes claimed emission: %s\n"+
+					"\tglobal claimed emission plus confiscated emission: %s\n",
+				nodesClaimed, expected,
+			),
+		), broken
+	}
+}
+
+// DivisionCountInvariant checks that the counts of divisions match the actual nodes.
+func DivisionCountInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		nodes := k.GetNodes(ctx)
+		nodesByDivision := make(map[string]uint64)
+		for _, node := range nodes {
+			nodesByDivision[node.DivisionId]++
+		}
+
+		soldCount := uint64(0)
+		for _, division := range k.GetDivisions(ctx) {
+			soldCount += division.SoldCount
+			if division.SoldCount > division.InitialSupply {
+				broken = true
+				msg += fmt.Sprintf("\tdivision %s sold count %d exceeds initial supply %d\n",
+					division.Id, division.SoldCount, division.InitialSupply)
+			}
+			if division.TotalCount != nodesByDivision[division.Id] {
+				broken = true
+				msg += fmt.Sprintf("\tdivision %s total count %d mismatches actual nodes %d\n",
+					division.Id, division.TotalCount, nodesByDivision[division.Id])
+			}
+		}
+
+		if soldCount != uint64(len(nodes)) {
+			broken = true
+			msg += fmt.Sprintf("\tsum of divisions sold count %d mismatches actual nodes %d\n",
+				soldCount, len(nodes))
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "division-count", msg), broken
+	}
+}
+
+// ClaimableComputingPowerInvariant checks that the claimable computing power of the owners
+// equals the computing power committed to them which is not claimed into nodes yet.
+func ClaimableComputingPowerInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		claimable := types.SumClaimableComputingPower(k.GetClaimableComputingPowers(ctx))
+		committed := k.GetGlobalCommittedComputingPower(ctx)
+		claimed := k.GetGlobalClaimedComputingPower(ctx)
+
+		broken := claimed > committed || claimable != committed-claimed
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "claimable-computing-power",
+			fmt.Sprintf(
+				"\tsum of owners claimable computing power: %d\n"+
+					"\tcommitted computing power: %d\n"+
+					"\tclaimed computing power: %d\n",
+				claimable, committed, claimed,
+			),
+		), broken
+	}
+}
+
+// NodeEmissionInvariant checks that no node emission is reported on the current epoch
+// before the epoch emission is settled.
+func NodeEmissionInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		epochID := k.GetCurrentEpoch(ctx)
+		if !k.HasEpochEmission(ctx, epochID) {
+			for _, node := range k.GetNodes(ctx) {
+				emission := k.GetNodeEmissionByEpoch(ctx, epochID, node.Id)
+				if emission.IsPositive() {
+					broken = true
+					msg += fmt.Sprintf("\tnode %s has emission %s on epoch %d without epoch emission\n",
+						node.Id, emission, epochID)
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "node-emission", msg), broken
+	}
+}
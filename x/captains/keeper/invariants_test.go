@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/keeper"
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+func (suite *IntegrationTestSuite) TestInvariants() {
+	testCases := []struct {
+		name      string
+		malleate  func()
+		invariant func(k keeper.Keeper) sdk.Invariant
+		expBroken bool
+	}{
+		{
+			name:      "all invariants hold on fresh nodes",
+			malleate:  func() {},
+			invariant: keeper.AllInvariants,
+			expBroken: false,
+		},
+		{
+			name: "claimed emission holds when node and global claimed match",
+			malleate: func() {
+				nodeID := suite.Keeper.GetNodes(suite.Ctx)[0].Id
+				suite.Keeper.SetNodeClaimedEmission(suite.Ctx, nodeID, sdk.NewDec(100))
+				suite.Keeper.SetGlobalClaimedEmission(suite.Ctx, sdk.NewDec(100))
+			},
+			invariant: keeper.ClaimedEmissionInvariant,
+			expBroken: false,
+		},
+		{
+			name: "claimed emission broken by global claimed emission",
+			malleate: func() {
+				suite.Keeper.SetGlobalClaimedEmission(suite.Ctx, sdk.NewDec(100))
+			},
+			invariant: keeper.ClaimedEmissionInvariant,
+			expBroken: true,
+		},
+		{
+			name: "division count broken by node out of division",
+			malleate: func() {
+				node := suite.Keeper.GetNodes(suite.Ctx)[0]
+				node.Id = "orphan"
+				store := suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey))
+				store.Set(types.NodeStoreKey(node.Id), suite.App.AppCodec().MustMarshal(&node))
+			},
+			invariant: keeper.DivisionCountInvariant,
+			expBroken: true,
+		},
+		{
+			name: "claimable computing power holds after commit and claim",
+			malleate: func() {
+				owner := accounts[1]
+				suite.utilsCommitPower(owner.String(), 300)
+				nodeID := suite.Keeper.GetNodes(suite.Ctx)[0].Id
+				suite.Require().NoError(suite.Keeper.UpdateNode(suite.Ctx, nodeID, 100, owner))
+			},
+			invariant: keeper.ClaimableComputingPowerInvariant,
+			expBroken: false,
+		},
+		{
+			name: "claimable computing power broken by power not committed",
+			malleate: func() {
+				store := suite.Ctx.KVStore(suite.App.GetKey(types.StoreKey))
+				store.Set(types.ClaimableComputingPowerStoreKey(accounts[1]), sdk.Uint64ToBigEndian(100))
+			},
+			invariant: keeper.ClaimableComputingPowerInvariant,
+			expBroken: true,
+		},
+		{
+			name: "node emission broken by emission before epoch emission",
+			malleate: func() {
+				nodeID := suite.Keeper.GetNodes(suite.Ctx)[0].Id
+				epochID := suite.Keeper.GetCurrentEpoch(suite.Ctx)
+				suite.Keeper.SetNodeEmissionByEpoch(suite.Ctx, epochID, nodeID, "100")
+			},
+			invariant: keeper.NodeEmissionInvariant,
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.utilsBatchCreateCaptainNode(accounts[1].String(), 1, 3)
+
+			tc.malleate()
+
+			msg, broken := tc.invariant(*suite.Keeper)(suite.Ctx)
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
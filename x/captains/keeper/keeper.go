@@ -21,6 +21,9 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 
 	authority sdk.AccAddress
+
+	// hooks of the other modules reacting to the captain nodes
+	hooks types.CaptainsHooks
 }
 
 func NewKeeper(
@@ -43,3 +46,21 @@ func NewKeeper(
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("%s", types.ModuleName))
 }
+
+// SetHooks sets the hooks for the captains module
+// It should be called only once during initialization, it panic if called more than once.
+func (k *Keeper) SetHooks(ch types.CaptainsHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set captains hooks twice")
+	}
+
+	k.hooks = ch
+	return k
+}
+
+// CleanHooks resets the hooks for the captains module
+// NOTE: Should only be used for testing purposes
+func (k *Keeper) CleanHooks() *Keeper {
+	k.hooks = nil
+	return k
+}
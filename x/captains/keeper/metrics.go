@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"sync/atomic"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// epoch phases reported by the phase gauge.
+const (
+	phaseStandBy = 0
+	phaseBusy    = 1
+)
+
+var (
+	metricEpoch = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "tabid",
+		Subsystem: types.ModuleName,
+		Name:      "epoch",
+		Help:      "The current captains epoch",
+	})
+
+	metricEpochPhase = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: "tabid",
+		Subsystem: types.ModuleName,
+		Name:      "epoch_phase",
+		Help:      "The phase of the current epoch, 0 for stand-by and 1 for busy",
+	})
+
+	metricReportLatency = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "tabid",
+		Subsystem: types.ModuleName,
+		Name:      "report_latency_seconds",
+		Help:      "The block time elapsed since the epoch entered its current phase when the last report of each type was committed",
+	}, []string{"report_type"})
+
+	// phaseSince holds the block time in unix nanoseconds at which the current
+	// phase was entered. It is only known once a phase transition is seen by
+	// this process.
+	phaseSince atomic.Int64
+)
+
+// recordEpochMetrics updates the epoch and phase gauges.
+func (k Keeper) recordEpochMetrics(ctx sdk.Context) {
+	metricEpoch.Set(float64(k.GetCurrentEpoch(ctx)))
+
+	if k.IsStandByPhase(ctx) {
+		metricEpochPhase.Set(phaseStandBy)
+	} else {
+		metricEpochPhase.Set(phaseBusy)
+	}
+}
+
+// recordPhaseTransition marks the block time at which a new phase starts.
+func recordPhaseTransition(ctx sdk.Context) {
+	phaseSince.Store(ctx.BlockTime().UnixNano())
+}
+
+// recordReportLatency updates the latency gauge of the committed report type.
+func recordReportLatency(ctx sdk.Context, reportType string) {
+	// skip checks and simulations
+	if ctx.IsCheckTx() {
+		return
+	}
+
+	since := phaseSince.Load()
+	if since == 0 {
+		return
+	}
+
+	latency := ctx.BlockTime().UnixNano() - since
+	metricReportLatency.WithLabelValues(reportType).Set(float64(latency) / 1e9)
+}
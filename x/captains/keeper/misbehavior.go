@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// HandleNodeMisbehavior penalizes a node for a proven misbehavior and returns the confiscated emission.
+func (k Keeper) HandleNodeMisbehavior(
+	ctx sdk.Context,
+	msg *types.MsgSubmitNodeMisbehavior,
+) (types.NodeSlashInfo, sdk.Dec, error) {
+	if !k.HasNode(ctx, msg.NodeId) {
+		return types.NodeSlashInfo{}, sdk.ZeroDec(), types.ErrNodeNotExists.Wrapf("not found node: %s", msg.NodeId)
+	}
+
+	info := k.GetNodeSlashInfo(ctx, msg.NodeId)
+
+	// the node earns no emission from the current epoch on.
+	if msg.PenaltyEpochs > 0 {
+		end := k.GetCurrentEpoch(ctx) + msg.PenaltyEpochs - 1
+		if end > info.PenaltyEndEpoch {
+			info.PenaltyEndEpoch = end
+		}
+	}
+
+	confiscated := sdk.ZeroDec()
+	if msg.SlashFraction.IsPositive() {
+		confiscated = k.confiscateNodeEmission(ctx, msg.NodeId, msg.SlashFraction)
+		info.ConfiscatedEmission = info.ConfiscatedEmission.Add(confiscated)
+	}
+
+	if msg.Jail {
+		info.Jailed = true
+	}
+
+	k.setNodeSlashInfo(ctx, info)
+
+	if err := k.afterNodeMisbehavior(ctx, msg.NodeId, confiscated, info.Jailed); err != nil {
+		return types.NodeSlashInfo{}, sdk.ZeroDec(), err
+	}
+
+	return info, confiscated, nil
+}
+
+// UnjailNode releases a jailed node.
+func (k Keeper) UnjailNode(ctx sdk.Context, nodeID string) error {
+	if !k.HasNode(ctx, nodeID) {
+		return types.ErrNodeNotExists.Wrapf("not found node: %s", nodeID)
+	}
+
+	info := k.GetNodeSlashInfo(ctx, nodeID)
+	if !info.Jailed {
+		return types.ErrNodeNotJailed.Wrapf("node: %s", nodeID)
+	}
+
+	info.Jailed = false
+	k.setNodeSlashInfo(ctx, info)
+
+	return nil
+}
+
+// IsNodePenalizedOnEpoch returns if a node is excluded from power and emission on the epoch.
+func (k Keeper) IsNodePenalizedOnEpoch(ctx sdk.Context, nodeID string, epochID uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NodeSlashInfoStoreKey(nodeID))
+	if bz == nil {
+		return false
+	}
+
+	var info types.NodeSlashInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return info.Jailed || epochID <= info.PenaltyEndEpoch
+}
+
+// confiscateNodeEmission moves a fraction of the node unclaimed emission to the community pool.
+// NOTE: the confiscated emission is marked as claimed by the node so that the owner can't claim it.
+func (k Keeper) confiscateNodeEmission(ctx sdk.Context, nodeID string, fraction sdk.Dec) sdk.Dec {
+	epoch := k.GetCurrentEpoch(ctx)
+	if epoch <= 1 {
+		return sdk.ZeroDec()
+	}
+
+	claimed := k.GetNodeClaimedEmission(ctx, nodeID)
+	unclaimed := k.CalcNodeCumulativeEmissionByEpoch(ctx, epoch-1, nodeID).Sub(claimed)
+	if !unclaimed.IsPositive() {
+		return sdk.ZeroDec()
+	}
+
+	amount := unclaimed.Mul(fraction)
+	k.SetNodeClaimedEmission(ctx, nodeID, claimed.Add(amount))
+	k.SetCommunityPoolEmission(ctx, k.GetCommunityPoolEmission(ctx).Add(amount))
+	return amount
+}
+
+// GetNodeSlashInfo returns the slash info of a node.
+func (k Keeper) GetNodeSlashInfo(ctx sdk.Context, nodeID string) types.NodeSlashInfo {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.NodeSlashInfoStoreKey(nodeID))
+	if bz == nil {
+		return types.NodeSlashInfo{
+			NodeId:              nodeID,
+			ConfiscatedEmission: sdk.ZeroDec(),
+		}
+	}
+
+	var info types.NodeSlashInfo
+	k.cdc.MustUnmarshal(bz, &info)
+	return info
+}
+
+// setNodeSlashInfo sets the slash info of a node.
+func (k Keeper) setNodeSlashInfo(ctx sdk.Context, info types.NodeSlashInfo) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&info)
+	store.Set(types.NodeSlashInfoStoreKey(info.NodeId), bz)
+}
+
+// GetCommunityPoolEmission returns the confiscated emission pending for the community pool.
+func (k Keeper) GetCommunityPoolEmission(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.CommunityPoolEmissionKey)
+	if len(bz) == 0 {
+		return sdk.ZeroDec()
+	}
+	return sdk.MustNewDecFromStr(string(bz))
+}
+
+// SetCommunityPoolEmission sets the confiscated emission pending for the community pool.
+func (k Keeper) SetCommunityPoolEmission(ctx sdk.Context, amount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.CommunityPoolEmissionKey, []byte(amount.String()))
+}
+
+// Genesis State Export/Import Helpers
+
+// GetNodesSlashInfo returns the slash info of all nodes.
+func (k Keeper) GetNodesSlashInfo(ctx sdk.Context) []types.NodeSlashInfo {
+	var infos []types.NodeSlashInfo
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.NodeSlashInfoKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var info types.NodeSlashInfo
+		k.cdc.MustUnmarshal(iterator.Value(), &info)
+		infos = append(infos, info)
+	}
+	return infos
+}
@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+func (suite *IntegrationTestSuite) TestSubmitNodeMisbehavior() {
+	member := accounts[0].String()
+	nodeID := suite.utilsCreateCaptainNode(accounts[1].String(), 1)
+
+	// move to epoch 2 with some unclaimed emission of epoch 1.
+	baseState := suite.Keeper.GetBaseState(suite.Ctx)
+	baseState.EpochId = 2
+	suite.Keeper.SetBaseState(suite.Ctx, &baseState)
+	suite.Keeper.SetNodeEmissionByEpoch(suite.Ctx, 1, nodeID, "1000")
+
+	// unauthorized sender is rejected.
+	_, err := suite.MsgServer.SubmitNodeMisbehavior(suite.Ctx, types.NewMsgSubmitNodeMisbehavior(
+		accounts[2].String(), nodeID, types.MisbehaviorType_MISBEHAVIOR_TYPE_FRAUD,
+		"evidence", 2, sdk.NewDecWithPrec(5, 1), true,
+	))
+	suite.Require().Error(err)
+
+	communityPool := suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx).AmountOf(tabitypes.AttoVeTabi)
+	_, err = suite.MsgServer.SubmitNodeMisbehavior(suite.Ctx, types.NewMsgSubmitNodeMisbehavior(
+		member, nodeID, types.MisbehaviorType_MISBEHAVIOR_TYPE_FRAUD,
+		"evidence", 2, sdk.NewDecWithPrec(5, 1), true,
+	))
+	suite.Require().NoError(err)
+
+	info := suite.Keeper.GetNodeSlashInfo(suite.Ctx, nodeID)
+	suite.Require().True(info.Jailed)
+	suite.Require().Equal(uint64(3), info.PenaltyEndEpoch)
+	suite.Require().Equal(sdk.NewDec(500), info.ConfiscatedEmission)
+	suite.Require().Equal(sdk.NewDec(500), suite.Keeper.GetNodeClaimedEmission(suite.Ctx, nodeID))
+	// the claims hooks fund the community pool with the confiscated emission.
+	suite.Require().True(suite.Keeper.GetCommunityPoolEmission(suite.Ctx).IsZero())
+	suite.Require().Equal(
+		communityPool.Add(sdk.NewDec(500)),
+		suite.App.DistrKeeper.GetFeePoolCommunityCoins(suite.Ctx).AmountOf(tabitypes.AttoVeTabi),
+	)
+
+	// jailed node stays penalized after the penalty epochs.
+	suite.Require().True(suite.Keeper.IsNodePenalizedOnEpoch(suite.Ctx, nodeID, 4))
+
+	_, err = suite.MsgServer.UnjailNode(suite.Ctx, types.NewMsgUnjailNode(member, nodeID))
+	suite.Require().NoError(err)
+	suite.Require().True(suite.Keeper.IsNodePenalizedOnEpoch(suite.Ctx, nodeID, 3))
+	suite.Require().False(suite.Keeper.IsNodePenalizedOnEpoch(suite.Ctx, nodeID, 4))
+
+	// unjail a free node fails.
+	_, err = suite.MsgServer.UnjailNode(suite.Ctx, types.NewMsgUnjailNode(member, nodeID))
+	suite.Require().ErrorIs(err, types.ErrNodeNotJailed)
+
+	// unknown node fails.
+	_, err = suite.MsgServer.SubmitNodeMisbehavior(suite.Ctx, types.NewMsgSubmitNodeMisbehavior(
+		member, "unknown-node-id", types.MisbehaviorType_MISBEHAVIOR_TYPE_DOWNTIME,
+		"evidence", 1, sdk.ZeroDec(), false,
+	))
+	suite.Require().ErrorIs(err, types.ErrNodeNotExists)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -88,6 +89,15 @@ func (m msgServer) CreateCaptainNode(
 		),
 	})
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCreateNode{
+		NodeId:     nodeID,
+		DivisionId: msg.DivisionId,
+		Owner:      msg.Owner,
+		Authority:  msg.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCreateCaptainNodeResponse{
 		NodeId: nodeID,
 	}, nil
@@ -132,6 +142,14 @@ func (m msgServer) CommitReport(
 		),
 	})
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCommitReport{
+		EpochId:    m.k.GetCurrentEpoch(ctx),
+		ReportType: msg.ReportType,
+		Authority:  msg.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCommitReportResponse{}, nil
 }
 
@@ -337,3 +355,88 @@ func (m msgServer) ClaimComputingPower(
 
 	return &types.MsgClaimComputingPowerResponse{}, nil
 }
+
+// SubmitNodeMisbehavior implement the interface of types.MsgServer
+func (m msgServer) SubmitNodeMisbehavior(
+	goCtx context.Context,
+	msg *types.MsgSubmitNodeMisbehavior,
+) (*types.MsgSubmitNodeMisbehaviorResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.validateMemberOrAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	info, confiscated, err := m.k.HandleNodeMisbehavior(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeNodeMisbehavior,
+			sdk.NewAttribute(types.AttributeKeyNodeID, msg.NodeId),
+			sdk.NewAttribute(types.AttributeKeyMisbehaviorType, msg.MisbehaviorType.String()),
+			sdk.NewAttribute(types.AttributeKeyPenaltyEndEpoch, fmt.Sprintf("%d", info.PenaltyEndEpoch)),
+			sdk.NewAttribute(types.AttributeKeyConfiscatedEmission, confiscated.String()),
+			sdk.NewAttribute(types.AttributeKeyJailed, strconv.FormatBool(info.Jailed)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgSubmitNodeMisbehaviorResponse{}, nil
+}
+
+// UnjailNode implement the interface of types.MsgServer
+func (m msgServer) UnjailNode(
+	goCtx context.Context,
+	msg *types.MsgUnjailNode,
+) (*types.MsgUnjailNodeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.validateMemberOrAuthority(ctx, msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := m.k.UnjailNode(ctx, msg.NodeId); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjailNode,
+			sdk.NewAttribute(types.AttributeKeyNodeID, msg.NodeId),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		),
+	})
+
+	return &types.MsgUnjailNodeResponse{}, nil
+}
+
+// validateMemberOrAuthority checks the sender is an authorized member or the governance account.
+func (m msgServer) validateMemberOrAuthority(ctx sdk.Context, sender string) error {
+	if m.k.authority.String() == sender {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+
+	if !m.k.HasAuthorizedMember(ctx, addr) {
+		return errorsmod.Wrapf(
+			sdkerrors.ErrUnauthorized,
+			"invalid sender; neither an authorized member nor the governance account",
+		)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"fmt"
 
+	"github.com/gogo/protobuf/proto"
+
 	sdkcdc "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -384,3 +386,61 @@ func (suite *IntegrationTestSuite) TestCommitReport() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestTypedEvents() {
+	typedEvents := func() []proto.Message {
+		var events []proto.Message
+		for _, event := range suite.Ctx.EventManager().ABCIEvents() {
+			if msg, err := sdk.ParseTypedEvent(event); err == nil {
+				events = append(events, msg)
+			}
+		}
+		suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+		return events
+	}
+
+	owner := accounts[1].String()
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	nodeID := suite.utilsCreateCaptainNode(owner, 1)
+	suite.Require().Equal([]proto.Message{&types.EventCreateNode{
+		NodeId:     nodeID,
+		DivisionId: suite.utilsGetDivisions()[1],
+		Owner:      owner,
+		Authority:  accounts[0].String(),
+	}}, typedEvents())
+
+	digest, err := sdkcdc.NewAnyWithValue(&types.ReportDigest{
+		EpochId:                  1,
+		TotalBatchCount:          1,
+		TotalNodeCount:           1,
+		MaximumNodeCountPerBatch: 1,
+		GlobalOnOperationRatio:   sdk.OneDec(),
+	})
+	suite.Require().NoError(err)
+	_, err = suite.MsgServer.CommitReport(suite.Ctx, &types.MsgCommitReport{
+		Authority:  accounts[0].String(),
+		ReportType: types.ReportType_REPORT_TYPE_DIGEST,
+		Report:     digest,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]proto.Message{&types.EventCommitReport{
+		EpochId:    1,
+		ReportType: types.ReportType_REPORT_TYPE_DIGEST,
+		Authority:  accounts[0].String(),
+	}}, typedEvents())
+
+	// the busy phase is only entered once
+	suite.Keeper.EndBlocker(suite.Ctx)
+	suite.Keeper.EndBlocker(suite.Ctx)
+	suite.Require().Equal([]proto.Message{&types.EventEpochPhase{
+		EpochId: 1,
+		Phase:   types.EpochPhase_EPOCH_PHASE_BUSY,
+	}}, typedEvents())
+
+	suite.Require().NoError(suite.Keeper.HandleReportEnd(suite.Ctx, &types.ReportEnd{EpochId: 1}))
+	suite.Keeper.BeginBlocker(suite.Ctx)
+	suite.Require().Equal([]proto.Message{&types.EventEpochPhase{
+		EpochId: 2,
+		Phase:   types.EpochPhase_EPOCH_PHASE_STAND_BY,
+	}}, typedEvents())
+}
@@ -43,6 +43,8 @@ func (k Keeper) CreateNode(
 		return "", err
 	}
 	k.setNodeByOwner(ctx, nodeID, owner)
+	k.appendNodeDivisionChange(ctx, node, "")
+	k.trackOwnerPledge(ctx, owner)
 
 	division.TotalCount += 1
 	division.SoldCount += 1
@@ -74,28 +76,27 @@ func (k Keeper) UpdateNode(
 		return errorsmod.Wrap(types.ErrInsufficientComputingPower, nodeID)
 	}
 
-	after := node.ComputingPower + amount
+	before := node.ComputingPower
+	after := before + amount
 
-	if after < node.ComputingPower || after < 0 {
+	if after < before || after < 0 {
 		return errorsmod.Wrap(types.ErrTypeOverflow, nodeID)
 	}
+	node.ComputingPower = after
 	currDivision, _ := k.GetDivision(ctx, node.DivisionId)
 	if after > currDivision.ComputingPowerUpperBound {
 		// check if we need to improve node division
-		nextDivision := k.DecideDivision(ctx, after)
-		node.DivisionId = nextDivision.Id
-		k.incrDivisionTotalCount(ctx, nextDivision)
-		k.decrDivisionTotalCount(ctx, currDivision)
+		k.upgradeNodeDivision(ctx, &node, currDivision)
 	}
 
 	// set node info
-	node.ComputingPower = after
 	if err := k.setNode(ctx, node); err != nil {
 		return err
 	}
 
 	// set claimable power
 	k.decrClaimableComputingPower(ctx, amount, owner)
+	k.SetGlobalClaimedComputingPower(ctx, k.GetGlobalClaimedComputingPower(ctx)+amount)
 
 	return nil
 }
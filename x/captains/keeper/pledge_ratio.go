@@ -52,12 +52,25 @@ func (k Keeper) CalcNodePledgeRatioOnEpoch(ctx sdk.Context, epochID uint64, node
 	return nodePledgeRatio
 }
 
-// SampleOwnerPledge sample pledge amount of the owner on the epoch.
+// SampleOwnerPledge sample pledge amount of the owner at the boundary of the current epoch.
 func (k Keeper) SampleOwnerPledge(ctx sdk.Context, owner sdk.AccAddress) (sdk.Dec, error) {
+	cp := k.trackOwnerPledge(ctx, owner)
+	if cp.BoundaryEpoch == k.GetCurrentEpoch(ctx) {
+		return cp.BoundaryAmount, nil
+	}
+	return cp.Amount, nil
+}
+
+// trackOwnerPledge starts tracking the pledge of the owner by staking hooks and returns its checkpoint.
+// NOTE: delegations are only scanned once, later changes are applied by the staking hooks.
+func (k Keeper) trackOwnerPledge(ctx sdk.Context, owner sdk.AccAddress) types.OwnerPledgeCheckpoint {
+	if cp, found := k.GetOwnerPledgeCheckpoint(ctx, owner); found {
+		return cp
+	}
+
 	stakingParams := k.stakingKeeper.GetParams(ctx)
 	maxRetrieve := stakingParams.GetMaxValidators()
 
-	// FIXME: it seems that we don't have a good way to get owner delegations at the end of one epoch.
 	delegations := k.stakingKeeper.GetDelegatorDelegations(ctx, owner, uint16(maxRetrieve))
 	totalAmount := sdk.ZeroDec()
 
@@ -66,10 +79,112 @@ func (k Keeper) SampleOwnerPledge(ctx sdk.Context, owner sdk.AccAddress) (sdk.De
 		if !found {
 			continue
 		}
-		totalAmount = totalAmount.Add(val.TokensFromShares(delegation.GetShares()))
+		amount := val.TokensFromShares(delegation.GetShares())
+		k.setOwnerDelegationPledge(ctx, owner, delegation.GetValidatorAddr(), amount)
+		totalAmount = totalAmount.Add(amount)
+	}
+
+	cp := types.OwnerPledgeCheckpoint{
+		Owner:          owner.String(),
+		Amount:         totalAmount,
+		BoundaryAmount: totalAmount,
+	}
+	k.setOwnerPledgeCheckpoint(ctx, cp)
+	return cp
+}
+
+// updateOwnerDelegationPledge sets the owner pledge on the validator and applies the delta to the checkpoint.
+// The pledge before the change is kept as the boundary pledge if the current epoch has ended.
+func (k Keeper) updateOwnerDelegationPledge(
+	ctx sdk.Context,
+	owner sdk.AccAddress,
+	validator sdk.ValAddress,
+	amount sdk.Dec,
+) {
+	cp, found := k.GetOwnerPledgeCheckpoint(ctx, owner)
+	if !found {
+		return
+	}
+
+	epochID := k.GetCurrentEpoch(ctx)
+	if !k.IsStandByPhase(ctx) && cp.BoundaryEpoch != epochID {
+		cp.BoundaryEpoch = epochID
+		cp.BoundaryAmount = cp.Amount
+	}
+
+	old := k.GetOwnerDelegationPledge(ctx, owner, validator)
+	cp.Amount = cp.Amount.Sub(old).Add(amount)
+	if amount.IsZero() {
+		k.delOwnerDelegationPledge(ctx, owner, validator)
+	} else {
+		k.setOwnerDelegationPledge(ctx, owner, validator, amount)
+	}
+	k.setOwnerPledgeCheckpoint(ctx, cp)
+}
+
+// GetOwnerPledgeCheckpoint returns the pledge checkpoint of the owner.
+func (k Keeper) GetOwnerPledgeCheckpoint(ctx sdk.Context, owner sdk.AccAddress) (types.OwnerPledgeCheckpoint, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OwnerPledgeCheckpointStoreKey(owner))
+	if bz == nil {
+		return types.OwnerPledgeCheckpoint{}, false
+	}
+
+	var cp types.OwnerPledgeCheckpoint
+	k.cdc.MustUnmarshal(bz, &cp)
+	return cp, true
+}
+
+// HasOwnerPledgeCheckpoint checks if the pledge of the owner is tracked.
+func (k Keeper) HasOwnerPledgeCheckpoint(ctx sdk.Context, owner sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.OwnerPledgeCheckpointStoreKey(owner))
+}
+
+// setOwnerPledgeCheckpoint sets the pledge checkpoint of the owner.
+func (k Keeper) setOwnerPledgeCheckpoint(ctx sdk.Context, cp types.OwnerPledgeCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&cp)
+	store.Set(types.OwnerPledgeCheckpointStoreKey(sdk.MustAccAddressFromBech32(cp.Owner)), bz)
+}
+
+// GetOwnerDelegationPledge returns the pledge of the owner on the validator.
+func (k Keeper) GetOwnerDelegationPledge(ctx sdk.Context, owner sdk.AccAddress, validator sdk.ValAddress) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.OwnerDelegationPledgeStoreKey(validator, owner))
+	if bz == nil {
+		return sdk.ZeroDec()
 	}
+	return sdk.MustNewDecFromStr(string(bz))
+}
+
+// setOwnerDelegationPledge sets the pledge of the owner on the validator.
+func (k Keeper) setOwnerDelegationPledge(ctx sdk.Context, owner sdk.AccAddress, validator sdk.ValAddress, amount sdk.Dec) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.OwnerDelegationPledgeStoreKey(validator, owner), []byte(amount.String()))
+}
 
-	return totalAmount, nil
+// delOwnerDelegationPledge deletes the pledge of the owner on the validator.
+func (k Keeper) delOwnerDelegationPledge(ctx sdk.Context, owner sdk.AccAddress, validator sdk.ValAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.OwnerDelegationPledgeStoreKey(validator, owner))
+}
+
+// GetOwnersDelegationPledgeByValidator returns the pledge of all owners on the validator.
+func (k Keeper) GetOwnersDelegationPledgeByValidator(ctx sdk.Context, validator sdk.ValAddress) []types.OwnerDelegationPledge {
+	var pledges []types.OwnerDelegationPledge
+	store := ctx.KVStore(k.storeKey)
+	prefixKey := types.OwnerDelegationPledgePrefixStoreKey(validator)
+	iterator := sdk.KVStorePrefixIterator(store, prefixKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		pledges = append(pledges, types.OwnerDelegationPledge{
+			Owner:     sdk.AccAddress(iterator.Key()[len(prefixKey):]).String(),
+			Validator: validator.String(),
+			Amount:    sdk.MustNewDecFromStr(string(iterator.Value())),
+		})
+	}
+	return pledges
 }
 
 // GetOwnerPledge returns the sampled pledge amount of the owner on the epoch.
@@ -175,3 +290,36 @@ func (k Keeper) GetOwnersPledge(ctx sdk.Context) []types.OwnerPledge {
 	}
 	return ownersPledge
 }
+
+// GetOwnersPledgeCheckpoint returns all owner pledge checkpoints.
+func (k Keeper) GetOwnersPledgeCheckpoint(ctx sdk.Context) []types.OwnerPledgeCheckpoint {
+	var checkpoints []types.OwnerPledgeCheckpoint
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OwnerPledgeCheckpointKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var cp types.OwnerPledgeCheckpoint
+		k.cdc.MustUnmarshal(iterator.Value(), &cp)
+		checkpoints = append(checkpoints, cp)
+	}
+	return checkpoints
+}
+
+// GetOwnersDelegationPledge returns all owner pledges on validators.
+func (k Keeper) GetOwnersDelegationPledge(ctx sdk.Context) []types.OwnerDelegationPledge {
+	var pledges []types.OwnerDelegationPledge
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.OwnerDelegationPledgeKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		// <prefix_key><validator_len><validator><owner>
+		key := iterator.Key()[len(types.OwnerDelegationPledgeKey):]
+		valLen := int(key[0])
+		pledges = append(pledges, types.OwnerDelegationPledge{
+			Owner:     sdk.AccAddress(key[1+valLen:]).String(),
+			Validator: sdk.ValAddress(key[1 : 1+valLen]).String(),
+			Amount:    sdk.MustNewDecFromStr(string(iterator.Value())),
+		})
+	}
+	return pledges
+}
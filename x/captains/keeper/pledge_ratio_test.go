@@ -134,3 +134,72 @@ func (suite *IntegrationTestSuite) TestCalcNodePledgeRatioOnEpoch() {
 		})
 	}
 }
+
+func (suite *IntegrationTestSuite) TestOwnerPledgeCheckpoint() {
+	owner := accounts[1]
+	err := suite.utilsFundToken(owner, 10_000_000, tabitypes.AttoTabi)
+	suite.Require().NoError(err)
+
+	validator := suite.Validators[0]
+	valAddr := validator.GetOperator()
+	suite.utilsStakingTabiWithAmount(owner, 1_000_000, validator)
+
+	// owner pledge is tracked once the owner holds a node.
+	suite.Require().False(suite.Keeper.HasOwnerPledgeCheckpoint(suite.Ctx, owner))
+	suite.utilsCreateCaptainNode(owner.String(), 1)
+	cp, found := suite.Keeper.GetOwnerPledgeCheckpoint(suite.Ctx, owner)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(1_000_000), cp.Amount)
+
+	// delegation changes are applied by staking hooks.
+	validator, found = suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+	suite.Require().True(found)
+	suite.utilsStakingTabiWithAmount(owner, 2_000_000, validator)
+	pledge, err := suite.Keeper.SampleOwnerPledge(suite.Ctx, owner)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(3_000_000), pledge)
+
+	// undelegate after the epoch ends doesn't change the sampled pledge.
+	bs := suite.Keeper.GetBaseState(suite.Ctx)
+	bs.IsStandBy = false
+	suite.Keeper.SetBaseState(suite.Ctx, &bs)
+
+	delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, owner, valAddr)
+	suite.Require().True(found)
+	_, err = suite.App.StakingKeeper.Undelegate(suite.Ctx, owner, valAddr, delegation.GetShares())
+	suite.Require().NoError(err)
+
+	cp, found = suite.Keeper.GetOwnerPledgeCheckpoint(suite.Ctx, owner)
+	suite.Require().True(found)
+	suite.Require().True(cp.Amount.IsZero())
+	suite.Require().Equal(sdk.ZeroDec(), suite.Keeper.GetOwnerDelegationPledge(suite.Ctx, owner, valAddr))
+	pledge, err = suite.Keeper.SampleOwnerPledge(suite.Ctx, owner)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(3_000_000), pledge)
+
+	// the boundary pledge expires with the epoch.
+	bs.EpochId = 2
+	suite.Keeper.SetBaseState(suite.Ctx, &bs)
+	pledge, err = suite.Keeper.SampleOwnerPledge(suite.Ctx, owner)
+	suite.Require().NoError(err)
+	suite.Require().True(pledge.IsZero())
+}
+
+func (suite *IntegrationTestSuite) TestOwnerPledgeCheckpointSlashed() {
+	owner := accounts[1]
+	err := suite.utilsFundToken(owner, 10_000_000, tabitypes.AttoTabi)
+	suite.Require().NoError(err)
+
+	validator := suite.Validators[0]
+	valAddr := validator.GetOperator()
+	suite.utilsCreateCaptainNode(owner.String(), 1)
+	suite.utilsStakingTabiWithAmount(owner, 1_000_000, validator)
+
+	err = suite.Keeper.Hooks().BeforeValidatorSlashed(suite.Ctx, valAddr, sdk.NewDecWithPrec(1, 1))
+	suite.Require().NoError(err)
+
+	cp, found := suite.Keeper.GetOwnerPledgeCheckpoint(suite.Ctx, owner)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewDec(900_000), cp.Amount)
+	suite.Require().Equal(sdk.NewDec(900_000), suite.Keeper.GetOwnerDelegationPledge(suite.Ctx, owner, valAddr))
+}
@@ -14,12 +14,16 @@ import (
 func (k Keeper) CommitReport(ctx sdk.Context, report any) error {
 	switch report := report.(type) {
 	case *types.ReportDigest:
+		recordReportLatency(ctx, "digest")
 		return k.HandleReportDigest(ctx, report)
 	case *types.ReportBatch:
+		recordReportLatency(ctx, "batch")
 		return nil
 	case *types.ReportEmission:
+		recordReportLatency(ctx, "emission")
 		return k.HandleReportEmission(ctx, report)
 	case *types.ReportEnd:
+		recordReportLatency(ctx, "end")
 		return k.HandleReportEnd(ctx, report)
 	}
 	return errorsmod.Wrapf(types.ErrInvalidReport, "invalid report type")
@@ -41,6 +45,14 @@ func (k Keeper) execReportDigestEndBlock(ctx sdk.Context, digest *types.ReportDi
 	epochId := k.GetCurrentEpoch(ctx)
 	sum := k.CalcEpochEmission(ctx, epochId, digest.GlobalOnOperationRatio)
 
+	// top up the emission with the fees redirected by the fee market. The pool is
+	// drained on the first run only, later runs reuse the amount kept on the epoch.
+	if k.IsStandByPhase(ctx) {
+		k.setEpochFeePoolEmission(ctx, epochId, k.GetFeePoolEmission(ctx))
+		k.SetFeePoolEmission(ctx, sdk.ZeroDec())
+	}
+	sum = sum.Add(k.getEpochFeePoolEmission(ctx, epochId))
+
 	k.DelGlobalPledge(ctx, epochId)
 	k.setEpochEmission(ctx, epochId, sum)
 	// we will enter report calculation in the next block.
@@ -66,7 +78,11 @@ func (k Keeper) HandleReportBatch(ctx sdk.Context, report *types.ReportBatch) er
 
 		// try to calculate historical emission
 		k.CalcAndSetNodeCumulativeEmissionByEpoch(ctx, epochId-1, node.NodeId, lastEpochGlobalEmission, lastEpochGlobalPower)
-		power := k.CalcNodeComputingPowerOnEpoch(ctx, epochId, node.NodeId, node.OnOperationRatio)
+		power := sdk.ZeroDec()
+		// jailed or penalized nodes are excluded from power calculation.
+		if !k.IsNodePenalizedOnEpoch(ctx, node.NodeId, epochId) {
+			power = k.CalcNodeComputingPowerOnEpoch(ctx, epochId, node.NodeId, node.OnOperationRatio)
+		}
 		oldPower := k.GetNodeComputingPowerOnEpoch(ctx, epochId, node.NodeId)
 
 		k.setNodeComputingPowerOnEpoch(ctx, epochId, node.NodeId, power)
@@ -105,7 +121,11 @@ func (k Keeper) HandleReportEmission(ctx sdk.Context, report *types.ReportEmissi
 		if !found {
 			return errorsmod.Wrapf(types.ErrNodeNotExists, "node-%s not exists", node.NodeId)
 		}
-		k.SetNodeEmissionByEpoch(ctx, epochId, node.NodeId, node.NodeEmission.Amount.String())
+		emission := node.NodeEmission.Amount
+		if k.IsNodePenalizedOnEpoch(ctx, node.NodeId, epochId) {
+			emission = sdk.ZeroDec()
+		}
+		k.SetNodeEmissionByEpoch(ctx, epochId, node.NodeId, emission.String())
 
 		historyEmission2 := k.GetNodeCumulativeEmissionByEpoch(ctx, epochId-2, node.NodeId)
 		oldEmission := k.GetNodeEmissionByEpoch(ctx, epochId-1, node.NodeId)
@@ -269,8 +289,17 @@ func (k Keeper) ValidateReportBatch(ctx sdk.Context, report *types.ReportBatch)
 	return nil
 }
 
-// ValidateReportEmission checks if the report end is valid
+// ValidateReportEmission checks if the report emission is valid
 func (k Keeper) ValidateReportEmission(ctx sdk.Context, report *types.ReportEmission) error {
+	if err := k.ValidateReportEpoch(ctx, report.EpochId); err != nil {
+		return err
+	}
+
+	// node emission is only reported after the epoch emission is settled.
+	if !k.HasEpochEmission(ctx, report.EpochId) {
+		return errorsmod.Wrapf(types.ErrInvalidReport, "epoch emission not found")
+	}
+
 	return nil
 }
 
@@ -336,12 +365,14 @@ func (k Keeper) setReportBatch(ctx sdk.Context, epochID, batchID, count uint64)
 // GetReportBatches returns the batch count.
 func (k Keeper) GetReportBatches(ctx sdk.Context, epochID uint64) []types.BatchBase {
 	store := ctx.KVStore(k.storeKey)
-	iterator := sdk.KVStorePrefixIterator(store, types.ReportBatchOnEpochPrefixStoreKey(epochID))
+	prefixStore := prefix.NewStore(store, types.ReportBatchOnEpochPrefixStoreKey(epochID))
+	iterator := prefixStore.Iterator(nil, nil)
 	defer iterator.Close()
 
 	var batches []types.BatchBase
 	for ; iterator.Valid(); iterator.Next() {
-		batchID := sdk.BigEndianToUint64(iterator.Key())
+		// the key left by the prefix store is the delimiter followed by the batch id.
+		batchID := sdk.BigEndianToUint64(iterator.Key()[len(types.Delimiter):])
 		count := sdk.BigEndianToUint64(iterator.Value())
 		batches = append(batches, types.BatchBase{
 			BatchId: batchID,
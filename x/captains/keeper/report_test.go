@@ -34,6 +34,63 @@ func (suite *IntegrationTestSuite) TestHandleReportDigest() {
 	}
 }
 
+func (suite *IntegrationTestSuite) TestValidateReportEmission() {
+	epoch := suite.Keeper.GetCurrentEpoch(suite.Ctx)
+
+	testCases := []struct {
+		name      string
+		report    *types.ReportEmission
+		malleate  func()
+		expectErr bool
+	}{
+		{
+			name:      "fail - epoch emission not settled",
+			report:    &types.ReportEmission{EpochId: epoch, BatchId: 1},
+			malleate:  func() {},
+			expectErr: true,
+		},
+		{
+			name:   "fail - another epoch",
+			report: &types.ReportEmission{EpochId: epoch + 1, BatchId: 1},
+			malleate: func() {
+				err := suite.Keeper.HandleReportDigest(suite.Ctx, &types.ReportDigest{
+					EpochId:                epoch,
+					GlobalOnOperationRatio: sdk.OneDec(),
+				})
+				suite.Require().NoError(err)
+				suite.Keeper.EndBlocker(suite.Ctx)
+			},
+			expectErr: true,
+		},
+		{
+			name:   "success - epoch emission settled",
+			report: &types.ReportEmission{EpochId: epoch, BatchId: 1},
+			malleate: func() {
+				err := suite.Keeper.HandleReportDigest(suite.Ctx, &types.ReportDigest{
+					EpochId:                epoch,
+					GlobalOnOperationRatio: sdk.OneDec(),
+				})
+				suite.Require().NoError(err)
+				suite.Keeper.EndBlocker(suite.Ctx)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := suite.Keeper.ValidateReportEmission(suite.Ctx, tc.report)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
+
 func (suite *IntegrationTestSuite) TestHandleReportBatch() {
 	// prepare addresses and args
 	addr1 := accounts[1].String()
@@ -96,3 +153,27 @@ func (suite *IntegrationTestSuite) TestHandleReportBatch() {
 	})
 	suite.Require().NoError(err)
 }
+
+func (suite *IntegrationTestSuite) TestReportDigestFeePoolEmission() {
+	epochId := suite.Keeper.GetCurrentEpoch(suite.Ctx)
+	digest := &types.ReportDigest{
+		EpochId:                epochId,
+		TotalNodeCount:         suite.Keeper.GetNodesCount(suite.Ctx),
+		GlobalOnOperationRatio: sdk.NewDecWithPrec(5, 1),
+	}
+	suite.Require().NoError(suite.Keeper.HandleReportDigest(suite.Ctx, digest))
+
+	base := suite.Keeper.CalcEpochEmission(suite.Ctx, epochId, digest.GlobalOnOperationRatio)
+	suite.Keeper.IncrFeePoolEmission(suite.Ctx, sdk.NewDec(100))
+
+	// the fee pool tops up the epoch emission and is drained.
+	suite.Keeper.EndBlocker(suite.Ctx)
+	suite.Require().Equal(base.Add(sdk.NewDec(100)), suite.Keeper.GetEpochEmission(suite.Ctx, epochId))
+	suite.Require().True(suite.Keeper.GetFeePoolEmission(suite.Ctx).IsZero())
+
+	// fees redirected during the busy phase are kept for the next epoch.
+	suite.Keeper.IncrFeePoolEmission(suite.Ctx, sdk.NewDec(50))
+	suite.Keeper.EndBlocker(suite.Ctx)
+	suite.Require().Equal(base.Add(sdk.NewDec(100)), suite.Keeper.GetEpochEmission(suite.Ctx, epochId))
+	suite.Require().Equal(sdk.NewDec(50), suite.Keeper.GetFeePoolEmission(suite.Ctx))
+}
@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// ApplySchedule unlocks the sale levels and enters the halving eras which are due.
+func (k Keeper) ApplySchedule(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+
+	saleLevel, unlocked := k.nextScheduledSaleLevel(ctx, params)
+	era, entered := k.nextScheduledHalvingEra(ctx, params)
+	if !unlocked && !entered {
+		return
+	}
+
+	beforeSaleLevel := params.CurrentSaleLevel
+	beforeCoefficient := params.HalvingEraCoefficient
+	if unlocked {
+		params.CurrentSaleLevel = saleLevel
+	}
+	if entered {
+		params.HalvingEraCoefficient = era.Coefficient
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		k.Logger(ctx).Error("failed to apply schedule", "error", err.Error())
+		return
+	}
+
+	events := make([]sdk.Event, 0)
+	if unlocked {
+		events = append(events, sdk.NewEvent(
+			types.EventTypeSaleLevelUnlocked,
+			sdk.NewAttribute(types.AttributeKeySaleLevelBefore, fmt.Sprintf("%d", beforeSaleLevel)),
+			sdk.NewAttribute(types.AttributeKeySaleLevelAfter, fmt.Sprintf("%d", saleLevel)),
+		))
+	}
+	if entered {
+		k.setLastHalvingEraEpoch(ctx, era.EpochId)
+		events = append(events, sdk.NewEvent(
+			types.EventTypeHalvingEraEntered,
+			sdk.NewAttribute(types.AttributeKeyEpochID, fmt.Sprintf("%d", era.EpochId)),
+			sdk.NewAttribute(types.AttributeKeyHalvingEraBefore, beforeCoefficient.String()),
+			sdk.NewAttribute(types.AttributeKeyHalvingEraAfter, era.Coefficient.String()),
+		))
+	}
+	ctx.EventManager().EmitEvents(events)
+}
+
+// nextScheduledSaleLevel returns the highest sale level whose milestone is reached.
+func (k Keeper) nextScheduledSaleLevel(ctx sdk.Context, params types.Params) (uint64, bool) {
+	next := params.CurrentSaleLevel
+	for _, milestone := range params.SaleLevelSchedule {
+		if milestone.SaleLevel <= next {
+			continue
+		}
+		division, found := k.GetDivision(ctx, milestone.DivisionId)
+		if found && division.SoldCount >= milestone.SoldCount {
+			next = milestone.SaleLevel
+		}
+	}
+	return next, next != params.CurrentSaleLevel
+}
+
+// nextScheduledHalvingEra returns the latest halving era started but not entered yet.
+func (k Keeper) nextScheduledHalvingEra(ctx sdk.Context, params types.Params) (types.HalvingEra, bool) {
+	var next types.HalvingEra
+	epoch := k.GetCurrentEpoch(ctx)
+	last := k.GetLastHalvingEraEpoch(ctx)
+	for _, era := range params.HalvingEraSchedule {
+		if era.EpochId <= last || era.EpochId > epoch {
+			continue
+		}
+		if era.EpochId > next.EpochId {
+			next = era
+		}
+	}
+	return next, next.EpochId != 0
+}
+
+// GetSaleLevelTimeline returns the sale level milestones ordered by sale level.
+func (k Keeper) GetSaleLevelTimeline(ctx sdk.Context) []types.SaleLevelMilestoneStatus {
+	params := k.GetParams(ctx)
+	timeline := make([]types.SaleLevelMilestoneStatus, 0, len(params.SaleLevelSchedule))
+	for _, milestone := range params.SaleLevelSchedule {
+		timeline = append(timeline, types.SaleLevelMilestoneStatus{
+			Milestone: milestone,
+			Reached:   params.CurrentSaleLevel >= milestone.SaleLevel,
+		})
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Milestone.SaleLevel < timeline[j].Milestone.SaleLevel
+	})
+	return timeline
+}
+
+// GetHalvingEraTimeline returns the halving eras ordered by epoch.
+func (k Keeper) GetHalvingEraTimeline(ctx sdk.Context) []types.HalvingEraStatus {
+	params := k.GetParams(ctx)
+	last := k.GetLastHalvingEraEpoch(ctx)
+	timeline := make([]types.HalvingEraStatus, 0, len(params.HalvingEraSchedule))
+	for _, era := range params.HalvingEraSchedule {
+		timeline = append(timeline, types.HalvingEraStatus{
+			Era:     era,
+			Applied: era.EpochId <= last,
+		})
+	}
+	sort.SliceStable(timeline, func(i, j int) bool {
+		return timeline[i].Era.EpochId < timeline[j].Era.EpochId
+	})
+	return timeline
+}
+
+// GetLastHalvingEraEpoch returns the start epoch of the last entered halving era.
+func (k Keeper) GetLastHalvingEraEpoch(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastHalvingEraEpochKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setLastHalvingEraEpoch sets the start epoch of the last entered halving era.
+func (k Keeper) setLastHalvingEraEpoch(ctx sdk.Context, epochID uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastHalvingEraEpochKey, sdk.Uint64ToBigEndian(epochID))
+}
@@ -0,0 +1,51 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+func (suite *IntegrationTestSuite) TestApplySchedule() {
+	divisions := suite.utilsGetDivisions()
+
+	params := suite.Keeper.GetParams(suite.Ctx)
+	params.SaleLevelSchedule = []types.SaleLevelMilestone{
+		{SaleLevel: 3, DivisionId: divisions[1], SoldCount: 4},
+		{SaleLevel: 2, DivisionId: divisions[1], SoldCount: 2},
+	}
+	params.HalvingEraSchedule = []types.HalvingEra{
+		{EpochId: 1, Coefficient: sdk.NewDecWithPrec(5, 1)},
+		{EpochId: 100, Coefficient: sdk.NewDecWithPrec(25, 2)},
+	}
+	suite.Require().NoError(suite.Keeper.SetParams(suite.Ctx, params))
+
+	// first era is due at epoch 1, no milestone is reached yet.
+	suite.Keeper.ApplySchedule(suite.Ctx)
+	params = suite.Keeper.GetParams(suite.Ctx)
+	suite.Require().Equal(uint64(1), params.CurrentSaleLevel)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), params.HalvingEraCoefficient)
+	suite.Require().Equal(uint64(1), suite.Keeper.GetLastHalvingEraEpoch(suite.Ctx))
+
+	// reaching the second milestone unlocks sale level 2 only.
+	suite.utilsBatchCreateCaptainNode(accounts[1].String(), 1, 2)
+	suite.Keeper.ApplySchedule(suite.Ctx)
+	suite.Require().Equal(uint64(2), suite.Keeper.GetParams(suite.Ctx).CurrentSaleLevel)
+
+	// both milestones are reached.
+	suite.utilsBatchCreateCaptainNode(accounts[1].String(), 1, 2)
+	suite.Keeper.ApplySchedule(suite.Ctx)
+	params = suite.Keeper.GetParams(suite.Ctx)
+	suite.Require().Equal(uint64(3), params.CurrentSaleLevel)
+	suite.Require().Equal(sdk.NewDecWithPrec(5, 1), params.HalvingEraCoefficient)
+
+	resp, err := suite.QueryClient.Schedule(suite.Ctx, &types.QueryScheduleRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), resp.CurrentSaleLevel)
+	suite.Require().Len(resp.SaleLevels, 2)
+	suite.Require().Equal(uint64(2), resp.SaleLevels[0].Milestone.SaleLevel)
+	suite.Require().True(resp.SaleLevels[1].Reached)
+	suite.Require().Len(resp.HalvingEras, 2)
+	suite.Require().True(resp.HalvingEras[0].Applied)
+	suite.Require().False(resp.HalvingEras[1].Applied)
+}
@@ -0,0 +1,33 @@
+package v2
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// MigrateStore migrates the x/captains module state from the consensus
+// version 1 to version 2. Specifically, it accounts the claimable computing
+// power of the owners as committed, so that the committed and claimed totals
+// tracked from now on reconcile with the claimable computing power.
+func MigrateStore(ctx sdk.Context, storeKey storetypes.StoreKey) error {
+	store := ctx.KVStore(storeKey)
+
+	iterator := sdk.KVStorePrefixIterator(store, types.ClaimableComputingPowerKey)
+	defer iterator.Close()
+
+	committed := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		amount := sdk.BigEndianToUint64(iterator.Value())
+		if committed+amount < committed {
+			return types.ErrTypeOverflow.Wrap("claimable computing power")
+		}
+		committed += amount
+	}
+
+	store.Set(types.GlobalCommittedComputingPowerKey, sdk.Uint64ToBigEndian(committed))
+	store.Set(types.GlobalClaimedComputingPowerKey, sdk.Uint64ToBigEndian(0))
+
+	return nil
+}
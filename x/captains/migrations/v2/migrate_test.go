@@ -0,0 +1,27 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/tabilabs/tabi/x/captains/migrations/v2"
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+func TestMigrate(t *testing.T) {
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	kvStore.Set(types.ClaimableComputingPowerStoreKey(sdk.AccAddress("owner1")), sdk.Uint64ToBigEndian(100))
+	kvStore.Set(types.ClaimableComputingPowerStoreKey(sdk.AccAddress("owner2")), sdk.Uint64ToBigEndian(250))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeKey))
+
+	require.Equal(t, uint64(350), sdk.BigEndianToUint64(kvStore.Get(types.GlobalCommittedComputingPowerKey)))
+	require.Equal(t, uint64(0), sdk.BigEndianToUint64(kvStore.Get(types.GlobalClaimedComputingPowerKey)))
+}
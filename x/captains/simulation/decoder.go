@@ -0,0 +1,95 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding captains type.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.ParamsKey):
+			var paramsA, paramsB types.Params
+			cdc.MustUnmarshal(kvA.Value, &paramsA)
+			cdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("%v\n%v", paramsA, paramsB)
+
+		case bytes.Equal(kvA.Key[:1], types.NodeKey):
+			var nodeA, nodeB types.Node
+			cdc.MustUnmarshal(kvA.Value, &nodeA)
+			cdc.MustUnmarshal(kvB.Value, &nodeB)
+			return fmt.Sprintf("%v\n%v", nodeA, nodeB)
+
+		case bytes.Equal(kvA.Key[:1], types.DivisionKey):
+			var divisionA, divisionB types.Division
+			cdc.MustUnmarshal(kvA.Value, &divisionA)
+			cdc.MustUnmarshal(kvB.Value, &divisionB)
+			return fmt.Sprintf("%v\n%v", divisionA, divisionB)
+
+		case bytes.Equal(kvA.Key[:1], types.ReportDigestOnEpochKey):
+			var digestA, digestB types.ReportDigest
+			cdc.MustUnmarshal(kvA.Value, &digestA)
+			cdc.MustUnmarshal(kvB.Value, &digestB)
+			return fmt.Sprintf("%v\n%v", digestA, digestB)
+
+		case bytes.Equal(kvA.Key[:1], types.NodeDivisionHistoryKey):
+			var changeA, changeB types.NodeDivisionChange
+			cdc.MustUnmarshal(kvA.Value, &changeA)
+			cdc.MustUnmarshal(kvB.Value, &changeB)
+			return fmt.Sprintf("%v\n%v", changeA, changeB)
+
+		case bytes.Equal(kvA.Key[:1], types.NodeSlashInfoKey):
+			var infoA, infoB types.NodeSlashInfo
+			cdc.MustUnmarshal(kvA.Value, &infoA)
+			cdc.MustUnmarshal(kvB.Value, &infoB)
+			return fmt.Sprintf("%v\n%v", infoA, infoB)
+
+		case bytes.Equal(kvA.Key[:1], types.OwnerPledgeCheckpointKey):
+			var cpA, cpB types.OwnerPledgeCheckpoint
+			cdc.MustUnmarshal(kvA.Value, &cpA)
+			cdc.MustUnmarshal(kvB.Value, &cpB)
+			return fmt.Sprintf("%v\n%v", cpA, cpB)
+
+		case bytes.Equal(kvA.Key[:1], types.NodeNextSequenceKey),
+			bytes.Equal(kvA.Key[:1], types.CurrEpochKey),
+			bytes.Equal(kvA.Key[:1], types.ClaimableComputingPowerKey),
+			bytes.Equal(kvA.Key[:1], types.ReportBatchOnEpochKey),
+			bytes.Equal(kvA.Key[:1], types.LastHalvingEraEpochKey),
+			bytes.Equal(kvA.Key[:1], types.GlobalCommittedComputingPowerKey),
+			bytes.Equal(kvA.Key[:1], types.GlobalClaimedComputingPowerKey):
+			return fmt.Sprintf("%d\n%d", sdk.BigEndianToUint64(kvA.Value), sdk.BigEndianToUint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.EpochEmissionKey),
+			bytes.Equal(kvA.Key[:1], types.GlobalClaimedEmissionKey),
+			bytes.Equal(kvA.Key[:1], types.NodeClaimedEmissionKey),
+			bytes.Equal(kvA.Key[:1], types.NodeCumulativeEmissionByEpochKey),
+			bytes.Equal(kvA.Key[:1], types.GlobalComputingPowerOnEpochKey),
+			bytes.Equal(kvA.Key[:1], types.NodeComputingPowerOnEpochKey),
+			bytes.Equal(kvA.Key[:1], types.GlobalPledgeOnEpochKey),
+			bytes.Equal(kvA.Key[:1], types.OwnerPledgeOnEpochKey),
+			bytes.Equal(kvA.Key[:1], types.NodeEpochEmissionKey),
+			bytes.Equal(kvA.Key[:1], types.CommunityPoolEmissionKey),
+			bytes.Equal(kvA.Key[:1], types.FeePoolEmissionKey),
+			bytes.Equal(kvA.Key[:1], types.EpochFeePoolEmissionKey),
+			bytes.Equal(kvA.Key[:1], types.OwnerDelegationPledgeKey):
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		case bytes.Equal(kvA.Key[:1], types.NodeByOwnerKey),
+			bytes.Equal(kvA.Key[:1], types.DivisionByNode),
+			bytes.Equal(kvA.Key[:1], types.EndOnEpochKey),
+			bytes.Equal(kvA.Key[:1], types.StandByOverKey):
+			return fmt.Sprintf("%v\n%v", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid captains key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/tabilabs/tabi/encoding"
+	"github.com/tabilabs/tabi/x/captains"
+	"github.com/tabilabs/tabi/x/captains/simulation"
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+func TestDecodeCaptainsStore(t *testing.T) {
+	cdc := encoding.MakeConfig(module.NewBasicManager(captains.AppModuleBasic{})).Codec
+	dec := simulation.NewDecodeStore(cdc)
+
+	node := types.Node{Id: "node", DivisionId: "division", Owner: "owner", ComputingPower: 100}
+	division := types.Division{Id: "division", Level: 1, InitialSupply: 10, SoldCount: 1, TotalCount: 1}
+	emission := sdk.NewDec(100)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.NodeStoreKey(node.Id), Value: cdc.MustMarshal(&node)},
+			{Key: types.DivisionStoreKey(division.Id), Value: cdc.MustMarshal(&division)},
+			{Key: types.CurrEpochKey, Value: sdk.Uint64ToBigEndian(2)},
+			{Key: types.EpochEmissionStoreKey(1), Value: []byte(emission.String())},
+			{Key: []byte{0x99}, Value: []byte{0x99}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Node", fmt.Sprintf("%v\n%v", node, node)},
+		{"Division", fmt.Sprintf("%v\n%v", division, division)},
+		{"CurrEpoch", "2\n2"},
+		{"EpochEmission", fmt.Sprintf("%s\n%s", emission, emission)},
+		{"other", ""},
+	}
+	for i, tt := range tests {
+		i, tt := i, tt
+		t.Run(tt.name, func(t *testing.T) {
+			switch i {
+			case len(tests) - 1:
+				require.Panics(t, func() { dec(kvPairs.Pairs[i], kvPairs.Pairs[i]) }, tt.name)
+			default:
+				require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+			}
+		})
+	}
+}
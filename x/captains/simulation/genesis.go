@@ -0,0 +1,63 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/tabilabs/tabi/x/captains/types"
+)
+
+// Simulation parameter constants
+const (
+	AuthorizedMembers = "authorized_members"
+	CurrentSaleLevel  = "current_sale_level"
+)
+
+// genAuthorizedMembers picks a few random accounts as the authorized members.
+func genAuthorizedMembers(r *rand.Rand, accs []simtypes.Account) []string {
+	n := simtypes.RandIntBetween(r, 1, 4)
+	if n > len(accs) {
+		n = len(accs)
+	}
+
+	members := make([]string, 0, n)
+	for _, i := range r.Perm(len(accs))[:n] {
+		members = append(members, accs[i].Address.String())
+	}
+	return members
+}
+
+// genCurrentSaleLevel returns a random sale level.
+func genCurrentSaleLevel(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 4))
+}
+
+// RandomizedGenState generates a random GenesisState for captains
+func RandomizedGenState(simState *module.SimulationState) {
+	var members []string
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, AuthorizedMembers, &members, simState.Rand,
+		func(r *rand.Rand) { members = genAuthorizedMembers(r, simState.Accounts) },
+	)
+
+	var saleLevel uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CurrentSaleLevel, &saleLevel, simState.Rand,
+		func(r *rand.Rand) { saleLevel = genCurrentSaleLevel(r) },
+	)
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.AuthorizedMembers = members
+	genesis.Params.CurrentSaleLevel = saleLevel
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated captains parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
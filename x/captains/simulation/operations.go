@@ -0,0 +1,493 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/captains/keeper"
+	"github.com/tabilabs/tabi/x/captains/types"
+	claimstypes "github.com/tabilabs/tabi/x/claims/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateCaptainNode    = "op_weight_msg_create_captain_node"    //nolint:gosec
+	OpWeightMsgCommitComputingPower = "op_weight_msg_commit_computing_power" //nolint:gosec
+	OpWeightMsgClaimComputingPower  = "op_weight_msg_claim_computing_power"  //nolint:gosec
+	OpWeightMsgCommitReport         = "op_weight_msg_commit_report"          //nolint:gosec
+	OpWeightMsgUpdateSaleLevel      = "op_weight_msg_update_sale_level"      //nolint:gosec
+	OpWeightMsgClaims               = "op_weight_msg_claims"                 //nolint:gosec
+
+	DefaultWeightMsgCreateCaptainNode    = 50
+	DefaultWeightMsgCommitComputingPower = 30
+	DefaultWeightMsgClaimComputingPower  = 30
+	DefaultWeightMsgCommitReport         = 60
+	DefaultWeightMsgUpdateSaleLevel      = 5
+	DefaultWeightMsgClaims               = 20
+)
+
+var (
+	TypeMsgCreateCaptainNode    = sdk.MsgTypeURL(&types.MsgCreateCaptainNode{})
+	TypeMsgCommitComputingPower = sdk.MsgTypeURL(&types.MsgCommitComputingPower{})
+	TypeMsgClaimComputingPower  = sdk.MsgTypeURL(&types.MsgClaimComputingPower{})
+	TypeMsgCommitReport         = sdk.MsgTypeURL(&types.MsgCommitReport{})
+	TypeMsgUpdateSaleLevel      = sdk.MsgTypeURL(&types.MsgUpdateSaleLevel{})
+	TypeMsgClaims               = sdk.MsgTypeURL(&claimstypes.MsgClaims{})
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	cdc codec.JSONCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simulation.WeightedOperations {
+	var (
+		weightMsgCreateCaptainNode    int
+		weightMsgCommitComputingPower int
+		weightMsgClaimComputingPower  int
+		weightMsgCommitReport         int
+		weightMsgUpdateSaleLevel      int
+		weightMsgClaims               int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateCaptainNode, &weightMsgCreateCaptainNode, nil,
+		func(_ *rand.Rand) { weightMsgCreateCaptainNode = DefaultWeightMsgCreateCaptainNode },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCommitComputingPower, &weightMsgCommitComputingPower, nil,
+		func(_ *rand.Rand) { weightMsgCommitComputingPower = DefaultWeightMsgCommitComputingPower },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaimComputingPower, &weightMsgClaimComputingPower, nil,
+		func(_ *rand.Rand) { weightMsgClaimComputingPower = DefaultWeightMsgClaimComputingPower },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgCommitReport, &weightMsgCommitReport, nil,
+		func(_ *rand.Rand) { weightMsgCommitReport = DefaultWeightMsgCommitReport },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateSaleLevel, &weightMsgUpdateSaleLevel, nil,
+		func(_ *rand.Rand) { weightMsgUpdateSaleLevel = DefaultWeightMsgUpdateSaleLevel },
+	)
+	appParams.GetOrGenerate(cdc, OpWeightMsgClaims, &weightMsgClaims, nil,
+		func(_ *rand.Rand) { weightMsgClaims = DefaultWeightMsgClaims },
+	)
+
+	// the messages carry the reports in Any, so we need a codec which resolves them.
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	claimstypes.RegisterInterfaces(registry)
+	protoCdc := codec.NewProtoCodec(registry)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateCaptainNode,
+			SimulateMsgCreateCaptainNode(protoCdc, k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCommitComputingPower,
+			SimulateMsgCommitComputingPower(protoCdc, k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaimComputingPower,
+			SimulateMsgClaimComputingPower(protoCdc, k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgCommitReport,
+			SimulateMsgCommitReport(protoCdc, k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgUpdateSaleLevel,
+			SimulateMsgUpdateSaleLevel(protoCdc, k, ak, bk),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClaims,
+			SimulateMsgClaims(protoCdc, k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgCreateCaptainNode generates a MsgCreateCaptainNode with random values.
+func SimulateMsgCreateCaptainNode(
+	cdc *codec.ProtoCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.IsStandByPhase(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateCaptainNode, "not allowed in busy phase"), nil, nil
+		}
+
+		member, found := randomAuthorizedMember(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateCaptainNode, "no authorized member"), nil, nil
+		}
+
+		var divisions []types.Division
+		for _, division := range k.GetDivisions(ctx) {
+			if division.SoldCount < division.InitialSupply {
+				divisions = append(divisions, division)
+			}
+		}
+		if len(divisions) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCreateCaptainNode, "all divisions sold out"), nil, nil
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+		division := divisions[r.Intn(len(divisions))]
+		msg := types.NewMsgCreateCaptainNode(member.Address.String(), owner.Address.String(), division.Id)
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, member, msg, TypeMsgCreateCaptainNode)
+	}
+}
+
+// SimulateMsgCommitComputingPower generates a MsgCommitComputingPower with random values.
+func SimulateMsgCommitComputingPower(
+	cdc *codec.ProtoCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.IsStandByPhase(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCommitComputingPower, "not allowed in busy phase"), nil, nil
+		}
+
+		member, found := randomAuthorizedMember(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCommitComputingPower, "no authorized member"), nil, nil
+		}
+
+		// prefer node owners so that the power can be claimed later on
+		var owners []string
+		seen := make(map[string]bool)
+		for _, node := range k.GetNodes(ctx) {
+			if !seen[node.Owner] {
+				seen[node.Owner] = true
+				owners = append(owners, node.Owner)
+			}
+		}
+		if len(owners) == 0 {
+			for _, acc := range accs {
+				owners = append(owners, acc.Address.String())
+			}
+		}
+
+		n := simtypes.RandIntBetween(r, 1, 4)
+		if n > len(owners) {
+			n = len(owners)
+		}
+
+		rewards := make([]types.ClaimableComputingPower, 0, n)
+		for _, i := range r.Perm(len(owners))[:n] {
+			rewards = append(rewards, types.ClaimableComputingPower{
+				Amount: uint64(simtypes.RandIntBetween(r, 1, 100_000)),
+				Owner:  owners[i],
+			})
+		}
+		msg := types.NewMsgCommitComputingPower(rewards, member.Address.String())
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, member, msg, TypeMsgCommitComputingPower)
+	}
+}
+
+// SimulateMsgClaimComputingPower generates a MsgClaimComputingPower with random values.
+func SimulateMsgClaimComputingPower(
+	cdc *codec.ProtoCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.IsStandByPhase(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgClaimComputingPower, "not allowed in busy phase"), nil, nil
+		}
+
+		member, found := randomAuthorizedMember(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgClaimComputingPower, "no authorized member"), nil, nil
+		}
+
+		var (
+			nodes     []types.Node
+			claimable []uint64
+		)
+		for _, node := range k.GetNodes(ctx) {
+			power := k.GetClaimableComputingPower(ctx, sdk.MustAccAddressFromBech32(node.Owner))
+			if power > 0 {
+				nodes = append(nodes, node)
+				claimable = append(claimable, power)
+			}
+		}
+		if len(nodes) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgClaimComputingPower, "no claimable computing power"), nil, nil
+		}
+
+		i := r.Intn(len(nodes))
+		amount := uint64(simtypes.RandIntBetween(r, 1, int(claimable[i])+1))
+		msg := types.NewMsgWithdrawComputingPower(nodes[i].Id, amount, member.Address.String())
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, member, msg, TypeMsgClaimComputingPower)
+	}
+}
+
+// SimulateMsgCommitReport generates the next report of the epoch lifecycle:
+// digest in stand-by phase, then batches and emissions in busy phase until the end report.
+func SimulateMsgCommitReport(
+	cdc *codec.ProtoCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		member, found := randomAuthorizedMember(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCommitReport, "no authorized member"), nil, nil
+		}
+
+		epochID := k.GetCurrentEpoch(ctx)
+		nodes := k.GetNodes(ctx)
+
+		var (
+			reportType types.ReportType
+			report     any
+		)
+		switch {
+		case k.HasEndEpoch(ctx, epochID):
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCommitReport, "epoch already ended"), nil, nil
+
+		case !k.HasReportDigest(ctx, epochID):
+			if len(nodes) == 0 {
+				return simtypes.NoOpMsg(types.ModuleName, TypeMsgCommitReport, "no nodes to report"), nil, nil
+			}
+			reportType, report = types.ReportType_REPORT_TYPE_DIGEST, genReportDigest(r, epochID, uint64(len(nodes)))
+
+		case !k.HasEpochEmission(ctx, epochID):
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCommitReport, "digest not settled"), nil, nil
+
+		default:
+			digest, _ := k.GetReportDigest(ctx, epochID)
+			switch r.Intn(3) {
+			case 0:
+				reportType, report = types.ReportType_REPORT_TYPE_BATCH, genReportBatch(r, digest, nodes)
+			case 1:
+				reportType = types.ReportType_REPORT_TYPE_EMISSION
+				report = genReportEmission(r, digest, nodes, k.GetEpochEmission(ctx, epochID))
+			default:
+				reportType, report = types.ReportType_REPORT_TYPE_END, &types.ReportEnd{EpochId: epochID}
+			}
+		}
+
+		msg, err := types.NewMsgCommitReport(member.Address.String(), reportType, report)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgCommitReport, "unable to build report"), nil, err
+		}
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, member, msg, TypeMsgCommitReport)
+	}
+}
+
+// SimulateMsgUpdateSaleLevel generates a MsgUpdateSaleLevel with random values.
+func SimulateMsgUpdateSaleLevel(
+	cdc *codec.ProtoCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.IsStandByPhase(ctx) {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgUpdateSaleLevel, "not allowed in busy phase"), nil, nil
+		}
+
+		member, found := randomAuthorizedMember(r, ctx, k, accs)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgUpdateSaleLevel, "no authorized member"), nil, nil
+		}
+
+		current := k.GetSaleLevel(ctx)
+		if current >= 5 {
+			return simtypes.NoOpMsg(types.ModuleName, TypeMsgUpdateSaleLevel, "max sale level reached"), nil, nil
+		}
+
+		level := uint64(simtypes.RandIntBetween(r, int(current)+1, 6))
+		msg := types.NewMsgUpdateSaleLevel(member.Address.String(), level)
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, member, msg, TypeMsgUpdateSaleLevel)
+	}
+}
+
+// SimulateMsgClaims generates a claims MsgClaims for an owner with unclaimed emission.
+func SimulateMsgClaims(
+	cdc *codec.ProtoCodec,
+	k keeper.Keeper,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if !k.IsStandByPhase(ctx) {
+			return simtypes.NoOpMsg(claimstypes.ModuleName, TypeMsgClaims, "not allowed in busy phase"), nil, nil
+		}
+
+		epochID := k.GetCurrentEpoch(ctx)
+		if epochID <= 1 {
+			return simtypes.NoOpMsg(claimstypes.ModuleName, TypeMsgClaims, "no epoch settled yet"), nil, nil
+		}
+
+		owner, _ := simtypes.RandomAcc(r, accs)
+		nodes := k.GetNodesByOwner(ctx, owner.Address)
+		if len(nodes) == 0 {
+			return simtypes.NoOpMsg(claimstypes.ModuleName, TypeMsgClaims, "owner holds no node"), nil, nil
+		}
+
+		rewards := sdk.ZeroDec()
+		for _, node := range nodes {
+			reward := k.CalcNodeCumulativeEmissionByEpoch(ctx, epochID-1, node.Id).
+				Sub(k.GetNodeClaimedEmission(ctx, node.Id))
+			if reward.IsNegative() {
+				return simtypes.NoOpMsg(claimstypes.ModuleName, TypeMsgClaims, "negative rewards"), nil, nil
+			}
+			rewards = rewards.Add(reward)
+		}
+		if rewards.LT(sdk.OneDec()) {
+			return simtypes.NoOpMsg(claimstypes.ModuleName, TypeMsgClaims, "no rewards to claim"), nil, nil
+		}
+
+		msg := claimstypes.NewMsgClaims(owner.Address, owner.Address)
+
+		return deliverMsg(r, app, ctx, cdc, ak, bk, owner, msg, TypeMsgClaims)
+	}
+}
+
+// genReportDigest returns a report digest of all nodes.
+func genReportDigest(r *rand.Rand, epochID, nodeCount uint64) *types.ReportDigest {
+	maxPerBatch := uint64(simtypes.RandIntBetween(r, 1, 10))
+	return &types.ReportDigest{
+		EpochId:                  epochID,
+		TotalBatchCount:          (nodeCount + maxPerBatch - 1) / maxPerBatch,
+		TotalNodeCount:           nodeCount,
+		MaximumNodeCountPerBatch: maxPerBatch,
+		GlobalOnOperationRatio:   sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 1, 101)), 2),
+	}
+}
+
+// genReportBatch returns a report batch of random nodes.
+func genReportBatch(r *rand.Rand, digest *types.ReportDigest, nodes []types.Node) *types.ReportBatch {
+	batch := randomBatchNodes(r, digest, nodes)
+	ratios := make([]types.NodePowerOnRatio, 0, len(batch))
+	for _, node := range batch {
+		ratios = append(ratios, types.NodePowerOnRatio{
+			NodeId:           node.Id,
+			OnOperationRatio: sdk.NewDecWithPrec(int64(simtypes.RandIntBetween(r, 0, 101)), 2),
+		})
+	}
+
+	return &types.ReportBatch{
+		EpochId:   digest.EpochId,
+		BatchId:   uint64(simtypes.RandIntBetween(r, 1, int(digest.TotalBatchCount)+1)),
+		NodeCount: uint64(len(ratios)),
+		Nodes:     ratios,
+	}
+}
+
+// genReportEmission returns a report emission of random nodes sharing the epoch emission evenly.
+func genReportEmission(
+	r *rand.Rand,
+	digest *types.ReportDigest,
+	nodes []types.Node,
+	epochEmission sdk.Dec,
+) *types.ReportEmission {
+	share := epochEmission.QuoInt64(int64(digest.TotalNodeCount))
+	batch := randomBatchNodes(r, digest, nodes)
+	emissions := make([]types.NodeEpochEmission, 0, len(batch))
+	for _, node := range batch {
+		emissions = append(emissions, types.NodeEpochEmission{
+			NodeId:       node.Id,
+			NodeEmission: sdk.NewDecCoinFromDec(tabitypes.AttoVeTabi, share),
+		})
+	}
+
+	return &types.ReportEmission{
+		EpochId:   digest.EpochId,
+		BatchId:   uint64(simtypes.RandIntBetween(r, 1, int(digest.TotalBatchCount)+1)),
+		NodeCount: uint64(len(emissions)),
+		Nodes:     emissions,
+	}
+}
+
+// randomBatchNodes returns a random window of nodes which fits in a batch.
+func randomBatchNodes(r *rand.Rand, digest *types.ReportDigest, nodes []types.Node) []types.Node {
+	size := simtypes.RandIntBetween(r, 1, int(digest.MaximumNodeCountPerBatch)+1)
+	if size > len(nodes) {
+		size = len(nodes)
+	}
+	start := r.Intn(len(nodes) - size + 1)
+	return nodes[start : start+size]
+}
+
+// randomAuthorizedMember returns a random simulation account which is an authorized member.
+func randomAuthorizedMember(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accs []simtypes.Account,
+) (simtypes.Account, bool) {
+	members := k.GetParams(ctx).AuthorizedMembers
+	if len(members) == 0 {
+		return simtypes.Account{}, false
+	}
+
+	addr, err := sdk.AccAddressFromBech32(members[r.Intn(len(members))])
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+// deliverMsg signs the msg by the account and delivers it with random fees.
+func deliverMsg(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	cdc *codec.ProtoCodec,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	account simtypes.Account,
+	msg sdk.Msg,
+	msgType string,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	txCtx := simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           simappparams.MakeTestEncodingConfig().TxConfig,
+		Cdc:             cdc,
+		Msg:             msg,
+		MsgType:         msgType,
+		Context:         ctx,
+		SimAccount:      account,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+		CoinsSpentInMsg: sdk.NewCoins(),
+	}
+
+	return simulation.GenAndDeliverTxWithRandFees(txCtx)
+}
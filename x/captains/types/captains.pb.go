@@ -205,6 +205,38 @@ var E_DeclareInterface = cosmos_proto.E_DeclareInterface
 
 var E_DeclareScalar = cosmos_proto.E_DeclareScalar
 
+// MisbehaviorType defines the type of a captain node misbehavior
+type MisbehaviorType int32
+
+const (
+	// MISBEHAVIOR_TYPE_UNSPECIFIED
+	MisbehaviorType_MISBEHAVIOR_TYPE_UNSPECIFIED MisbehaviorType = 0
+	// MISBEHAVIOR_TYPE_DOWNTIME defines a proven downtime of the node
+	MisbehaviorType_MISBEHAVIOR_TYPE_DOWNTIME MisbehaviorType = 1
+	// MISBEHAVIOR_TYPE_FRAUD defines a proven fraud of the node
+	MisbehaviorType_MISBEHAVIOR_TYPE_FRAUD MisbehaviorType = 2
+)
+
+var MisbehaviorType_name = map[int32]string{
+	0: "MISBEHAVIOR_TYPE_UNSPECIFIED",
+	1: "MISBEHAVIOR_TYPE_DOWNTIME",
+	2: "MISBEHAVIOR_TYPE_FRAUD",
+}
+
+var MisbehaviorType_value = map[string]int32{
+	"MISBEHAVIOR_TYPE_UNSPECIFIED": 0,
+	"MISBEHAVIOR_TYPE_DOWNTIME":    1,
+	"MISBEHAVIOR_TYPE_FRAUD":       2,
+}
+
+func (x MisbehaviorType) String() string {
+	return proto.EnumName(MisbehaviorType_name, int32(x))
+}
+
+func (MisbehaviorType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{0}
+}
+
 // Params defines captains module's parameters
 type Params struct {
 	// captains_total_count defines the total count of the captains
@@ -223,6 +255,10 @@ type Params struct {
 	CurrentSaleLevel uint64 `protobuf:"varint,7,opt,name=current_sale_level,json=currentSaleLevel,proto3" json:"current_sale_level,omitempty"`
 	// authorized_members authorized members list
 	AuthorizedMembers []string `protobuf:"bytes,8,rep,name=authorized_members,json=authorizedMembers,proto3" json:"authorized_members,omitempty"`
+	// sale_level_schedule defines the sale levels unlocked by division sales.
+	SaleLevelSchedule []SaleLevelMilestone `protobuf:"bytes,9,rep,name=sale_level_schedule,json=saleLevelSchedule,proto3" json:"sale_level_schedule"`
+	// halving_era_schedule defines the halving era coefficients applied at epochs.
+	HalvingEraSchedule []HalvingEra `protobuf:"bytes,10,rep,name=halving_era_schedule,json=halvingEraSchedule,proto3" json:"halving_era_schedule"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -293,6 +329,242 @@ func (m *Params) GetAuthorizedMembers() []string {
 	return nil
 }
 
+func (m *Params) GetSaleLevelSchedule() []SaleLevelMilestone {
+	if m != nil {
+		return m.SaleLevelSchedule
+	}
+	return nil
+}
+
+func (m *Params) GetHalvingEraSchedule() []HalvingEra {
+	if m != nil {
+		return m.HalvingEraSchedule
+	}
+	return nil
+}
+
+// SaleLevelMilestone unlocks a sale level once a division sold enough nodes.
+type SaleLevelMilestone struct {
+	// sale_level is the sale level to unlock.
+	SaleLevel uint64 `protobuf:"varint,1,opt,name=sale_level,json=saleLevel,proto3" json:"sale_level,omitempty"`
+	// division_id is the division whose sales are tracked.
+	DivisionId string `protobuf:"bytes,2,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	// sold_count is the sold count of the division to reach.
+	SoldCount uint64 `protobuf:"varint,3,opt,name=sold_count,json=soldCount,proto3" json:"sold_count,omitempty"`
+}
+
+func (m *SaleLevelMilestone) Reset()         { *m = SaleLevelMilestone{} }
+func (m *SaleLevelMilestone) String() string { return proto.CompactTextString(m) }
+func (*SaleLevelMilestone) ProtoMessage()    {}
+func (*SaleLevelMilestone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{1}
+}
+func (m *SaleLevelMilestone) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaleLevelMilestone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SaleLevelMilestone.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SaleLevelMilestone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaleLevelMilestone.Merge(m, src)
+}
+func (m *SaleLevelMilestone) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaleLevelMilestone) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaleLevelMilestone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaleLevelMilestone proto.InternalMessageInfo
+
+func (m *SaleLevelMilestone) GetSaleLevel() uint64 {
+	if m != nil {
+		return m.SaleLevel
+	}
+	return 0
+}
+
+func (m *SaleLevelMilestone) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
+}
+
+func (m *SaleLevelMilestone) GetSoldCount() uint64 {
+	if m != nil {
+		return m.SoldCount
+	}
+	return 0
+}
+
+// HalvingEra applies a halving era coefficient from an epoch on.
+type HalvingEra struct {
+	// epoch_id is the epoch the era starts at.
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// coefficient is the halving era coefficient of the era.
+	Coefficient github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=coefficient,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"coefficient"`
+}
+
+func (m *HalvingEra) Reset()         { *m = HalvingEra{} }
+func (m *HalvingEra) String() string { return proto.CompactTextString(m) }
+func (*HalvingEra) ProtoMessage()    {}
+func (*HalvingEra) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{2}
+}
+func (m *HalvingEra) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HalvingEra) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HalvingEra.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HalvingEra) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HalvingEra.Merge(m, src)
+}
+func (m *HalvingEra) XXX_Size() int {
+	return m.Size()
+}
+func (m *HalvingEra) XXX_DiscardUnknown() {
+	xxx_messageInfo_HalvingEra.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HalvingEra proto.InternalMessageInfo
+
+func (m *HalvingEra) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+// SaleLevelMilestoneStatus is a sale level milestone on the timeline.
+type SaleLevelMilestoneStatus struct {
+	// milestone
+	Milestone SaleLevelMilestone `protobuf:"bytes,1,opt,name=milestone,proto3" json:"milestone"`
+	// reached is whether the sale level is unlocked.
+	Reached bool `protobuf:"varint,2,opt,name=reached,proto3" json:"reached,omitempty"`
+}
+
+func (m *SaleLevelMilestoneStatus) Reset()         { *m = SaleLevelMilestoneStatus{} }
+func (m *SaleLevelMilestoneStatus) String() string { return proto.CompactTextString(m) }
+func (*SaleLevelMilestoneStatus) ProtoMessage()    {}
+func (*SaleLevelMilestoneStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{3}
+}
+func (m *SaleLevelMilestoneStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SaleLevelMilestoneStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SaleLevelMilestoneStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SaleLevelMilestoneStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SaleLevelMilestoneStatus.Merge(m, src)
+}
+func (m *SaleLevelMilestoneStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *SaleLevelMilestoneStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_SaleLevelMilestoneStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SaleLevelMilestoneStatus proto.InternalMessageInfo
+
+func (m *SaleLevelMilestoneStatus) GetMilestone() SaleLevelMilestone {
+	if m != nil {
+		return m.Milestone
+	}
+	return SaleLevelMilestone{}
+}
+
+func (m *SaleLevelMilestoneStatus) GetReached() bool {
+	if m != nil {
+		return m.Reached
+	}
+	return false
+}
+
+// HalvingEraStatus is a halving era on the timeline.
+type HalvingEraStatus struct {
+	// era
+	Era HalvingEra `protobuf:"bytes,1,opt,name=era,proto3" json:"era"`
+	// applied is whether the era has been entered.
+	Applied bool `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+}
+
+func (m *HalvingEraStatus) Reset()         { *m = HalvingEraStatus{} }
+func (m *HalvingEraStatus) String() string { return proto.CompactTextString(m) }
+func (*HalvingEraStatus) ProtoMessage()    {}
+func (*HalvingEraStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{4}
+}
+func (m *HalvingEraStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HalvingEraStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HalvingEraStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HalvingEraStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HalvingEraStatus.Merge(m, src)
+}
+func (m *HalvingEraStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *HalvingEraStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_HalvingEraStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HalvingEraStatus proto.InternalMessageInfo
+
+func (m *HalvingEraStatus) GetEra() HalvingEra {
+	if m != nil {
+		return m.Era
+	}
+	return HalvingEra{}
+}
+
+func (m *HalvingEraStatus) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
 // Division defines the division a node belongs to.
 type Division struct {
 	// id
@@ -315,7 +587,7 @@ func (m *Division) Reset()         { *m = Division{} }
 func (m *Division) String() string { return proto.CompactTextString(m) }
 func (*Division) ProtoMessage()    {}
 func (*Division) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{1}
+	return fileDescriptor_35d5085f64c624e4, []int{5}
 }
 func (m *Division) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -409,7 +681,7 @@ func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{2}
+	return fileDescriptor_35d5085f64c624e4, []int{6}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,6 +738,106 @@ func (m *Node) GetComputingPower() uint64 {
 	return 0
 }
 
+// NodeDivisionChange records a node entering a division.
+type NodeDivisionChange struct {
+	// node_id
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// sequence is the order of the change in the node history
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// from_division_id is empty when the node is created
+	FromDivisionId string `protobuf:"bytes,3,opt,name=from_division_id,json=fromDivisionId,proto3" json:"from_division_id,omitempty"`
+	// to_division_id
+	ToDivisionId string `protobuf:"bytes,4,opt,name=to_division_id,json=toDivisionId,proto3" json:"to_division_id,omitempty"`
+	// computing_power is the node computing power after the change
+	ComputingPower uint64 `protobuf:"varint,5,opt,name=computing_power,json=computingPower,proto3" json:"computing_power,omitempty"`
+	// epoch_id is the epoch the change happened in
+	EpochId uint64 `protobuf:"varint,6,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// height is the block height the change happened at
+	Height int64 `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *NodeDivisionChange) Reset()         { *m = NodeDivisionChange{} }
+func (m *NodeDivisionChange) String() string { return proto.CompactTextString(m) }
+func (*NodeDivisionChange) ProtoMessage()    {}
+func (*NodeDivisionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{7}
+}
+func (m *NodeDivisionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeDivisionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeDivisionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeDivisionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDivisionChange.Merge(m, src)
+}
+func (m *NodeDivisionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeDivisionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDivisionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDivisionChange proto.InternalMessageInfo
+
+func (m *NodeDivisionChange) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *NodeDivisionChange) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *NodeDivisionChange) GetFromDivisionId() string {
+	if m != nil {
+		return m.FromDivisionId
+	}
+	return ""
+}
+
+func (m *NodeDivisionChange) GetToDivisionId() string {
+	if m != nil {
+		return m.ToDivisionId
+	}
+	return ""
+}
+
+func (m *NodeDivisionChange) GetComputingPower() uint64 {
+	if m != nil {
+		return m.ComputingPower
+	}
+	return 0
+}
+
+func (m *NodeDivisionChange) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *NodeDivisionChange) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 // BaseState defines the state of the epoch
 type BaseState struct {
 	// epoch_id id of the epoch
@@ -480,13 +852,26 @@ type BaseState struct {
 	ReportDigest *ReportDigest `protobuf:"bytes,5,opt,name=report_digest,json=reportDigest,proto3" json:"report_digest,omitempty"`
 	// is_stand_by is in epoch stand_by phase
 	IsStandBy bool `protobuf:"varint,6,opt,name=is_stand_by,json=isStandBy,proto3" json:"is_stand_by,omitempty"`
+	// last_halving_era_epoch the start epoch of the last applied halving era
+	LastHalvingEraEpoch uint64 `protobuf:"varint,7,opt,name=last_halving_era_epoch,json=lastHalvingEraEpoch,proto3" json:"last_halving_era_epoch,omitempty"`
+	// community_pool_emission the confiscated emission pending for the community pool
+	CommunityPoolEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=community_pool_emission,json=communityPoolEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_emission"`
+	// fee_pool_emission the fees redirected by the fee market pending to top up
+	// the emission of the next epoch
+	FeePoolEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=fee_pool_emission,json=feePoolEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_pool_emission"`
+	// epoch_fee_pool_emission the fee pool emission topping up the emission of the epoch
+	EpochFeePoolEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=epoch_fee_pool_emission,json=epochFeePoolEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_fee_pool_emission"`
+	// global_committed_computing_power the computing power committed to the owners
+	GlobalCommittedComputingPower uint64 `protobuf:"varint,11,opt,name=global_committed_computing_power,json=globalCommittedComputingPower,proto3" json:"global_committed_computing_power,omitempty"`
+	// global_claimed_computing_power the committed computing power claimed into nodes
+	GlobalClaimedComputingPower uint64 `protobuf:"varint,12,opt,name=global_claimed_computing_power,json=globalClaimedComputingPower,proto3" json:"global_claimed_computing_power,omitempty"`
 }
 
 func (m *BaseState) Reset()         { *m = BaseState{} }
 func (m *BaseState) String() string { return proto.CompactTextString(m) }
 func (*BaseState) ProtoMessage()    {}
 func (*BaseState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{3}
+	return fileDescriptor_35d5085f64c624e4, []int{8}
 }
 func (m *BaseState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -550,6 +935,27 @@ func (m *BaseState) GetIsStandBy() bool {
 	return false
 }
 
+func (m *BaseState) GetLastHalvingEraEpoch() uint64 {
+	if m != nil {
+		return m.LastHalvingEraEpoch
+	}
+	return 0
+}
+
+func (m *BaseState) GetGlobalCommittedComputingPower() uint64 {
+	if m != nil {
+		return m.GlobalCommittedComputingPower
+	}
+	return 0
+}
+
+func (m *BaseState) GetGlobalClaimedComputingPower() uint64 {
+	if m != nil {
+		return m.GlobalClaimedComputingPower
+	}
+	return 0
+}
+
 // EpochEmission
 type EpochEmission struct {
 	// epoch_id
@@ -562,7 +968,7 @@ func (m *EpochEmission) Reset()         { *m = EpochEmission{} }
 func (m *EpochEmission) String() string { return proto.CompactTextString(m) }
 func (*EpochEmission) ProtoMessage()    {}
 func (*EpochEmission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{4}
+	return fileDescriptor_35d5085f64c624e4, []int{9}
 }
 func (m *EpochEmission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -610,7 +1016,7 @@ func (m *NodeClaimedEmission) Reset()         { *m = NodeClaimedEmission{} }
 func (m *NodeClaimedEmission) String() string { return proto.CompactTextString(m) }
 func (*NodeClaimedEmission) ProtoMessage()    {}
 func (*NodeClaimedEmission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{5}
+	return fileDescriptor_35d5085f64c624e4, []int{10}
 }
 func (m *NodeClaimedEmission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +1064,7 @@ func (m *ClaimableComputingPower) Reset()         { *m = ClaimableComputingPower
 func (m *ClaimableComputingPower) String() string { return proto.CompactTextString(m) }
 func (*ClaimableComputingPower) ProtoMessage()    {}
 func (*ClaimableComputingPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{6}
+	return fileDescriptor_35d5085f64c624e4, []int{11}
 }
 func (m *ClaimableComputingPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -715,7 +1121,7 @@ func (m *NodeCumulativeEmission) Reset()         { *m = NodeCumulativeEmission{}
 func (m *NodeCumulativeEmission) String() string { return proto.CompactTextString(m) }
 func (*NodeCumulativeEmission) ProtoMessage()    {}
 func (*NodeCumulativeEmission) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{7}
+	return fileDescriptor_35d5085f64c624e4, []int{12}
 }
 func (m *NodeCumulativeEmission) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -770,7 +1176,7 @@ func (m *GlobalComputingPower) Reset()         { *m = GlobalComputingPower{} }
 func (m *GlobalComputingPower) String() string { return proto.CompactTextString(m) }
 func (*GlobalComputingPower) ProtoMessage()    {}
 func (*GlobalComputingPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{8}
+	return fileDescriptor_35d5085f64c624e4, []int{13}
 }
 func (m *GlobalComputingPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +1226,7 @@ func (m *NodesComputingPower) Reset()         { *m = NodesComputingPower{} }
 func (m *NodesComputingPower) String() string { return proto.CompactTextString(m) }
 func (*NodesComputingPower) ProtoMessage()    {}
 func (*NodesComputingPower) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{9}
+	return fileDescriptor_35d5085f64c624e4, []int{14}
 }
 func (m *NodesComputingPower) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1281,7 @@ func (m *GlobalPledge) Reset()         { *m = GlobalPledge{} }
 func (m *GlobalPledge) String() string { return proto.CompactTextString(m) }
 func (*GlobalPledge) ProtoMessage()    {}
 func (*GlobalPledge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{10}
+	return fileDescriptor_35d5085f64c624e4, []int{15}
 }
 func (m *GlobalPledge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1331,7 @@ func (m *OwnerPledge) Reset()         { *m = OwnerPledge{} }
 func (m *OwnerPledge) String() string { return proto.CompactTextString(m) }
 func (*OwnerPledge) ProtoMessage()    {}
 func (*OwnerPledge) Descriptor() ([]byte, []int) {
-	return fileDescriptor_35d5085f64c624e4, []int{11}
+	return fileDescriptor_35d5085f64c624e4, []int{16}
 }
 func (m *OwnerPledge) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -968,86 +1374,314 @@ func (m *OwnerPledge) GetEpochId() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "tabi.captains.v1.Params")
-	proto.RegisterType((*Division)(nil), "tabi.captains.v1.Division")
-	proto.RegisterType((*Node)(nil), "tabi.captains.v1.Node")
-	proto.RegisterType((*BaseState)(nil), "tabi.captains.v1.BaseState")
-	proto.RegisterType((*EpochEmission)(nil), "tabi.captains.v1.EpochEmission")
-	proto.RegisterType((*NodeClaimedEmission)(nil), "tabi.captains.v1.NodeClaimedEmission")
-	proto.RegisterType((*ClaimableComputingPower)(nil), "tabi.captains.v1.ClaimableComputingPower")
-	proto.RegisterType((*NodeCumulativeEmission)(nil), "tabi.captains.v1.NodeCumulativeEmission")
-	proto.RegisterType((*GlobalComputingPower)(nil), "tabi.captains.v1.GlobalComputingPower")
-	proto.RegisterType((*NodesComputingPower)(nil), "tabi.captains.v1.NodesComputingPower")
-	proto.RegisterType((*GlobalPledge)(nil), "tabi.captains.v1.GlobalPledge")
+// OwnerPledgeCheckpoint is the running pledge of an owner kept by staking hooks.
+type OwnerPledgeCheckpoint struct {
+	// owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// amount is the current pledge of the owner
+	Amount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount"`
+	// boundary_epoch is the epoch whose boundary pledge is recorded
+	BoundaryEpoch uint64 `protobuf:"varint,3,opt,name=boundary_epoch,json=boundaryEpoch,proto3" json:"boundary_epoch,omitempty"`
+	// boundary_amount is the pledge of the owner at the boundary of boundary_epoch
+	BoundaryAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=boundary_amount,json=boundaryAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"boundary_amount"`
+}
+
+func (m *OwnerPledgeCheckpoint) Reset()         { *m = OwnerPledgeCheckpoint{} }
+func (m *OwnerPledgeCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*OwnerPledgeCheckpoint) ProtoMessage()    {}
+func (*OwnerPledgeCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{17}
+}
+func (m *OwnerPledgeCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerPledgeCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerPledgeCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerPledgeCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerPledgeCheckpoint.Merge(m, src)
+}
+func (m *OwnerPledgeCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerPledgeCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerPledgeCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerPledgeCheckpoint proto.InternalMessageInfo
+
+func (m *OwnerPledgeCheckpoint) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OwnerPledgeCheckpoint) GetBoundaryEpoch() uint64 {
+	if m != nil {
+		return m.BoundaryEpoch
+	}
+	return 0
+}
+
+// OwnerDelegationPledge is the pledge of an owner on a validator.
+type OwnerDelegationPledge struct {
+	// owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// validator
+	Validator string `protobuf:"bytes,2,opt,name=validator,proto3" json:"validator,omitempty"`
+	// amount
+	Amount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"amount"`
+}
+
+func (m *OwnerDelegationPledge) Reset()         { *m = OwnerDelegationPledge{} }
+func (m *OwnerDelegationPledge) String() string { return proto.CompactTextString(m) }
+func (*OwnerDelegationPledge) ProtoMessage()    {}
+func (*OwnerDelegationPledge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{18}
+}
+func (m *OwnerDelegationPledge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OwnerDelegationPledge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OwnerDelegationPledge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OwnerDelegationPledge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OwnerDelegationPledge.Merge(m, src)
+}
+func (m *OwnerDelegationPledge) XXX_Size() int {
+	return m.Size()
+}
+func (m *OwnerDelegationPledge) XXX_DiscardUnknown() {
+	xxx_messageInfo_OwnerDelegationPledge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OwnerDelegationPledge proto.InternalMessageInfo
+
+func (m *OwnerDelegationPledge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *OwnerDelegationPledge) GetValidator() string {
+	if m != nil {
+		return m.Validator
+	}
+	return ""
+}
+
+// NodeSlashInfo defines the penalties of a captain node
+type NodeSlashInfo struct {
+	// node_id is the id of the node
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// jailed defines whether the node is excluded from power calculation
+	Jailed bool `protobuf:"varint,2,opt,name=jailed,proto3" json:"jailed,omitempty"`
+	// penalty_end_epoch is the last epoch the node earns no emission
+	PenaltyEndEpoch uint64 `protobuf:"varint,3,opt,name=penalty_end_epoch,json=penaltyEndEpoch,proto3" json:"penalty_end_epoch,omitempty"`
+	// confiscated_emission is the total emission confiscated from the node
+	ConfiscatedEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=confiscated_emission,json=confiscatedEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"confiscated_emission"`
+}
+
+func (m *NodeSlashInfo) Reset()         { *m = NodeSlashInfo{} }
+func (m *NodeSlashInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeSlashInfo) ProtoMessage()    {}
+func (*NodeSlashInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35d5085f64c624e4, []int{19}
+}
+func (m *NodeSlashInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeSlashInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeSlashInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NodeSlashInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeSlashInfo.Merge(m, src)
+}
+func (m *NodeSlashInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeSlashInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeSlashInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeSlashInfo proto.InternalMessageInfo
+
+func (m *NodeSlashInfo) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *NodeSlashInfo) GetJailed() bool {
+	if m != nil {
+		return m.Jailed
+	}
+	return false
+}
+
+func (m *NodeSlashInfo) GetPenaltyEndEpoch() uint64 {
+	if m != nil {
+		return m.PenaltyEndEpoch
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("tabi.captains.v1.MisbehaviorType", MisbehaviorType_name, MisbehaviorType_value)
+	proto.RegisterType((*Params)(nil), "tabi.captains.v1.Params")
+	proto.RegisterType((*SaleLevelMilestone)(nil), "tabi.captains.v1.SaleLevelMilestone")
+	proto.RegisterType((*HalvingEra)(nil), "tabi.captains.v1.HalvingEra")
+	proto.RegisterType((*SaleLevelMilestoneStatus)(nil), "tabi.captains.v1.SaleLevelMilestoneStatus")
+	proto.RegisterType((*HalvingEraStatus)(nil), "tabi.captains.v1.HalvingEraStatus")
+	proto.RegisterType((*Division)(nil), "tabi.captains.v1.Division")
+	proto.RegisterType((*Node)(nil), "tabi.captains.v1.Node")
+	proto.RegisterType((*NodeDivisionChange)(nil), "tabi.captains.v1.NodeDivisionChange")
+	proto.RegisterType((*BaseState)(nil), "tabi.captains.v1.BaseState")
+	proto.RegisterType((*EpochEmission)(nil), "tabi.captains.v1.EpochEmission")
+	proto.RegisterType((*NodeClaimedEmission)(nil), "tabi.captains.v1.NodeClaimedEmission")
+	proto.RegisterType((*ClaimableComputingPower)(nil), "tabi.captains.v1.ClaimableComputingPower")
+	proto.RegisterType((*NodeCumulativeEmission)(nil), "tabi.captains.v1.NodeCumulativeEmission")
+	proto.RegisterType((*GlobalComputingPower)(nil), "tabi.captains.v1.GlobalComputingPower")
+	proto.RegisterType((*NodesComputingPower)(nil), "tabi.captains.v1.NodesComputingPower")
+	proto.RegisterType((*GlobalPledge)(nil), "tabi.captains.v1.GlobalPledge")
 	proto.RegisterType((*OwnerPledge)(nil), "tabi.captains.v1.OwnerPledge")
+	proto.RegisterType((*OwnerPledgeCheckpoint)(nil), "tabi.captains.v1.OwnerPledgeCheckpoint")
+	proto.RegisterType((*OwnerDelegationPledge)(nil), "tabi.captains.v1.OwnerDelegationPledge")
+	proto.RegisterType((*NodeSlashInfo)(nil), "tabi.captains.v1.NodeSlashInfo")
 }
 
 func init() { proto.RegisterFile("tabi/captains/v1/captains.proto", fileDescriptor_35d5085f64c624e4) }
 
 var fileDescriptor_35d5085f64c624e4 = []byte{
-	// 967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4d, 0x6f, 0x1b, 0x45,
-	0x18, 0xce, 0x3a, 0x4e, 0x62, 0xbf, 0x4e, 0x42, 0x3a, 0xb8, 0x8d, 0x5b, 0x54, 0x3b, 0xb2, 0x54,
-	0x08, 0x12, 0xb1, 0x29, 0x88, 0x1b, 0x1c, 0xb0, 0x13, 0xa1, 0x4a, 0x85, 0x5a, 0xeb, 0x22, 0x21,
-	0x2e, 0xa3, 0xf1, 0xce, 0xd4, 0x1e, 0xb1, 0x3b, 0xb3, 0xec, 0xcc, 0xba, 0x09, 0x17, 0x2e, 0x48,
-	0x70, 0x83, 0x03, 0x9c, 0xb9, 0xf1, 0x0b, 0xfa, 0x23, 0x7a, 0x2c, 0x3d, 0x21, 0x0e, 0x15, 0x4a,
-	0xfe, 0x04, 0x47, 0x34, 0x1f, 0x5e, 0x3b, 0x69, 0x28, 0x12, 0x72, 0x7b, 0x59, 0xef, 0xbc, 0xcf,
-	0xfb, 0x35, 0xcf, 0xfb, 0xcc, 0x78, 0xa1, 0xa5, 0xc9, 0x88, 0x77, 0x23, 0x92, 0x6a, 0xc2, 0x85,
-	0xea, 0x4e, 0x6f, 0x17, 0xef, 0x9d, 0x34, 0x93, 0x5a, 0xa2, 0x1d, 0xe3, 0xd0, 0x29, 0x8c, 0xd3,
-	0xdb, 0x37, 0xea, 0x63, 0x39, 0x96, 0x16, 0xec, 0x9a, 0x37, 0xe7, 0x77, 0xe3, 0x7a, 0x24, 0x55,
-	0x22, 0x15, 0x76, 0x80, 0x5b, 0x78, 0xe8, 0xe6, 0x73, 0x35, 0x32, 0x96, 0xca, 0x4c, 0x3b, 0xb8,
-	0xfd, 0x77, 0x19, 0xd6, 0x07, 0x24, 0x23, 0x89, 0x42, 0xef, 0x42, 0x7d, 0xe6, 0x86, 0xb5, 0xd4,
-	0x24, 0xc6, 0x91, 0xcc, 0x85, 0x6e, 0x04, 0x7b, 0xc1, 0x7e, 0x39, 0x44, 0x33, 0xec, 0xbe, 0x81,
-	0xfa, 0x06, 0x41, 0x1f, 0xc0, 0x6e, 0xc2, 0x05, 0x4f, 0xf2, 0x04, 0xa7, 0xf2, 0x21, 0xcb, 0xb0,
-	0x14, 0x38, 0x65, 0x19, 0x97, 0xb4, 0x51, 0xb2, 0x41, 0x75, 0x0f, 0x0f, 0x0c, 0x7a, 0x4f, 0x0c,
-	0x2c, 0x66, 0xc3, 0xc8, 0xf1, 0xa5, 0x61, 0xab, 0x3e, 0xcc, 0xc1, 0xe7, 0xc3, 0x38, 0x5c, 0x29,
-	0xfa, 0x8b, 0xa4, 0x50, 0x9a, 0x08, 0xdd, 0x28, 0xef, 0x05, 0xfb, 0xd5, 0xde, 0x87, 0x8f, 0x9f,
-	0xb5, 0x56, 0xfe, 0x7c, 0xd6, 0x7a, 0x73, 0xcc, 0xf5, 0x24, 0x1f, 0x75, 0x22, 0x99, 0x78, 0x16,
-	0xfc, 0xcf, 0x81, 0xa2, 0x5f, 0x75, 0xf5, 0x49, 0xca, 0x54, 0xe7, 0x90, 0x45, 0x4f, 0x1f, 0x1d,
-	0x80, 0x27, 0xe9, 0x90, 0x45, 0xe1, 0xce, 0x2c, 0x6d, 0xdf, 0x67, 0x45, 0x1a, 0x76, 0x27, 0x24,
-	0x9e, 0x72, 0x31, 0xc6, 0x2c, 0x23, 0x38, 0x92, 0xec, 0xc1, 0x03, 0x1e, 0x71, 0x26, 0x74, 0x63,
-	0x6d, 0x09, 0x05, 0xaf, 0xfa, 0xe4, 0x47, 0x19, 0xe9, 0xcf, 0x53, 0xa3, 0x1f, 0x03, 0xb8, 0xa5,
-	0x59, 0x34, 0x31, 0x63, 0x1c, 0x67, 0x4c, 0xa9, 0xc5, 0xc2, 0x38, 0x22, 0x19, 0xe5, 0x82, 0xc4,
-	0x5c, 0x9f, 0x34, 0xd6, 0x97, 0xd0, 0x44, 0xdb, 0x94, 0x1a, 0xf8, 0x4a, 0x0b, 0x6d, 0xf4, 0xe7,
-	0x75, 0xd0, 0x3b, 0x80, 0xa2, 0x3c, 0xcb, 0x4c, 0x79, 0x45, 0x62, 0x86, 0x63, 0x36, 0x65, 0x71,
-	0x63, 0xc3, 0x0e, 0x69, 0xc7, 0x23, 0x43, 0x12, 0xb3, 0xbb, 0xc6, 0x8e, 0x0e, 0x00, 0x91, 0x5c,
-	0x4f, 0x64, 0xc6, 0xbf, 0x61, 0x14, 0x27, 0x2c, 0x19, 0xb1, 0x4c, 0x35, 0x2a, 0x7b, 0xab, 0xfb,
-	0xd5, 0xf0, 0xca, 0x1c, 0xf9, 0xd4, 0x01, 0xed, 0x5f, 0x4a, 0x50, 0x39, 0xe4, 0x53, 0xae, 0xb8,
-	0x14, 0x68, 0x1b, 0x4a, 0x9c, 0x5a, 0xa9, 0x55, 0xc3, 0x12, 0xa7, 0xa8, 0x0e, 0x6b, 0xae, 0x98,
-	0x13, 0x92, 0x5b, 0xa0, 0x5b, 0xb0, 0xcd, 0x05, 0xd7, 0x9c, 0xc4, 0x58, 0xe5, 0x69, 0x1a, 0x9f,
-	0x78, 0xc1, 0x6c, 0x79, 0xeb, 0xd0, 0x1a, 0xd1, 0x4d, 0x00, 0x25, 0x63, 0xea, 0xf5, 0x5b, 0xb6,
-	0x2e, 0x55, 0x63, 0x71, 0xb2, 0x6d, 0x41, 0x6d, 0x51, 0xdf, 0x6b, 0x16, 0x07, 0x3d, 0xd7, 0xf5,
-	0x47, 0xf0, 0x46, 0x24, 0x93, 0x34, 0xd7, 0x46, 0x00, 0x4e, 0xa2, 0xb1, 0x7d, 0x8e, 0x64, 0x2e,
-	0xa8, 0x65, 0xbf, 0x1c, 0x36, 0x0a, 0x17, 0x2b, 0xd3, 0xbb, 0xe6, 0xd1, 0x33, 0xf8, 0x65, 0xe1,
-	0x79, 0x9a, 0x16, 0xe1, 0x1b, 0x97, 0x85, 0x7f, 0x6e, 0x1c, 0x6c, 0x78, 0x7b, 0x0a, 0xe5, 0xcf,
-	0x24, 0x65, 0xcf, 0x51, 0xd2, 0x82, 0x1a, 0xf5, 0x74, 0x61, 0xee, 0x4e, 0x58, 0x35, 0x84, 0x99,
-	0xe9, 0x8e, 0xe5, 0x4c, 0x3e, 0x14, 0x2c, 0xb3, 0xa4, 0x54, 0x43, 0xb7, 0x40, 0x6f, 0xc1, 0x6b,
-	0x17, 0xba, 0xf1, 0x8c, 0x6c, 0x9f, 0xef, 0xa0, 0xfd, 0x7b, 0x09, 0xaa, 0x3d, 0xa2, 0xd8, 0x50,
-	0x13, 0xcd, 0xd0, 0x75, 0xa8, 0xb0, 0x54, 0x46, 0x13, 0xec, 0x7b, 0x28, 0x87, 0x1b, 0x76, 0x7d,
-	0x87, 0xa2, 0x3d, 0xd8, 0xe4, 0x0a, 0x3b, 0x94, 0x09, 0xd7, 0x49, 0x25, 0x04, 0xae, 0x8e, 0x8c,
-	0xe9, 0x48, 0x50, 0xa3, 0x1b, 0xc1, 0x8e, 0x35, 0x16, 0x92, 0x32, 0xac, 0xd8, 0xd7, 0x39, 0x13,
-	0x11, 0xf3, 0xb3, 0xda, 0x31, 0x88, 0xd9, 0xe0, 0xd0, 0xdb, 0xcd, 0x69, 0x1b, 0xc7, 0x72, 0x64,
-	0x06, 0x12, 0x13, 0x9e, 0x30, 0x8a, 0x59, 0xc2, 0x95, 0xd9, 0xd4, 0x52, 0x8e, 0xf7, 0x55, 0x97,
-	0xbc, 0xef, 0x72, 0x1f, 0xf9, 0xd4, 0xa8, 0x0f, 0x5b, 0xee, 0x26, 0xc4, 0x94, 0x8f, 0x99, 0x72,
-	0x3a, 0xa8, 0xbd, 0xd7, 0xec, 0x5c, 0xbc, 0x73, 0x3b, 0xa1, 0x75, 0x3b, 0xb4, 0x5e, 0xe1, 0x66,
-	0xb6, 0xb0, 0x42, 0x4d, 0xa8, 0x71, 0x85, 0xcd, 0xa5, 0x41, 0xf1, 0xc8, 0x9d, 0xcb, 0x4a, 0x58,
-	0xe5, 0x6a, 0x68, 0x2c, 0xbd, 0x93, 0xf6, 0x77, 0x01, 0x6c, 0x39, 0x56, 0x66, 0x65, 0x5f, 0xc0,
-	0xeb, 0x17, 0x50, 0x29, 0x36, 0x5e, 0x5a, 0xc2, 0xc6, 0x8b, 0x6c, 0xed, 0x1f, 0x02, 0x78, 0xdd,
-	0x50, 0x7e, 0x91, 0x83, 0x5d, 0xd8, 0xb0, 0x23, 0x2a, 0x74, 0xb6, 0x6e, 0x96, 0x2f, 0xb5, 0x15,
-	0x02, 0xbb, 0xb6, 0x0b, 0x32, 0x8a, 0x59, 0xff, 0x9c, 0x00, 0xd1, 0x35, 0x58, 0x27, 0xc9, 0xc2,
-	0x5f, 0x8e, 0x5f, 0xa1, 0xce, 0x4c, 0xd7, 0xae, 0x93, 0xc6, 0xd3, 0x47, 0x07, 0x75, 0x9f, 0xfb,
-	0x63, 0x4a, 0xcd, 0xf5, 0x35, 0xd4, 0x19, 0x17, 0x63, 0xaf, 0xf8, 0xf6, 0x6f, 0x01, 0x5c, 0xb3,
-	0xbb, 0xcd, 0x93, 0x3c, 0x26, 0x9a, 0x4f, 0xd9, 0x7f, 0x6f, 0x78, 0x71, 0x2c, 0xa5, 0x7f, 0x1f,
-	0xcb, 0xea, 0x52, 0xb9, 0xf8, 0x3e, 0x80, 0xfa, 0x27, 0x4e, 0x9c, 0xe7, 0x99, 0x78, 0x81, 0x48,
-	0xee, 0x17, 0x24, 0x2d, 0x63, 0x2e, 0x3e, 0x57, 0xfb, 0x57, 0x2f, 0x10, 0x75, 0xa1, 0x91, 0xff,
-	0xc3, 0xd7, 0xbc, 0xc3, 0xd5, 0x25, 0x76, 0xf8, 0x2d, 0x6c, 0x3a, 0xaa, 0x06, 0x31, 0xa3, 0x63,
-	0xf6, 0xea, 0x29, 0xfa, 0x39, 0x80, 0xda, 0x3d, 0xa3, 0x2f, 0xdf, 0x40, 0x71, 0xdb, 0x06, 0x8b,
-	0xb7, 0xed, 0xab, 0xe6, 0xa5, 0xd7, 0x7f, 0x7c, 0xda, 0x0c, 0x9e, 0x9c, 0x36, 0x83, 0xbf, 0x4e,
-	0x9b, 0xc1, 0x4f, 0x67, 0xcd, 0x95, 0x27, 0x67, 0xcd, 0x95, 0x3f, 0xce, 0x9a, 0x2b, 0x5f, 0xbe,
-	0xbd, 0x90, 0xd7, 0xdc, 0x69, 0x31, 0x19, 0x29, 0xfb, 0xd2, 0x3d, 0x9e, 0x7f, 0x0f, 0xda, 0xf4,
-	0x83, 0x95, 0x41, 0x30, 0x5a, 0xb7, 0x1f, 0x84, 0xef, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x68,
-	0x65, 0x4c, 0x00, 0x95, 0x0a, 0x00, 0x00,
+	// 1563 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4d, 0x73, 0x1b, 0x49,
+	0x19, 0xf6, 0x48, 0xb6, 0x2c, 0xbd, 0xfe, 0x92, 0xdb, 0x8a, 0xad, 0x78, 0x63, 0xd9, 0xa5, 0xda,
+	0x05, 0xb3, 0x85, 0x6d, 0x76, 0x17, 0x6e, 0x70, 0xb0, 0x25, 0x65, 0xa3, 0xaa, 0x75, 0xac, 0x1a,
+	0x39, 0x7c, 0xec, 0x81, 0xa9, 0xd6, 0x4c, 0x5b, 0x6a, 0x32, 0x33, 0x3d, 0x4c, 0xb7, 0x14, 0x8b,
+	0x03, 0x5c, 0xa8, 0x0a, 0x37, 0xa0, 0x0a, 0xce, 0x14, 0x17, 0x0e, 0x9c, 0xf3, 0x23, 0x72, 0x4c,
+	0xe5, 0x44, 0x51, 0x45, 0x8a, 0x4a, 0x4e, 0xfc, 0x00, 0xee, 0x54, 0xf7, 0xf4, 0x7c, 0x48, 0xb2,
+	0x13, 0xa0, 0x94, 0x5c, 0x6c, 0x75, 0xbf, 0xdd, 0xcf, 0xfb, 0xf4, 0xf3, 0x7e, 0x74, 0x4b, 0xb0,
+	0x2f, 0x70, 0x8f, 0x9e, 0xd8, 0x38, 0x10, 0x98, 0xfa, 0xfc, 0x64, 0xf4, 0x59, 0xf2, 0xf9, 0x38,
+	0x08, 0x99, 0x60, 0xa8, 0x2c, 0x17, 0x1c, 0x27, 0x93, 0xa3, 0xcf, 0x76, 0x2b, 0x7d, 0xd6, 0x67,
+	0xca, 0x78, 0x22, 0x3f, 0x45, 0xeb, 0x76, 0xef, 0xda, 0x8c, 0x7b, 0x8c, 0x5b, 0x91, 0x21, 0x1a,
+	0x68, 0xd3, 0xde, 0x8c, 0x8f, 0x90, 0x04, 0x2c, 0x14, 0x91, 0xb9, 0xfe, 0xd7, 0x02, 0x14, 0x3a,
+	0x38, 0xc4, 0x1e, 0x47, 0xdf, 0x81, 0x4a, 0xbc, 0xcc, 0x12, 0x4c, 0x60, 0xd7, 0xb2, 0xd9, 0xd0,
+	0x17, 0x55, 0xe3, 0xc0, 0x38, 0x5c, 0x34, 0x51, 0x6c, 0xbb, 0x94, 0xa6, 0x86, 0xb4, 0xa0, 0xef,
+	0xc1, 0x8e, 0x47, 0x7d, 0xea, 0x0d, 0x3d, 0x2b, 0x60, 0x4f, 0x48, 0x68, 0x31, 0xdf, 0x0a, 0x48,
+	0x48, 0x99, 0x53, 0xcd, 0xa9, 0x4d, 0x15, 0x6d, 0xee, 0x48, 0xeb, 0x85, 0xdf, 0x51, 0x36, 0xb5,
+	0x0d, 0x5f, 0xdf, 0xb8, 0x2d, 0xaf, 0xb7, 0xe1, 0xeb, 0xd9, 0x6d, 0x14, 0x36, 0x13, 0x7e, 0x36,
+	0xf3, 0xb9, 0xc0, 0xbe, 0xa8, 0x2e, 0x1e, 0x18, 0x87, 0xa5, 0xb3, 0xef, 0x3f, 0x7f, 0xb5, 0xbf,
+	0xf0, 0xf7, 0x57, 0xfb, 0xdf, 0xe8, 0x53, 0x31, 0x18, 0xf6, 0x8e, 0x6d, 0xe6, 0x69, 0x15, 0xf4,
+	0xbf, 0x23, 0xee, 0x3c, 0x3e, 0x11, 0xe3, 0x80, 0xf0, 0xe3, 0x26, 0xb1, 0x5f, 0x3e, 0x3b, 0x02,
+	0x2d, 0x52, 0x93, 0xd8, 0x66, 0x39, 0x86, 0x6d, 0x68, 0x54, 0x24, 0x60, 0x67, 0x80, 0xdd, 0x11,
+	0xf5, 0xfb, 0x16, 0x09, 0xb1, 0x65, 0x33, 0x72, 0x75, 0x45, 0x6d, 0x4a, 0x7c, 0x51, 0x5d, 0x9a,
+	0x83, 0xc3, 0x3b, 0x1a, 0xbc, 0x15, 0xe2, 0x46, 0x0a, 0x8d, 0x7e, 0x6b, 0xc0, 0x27, 0x82, 0xd8,
+	0x03, 0x19, 0xc6, 0x7e, 0x48, 0x38, 0xcf, 0x3a, 0xb6, 0x6c, 0x1c, 0x3a, 0xd4, 0xc7, 0x2e, 0x15,
+	0xe3, 0x6a, 0x61, 0x0e, 0x24, 0xea, 0xd2, 0x55, 0x47, 0x7b, 0xca, 0xd0, 0x68, 0xa4, 0x7e, 0xd0,
+	0xb7, 0x01, 0xd9, 0xc3, 0x30, 0x94, 0xee, 0x39, 0x76, 0x89, 0xe5, 0x92, 0x11, 0x71, 0xab, 0xcb,
+	0x2a, 0x48, 0x65, 0x6d, 0xe9, 0x62, 0x97, 0x7c, 0x25, 0xe7, 0xd1, 0x11, 0x20, 0x3c, 0x14, 0x03,
+	0x16, 0xd2, 0x5f, 0x10, 0xc7, 0xf2, 0x88, 0xd7, 0x23, 0x21, 0xaf, 0x16, 0x0f, 0xf2, 0x87, 0x25,
+	0x73, 0x33, 0xb5, 0x9c, 0x47, 0x06, 0xf4, 0x35, 0x6c, 0xa5, 0xa0, 0x16, 0xb7, 0x07, 0xc4, 0x19,
+	0xba, 0xa4, 0x5a, 0x3a, 0xc8, 0x1f, 0xae, 0x7c, 0xfe, 0xf1, 0xf1, 0x74, 0xea, 0x1f, 0x27, 0x8e,
+	0xce, 0xa9, 0x4b, 0xb8, 0x60, 0x3e, 0x39, 0x5b, 0x94, 0x0a, 0x98, 0x9b, 0x3c, 0xb6, 0x74, 0x35,
+	0x08, 0xba, 0x84, 0x4a, 0x36, 0x80, 0x09, 0x38, 0x28, 0xf0, 0x7b, 0xb3, 0xe0, 0x0f, 0x92, 0x88,
+	0x68, 0x50, 0x94, 0xc6, 0x28, 0x46, 0xad, 0x73, 0x40, 0xb3, 0x24, 0xd0, 0x1e, 0x40, 0x46, 0x9c,
+	0xa8, 0x5a, 0x4a, 0x09, 0x25, 0xb4, 0x0f, 0x2b, 0x0e, 0x1d, 0x51, 0x4e, 0x99, 0x6f, 0xd1, 0xa8,
+	0x30, 0x4a, 0x26, 0xc4, 0x53, 0x6d, 0x47, 0xed, 0x67, 0xae, 0xa3, 0xab, 0x2d, 0xaf, 0xf7, 0x33,
+	0xd7, 0x51, 0x45, 0x56, 0x7f, 0x6a, 0x00, 0xa4, 0xec, 0xd0, 0x5d, 0x28, 0x92, 0x80, 0xd9, 0x03,
+	0x89, 0x15, 0xf9, 0x5a, 0x56, 0xe3, 0xb6, 0x83, 0x7e, 0x0a, 0x2b, 0xd9, 0x4c, 0xcd, 0xcd, 0x21,
+	0x49, 0xb2, 0x80, 0xf5, 0x5f, 0x42, 0x75, 0xf6, 0xf8, 0x5d, 0x81, 0xc5, 0x90, 0xa3, 0x07, 0x50,
+	0xf2, 0xe2, 0x29, 0xc5, 0xeb, 0x7f, 0x0b, 0x61, 0xba, 0x19, 0x55, 0x61, 0x39, 0x24, 0x58, 0x4a,
+	0xae, 0x4e, 0x50, 0x34, 0xe3, 0x61, 0xbd, 0x07, 0xe5, 0x54, 0x08, 0xed, 0xf7, 0xbb, 0x90, 0x27,
+	0x21, 0xd6, 0x1e, 0xff, 0x9b, 0xb8, 0xca, 0xe5, 0xd2, 0x07, 0x0e, 0x02, 0x97, 0xa6, 0x3e, 0xf4,
+	0xb0, 0xfe, 0xc7, 0x1c, 0x14, 0x9b, 0x3a, 0x36, 0x68, 0x1d, 0x72, 0x5a, 0xe5, 0x92, 0x99, 0xa3,
+	0x0e, 0xaa, 0xc0, 0x52, 0x14, 0xe4, 0xa8, 0xbb, 0x45, 0x03, 0xf4, 0x09, 0xac, 0x53, 0x9f, 0x0a,
+	0x8a, 0x5d, 0x8b, 0x0f, 0x83, 0xc0, 0x1d, 0xeb, 0x18, 0xae, 0xe9, 0xd9, 0xae, 0x9a, 0x9c, 0x0a,
+	0xf3, 0xe2, 0x54, 0x98, 0x65, 0x9a, 0x64, 0x9b, 0xee, 0x92, 0xb2, 0x83, 0x48, 0x9b, 0xed, 0x0f,
+	0xe0, 0x23, 0x9b, 0x79, 0xc1, 0x50, 0xc8, 0xa4, 0x8e, 0xfa, 0xa6, 0xab, 0xfe, 0xf6, 0xd8, 0xd0,
+	0x77, 0x54, 0x4b, 0x58, 0x34, 0xab, 0xc9, 0x12, 0xd5, 0x3b, 0xbf, 0x92, 0x7f, 0xce, 0xa4, 0xfd,
+	0xa6, 0xed, 0xc3, 0x20, 0x48, 0xb6, 0x2f, 0xdf, 0xb4, 0xfd, 0x51, 0x10, 0xe8, 0xed, 0xf5, 0x11,
+	0x2c, 0x3e, 0x64, 0x0e, 0x99, 0x91, 0xe4, 0x9d, 0xd9, 0x5d, 0x81, 0x25, 0xf6, 0xc4, 0x27, 0xa1,
+	0x12, 0xa5, 0x64, 0x46, 0x03, 0xf4, 0x4d, 0xd8, 0x98, 0x62, 0xa3, 0x15, 0x59, 0x9f, 0x64, 0x50,
+	0xff, 0xb7, 0x01, 0x48, 0x3a, 0x8e, 0x63, 0xd2, 0x18, 0x60, 0xbf, 0x4f, 0xd0, 0x0e, 0x2c, 0xfb,
+	0xcc, 0x21, 0x56, 0xc2, 0xa5, 0x20, 0x87, 0x6d, 0x07, 0xed, 0x42, 0x91, 0x93, 0x9f, 0x0f, 0x89,
+	0x6f, 0x13, 0x1d, 0xa5, 0x64, 0x8c, 0x0e, 0xa1, 0x7c, 0x15, 0x32, 0xcf, 0xca, 0x12, 0x8e, 0x58,
+	0xad, 0xcb, 0xf9, 0x66, 0x4a, 0xfa, 0x63, 0x58, 0x17, 0x6c, 0x62, 0x9d, 0xba, 0x67, 0xcc, 0x55,
+	0xc1, 0x32, 0xab, 0x6e, 0x38, 0xc4, 0xd2, 0x4d, 0x87, 0x98, 0xa8, 0xd9, 0xc2, 0x64, 0xcd, 0x6e,
+	0x43, 0x61, 0x40, 0x68, 0x7f, 0x20, 0x54, 0x04, 0xf2, 0xa6, 0x1e, 0xd5, 0xff, 0x55, 0x80, 0xd2,
+	0x19, 0xe6, 0xaa, 0xbc, 0xc8, 0xdb, 0x8a, 0xfe, 0x00, 0x56, 0x29, 0xb7, 0x22, 0x2b, 0xf1, 0xe3,
+	0x7c, 0x06, 0xca, 0x5b, 0x72, 0xaa, 0xe5, 0x3b, 0xb2, 0x89, 0xfb, 0xe4, 0x5a, 0x58, 0x4a, 0xb0,
+	0x44, 0x9c, 0x28, 0x47, 0xcb, 0xd2, 0x22, 0xf5, 0xed, 0xc6, 0x22, 0x09, 0xd8, 0xe9, 0xbb, 0xac,
+	0x27, 0x13, 0xd1, 0xc5, 0xd4, 0x23, 0x8e, 0x45, 0x3c, 0xca, 0xe5, 0x89, 0xe7, 0x72, 0xd7, 0xde,
+	0x89, 0xc0, 0x1b, 0x11, 0x76, 0x4b, 0x43, 0xa3, 0x06, 0xac, 0x45, 0xcf, 0x12, 0xcb, 0xa1, 0x7d,
+	0xc2, 0xa3, 0xfc, 0x5f, 0xf9, 0xbc, 0x36, 0x5b, 0xd0, 0xa6, 0x5a, 0xd6, 0x54, 0xab, 0xcc, 0xd5,
+	0x30, 0x33, 0x42, 0x35, 0x58, 0xa1, 0xdc, 0x92, 0x37, 0xb8, 0x63, 0xf5, 0xa2, 0x4b, 0xb2, 0x68,
+	0x96, 0x28, 0xef, 0xca, 0x99, 0xb3, 0x31, 0xfa, 0x02, 0xb6, 0x5d, 0xcc, 0x85, 0x95, 0xbd, 0x19,
+	0x94, 0x70, 0x3a, 0xfb, 0xb7, 0xa4, 0x35, 0x6d, 0x18, 0x4a, 0x40, 0xa9, 0x87, 0xcd, 0x3c, 0x6f,
+	0xe8, 0x53, 0x31, 0xb6, 0x02, 0xc6, 0xdc, 0x54, 0x8f, 0xe2, 0x3c, 0xf4, 0x48, 0xc0, 0x3b, 0x8c,
+	0xb9, 0x89, 0x1e, 0x03, 0xd8, 0xbc, 0x22, 0x64, 0xca, 0x5f, 0x69, 0x0e, 0xfe, 0x36, 0xae, 0x08,
+	0x99, 0xf0, 0xc4, 0x61, 0x27, 0x4a, 0x9e, 0x59, 0x7f, 0x30, 0x07, 0x7f, 0x15, 0x05, 0x7e, 0x7f,
+	0xca, 0xe9, 0x97, 0x70, 0x10, 0x27, 0x19, 0xf3, 0x3c, 0x2a, 0x04, 0x71, 0xac, 0xa4, 0x66, 0x74,
+	0x29, 0xad, 0xa8, 0x98, 0xec, 0xe9, 0x7c, 0x89, 0x97, 0x35, 0x26, 0x2b, 0xab, 0x01, 0xb5, 0xa9,
+	0x6c, 0x9d, 0x86, 0x59, 0x55, 0x30, 0x1f, 0x4d, 0xa4, 0xdd, 0x24, 0x48, 0xfd, 0xd7, 0x06, 0xac,
+	0x45, 0xd5, 0x12, 0xf3, 0x7b, 0x4b, 0xbd, 0xfd, 0x18, 0x8a, 0x89, 0x40, 0xf3, 0xb8, 0x61, 0x13,
+	0xb4, 0xfa, 0x6f, 0x0c, 0xd8, 0x92, 0xa5, 0x38, 0x5d, 0x1b, 0xb7, 0xf6, 0xba, 0xf7, 0x47, 0x05,
+	0xc3, 0x8e, 0x62, 0x81, 0x7b, 0x2e, 0x99, 0x52, 0x7c, 0x1b, 0x0a, 0xd8, 0xcb, 0x7c, 0x2f, 0xd0,
+	0x23, 0x74, 0x1c, 0xf7, 0xf9, 0x88, 0x49, 0xf5, 0xe5, 0xb3, 0xa3, 0x8a, 0xc6, 0x3e, 0x75, 0x1c,
+	0xf9, 0xc6, 0xec, 0x8a, 0x90, 0xfa, 0x7d, 0x7d, 0x03, 0xd4, 0xff, 0x62, 0xc0, 0xb6, 0x3a, 0xed,
+	0xd0, 0x1b, 0xba, 0x58, 0xd0, 0x11, 0x79, 0xf7, 0x81, 0xb3, 0x61, 0xc9, 0xdd, 0x1e, 0x96, 0xfc,
+	0x5c, 0xb5, 0x78, 0x6a, 0x40, 0xe5, 0xcb, 0x38, 0x09, 0x6f, 0xeb, 0xea, 0x53, 0x49, 0x72, 0x99,
+	0x88, 0x34, 0x8f, 0xb8, 0x68, 0xac, 0xfa, 0x9f, 0x74, 0x82, 0xf0, 0x29, 0x22, 0xff, 0x8f, 0x5e,
+	0x29, 0xc3, 0xfc, 0x1c, 0x19, 0xfe, 0x0a, 0x56, 0x23, 0xa9, 0x3a, 0x2e, 0x71, 0xfa, 0xe4, 0xc3,
+	0x4b, 0xf4, 0x07, 0x03, 0x56, 0x2e, 0x64, 0x7e, 0x69, 0x02, 0xc9, 0xeb, 0xc3, 0xc8, 0xbe, 0x3e,
+	0x3e, 0xb8, 0x2e, 0xbf, 0xcf, 0xc1, 0x9d, 0x0c, 0xad, 0xc6, 0x80, 0xd8, 0x8f, 0x03, 0x46, 0x7d,
+	0x71, 0x0b, 0xc1, 0xf7, 0x22, 0x8e, 0x7c, 0xa8, 0xaa, 0xc7, 0x1e, 0x0e, 0xc7, 0xfa, 0xde, 0xd3,
+	0x0f, 0xd5, 0x78, 0x36, 0xba, 0xf1, 0x08, 0x6c, 0x24, 0xcb, 0x34, 0x8b, 0x79, 0xdc, 0xfc, 0x89,
+	0xef, 0xd3, 0x48, 0x93, 0x3f, 0x1b, 0x5a, 0x93, 0x26, 0x71, 0x49, 0x1f, 0x0b, 0xca, 0xfc, 0xb7,
+	0x06, 0xed, 0x1e, 0x94, 0x46, 0xd8, 0xa5, 0x0e, 0x16, 0x4c, 0x37, 0x19, 0x33, 0x9d, 0x78, 0x4f,
+	0x71, 0xfb, 0x87, 0x01, 0x6b, 0xea, 0x75, 0xe4, 0x62, 0x3e, 0x68, 0xfb, 0x57, 0xec, 0xf6, 0x5a,
+	0xdb, 0x86, 0xc2, 0xcf, 0x30, 0x75, 0x93, 0x6f, 0x14, 0x7a, 0x84, 0x3e, 0x85, 0xcd, 0x80, 0xf8,
+	0xd8, 0x15, 0x63, 0xf9, 0x3c, 0x9b, 0xd0, 0x7d, 0x43, 0x1b, 0x5a, 0xbe, 0x13, 0x29, 0xcf, 0xa0,
+	0x62, 0x33, 0xff, 0x8a, 0x72, 0x1b, 0x8b, 0x79, 0x3f, 0xbc, 0xb6, 0x32, 0xc8, 0x71, 0xa7, 0xfd,
+	0xd4, 0x87, 0x8d, 0x73, 0xca, 0x7b, 0x64, 0x80, 0x47, 0x94, 0x85, 0x97, 0xe3, 0x80, 0xa0, 0x03,
+	0xb8, 0x77, 0xde, 0xee, 0x9e, 0xb5, 0x1e, 0x9c, 0xfe, 0xb0, 0x7d, 0x61, 0x5a, 0x97, 0x3f, 0xe9,
+	0xb4, 0xac, 0x47, 0x0f, 0xbb, 0x9d, 0x56, 0xa3, 0x7d, 0xbf, 0xdd, 0x6a, 0x96, 0x17, 0xd0, 0x1e,
+	0xdc, 0x9d, 0x59, 0xd1, 0xbc, 0xf8, 0xd1, 0xc3, 0xcb, 0xf6, 0x79, 0xab, 0x6c, 0xa0, 0x5d, 0xd8,
+	0x9e, 0x31, 0xdf, 0x37, 0x4f, 0x1f, 0x35, 0xcb, 0xb9, 0xb3, 0xc6, 0xf3, 0xd7, 0x35, 0xe3, 0xc5,
+	0xeb, 0x9a, 0xf1, 0xcf, 0xd7, 0x35, 0xe3, 0x77, 0x6f, 0x6a, 0x0b, 0x2f, 0xde, 0xd4, 0x16, 0xfe,
+	0xf6, 0xa6, 0xb6, 0xf0, 0xf5, 0xb7, 0x32, 0x87, 0x92, 0x6f, 0x3e, 0x17, 0xf7, 0xb8, 0xfa, 0x70,
+	0x72, 0x9d, 0xfe, 0x78, 0xa5, 0xce, 0xd6, 0x59, 0xe8, 0x18, 0xbd, 0x82, 0xfa, 0xf5, 0xea, 0x8b,
+	0xff, 0x0c, 0x00, 0x09, 0x62, 0x02, 0xd2, 0x42, 0x13, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -1070,6 +1704,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.HalvingEraSchedule) > 0 {
+		for iNdEx := len(m.HalvingEraSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HalvingEraSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCaptains(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.SaleLevelSchedule) > 0 {
+		for iNdEx := len(m.SaleLevelSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SaleLevelSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCaptains(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.AuthorizedMembers) > 0 {
 		for iNdEx := len(m.AuthorizedMembers) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AuthorizedMembers[iNdEx])
@@ -1132,7 +1794,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Division) Marshal() (dAtA []byte, err error) {
+func (m *SaleLevelMilestone) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1142,57 +1804,37 @@ func (m *Division) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Division) MarshalTo(dAtA []byte) (int, error) {
+func (m *SaleLevelMilestone) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Division) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SaleLevelMilestone) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ComputingPowerUpperBound != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.ComputingPowerUpperBound))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.ComputingPowerLowerBound != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.ComputingPowerLowerBound))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.TotalCount != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.TotalCount))
-		i--
-		dAtA[i] = 0x28
-	}
 	if m.SoldCount != 0 {
 		i = encodeVarintCaptains(dAtA, i, uint64(m.SoldCount))
 		i--
-		dAtA[i] = 0x20
-	}
-	if m.InitialSupply != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.InitialSupply))
-		i--
 		dAtA[i] = 0x18
 	}
-	if m.Level != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.Level))
+	if len(m.DivisionId) > 0 {
+		i -= len(m.DivisionId)
+		copy(dAtA[i:], m.DivisionId)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.DivisionId)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Id)))
+	if m.SaleLevel != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.SaleLevel))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Node) Marshal() (dAtA []byte, err error) {
+func (m *HalvingEra) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1202,46 +1844,35 @@ func (m *Node) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Node) MarshalTo(dAtA []byte) (int, error) {
+func (m *HalvingEra) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HalvingEra) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ComputingPower != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.ComputingPower))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.DivisionId) > 0 {
-		i -= len(m.DivisionId)
-		copy(dAtA[i:], m.DivisionId)
-		i = encodeVarintCaptains(dAtA, i, uint64(len(m.DivisionId)))
-		i--
-		dAtA[i] = 0x12
+	{
+		size := m.Coefficient.Size()
+		i -= size
+		if _, err := m.Coefficient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Id)))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochId != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BaseState) Marshal() (dAtA []byte, err error) {
+func (m *SaleLevelMilestoneStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1251,72 +1882,40 @@ func (m *BaseState) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BaseState) MarshalTo(dAtA []byte) (int, error) {
+func (m *SaleLevelMilestoneStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BaseState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *SaleLevelMilestoneStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsStandBy {
+	if m.Reached {
 		i--
-		if m.IsStandBy {
+		if m.Reached {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x30
-	}
-	if m.ReportDigest != nil {
-		{
-			size, err := m.ReportDigest.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCaptains(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x10
 	}
 	{
-		size := m.GlobalClaimedEmission.Size()
-		i -= size
-		if _, err := m.GlobalClaimedEmission.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.Milestone.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x22
-	if m.NextNodeSequence != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.NextNodeSequence))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.IsEpochEnd {
-		i--
-		if m.IsEpochEnd {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EpochId != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *EpochEmission) Marshal() (dAtA []byte, err error) {
+func (m *HalvingEraStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1326,35 +1925,40 @@ func (m *EpochEmission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EpochEmission) MarshalTo(dAtA []byte) (int, error) {
+func (m *HalvingEraStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EpochEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HalvingEraStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Emission.Size()
-		i -= size
-		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
+	if m.Applied {
+		i--
+		if m.Applied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Era.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
+		i -= size
 		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if m.EpochId != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
-		i--
-		dAtA[i] = 0x8
-	}
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeClaimedEmission) Marshal() (dAtA []byte, err error) {
+func (m *Division) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1364,37 +1968,57 @@ func (m *NodeClaimedEmission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeClaimedEmission) MarshalTo(dAtA []byte) (int, error) {
+func (m *Division) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeClaimedEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Division) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Emission.Size()
-		i -= size
-		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	if m.ComputingPowerUpperBound != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.ComputingPowerUpperBound))
+		i--
+		dAtA[i] = 0x38
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.NodeId) > 0 {
-		i -= len(m.NodeId)
-		copy(dAtA[i:], m.NodeId)
-		i = encodeVarintCaptains(dAtA, i, uint64(len(m.NodeId)))
+	if m.ComputingPowerLowerBound != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.ComputingPowerLowerBound))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.TotalCount != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.TotalCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.SoldCount != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.SoldCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.InitialSupply != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.InitialSupply))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Level != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.Level))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *ClaimableComputingPower) Marshal() (dAtA []byte, err error) {
+func (m *Node) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1404,32 +2028,46 @@ func (m *ClaimableComputingPower) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ClaimableComputingPower) MarshalTo(dAtA []byte) (int, error) {
+func (m *Node) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableComputingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Node) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.ComputingPower != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.ComputingPower))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
 		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Owner)))
 		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DivisionId) > 0 {
+		i -= len(m.DivisionId)
+		copy(dAtA[i:], m.DivisionId)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.DivisionId)))
+		i--
 		dAtA[i] = 0x12
 	}
-	if m.Amount != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.Amount))
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Id)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *NodeCumulativeEmission) Marshal() (dAtA []byte, err error) {
+func (m *NodeDivisionChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1439,29 +2077,48 @@ func (m *NodeCumulativeEmission) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodeCumulativeEmission) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeDivisionChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodeCumulativeEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeDivisionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Emission.Size()
-		i -= size
-		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	if m.Height != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
 	}
-	i--
-	dAtA[i] = 0x1a
 	if m.EpochId != 0 {
 		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
 		i--
+		dAtA[i] = 0x30
+	}
+	if m.ComputingPower != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.ComputingPower))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ToDivisionId) > 0 {
+		i -= len(m.ToDivisionId)
+		copy(dAtA[i:], m.ToDivisionId)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.ToDivisionId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromDivisionId) > 0 {
+		i -= len(m.FromDivisionId)
+		copy(dAtA[i:], m.FromDivisionId)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.FromDivisionId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.Sequence))
+		i--
 		dAtA[i] = 0x10
 	}
 	if len(m.NodeId) > 0 {
@@ -1474,7 +2131,7 @@ func (m *NodeCumulativeEmission) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *GlobalComputingPower) Marshal() (dAtA []byte, err error) {
+func (m *BaseState) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1484,26 +2141,108 @@ func (m *GlobalComputingPower) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GlobalComputingPower) MarshalTo(dAtA []byte) (int, error) {
+func (m *BaseState) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GlobalComputingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *BaseState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.GlobalClaimedComputingPower != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.GlobalClaimedComputingPower))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.GlobalCommittedComputingPower != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.GlobalCommittedComputingPower))
+		i--
+		dAtA[i] = 0x58
+	}
 	{
-		size := m.Amount.Size()
+		size := m.EpochFeePoolEmission.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.EpochFeePoolEmission.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x52
+	{
+		size := m.FeePoolEmission.Size()
+		i -= size
+		if _, err := m.FeePoolEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.CommunityPoolEmission.Size()
+		i -= size
+		if _, err := m.CommunityPoolEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.LastHalvingEraEpoch != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.LastHalvingEraEpoch))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.IsStandBy {
+		i--
+		if m.IsStandBy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ReportDigest != nil {
+		{
+			size, err := m.ReportDigest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCaptains(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.GlobalClaimedEmission.Size()
+		i -= size
+		if _, err := m.GlobalClaimedEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.NextNodeSequence != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.NextNodeSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsEpochEnd {
+		i--
+		if m.IsEpochEnd {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.EpochId != 0 {
 		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
 		i--
@@ -1512,7 +2251,7 @@ func (m *GlobalComputingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *NodesComputingPower) Marshal() (dAtA []byte, err error) {
+func (m *EpochEmission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1522,42 +2261,35 @@ func (m *NodesComputingPower) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NodesComputingPower) MarshalTo(dAtA []byte) (int, error) {
+func (m *EpochEmission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NodesComputingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EpochEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Amount.Size()
+		size := m.Emission.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x1a
+	dAtA[i] = 0x12
 	if m.EpochId != 0 {
 		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
 		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.NodeId) > 0 {
-		i -= len(m.NodeId)
-		copy(dAtA[i:], m.NodeId)
-		i = encodeVarintCaptains(dAtA, i, uint64(len(m.NodeId)))
-		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *GlobalPledge) Marshal() (dAtA []byte, err error) {
+func (m *NodeClaimedEmission) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1567,35 +2299,37 @@ func (m *GlobalPledge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *GlobalPledge) MarshalTo(dAtA []byte) (int, error) {
+func (m *NodeClaimedEmission) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GlobalPledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *NodeClaimedEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Amount.Size()
+		size := m.Emission.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if m.EpochId != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.NodeId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OwnerPledge) Marshal() (dAtA []byte, err error) {
+func (m *ClaimableComputingPower) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1605,263 +2339,475 @@ func (m *OwnerPledge) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *OwnerPledge) MarshalTo(dAtA []byte) (int, error) {
+func (m *ClaimableComputingPower) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *OwnerPledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ClaimableComputingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintCaptains(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.EpochId != 0 {
-		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
-		i--
-		dAtA[i] = 0x10
-	}
 	if len(m.Owner) > 0 {
 		i -= len(m.Owner)
 		copy(dAtA[i:], m.Owner)
 		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.Amount != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCaptains(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCaptains(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *NodeCumulativeEmission) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CaptainsTotalCount != 0 {
-		n += 1 + sovCaptains(uint64(m.CaptainsTotalCount))
-	}
-	if m.MinimumPowerOnPeriod != 0 {
-		n += 1 + sovCaptains(uint64(m.MinimumPowerOnPeriod))
-	}
-	if m.MaximumPowerOnPeriod != 0 {
-		n += 1 + sovCaptains(uint64(m.MaximumPowerOnPeriod))
-	}
-	l = m.CaptainsConstant.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	l = m.HalvingEraCoefficient.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	l = m.TechProgressCoefficientCardinality.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	if m.CurrentSaleLevel != 0 {
-		n += 1 + sovCaptains(uint64(m.CurrentSaleLevel))
-	}
-	if len(m.AuthorizedMembers) > 0 {
-		for _, s := range m.AuthorizedMembers {
-			l = len(s)
-			n += 1 + l + sovCaptains(uint64(l))
-		}
-	}
-	return n
+
+func (m *NodeCumulativeEmission) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Division) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *NodeCumulativeEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovCaptains(uint64(l))
-	}
-	if m.Level != 0 {
-		n += 1 + sovCaptains(uint64(m.Level))
-	}
-	if m.InitialSupply != 0 {
-		n += 1 + sovCaptains(uint64(m.InitialSupply))
-	}
-	if m.SoldCount != 0 {
-		n += 1 + sovCaptains(uint64(m.SoldCount))
-	}
-	if m.TotalCount != 0 {
-		n += 1 + sovCaptains(uint64(m.TotalCount))
+	{
+		size := m.Emission.Size()
+		i -= size
+		if _, err := m.Emission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
-	if m.ComputingPowerLowerBound != 0 {
-		n += 1 + sovCaptains(uint64(m.ComputingPowerLowerBound))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochId != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ComputingPowerUpperBound != 0 {
-		n += 1 + sovCaptains(uint64(m.ComputingPowerUpperBound))
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Node) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *GlobalComputingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *GlobalComputingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GlobalComputingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovCaptains(uint64(l))
-	}
-	l = len(m.DivisionId)
-	if l > 0 {
-		n += 1 + l + sovCaptains(uint64(l))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovCaptains(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
-	if m.ComputingPower != 0 {
-		n += 1 + sovCaptains(uint64(m.ComputingPower))
+	i--
+	dAtA[i] = 0x12
+	if m.EpochId != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *BaseState) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *NodesComputingPower) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *NodesComputingPower) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodesComputingPower) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EpochId != 0 {
-		n += 1 + sovCaptains(uint64(m.EpochId))
-	}
-	if m.IsEpochEnd {
-		n += 2
-	}
-	if m.NextNodeSequence != 0 {
-		n += 1 + sovCaptains(uint64(m.NextNodeSequence))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
-	l = m.GlobalClaimedEmission.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	if m.ReportDigest != nil {
-		l = m.ReportDigest.Size()
-		n += 1 + l + sovCaptains(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.EpochId != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.IsStandBy {
-		n += 2
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EpochEmission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EpochId != 0 {
-		n += 1 + sovCaptains(uint64(m.EpochId))
+func (m *GlobalPledge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Emission.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *NodeClaimedEmission) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovCaptains(uint64(l))
-	}
-	l = m.Emission.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	return n
+func (m *GlobalPledge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ClaimableComputingPower) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *GlobalPledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovCaptains(uint64(m.Amount))
-	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovCaptains(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if m.EpochId != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeCumulativeEmission) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OwnerPledge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerPledge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerPledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovCaptains(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x1a
 	if m.EpochId != 0 {
-		n += 1 + sovCaptains(uint64(m.EpochId))
+		i = encodeVarintCaptains(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Emission.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	return n
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *GlobalComputingPower) Size() (n int) {
+func (m *OwnerPledgeCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerPledgeCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerPledgeCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BoundaryAmount.Size()
+		i -= size
+		if _, err := m.BoundaryAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.BoundaryEpoch != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.BoundaryEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerDelegationPledge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OwnerDelegationPledge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OwnerDelegationPledge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Validator) > 0 {
+		i -= len(m.Validator)
+		copy(dAtA[i:], m.Validator)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Validator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeSlashInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeSlashInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeSlashInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConfiscatedEmission.Size()
+		i -= size
+		if _, err := m.ConfiscatedEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintCaptains(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PenaltyEndEpoch != 0 {
+		i = encodeVarintCaptains(dAtA, i, uint64(m.PenaltyEndEpoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Jailed {
+		i--
+		if m.Jailed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintCaptains(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCaptains(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCaptains(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.EpochId != 0 {
-		n += 1 + sovCaptains(uint64(m.EpochId))
+	if m.CaptainsTotalCount != 0 {
+		n += 1 + sovCaptains(uint64(m.CaptainsTotalCount))
 	}
-	l = m.Amount.Size()
+	if m.MinimumPowerOnPeriod != 0 {
+		n += 1 + sovCaptains(uint64(m.MinimumPowerOnPeriod))
+	}
+	if m.MaximumPowerOnPeriod != 0 {
+		n += 1 + sovCaptains(uint64(m.MaximumPowerOnPeriod))
+	}
+	l = m.CaptainsConstant.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	l = m.HalvingEraCoefficient.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	l = m.TechProgressCoefficientCardinality.Size()
 	n += 1 + l + sovCaptains(uint64(l))
+	if m.CurrentSaleLevel != 0 {
+		n += 1 + sovCaptains(uint64(m.CurrentSaleLevel))
+	}
+	if len(m.AuthorizedMembers) > 0 {
+		for _, s := range m.AuthorizedMembers {
+			l = len(s)
+			n += 1 + l + sovCaptains(uint64(l))
+		}
+	}
+	if len(m.SaleLevelSchedule) > 0 {
+		for _, e := range m.SaleLevelSchedule {
+			l = e.Size()
+			n += 1 + l + sovCaptains(uint64(l))
+		}
+	}
+	if len(m.HalvingEraSchedule) > 0 {
+		for _, e := range m.HalvingEraSchedule {
+			l = e.Size()
+			n += 1 + l + sovCaptains(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *NodesComputingPower) Size() (n int) {
+func (m *SaleLevelMilestone) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.NodeId)
+	if m.SaleLevel != 0 {
+		n += 1 + sovCaptains(uint64(m.SaleLevel))
+	}
+	l = len(m.DivisionId)
 	if l > 0 {
 		n += 1 + l + sovCaptains(uint64(l))
 	}
-	if m.EpochId != 0 {
-		n += 1 + sovCaptains(uint64(m.EpochId))
+	if m.SoldCount != 0 {
+		n += 1 + sovCaptains(uint64(m.SoldCount))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovCaptains(uint64(l))
 	return n
 }
 
-func (m *GlobalPledge) Size() (n int) {
+func (m *HalvingEra) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1870,36 +2816,1703 @@ func (m *GlobalPledge) Size() (n int) {
 	if m.EpochId != 0 {
 		n += 1 + sovCaptains(uint64(m.EpochId))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	return n
-}
+	l = m.Coefficient.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *SaleLevelMilestoneStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Milestone.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	if m.Reached {
+		n += 2
+	}
+	return n
+}
+
+func (m *HalvingEraStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Era.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	if m.Applied {
+		n += 2
+	}
+	return n
+}
+
+func (m *Division) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.Level != 0 {
+		n += 1 + sovCaptains(uint64(m.Level))
+	}
+	if m.InitialSupply != 0 {
+		n += 1 + sovCaptains(uint64(m.InitialSupply))
+	}
+	if m.SoldCount != 0 {
+		n += 1 + sovCaptains(uint64(m.SoldCount))
+	}
+	if m.TotalCount != 0 {
+		n += 1 + sovCaptains(uint64(m.TotalCount))
+	}
+	if m.ComputingPowerLowerBound != 0 {
+		n += 1 + sovCaptains(uint64(m.ComputingPowerLowerBound))
+	}
+	if m.ComputingPowerUpperBound != 0 {
+		n += 1 + sovCaptains(uint64(m.ComputingPowerUpperBound))
+	}
+	return n
+}
+
+func (m *Node) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	l = len(m.DivisionId)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.ComputingPower != 0 {
+		n += 1 + sovCaptains(uint64(m.ComputingPower))
+	}
+	return n
+}
+
+func (m *NodeDivisionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovCaptains(uint64(m.Sequence))
+	}
+	l = len(m.FromDivisionId)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	l = len(m.ToDivisionId)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.ComputingPower != 0 {
+		n += 1 + sovCaptains(uint64(m.ComputingPower))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovCaptains(uint64(m.EpochId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovCaptains(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *BaseState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovCaptains(uint64(m.EpochId))
+	}
+	if m.IsEpochEnd {
+		n += 2
+	}
+	if m.NextNodeSequence != 0 {
+		n += 1 + sovCaptains(uint64(m.NextNodeSequence))
+	}
+	l = m.GlobalClaimedEmission.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	if m.ReportDigest != nil {
+		l = m.ReportDigest.Size()
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.IsStandBy {
+		n += 2
+	}
+	if m.LastHalvingEraEpoch != 0 {
+		n += 1 + sovCaptains(uint64(m.LastHalvingEraEpoch))
+	}
+	l = m.CommunityPoolEmission.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	l = m.FeePoolEmission.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	l = m.EpochFeePoolEmission.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	if m.GlobalCommittedComputingPower != 0 {
+		n += 1 + sovCaptains(uint64(m.GlobalCommittedComputingPower))
+	}
+	if m.GlobalClaimedComputingPower != 0 {
+		n += 1 + sovCaptains(uint64(m.GlobalClaimedComputingPower))
+	}
+	return n
+}
+
+func (m *EpochEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovCaptains(uint64(m.EpochId))
+	}
+	l = m.Emission.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *NodeClaimedEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	l = m.Emission.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *ClaimableComputingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovCaptains(uint64(m.Amount))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	return n
+}
+
+func (m *NodeCumulativeEmission) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovCaptains(uint64(m.EpochId))
+	}
+	l = m.Emission.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *GlobalComputingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovCaptains(uint64(m.EpochId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *NodesComputingPower) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovCaptains(uint64(m.EpochId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *GlobalPledge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovCaptains(uint64(m.EpochId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *OwnerPledge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.EpochId != 0 {
+		n += 1 + sovCaptains(uint64(m.EpochId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *OwnerPledgeCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	if m.BoundaryEpoch != 0 {
+		n += 1 + sovCaptains(uint64(m.BoundaryEpoch))
+	}
+	l = m.BoundaryAmount.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *OwnerDelegationPledge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	l = len(m.Validator)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func (m *NodeSlashInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovCaptains(uint64(l))
+	}
+	if m.Jailed {
+		n += 2
+	}
+	if m.PenaltyEndEpoch != 0 {
+		n += 1 + sovCaptains(uint64(m.PenaltyEndEpoch))
+	}
+	l = m.ConfiscatedEmission.Size()
+	n += 1 + l + sovCaptains(uint64(l))
+	return n
+}
+
+func sovCaptains(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCaptains(x uint64) (n int) {
+	return sovCaptains(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaptainsTotalCount", wireType)
+			}
+			m.CaptainsTotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CaptainsTotalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumPowerOnPeriod", wireType)
+			}
+			m.MinimumPowerOnPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinimumPowerOnPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPowerOnPeriod", wireType)
+			}
+			m.MaximumPowerOnPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaximumPowerOnPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaptainsConstant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CaptainsConstant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingEraCoefficient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HalvingEraCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TechProgressCoefficientCardinality", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TechProgressCoefficientCardinality.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSaleLevel", wireType)
+			}
+			m.CurrentSaleLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentSaleLevel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedMembers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuthorizedMembers = append(m.AuthorizedMembers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleLevelSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SaleLevelSchedule = append(m.SaleLevelSchedule, SaleLevelMilestone{})
+			if err := m.SaleLevelSchedule[len(m.SaleLevelSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingEraSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HalvingEraSchedule = append(m.HalvingEraSchedule, HalvingEra{})
+			if err := m.HalvingEraSchedule[len(m.HalvingEraSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaleLevelMilestone) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaleLevelMilestone: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaleLevelMilestone: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleLevel", wireType)
+			}
+			m.SaleLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaleLevel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DivisionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DivisionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoldCount", wireType)
+			}
+			m.SoldCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SoldCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HalvingEra) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HalvingEra: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HalvingEra: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coefficient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SaleLevelMilestoneStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SaleLevelMilestoneStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SaleLevelMilestoneStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Milestone", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Milestone.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reached", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Reached = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HalvingEraStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HalvingEraStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HalvingEraStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Era", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Era.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Applied = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Division) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Division: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Division: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			m.Level = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Level |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
+			}
+			m.InitialSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InitialSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoldCount", wireType)
+			}
+			m.SoldCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SoldCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+			}
+			m.TotalCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPowerLowerBound", wireType)
+			}
+			m.ComputingPowerLowerBound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputingPowerLowerBound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPowerUpperBound", wireType)
+			}
+			m.ComputingPowerUpperBound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputingPowerUpperBound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Node) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DivisionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DivisionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPower", wireType)
+			}
+			m.ComputingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeDivisionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeDivisionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeDivisionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromDivisionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromDivisionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDivisionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDivisionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPower", wireType)
+			}
+			m.ComputingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ComputingPower |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *OwnerPledge) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovCaptains(uint64(l))
-	}
-	if m.EpochId != 0 {
-		n += 1 + sovCaptains(uint64(m.EpochId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovCaptains(uint64(l))
-	return n
-}
-
-func sovCaptains(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCaptains(x uint64) (n int) {
-	return sovCaptains(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BaseState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1922,17 +4535,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BaseState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BaseState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CaptainsTotalCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
 			}
-			m.CaptainsTotalCount = 0
+			m.EpochId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -1942,16 +4555,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CaptainsTotalCount |= uint64(b&0x7F) << shift
+				m.EpochId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinimumPowerOnPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEpochEnd", wireType)
 			}
-			m.MinimumPowerOnPeriod = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -1961,16 +4574,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MinimumPowerOnPeriod |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.IsEpochEnd = bool(v != 0)
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaximumPowerOnPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextNodeSequence", wireType)
 			}
-			m.MaximumPowerOnPeriod = 0
+			m.NextNodeSequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -1980,14 +4594,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaximumPowerOnPeriod |= uint64(b&0x7F) << shift
+				m.NextNodeSequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CaptainsConstant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalClaimedEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2015,15 +4629,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CaptainsConstant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GlobalClaimedEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HalvingEraCoefficient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportDigest", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2033,31 +4647,33 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCaptains
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCaptains
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HalvingEraCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ReportDigest == nil {
+				m.ReportDigest = &ReportDigest{}
+			}
+			if err := m.ReportDigest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TechProgressCoefficientCardinality", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStandBy", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2067,31 +4683,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCaptains
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCaptains
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TechProgressCoefficientCardinality.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.IsStandBy = bool(v != 0)
 		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSaleLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHalvingEraEpoch", wireType)
 			}
-			m.CurrentSaleLevel = 0
+			m.LastHalvingEraEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2101,14 +4703,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CurrentSaleLevel |= uint64(b&0x7F) << shift
+				m.LastHalvingEraEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuthorizedMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2136,61 +4738,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AuthorizedMembers = append(m.AuthorizedMembers, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCaptains(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CommunityPoolEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCaptains
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Division) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCaptains
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Division: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Division: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePoolEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2218,13 +4772,15 @@ func (m *Division) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if err := m.FeePoolEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochFeePoolEmission", wireType)
 			}
-			m.Level = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2234,35 +4790,31 @@ func (m *Division) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Level |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialSupply", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
 			}
-			m.InitialSupply = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCaptains
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.InitialSupply |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EpochFeePoolEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoldCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalCommittedComputingPower", wireType)
 			}
-			m.SoldCount = 0
+			m.GlobalCommittedComputingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2272,16 +4824,16 @@ func (m *Division) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SoldCount |= uint64(b&0x7F) << shift
+				m.GlobalCommittedComputingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 12:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalClaimedComputingPower", wireType)
 			}
-			m.TotalCount = 0
+			m.GlobalClaimedComputingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2291,16 +4843,66 @@ func (m *Division) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TotalCount |= uint64(b&0x7F) << shift
+				m.GlobalClaimedComputingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochEmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochEmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPowerLowerBound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
 			}
-			m.ComputingPowerLowerBound = 0
+			m.EpochId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2310,16 +4912,16 @@ func (m *Division) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ComputingPowerLowerBound |= uint64(b&0x7F) << shift
+				m.EpochId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPowerUpperBound", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
 			}
-			m.ComputingPowerUpperBound = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2329,11 +4931,26 @@ func (m *Division) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ComputingPowerUpperBound |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCaptains(dAtA[iNdEx:])
@@ -2355,7 +4972,7 @@ func (m *Division) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Node) Unmarshal(dAtA []byte) error {
+func (m *NodeClaimedEmission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2378,15 +4995,15 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Node: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeClaimedEmission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Node: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeClaimedEmission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2414,11 +5031,11 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DivisionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2446,59 +5063,10 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DivisionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCaptains
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCaptains
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCaptains
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPower", wireType)
-			}
-			m.ComputingPower = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCaptains
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ComputingPower |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCaptains(dAtA[iNdEx:])
@@ -2520,7 +5088,7 @@ func (m *Node) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BaseState) Unmarshal(dAtA []byte) error {
+func (m *ClaimableComputingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2543,17 +5111,17 @@ func (m *BaseState) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BaseState: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClaimableComputingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BaseState: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClaimableComputingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			m.EpochId = 0
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2563,16 +5131,16 @@ func (m *BaseState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsEpochEnd", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2582,34 +5150,77 @@ func (m *BaseState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsEpochEnd = bool(v != 0)
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextNodeSequence", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
 			}
-			m.NextNodeSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCaptains
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextNodeSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
 			}
-		case 4:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCaptains(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeCumulativeEmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCaptains
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeCumulativeEmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeCumulativeEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalClaimedEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2637,15 +5248,13 @@ func (m *BaseState) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GlobalClaimedEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportDigest", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
 			}
-			var msglen int
+			m.EpochId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2655,33 +5264,16 @@ func (m *BaseState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EpochId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthCaptains
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCaptains
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ReportDigest == nil {
-				m.ReportDigest = &ReportDigest{}
-			}
-			if err := m.ReportDigest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsStandBy", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2691,12 +5283,26 @@ func (m *BaseState) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsStandBy = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCaptains(dAtA[iNdEx:])
@@ -2718,7 +5324,7 @@ func (m *BaseState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EpochEmission) Unmarshal(dAtA []byte) error {
+func (m *GlobalComputingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2741,10 +5347,10 @@ func (m *EpochEmission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EpochEmission: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobalComputingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobalComputingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2768,7 +5374,7 @@ func (m *EpochEmission) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2796,7 +5402,7 @@ func (m *EpochEmission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2821,7 +5427,7 @@ func (m *EpochEmission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeClaimedEmission) Unmarshal(dAtA []byte) error {
+func (m *NodesComputingPower) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2844,10 +5450,10 @@ func (m *NodeClaimedEmission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeClaimedEmission: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodesComputingPower: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeClaimedEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodesComputingPower: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2883,8 +5489,27 @@ func (m *NodeClaimedEmission) Unmarshal(dAtA []byte) error {
 			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2912,7 +5537,7 @@ func (m *NodeClaimedEmission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2937,7 +5562,7 @@ func (m *NodeClaimedEmission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClaimableComputingPower) Unmarshal(dAtA []byte) error {
+func (m *GlobalPledge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2960,17 +5585,17 @@ func (m *ClaimableComputingPower) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClaimableComputingPower: wiretype end group for non-group")
+			return fmt.Errorf("proto: GlobalPledge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClaimableComputingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GlobalPledge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
 			}
-			m.Amount = 0
+			m.EpochId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -2980,14 +5605,14 @@ func (m *ClaimableComputingPower) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.EpochId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3015,7 +5640,9 @@ func (m *ClaimableComputingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3038,7 +5665,7 @@ func (m *ClaimableComputingPower) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeCumulativeEmission) Unmarshal(dAtA []byte) error {
+func (m *OwnerPledge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3061,15 +5688,15 @@ func (m *NodeCumulativeEmission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeCumulativeEmission: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnerPledge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeCumulativeEmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnerPledge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3097,7 +5724,7 @@ func (m *NodeCumulativeEmission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeId = string(dAtA[iNdEx:postIndex])
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
@@ -3120,7 +5747,7 @@ func (m *NodeCumulativeEmission) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Emission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3148,7 +5775,7 @@ func (m *NodeCumulativeEmission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Emission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3173,7 +5800,7 @@ func (m *NodeCumulativeEmission) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalComputingPower) Unmarshal(dAtA []byte) error {
+func (m *OwnerPledgeCheckpoint) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3196,34 +5823,15 @@ func (m *GlobalComputingPower) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalComputingPower: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnerPledgeCheckpoint: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalComputingPower: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnerPledgeCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
-			}
-			m.EpochId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCaptains
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3251,63 +5859,11 @@ func (m *GlobalComputingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCaptains(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCaptains
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodesComputingPower) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCaptains
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodesComputingPower: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodesComputingPower: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3335,13 +5891,15 @@ func (m *NodesComputingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeId = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryEpoch", wireType)
 			}
-			m.EpochId = 0
+			m.BoundaryEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -3351,14 +5909,14 @@ func (m *NodesComputingPower) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
+				m.BoundaryEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundaryAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3386,7 +5944,7 @@ func (m *NodesComputingPower) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BoundaryAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3411,7 +5969,7 @@ func (m *NodesComputingPower) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GlobalPledge) Unmarshal(dAtA []byte) error {
+func (m *OwnerDelegationPledge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3434,17 +5992,17 @@ func (m *GlobalPledge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GlobalPledge: wiretype end group for non-group")
+			return fmt.Errorf("proto: OwnerDelegationPledge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GlobalPledge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OwnerDelegationPledge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			m.EpochId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -3454,12 +6012,57 @@ func (m *GlobalPledge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Validator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCaptains
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Validator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -3514,7 +6117,7 @@ func (m *GlobalPledge) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnerPledge) Unmarshal(dAtA []byte) error {
+func (m *NodeSlashInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3537,15 +6140,15 @@ func (m *OwnerPledge) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnerPledge: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeSlashInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnerPledge: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeSlashInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3573,13 +6176,13 @@ func (m *OwnerPledge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Jailed", wireType)
 			}
-			m.EpochId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCaptains
@@ -3589,14 +6192,34 @@ func (m *OwnerPledge) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EpochId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Jailed = bool(v != 0)
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyEndEpoch", wireType)
+			}
+			m.PenaltyEndEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCaptains
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyEndEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfiscatedEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3624,7 +6247,7 @@ func (m *OwnerPledge) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ConfiscatedEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1,11 +1,44 @@
 package types
 
 import (
+	cryptocodec "github.com/tabilabs/tabi/crypto/codec"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	updateParamsName            = "captains/MsgUpdateParams"
+	createCaptainNodeName       = "captains/MsgCreateCaptainNode"
+	commitReportName            = "captains/MsgCommitReport"
+	addAuthorizedMembersName    = "captains/MsgAddAuthorizedMembers"
+	removeAuthorizedMembersName = "captains/MsgRemoveAuthorizedMembers"
+	updateSaleLevelName         = "captains/MsgUpdateSaleLevel"
+	commitComputingPowerName    = "captains/MsgCommitComputingPower"
+	claimComputingPowerName     = "captains/MsgClaimComputingPower"
+	submitNodeMisbehaviorName   = "captains/MsgSubmitNodeMisbehavior"
+	unjailNodeName              = "captains/MsgUnjailNode"
+
+	reportDigestName   = "captains/ReportDigest"
+	reportBatchName    = "captains/ReportBatch"
+	reportEmissionName = "captains/ReportEmission"
+	reportEndName      = "captains/ReportEnd"
+)
+
+func init() {
+	cryptocodec.RegisterCrypto(amino)
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateCaptainNode{},
@@ -16,6 +49,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCommitComputingPower{},
 		&MsgClaimComputingPower{},
 		&MsgUpdateParams{},
+		&MsgSubmitNodeMisbehavior{},
+		&MsgUnjailNode{},
 	)
 
 	registry.RegisterImplementations((*ReportContent)(nil),
@@ -27,3 +62,23 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgCreateCaptainNode{}, createCaptainNodeName, nil)
+	cdc.RegisterConcrete(&MsgCommitReport{}, commitReportName, nil)
+	cdc.RegisterConcrete(&MsgAddAuthorizedMembers{}, addAuthorizedMembersName, nil)
+	cdc.RegisterConcrete(&MsgRemoveAuthorizedMembers{}, removeAuthorizedMembersName, nil)
+	cdc.RegisterConcrete(&MsgUpdateSaleLevel{}, updateSaleLevelName, nil)
+	cdc.RegisterConcrete(&MsgCommitComputingPower{}, commitComputingPowerName, nil)
+	cdc.RegisterConcrete(&MsgClaimComputingPower{}, claimComputingPowerName, nil)
+	cdc.RegisterConcrete(&MsgSubmitNodeMisbehavior{}, submitNodeMisbehaviorName, nil)
+	cdc.RegisterConcrete(&MsgUnjailNode{}, unjailNodeName, nil)
+
+	cdc.RegisterInterface((*ReportContent)(nil), nil)
+	cdc.RegisterConcrete(&ReportDigest{}, reportDigestName, nil)
+	cdc.RegisterConcrete(&ReportBatch{}, reportBatchName, nil)
+	cdc.RegisterConcrete(&ReportEmission{}, reportEmissionName, nil)
+	cdc.RegisterConcrete(&ReportEnd{}, reportEndName, nil)
+}
@@ -17,4 +17,6 @@ var (
 	ErrInvalidReport              = errorsmod.Register(ModuleName, 10, "invalid report")
 	ErrDeleteLastMember           = errorsmod.Register(ModuleName, 11, "can not delete the last member")
 	ErrTypeOverflow               = errorsmod.Register(ModuleName, 12, "power overflow")
+	ErrInvalidMisbehavior         = errorsmod.Register(ModuleName, 13, "invalid misbehavior")
+	ErrNodeNotJailed              = errorsmod.Register(ModuleName, 14, "node is not jailed")
 )
@@ -1,5 +1,7 @@
 package types
 
+// The events below are the legacy untyped events, kept for a deprecation window
+// next to the typed events of events.proto.
 const (
 	EventTypeCreateNode              = "create_node"
 	EventTypeCommitReport            = "commit_report"
@@ -8,6 +10,11 @@ const (
 	EventTypeUpdateSaleLevel         = "update_sale_level"
 	EventTypeCommitComputingPower    = "commit_computing_power"
 	EventTypeClaimComputingPower     = "claim_computing_power"
+	EventTypeDivisionUpgraded        = "division_upgraded"
+	EventTypeSaleLevelUnlocked       = "sale_level_unlocked"
+	EventTypeHalvingEraEntered       = "halving_era_entered"
+	EventTypeNodeMisbehavior         = "node_misbehavior"
+	EventTypeUnjailNode              = "unjail_node"
 	EventTypeEpochPhase              = "epoch_phase"
 	EventTypeBeginBlock              = "begin_block"
 	EventTypeEndBlock                = "end_block"
@@ -15,6 +22,8 @@ const (
 	AttributeKeyEpochID              = "epoch_id"
 	AttributeKeyNodeID               = "node_id"
 	AttributeKeyDivisionID           = "division_id"
+	AttributeKeyDivisionBefore       = "division_before"
+	AttributeKeyDivisionAfter        = "division_after"
 	AttributeKeyReceiver             = "receiver"
 	AttributeKeyOwner                = "owner"
 	AttributeKeyReportType           = "report_type"
@@ -24,6 +33,13 @@ const (
 	AttributeKeyComputingPowerAfter  = "computing_power_after"
 	AttributeKeySaleLevelBefore      = "sale_level_before"
 	AttributeKeySaleLevelAfter       = "sale_level_after"
+	AttributeKeyHalvingEraBefore     = "halving_era_coefficient_before"
+	AttributeKeyHalvingEraAfter      = "halving_era_coefficient_after"
+	AttributeKeyMisbehaviorType      = "misbehavior_type"
+	AttributeKeyPenaltyEndEpoch      = "penalty_end_epoch"
+	AttributeKeyConfiscatedEmission  = "confiscated_emission"
+	AttributeKeyJailed               = "jailed"
+	AttributeKeyEpochPhase           = "epoch_phase"
 
 	AttributeValueCategory = ModuleName
 )
@@ -0,0 +1,964 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tabi/captains/v1/events.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EpochPhase defines the phase an epoch transitions into.
+type EpochPhase int32
+
+const (
+	// EPOCH_PHASE_UNSPECIFIED
+	EpochPhase_EPOCH_PHASE_UNSPECIFIED EpochPhase = 0
+	// EPOCH_PHASE_STAND_BY is entered when a new epoch starts
+	EpochPhase_EPOCH_PHASE_STAND_BY EpochPhase = 1
+	// EPOCH_PHASE_BUSY is entered once the report digest is executed
+	EpochPhase_EPOCH_PHASE_BUSY EpochPhase = 2
+	// EPOCH_PHASE_BUSY_FAILED is reported when the digest mismatches the node count
+	EpochPhase_EPOCH_PHASE_BUSY_FAILED EpochPhase = 3
+)
+
+var EpochPhase_name = map[int32]string{
+	0: "EPOCH_PHASE_UNSPECIFIED",
+	1: "EPOCH_PHASE_STAND_BY",
+	2: "EPOCH_PHASE_BUSY",
+	3: "EPOCH_PHASE_BUSY_FAILED",
+}
+
+var EpochPhase_value = map[string]int32{
+	"EPOCH_PHASE_UNSPECIFIED": 0,
+	"EPOCH_PHASE_STAND_BY":    1,
+	"EPOCH_PHASE_BUSY":        2,
+	"EPOCH_PHASE_BUSY_FAILED": 3,
+}
+
+func (x EpochPhase) String() string {
+	return proto.EnumName(EpochPhase_name, int32(x))
+}
+
+func (EpochPhase) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_9d4f6264af16c33f, []int{0}
+}
+
+// EventCreateNode defines the event for the creation of a captain node
+type EventCreateNode struct {
+	// node_id is the id of the node
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// division_id is the division of the node
+	DivisionId string `protobuf:"bytes,2,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	// owner is the owner of the node
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// authority is the authorized member creating the node
+	Authority string `protobuf:"bytes,4,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *EventCreateNode) Reset()         { *m = EventCreateNode{} }
+func (m *EventCreateNode) String() string { return proto.CompactTextString(m) }
+func (*EventCreateNode) ProtoMessage()    {}
+func (*EventCreateNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4f6264af16c33f, []int{0}
+}
+func (m *EventCreateNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateNode.Merge(m, src)
+}
+func (m *EventCreateNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateNode proto.InternalMessageInfo
+
+func (m *EventCreateNode) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *EventCreateNode) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
+}
+
+func (m *EventCreateNode) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventCreateNode) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// EventCommitReport defines the event for a report committed by an authorized member
+type EventCommitReport struct {
+	// epoch_id is the epoch the report is committed on
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// report_type is the type of the report
+	ReportType ReportType `protobuf:"varint,2,opt,name=report_type,json=reportType,proto3,enum=tabi.captains.v1.ReportType" json:"report_type,omitempty"`
+	// authority is the authorized member committing the report
+	Authority string `protobuf:"bytes,3,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *EventCommitReport) Reset()         { *m = EventCommitReport{} }
+func (m *EventCommitReport) String() string { return proto.CompactTextString(m) }
+func (*EventCommitReport) ProtoMessage()    {}
+func (*EventCommitReport) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4f6264af16c33f, []int{1}
+}
+func (m *EventCommitReport) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCommitReport) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCommitReport.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCommitReport) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCommitReport.Merge(m, src)
+}
+func (m *EventCommitReport) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCommitReport) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCommitReport.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCommitReport proto.InternalMessageInfo
+
+func (m *EventCommitReport) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EventCommitReport) GetReportType() ReportType {
+	if m != nil {
+		return m.ReportType
+	}
+	return ReportType_REPORT_TYPE_UNSPECIFIED
+}
+
+func (m *EventCommitReport) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// EventEpochPhase defines the event for an epoch phase transition
+type EventEpochPhase struct {
+	// epoch_id is the epoch of the transition
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// phase is the phase the epoch transitions into
+	Phase EpochPhase `protobuf:"varint,2,opt,name=phase,proto3,enum=tabi.captains.v1.EpochPhase" json:"phase,omitempty"`
+}
+
+func (m *EventEpochPhase) Reset()         { *m = EventEpochPhase{} }
+func (m *EventEpochPhase) String() string { return proto.CompactTextString(m) }
+func (*EventEpochPhase) ProtoMessage()    {}
+func (*EventEpochPhase) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d4f6264af16c33f, []int{2}
+}
+func (m *EventEpochPhase) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEpochPhase) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEpochPhase.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEpochPhase) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEpochPhase.Merge(m, src)
+}
+func (m *EventEpochPhase) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEpochPhase) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEpochPhase.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEpochPhase proto.InternalMessageInfo
+
+func (m *EventEpochPhase) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
+	}
+	return 0
+}
+
+func (m *EventEpochPhase) GetPhase() EpochPhase {
+	if m != nil {
+		return m.Phase
+	}
+	return EpochPhase_EPOCH_PHASE_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("tabi.captains.v1.EpochPhase", EpochPhase_name, EpochPhase_value)
+	proto.RegisterType((*EventCreateNode)(nil), "tabi.captains.v1.EventCreateNode")
+	proto.RegisterType((*EventCommitReport)(nil), "tabi.captains.v1.EventCommitReport")
+	proto.RegisterType((*EventEpochPhase)(nil), "tabi.captains.v1.EventEpochPhase")
+}
+
+func init() { proto.RegisterFile("tabi/captains/v1/events.proto", fileDescriptor_9d4f6264af16c33f) }
+
+var fileDescriptor_9d4f6264af16c33f = []byte{
+	// 434 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x6e, 0xd4, 0x30,
+	0x18, 0x86, 0xc7, 0x9d, 0xfe, 0xd0, 0xaf, 0x12, 0x04, 0x6b, 0xa4, 0xa6, 0x05, 0x02, 0xea, 0x0a,
+	0x90, 0x48, 0xd4, 0x22, 0xb1, 0x63, 0x31, 0x3f, 0xa9, 0x1a, 0x09, 0x0d, 0x51, 0xd2, 0x2e, 0xca,
+	0x26, 0x24, 0xb1, 0xd5, 0x58, 0x22, 0x71, 0x64, 0xbb, 0x81, 0xb9, 0x05, 0x97, 0xe0, 0x04, 0x70,
+	0x08, 0x96, 0x15, 0x2b, 0x96, 0x68, 0xe6, 0x22, 0xc8, 0x71, 0x87, 0x19, 0x06, 0x01, 0xdd, 0xd9,
+	0x7e, 0xde, 0xbc, 0x7a, 0x3e, 0xc7, 0xf0, 0x40, 0xa5, 0x19, 0xf3, 0xf2, 0xb4, 0x56, 0x29, 0xab,
+	0xa4, 0xd7, 0x1c, 0x7a, 0xb4, 0xa1, 0x95, 0x92, 0x6e, 0x2d, 0xb8, 0xe2, 0xd8, 0xd2, 0xd8, 0x9d,
+	0x63, 0xb7, 0x39, 0xdc, 0xdf, 0xcb, 0xb9, 0x2c, 0xb9, 0x4c, 0x5a, 0xee, 0x99, 0x8d, 0x09, 0xef,
+	0xff, 0xd9, 0x25, 0x68, 0xcd, 0x85, 0x32, 0xf8, 0xe0, 0x33, 0x82, 0x3b, 0xbe, 0x2e, 0x1f, 0x0a,
+	0x9a, 0x2a, 0x3a, 0xe6, 0x84, 0xe2, 0x5d, 0xd8, 0xaa, 0x38, 0xa1, 0x09, 0x23, 0x36, 0x7a, 0x84,
+	0x1e, 0x6f, 0x47, 0x9b, 0x7a, 0x1b, 0x10, 0xfc, 0x10, 0x76, 0x08, 0x6b, 0x98, 0x64, 0xbc, 0xd2,
+	0x70, 0xad, 0x85, 0x30, 0x3f, 0x0a, 0x08, 0x76, 0x61, 0x83, 0xbf, 0xaf, 0xa8, 0xb0, 0xbb, 0x1a,
+	0x0d, 0xec, 0x6f, 0x5f, 0x9e, 0xf5, 0xae, 0x6d, 0xfa, 0x84, 0x08, 0x2a, 0x65, 0xac, 0x04, 0xab,
+	0x2e, 0x22, 0x13, 0xc3, 0x2f, 0x60, 0x3b, 0xbd, 0x54, 0x05, 0x17, 0x4c, 0x4d, 0xec, 0xf5, 0xff,
+	0x7c, 0xb3, 0x88, 0x1e, 0x7c, 0x42, 0x70, 0xd7, 0x58, 0xf3, 0xb2, 0x64, 0x2a, 0x6a, 0x27, 0xc2,
+	0x7b, 0x70, 0x8b, 0xd6, 0x3c, 0x2f, 0xe6, 0xe2, 0xeb, 0xd1, 0x56, 0xbb, 0x0f, 0x08, 0x7e, 0x09,
+	0x3b, 0x66, 0xec, 0x44, 0x4d, 0x6a, 0xda, 0x9a, 0xdf, 0x3e, 0xba, 0xef, 0xae, 0x5e, 0xa4, 0x6b,
+	0x9a, 0x4e, 0x27, 0x35, 0x8d, 0x40, 0xfc, 0x5a, 0xff, 0xee, 0xd9, 0xbd, 0xb9, 0xe7, 0xdb, 0xeb,
+	0xcb, 0xf5, 0xb5, 0x46, 0x58, 0xa4, 0x92, 0xfe, 0x4b, 0xf2, 0x08, 0x36, 0x6a, 0x9d, 0xf9, 0xbb,
+	0xde, 0xa2, 0x27, 0x32, 0xd1, 0xa7, 0x0d, 0xc0, 0x52, 0xf9, 0x3d, 0xd8, 0xf5, 0xc3, 0xd7, 0xc3,
+	0x93, 0x24, 0x3c, 0xe9, 0xc7, 0x7e, 0x72, 0x36, 0x8e, 0x43, 0x7f, 0x18, 0x1c, 0x07, 0xfe, 0xc8,
+	0xea, 0x60, 0x1b, 0x7a, 0xcb, 0x30, 0x3e, 0xed, 0x8f, 0x47, 0xc9, 0xe0, 0xdc, 0x42, 0xb8, 0x07,
+	0xd6, 0x32, 0x19, 0x9c, 0xc5, 0xe7, 0xd6, 0xda, 0x6a, 0x99, 0x3e, 0x4d, 0x8e, 0xfb, 0xc1, 0x2b,
+	0x7f, 0x64, 0x75, 0x07, 0xc3, 0xaf, 0x53, 0x07, 0x5d, 0x4d, 0x1d, 0xf4, 0x63, 0xea, 0xa0, 0x8f,
+	0x33, 0xa7, 0x73, 0x35, 0x73, 0x3a, 0xdf, 0x67, 0x4e, 0xe7, 0xcd, 0x93, 0x0b, 0xa6, 0x8a, 0xcb,
+	0xcc, 0xcd, 0x79, 0xe9, 0xe9, 0x01, 0xde, 0xa5, 0x99, 0x6c, 0x17, 0xde, 0x87, 0xc5, 0x33, 0xd4,
+	0x7f, 0x41, 0x66, 0x9b, 0xed, 0x1b, 0x7c, 0xfe, 0x73, 0x00, 0x03, 0x7d, 0x01, 0x70, 0xf0, 0x02,
+	0x00, 0x00,
+}
+
+func (m *EventCreateNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DivisionId) > 0 {
+		i -= len(m.DivisionId)
+		copy(dAtA[i:], m.DivisionId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DivisionId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventCommitReport) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCommitReport) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCommitReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ReportType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ReportType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEpochPhase) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEpochPhase) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEpochPhase) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Phase != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Phase))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DivisionId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventCommitReport) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovEvents(uint64(m.EpochId))
+	}
+	if m.ReportType != 0 {
+		n += 1 + sovEvents(uint64(m.ReportType))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEpochPhase) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovEvents(uint64(m.EpochId))
+	}
+	if m.Phase != 0 {
+		n += 1 + sovEvents(uint64(m.Phase))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DivisionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DivisionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventCommitReport) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCommitReport: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCommitReport: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportType", wireType)
+			}
+			m.ReportType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReportType |= ReportType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEpochPhase) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEpochPhase: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEpochPhase: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
+			}
+			m.EpochId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			m.Phase = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Phase |= EpochPhase(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,87 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxEmissionForecastEpochs is the maximum number of epochs to forecast in one query.
+const MaxEmissionForecastEpochs = 100
+
+// EmissionForecastInput defines the hypothetical inputs of an emission forecast.
+type EmissionForecastInput struct {
+	NodeID                 string
+	PowerOnRatio           sdk.Dec
+	PledgeAmount           *sdk.Dec
+	ExtraComputingPower    uint64
+	SaleLevel              uint64
+	GlobalOnOperationRatio sdk.Dec
+	Epochs                 uint64
+}
+
+// NewEmissionForecastInput parses the forecast inputs from a query request.
+func NewEmissionForecastInput(req *QueryEmissionForecastRequest) (EmissionForecastInput, error) {
+	input := EmissionForecastInput{
+		NodeID:                 req.NodeId,
+		PowerOnRatio:           sdk.OneDec(),
+		ExtraComputingPower:    req.ExtraComputingPower,
+		SaleLevel:              req.SaleLevel,
+		GlobalOnOperationRatio: sdk.OneDec(),
+		Epochs:                 req.Epochs,
+	}
+
+	var err error
+	if req.PowerOnRatio != "" {
+		if input.PowerOnRatio, err = sdk.NewDecFromStr(req.PowerOnRatio); err != nil {
+			return input, fmt.Errorf("invalid power on ratio: %w", err)
+		}
+	}
+	if req.GlobalOnOperationRatio != "" {
+		if input.GlobalOnOperationRatio, err = sdk.NewDecFromStr(req.GlobalOnOperationRatio); err != nil {
+			return input, fmt.Errorf("invalid global on operation ratio: %w", err)
+		}
+	}
+	if req.PledgeAmount != "" {
+		pledge, err := sdk.NewDecFromStr(req.PledgeAmount)
+		if err != nil {
+			return input, fmt.Errorf("invalid pledge amount: %w", err)
+		}
+		input.PledgeAmount = &pledge
+	}
+	if input.Epochs == 0 {
+		input.Epochs = 1
+	}
+
+	return input, input.Validate()
+}
+
+// Validate validates the forecast inputs.
+func (input EmissionForecastInput) Validate() error {
+	if input.NodeID == "" {
+		return fmt.Errorf("empty node id")
+	}
+	if err := validateRatio(input.PowerOnRatio); err != nil {
+		return fmt.Errorf("invalid power on ratio: %w", err)
+	}
+	if err := validateRatio(input.GlobalOnOperationRatio); err != nil {
+		return fmt.Errorf("invalid global on operation ratio: %w", err)
+	}
+	if input.PledgeAmount != nil && input.PledgeAmount.IsNegative() {
+		return fmt.Errorf("pledge amount cannot be negative: %s", input.PledgeAmount)
+	}
+	if input.SaleLevel > 5 {
+		return fmt.Errorf("sale level cannot be greater than 5: %d", input.SaleLevel)
+	}
+	if input.Epochs > MaxEmissionForecastEpochs {
+		return fmt.Errorf("epochs cannot be greater than %d: %d", MaxEmissionForecastEpochs, input.Epochs)
+	}
+	return nil
+}
+
+func validateRatio(ratio sdk.Dec) error {
+	if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("ratio must be between 0 and 1: %s", ratio)
+	}
+	return nil
+}
@@ -31,6 +31,10 @@ func NewGenesisState(
 	globalsComputingPower []GlobalComputingPower,
 	nodesComputingPower []NodesComputingPower,
 	batches []BatchBase,
+	nodesDivisionHistory []NodeDivisionChange,
+	nodesSlashInfo []NodeSlashInfo,
+	ownersPledgeCheckpoint []OwnerPledgeCheckpoint,
+	ownersDelegationPledge []OwnerDelegationPledge,
 ) *GenesisState {
 	return &GenesisState{
 		Params:                        params,
@@ -46,6 +50,10 @@ func NewGenesisState(
 		GlobalsComputingPower:         globalsComputingPower,
 		NodesComputingPower:           nodesComputingPower,
 		Batches:                       batches,
+		NodesDivisionHistory:          nodesDivisionHistory,
+		NodesSlashInfo:                nodesSlashInfo,
+		OwnersPledgeCheckpoint:        ownersPledgeCheckpoint,
+		OwnersDelegationPledge:        ownersDelegationPledge,
 	}
 }
 
@@ -65,6 +73,9 @@ func DefaultBaseState() BaseState {
 		NextNodeSequence:      1,
 		GlobalClaimedEmission: sdk.ZeroDec(),
 		IsStandBy:             true, // for sure we start from stand-by phase.
+		CommunityPoolEmission: sdk.ZeroDec(),
+		FeePoolEmission:       sdk.ZeroDec(),
+		EpochFeePoolEmission:  sdk.ZeroDec(),
 	}
 }
 
@@ -186,6 +197,26 @@ func (gs *GenesisState) Validate() error {
 		return err
 	}
 
+	err = gs.ValidateNodesDivisionHistory(nodesMap)
+	if err != nil {
+		return err
+	}
+
+	err = gs.ValidateNodesSlashInfo(nodesMap)
+	if err != nil {
+		return err
+	}
+
+	err = gs.ValidateOwnersPledgeCheckpoint()
+	if err != nil {
+		return err
+	}
+
+	err = gs.ValidateOwnersDelegationPledge()
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -197,6 +228,15 @@ func (gs *GenesisState) ValidateBaseState() error {
 	if gs.BaseState.NextNodeSequence == 0 {
 		return fmt.Errorf("next node sequence should be greter than zero, is %d", gs.BaseState.NextNodeSequence)
 	}
+	if !gs.BaseState.CommunityPoolEmission.IsNil() && gs.BaseState.CommunityPoolEmission.IsNegative() {
+		return fmt.Errorf("community pool emission should not be negative, is %s", gs.BaseState.CommunityPoolEmission)
+	}
+	if !gs.BaseState.FeePoolEmission.IsNil() && gs.BaseState.FeePoolEmission.IsNegative() {
+		return fmt.Errorf("fee pool emission should not be negative, is %s", gs.BaseState.FeePoolEmission)
+	}
+	if !gs.BaseState.EpochFeePoolEmission.IsNil() && gs.BaseState.EpochFeePoolEmission.IsNegative() {
+		return fmt.Errorf("epoch fee pool emission should not be negative, is %s", gs.BaseState.EpochFeePoolEmission)
+	}
 	return nil
 }
 
@@ -384,9 +424,30 @@ func (gs *GenesisState) ValidateOwnersClaimableComputingPower() error {
 		}
 		seenMap[occp.Owner] = true
 	}
+
+	committed := gs.BaseState.GlobalCommittedComputingPower
+	claimed := gs.BaseState.GlobalClaimedComputingPower
+	if committed == 0 && claimed == 0 {
+		return nil
+	}
+	if claimed > committed {
+		return fmt.Errorf("claimed computing power %d exceeds committed computing power %d", claimed, committed)
+	}
+	if sum := SumClaimableComputingPower(gs.OwnersClaimableComputingPower); sum != committed-claimed {
+		return fmt.Errorf("claimable computing power %d mismatches committed computing power not claimed %d", sum, committed-claimed)
+	}
 	return nil
 }
 
+// SumClaimableComputingPower returns the sum of the claimable computing powers.
+func SumClaimableComputingPower(powers []ClaimableComputingPower) uint64 {
+	sum := uint64(0)
+	for _, power := range powers {
+		sum += power.Amount
+	}
+	return sum
+}
+
 // ValidateGlobalsComputingPower performs basic globals computing power validation returning an error upon any.
 func (gs *GenesisState) ValidateGlobalsComputingPower() error {
 	seenMap := make(map[uint64]bool)
@@ -442,3 +503,86 @@ func (gs *GenesisState) ValidateBatches() error {
 	}
 	return nil
 }
+
+// ValidateNodesDivisionHistory performs basic nodes division history validation returning an error upon any.
+func (gs *GenesisState) ValidateNodesDivisionHistory(nodesMap map[string]bool) error {
+	seenMap := make(map[string]bool)
+	for _, change := range gs.NodesDivisionHistory {
+		if !nodesMap[change.NodeId] {
+			return fmt.Errorf("unknown node id %s", change.NodeId)
+		}
+		if change.Sequence == 0 {
+			return fmt.Errorf("sequence should be greater than zero, is %d", change.Sequence)
+		}
+		if change.ToDivisionId == "" {
+			return fmt.Errorf("division id is empty")
+		}
+		uid := change.NodeId + "-" + strconv.FormatUint(change.Sequence, 10)
+		if _, ok := seenMap[uid]; ok {
+			return fmt.Errorf("duplicate on node id %s with sequence %d", change.NodeId, change.Sequence)
+		}
+		seenMap[uid] = true
+	}
+	return nil
+}
+
+// ValidateNodesSlashInfo performs basic nodes slash info validation returning an error upon any.
+func (gs *GenesisState) ValidateNodesSlashInfo(nodesMap map[string]bool) error {
+	seenMap := make(map[string]bool)
+	for _, info := range gs.NodesSlashInfo {
+		if !nodesMap[info.NodeId] {
+			return fmt.Errorf("unknown node id %s", info.NodeId)
+		}
+		if info.ConfiscatedEmission.IsNil() || info.ConfiscatedEmission.IsNegative() {
+			return fmt.Errorf("confiscated emission should not be negative, is %s", info.ConfiscatedEmission)
+		}
+		if _, ok := seenMap[info.NodeId]; ok {
+			return fmt.Errorf("duplicate slash info on node id %s", info.NodeId)
+		}
+		seenMap[info.NodeId] = true
+	}
+	return nil
+}
+
+// ValidateOwnersPledgeCheckpoint performs basic owners pledge checkpoint validation returning an error upon any.
+func (gs *GenesisState) ValidateOwnersPledgeCheckpoint() error {
+	seenMap := make(map[string]bool)
+	for _, cp := range gs.OwnersPledgeCheckpoint {
+		if _, err := sdk.AccAddressFromBech32(cp.Owner); err != nil {
+			return fmt.Errorf("invalid owner address %s", cp.Owner)
+		}
+		if cp.Amount.IsNil() || cp.Amount.IsNegative() {
+			return fmt.Errorf("pledge amount should not be negative, is %s", cp.Amount)
+		}
+		if cp.BoundaryAmount.IsNil() || cp.BoundaryAmount.IsNegative() {
+			return fmt.Errorf("boundary pledge amount should not be negative, is %s", cp.BoundaryAmount)
+		}
+		if _, ok := seenMap[cp.Owner]; ok {
+			return fmt.Errorf("duplicate pledge checkpoint on owner %s", cp.Owner)
+		}
+		seenMap[cp.Owner] = true
+	}
+	return nil
+}
+
+// ValidateOwnersDelegationPledge performs basic owners delegation pledge validation returning an error upon any.
+func (gs *GenesisState) ValidateOwnersDelegationPledge() error {
+	seenMap := make(map[string]bool)
+	for _, dp := range gs.OwnersDelegationPledge {
+		if _, err := sdk.AccAddressFromBech32(dp.Owner); err != nil {
+			return fmt.Errorf("invalid owner address %s", dp.Owner)
+		}
+		if _, err := sdk.ValAddressFromBech32(dp.Validator); err != nil {
+			return fmt.Errorf("invalid validator address %s", dp.Validator)
+		}
+		if dp.Amount.IsNil() || dp.Amount.IsNegative() {
+			return fmt.Errorf("pledge amount should not be negative, is %s", dp.Amount)
+		}
+		uid := dp.Owner + "-" + dp.Validator
+		if _, ok := seenMap[uid]; ok {
+			return fmt.Errorf("duplicate pledge on owner %s with validator %s", dp.Owner, dp.Validator)
+		}
+		seenMap[uid] = true
+	}
+	return nil
+}
@@ -51,6 +51,14 @@ type GenesisState struct {
 	NodesComputingPower []NodesComputingPower `protobuf:"bytes,12,rep,name=nodes_computing_power,json=nodesComputingPower,proto3" json:"nodes_computing_power"`
 	// batches
 	Batches []BatchBase `protobuf:"bytes,13,rep,name=batches,proto3" json:"batches"`
+	// nodes_division_history
+	NodesDivisionHistory []NodeDivisionChange `protobuf:"bytes,14,rep,name=nodes_division_history,json=nodesDivisionHistory,proto3" json:"nodes_division_history"`
+	// nodes_slash_info
+	NodesSlashInfo []NodeSlashInfo `protobuf:"bytes,15,rep,name=nodes_slash_info,json=nodesSlashInfo,proto3" json:"nodes_slash_info"`
+	// owners_pledge_checkpoint
+	OwnersPledgeCheckpoint []OwnerPledgeCheckpoint `protobuf:"bytes,16,rep,name=owners_pledge_checkpoint,json=ownersPledgeCheckpoint,proto3" json:"owners_pledge_checkpoint"`
+	// owners_delegation_pledge
+	OwnersDelegationPledge []OwnerDelegationPledge `protobuf:"bytes,17,rep,name=owners_delegation_pledge,json=ownersDelegationPledge,proto3" json:"owners_delegation_pledge"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -177,6 +185,34 @@ func (m *GenesisState) GetBatches() []BatchBase {
 	return nil
 }
 
+func (m *GenesisState) GetNodesDivisionHistory() []NodeDivisionChange {
+	if m != nil {
+		return m.NodesDivisionHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNodesSlashInfo() []NodeSlashInfo {
+	if m != nil {
+		return m.NodesSlashInfo
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOwnersPledgeCheckpoint() []OwnerPledgeCheckpoint {
+	if m != nil {
+		return m.OwnersPledgeCheckpoint
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOwnersDelegationPledge() []OwnerDelegationPledge {
+	if m != nil {
+		return m.OwnersDelegationPledge
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "tabi.captains.v1.GenesisState")
 }
@@ -184,41 +220,48 @@ func init() {
 func init() { proto.RegisterFile("tabi/captains/v1/genesis.proto", fileDescriptor_6b875c06e10d2c08) }
 
 var fileDescriptor_6b875c06e10d2c08 = []byte{
-	// 541 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x5b, 0xb6, 0x75, 0xab, 0xdb, 0xc2, 0x64, 0xf6, 0x27, 0x14, 0x35, 0xad, 0x90, 0x40,
-	0xdd, 0x25, 0xd1, 0x8a, 0xc4, 0x05, 0x09, 0xa1, 0x96, 0x69, 0x48, 0x48, 0x50, 0xc1, 0x8d, 0x4b,
-	0xe4, 0x24, 0x56, 0x6a, 0x94, 0xc4, 0x51, 0xec, 0x76, 0xe3, 0x2d, 0x78, 0x16, 0x9e, 0x62, 0xc7,
-	0x1d, 0x39, 0x21, 0xd4, 0xbe, 0x08, 0xf2, 0x2f, 0x4e, 0x2b, 0xe2, 0x96, 0x5b, 0xe4, 0xdf, 0xf7,
-	0xfb, 0xf9, 0x44, 0x4e, 0x6c, 0x64, 0x4b, 0xe2, 0x33, 0x37, 0x20, 0x99, 0x24, 0x2c, 0x15, 0xee,
-	0xe2, 0xd2, 0x8d, 0x68, 0x4a, 0x05, 0x13, 0x4e, 0x96, 0x73, 0xc9, 0xf1, 0xb1, 0x9a, 0x3b, 0xe5,
-	0xdc, 0x59, 0x5c, 0x76, 0x4f, 0x22, 0x1e, 0x71, 0x18, 0xba, 0xea, 0xa9, 0xc8, 0x75, 0x7b, 0x06,
-	0x27, 0xa7, 0x19, 0xcf, 0xa5, 0x1e, 0xf7, 0x8d, 0xf1, 0x1a, 0x09, 0x81, 0x67, 0x3f, 0x8f, 0x50,
-	0xfb, 0xba, 0x30, 0x7f, 0x91, 0x44, 0x52, 0xfc, 0x0a, 0x35, 0x32, 0x92, 0x93, 0x44, 0x58, 0xf5,
-	0x41, 0x7d, 0xd8, 0x1a, 0x59, 0x4e, 0xf5, 0x4d, 0x9c, 0x29, 0xcc, 0xc7, 0xfb, 0x77, 0xbf, 0xfb,
-	0xb5, 0xcf, 0x3a, 0x8d, 0xdf, 0x22, 0xe4, 0x13, 0x41, 0x3d, 0xa1, 0x28, 0xd6, 0x03, 0xe8, 0x3e,
-	0x35, 0xbb, 0x63, 0x22, 0x28, 0x88, 0x74, 0xbd, 0xe9, 0x97, 0x0b, 0xf8, 0x0d, 0x6a, 0x86, 0x6c,
-	0xc1, 0x04, 0xe3, 0xa9, 0xb0, 0xf6, 0x06, 0x7b, 0xc3, 0xd6, 0xa8, 0x6b, 0x02, 0xde, 0xe9, 0x48,
-	0xd9, 0x5f, 0x57, 0xf0, 0x08, 0x1d, 0xa4, 0x3c, 0xa4, 0xc2, 0xda, 0x87, 0xee, 0x99, 0xd9, 0xfd,
-	0xc8, 0xc3, 0xd2, 0x5b, 0x44, 0xf1, 0x14, 0x1d, 0xd3, 0x8c, 0x07, 0x33, 0x2a, 0x3c, 0x9a, 0x30,
-	0xa1, 0x40, 0xd6, 0x01, 0xd4, 0xfb, 0x66, 0xfd, 0x4a, 0x25, 0xaf, 0x74, 0x4c, 0x73, 0x1e, 0xe9,
-	0x7a, 0xb9, 0x8c, 0x09, 0x3a, 0x03, 0xb4, 0x17, 0xc4, 0x84, 0x25, 0x34, 0xdc, 0x70, 0x1b, 0xc0,
-	0x7d, 0xbe, 0xfd, 0xb5, 0x26, 0x45, 0xba, 0x42, 0x3f, 0x01, 0x54, 0x65, 0x86, 0xbf, 0xa1, 0x27,
-	0x5a, 0x31, 0x4f, 0xe6, 0x31, 0x91, 0x6c, 0x41, 0x37, 0x96, 0x43, 0xb0, 0x0c, 0x77, 0x58, 0xd6,
-	0x85, 0x8a, 0xe8, 0xbc, 0x10, 0x19, 0x63, 0xfc, 0x01, 0x3d, 0x8c, 0x62, 0xee, 0x93, 0x58, 0x78,
-	0x59, 0x4c, 0xc3, 0x88, 0x5a, 0x47, 0x20, 0xb0, 0x4d, 0xc1, 0x35, 0xe4, 0xa6, 0x90, 0xd2, 0xd8,
-	0x8e, 0xee, 0x16, 0x8b, 0xf8, 0x3d, 0xea, 0xf0, 0x9b, 0x94, 0xe6, 0x6b, 0x56, 0x13, 0x58, 0x3d,
-	0x93, 0xf5, 0x49, 0xc5, 0xfe, 0x41, 0xb5, 0x8b, 0xa6, 0x26, 0xdd, 0xa2, 0x81, 0x26, 0xc1, 0x36,
-	0x13, 0x3f, 0xa6, 0x5e, 0xc0, 0x93, 0x6c, 0x2e, 0x59, 0x1a, 0x79, 0x19, 0xbf, 0xa1, 0xb9, 0x85,
-	0x00, 0x7e, 0x61, 0xc2, 0x27, 0x65, 0x65, 0x52, 0x36, 0xa6, 0xaa, 0xa0, 0x45, 0xbd, 0x02, 0xbc,
-	0x23, 0x84, 0x43, 0x74, 0x5e, 0x6e, 0x48, 0x55, 0xd8, 0x02, 0xe1, 0x8b, 0x5d, 0x3b, 0xb3, 0xd5,
-	0x76, 0xaa, 0x61, 0x15, 0x8b, 0x87, 0x4e, 0xf5, 0x27, 0xae, 0x38, 0xda, 0xff, 0xfb, 0x89, 0xc4,
-	0x56, 0xc5, 0xe3, 0xd4, 0x1c, 0xe1, 0xd7, 0xe8, 0xd0, 0x27, 0x52, 0xfd, 0xb9, 0x56, 0x07, 0x90,
-	0x5b, 0xcf, 0xaa, 0x0c, 0x66, 0xea, 0xc0, 0x6a, 0x50, 0xd9, 0x18, 0x4f, 0xee, 0x96, 0x76, 0xfd,
-	0x7e, 0x69, 0xd7, 0xff, 0x2c, 0xed, 0xfa, 0x8f, 0x95, 0x5d, 0xbb, 0x5f, 0xd9, 0xb5, 0x5f, 0x2b,
-	0xbb, 0xf6, 0xf5, 0x22, 0x62, 0x72, 0x36, 0xf7, 0x9d, 0x80, 0x27, 0xae, 0xe2, 0xc5, 0xc4, 0x17,
-	0xf0, 0xe0, 0xde, 0x6e, 0x6e, 0x21, 0xf9, 0x3d, 0xa3, 0xc2, 0x6f, 0xc0, 0x05, 0xf4, 0xf2, 0x6f,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0xaa, 0x80, 0xdb, 0x0a, 0x05, 0x00, 0x00,
+	// 655 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0xc7, 0x5b, 0xf6, 0xc6, 0xdc, 0xbd, 0x14, 0xb3, 0x17, 0x33, 0xb4, 0xac, 0x42, 0xbc, 0x6c,
+	0x97, 0x56, 0x1b, 0x12, 0x17, 0x24, 0x84, 0xd6, 0x4d, 0x1b, 0x42, 0x62, 0x15, 0xbb, 0x71, 0x09,
+	0x4e, 0xe2, 0x25, 0x86, 0x24, 0x8e, 0x62, 0xb7, 0xdb, 0xbe, 0x05, 0xdf, 0x86, 0xaf, 0xb0, 0xe3,
+	0x8e, 0x9c, 0x10, 0xda, 0xbe, 0x08, 0xb2, 0x63, 0xa7, 0x6b, 0xdc, 0xf6, 0x56, 0xe5, 0xf9, 0xff,
+	0x7f, 0xbf, 0xc8, 0x7d, 0xac, 0x00, 0x47, 0x60, 0x8f, 0x76, 0x7c, 0x9c, 0x09, 0x4c, 0x53, 0xde,
+	0x19, 0xec, 0x77, 0x42, 0x92, 0x12, 0x4e, 0x79, 0x3b, 0xcb, 0x99, 0x60, 0xb0, 0x29, 0xe7, 0x6d,
+	0x33, 0x6f, 0x0f, 0xf6, 0xb7, 0xd6, 0x42, 0x16, 0x32, 0x35, 0xec, 0xc8, 0x5f, 0x45, 0x6e, 0x6b,
+	0xdb, 0xe2, 0xe4, 0x24, 0x63, 0xb9, 0xd0, 0xe3, 0x1d, 0x6b, 0x5c, 0x22, 0x55, 0xe0, 0xc5, 0xef,
+	0x06, 0x58, 0x3a, 0x29, 0xcc, 0xe7, 0x02, 0x0b, 0x02, 0xdf, 0x81, 0xf9, 0x0c, 0xe7, 0x38, 0xe1,
+	0xa8, 0xde, 0xaa, 0xef, 0x36, 0x0e, 0x50, 0xbb, 0xfa, 0x26, 0xed, 0x9e, 0x9a, 0x1f, 0xce, 0xde,
+	0xfc, 0xdd, 0xa9, 0x7d, 0xd5, 0x69, 0xf8, 0x11, 0x00, 0x0f, 0x73, 0xe2, 0x72, 0x49, 0x41, 0x8f,
+	0x54, 0xf7, 0xb9, 0xdd, 0x3d, 0xc4, 0x9c, 0x28, 0x91, 0xae, 0x2f, 0x7a, 0xe6, 0x01, 0xfc, 0x00,
+	0x16, 0x03, 0x3a, 0xa0, 0x9c, 0xb2, 0x94, 0xa3, 0x99, 0xd6, 0xcc, 0x6e, 0xe3, 0x60, 0xcb, 0x06,
+	0x1c, 0xe9, 0x88, 0xe9, 0x97, 0x15, 0x78, 0x00, 0xe6, 0x52, 0x16, 0x10, 0x8e, 0x66, 0x55, 0x77,
+	0xc3, 0xee, 0x7e, 0x61, 0x81, 0xf1, 0x16, 0x51, 0xd8, 0x03, 0x4d, 0x92, 0x31, 0x3f, 0x22, 0xdc,
+	0x25, 0x09, 0xe5, 0x12, 0x84, 0xe6, 0x54, 0x7d, 0xc7, 0xae, 0x1f, 0xcb, 0xe4, 0xb1, 0x8e, 0x69,
+	0xce, 0xaa, 0xae, 0x9b, 0xc7, 0x10, 0x83, 0x0d, 0x85, 0x76, 0xfd, 0x18, 0xd3, 0x84, 0x04, 0x43,
+	0xee, 0xbc, 0xe2, 0xbe, 0x1a, 0xff, 0x5a, 0xdd, 0x22, 0x5d, 0xa1, 0xaf, 0x29, 0x54, 0x65, 0x06,
+	0x7f, 0x80, 0x67, 0x5a, 0xd1, 0x4f, 0xfa, 0x31, 0x16, 0x74, 0x40, 0x86, 0x96, 0x05, 0x65, 0xd9,
+	0x9d, 0x60, 0x29, 0x0b, 0x15, 0xd1, 0x66, 0x21, 0xb2, 0xc6, 0xf0, 0x33, 0x58, 0x09, 0x63, 0xe6,
+	0xe1, 0x98, 0xbb, 0x59, 0x4c, 0x82, 0x90, 0xa0, 0xc7, 0x4a, 0xe0, 0xd8, 0x82, 0x13, 0x95, 0xeb,
+	0xa9, 0x94, 0xc6, 0x2e, 0xeb, 0x6e, 0xf1, 0x10, 0x9e, 0x82, 0x65, 0x76, 0x99, 0x92, 0xbc, 0x64,
+	0x2d, 0x2a, 0xd6, 0xb6, 0xcd, 0x3a, 0x93, 0xb1, 0x11, 0xd4, 0x52, 0xd1, 0xd4, 0xa4, 0x2b, 0xd0,
+	0xd2, 0x24, 0x75, 0xcc, 0xd8, 0x8b, 0x89, 0xeb, 0xb3, 0x24, 0xeb, 0x0b, 0x9a, 0x86, 0x6e, 0xc6,
+	0x2e, 0x49, 0x8e, 0x80, 0x82, 0xef, 0xd9, 0xf0, 0xae, 0xa9, 0x74, 0x4d, 0xa3, 0x27, 0x0b, 0x5a,
+	0xb4, 0x5d, 0x80, 0x27, 0x84, 0x60, 0x00, 0x36, 0xcd, 0x81, 0x54, 0x85, 0x0d, 0x25, 0x7c, 0x3d,
+	0xe9, 0x64, 0xc6, 0xda, 0xd6, 0x35, 0xac, 0x62, 0x71, 0xc1, 0xba, 0xfe, 0x8b, 0x2b, 0x8e, 0xa5,
+	0x69, 0x4b, 0xc4, 0xc7, 0x2a, 0x9e, 0xa6, 0xf6, 0x08, 0xbe, 0x07, 0x0b, 0x1e, 0x16, 0x72, 0x73,
+	0xd1, 0x72, 0x6b, 0x66, 0xd2, 0x5d, 0x15, 0x7e, 0x24, 0x2f, 0xac, 0x06, 0x99, 0x06, 0xfc, 0x6e,
+	0x76, 0xdc, 0x5c, 0x3e, 0x37, 0xa2, 0x5c, 0xb0, 0xfc, 0x1a, 0xad, 0x28, 0xd6, 0xcb, 0xf1, 0xaf,
+	0x67, 0xae, 0x6e, 0x37, 0xc2, 0x69, 0x48, 0x46, 0x56, 0xdc, 0x8c, 0x4e, 0x0b, 0x0e, 0x3c, 0x03,
+	0xcd, 0xc2, 0xc0, 0x63, 0xcc, 0x23, 0x97, 0xa6, 0x17, 0x0c, 0xad, 0x4e, 0xba, 0x97, 0x92, 0x7d,
+	0x2e, 0x73, 0x9f, 0xd2, 0x0b, 0xa6, 0xb1, 0x2b, 0xaa, 0x5e, 0x3e, 0x85, 0x21, 0x40, 0x23, 0xab,
+	0xe7, 0xfa, 0x11, 0xf1, 0x7f, 0x66, 0x8c, 0xa6, 0x02, 0x35, 0x15, 0xf8, 0xcd, 0xd4, 0x2d, 0xec,
+	0x96, 0x71, 0x2d, 0xd8, 0x78, 0xb8, 0x8f, 0xc3, 0xe9, 0x03, 0x51, 0x40, 0x62, 0x12, 0x62, 0x21,
+	0x8f, 0x47, 0xaf, 0xfb, 0x93, 0xa9, 0xa2, 0xa3, 0x32, 0x3f, 0xb2, 0xf8, 0x5a, 0x64, 0x4d, 0xbb,
+	0x37, 0x77, 0x4e, 0xfd, 0xf6, 0xce, 0xa9, 0xff, 0xbb, 0x73, 0xea, 0xbf, 0xee, 0x9d, 0xda, 0xed,
+	0xbd, 0x53, 0xfb, 0x73, 0xef, 0xd4, 0xbe, 0xed, 0x85, 0x54, 0x44, 0x7d, 0xaf, 0xed, 0xb3, 0xa4,
+	0x23, 0x55, 0x31, 0xf6, 0xb8, 0xfa, 0xd1, 0xb9, 0x1a, 0x7e, 0x0a, 0xc4, 0x75, 0x46, 0xb8, 0x37,
+	0xaf, 0xbe, 0x02, 0x6f, 0xff, 0x0f, 0x00, 0x14, 0x55, 0x4c, 0x30, 0x8f, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -241,6 +284,66 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OwnersDelegationPledge) > 0 {
+		for iNdEx := len(m.OwnersDelegationPledge) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnersDelegationPledge[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.OwnersPledgeCheckpoint) > 0 {
+		for iNdEx := len(m.OwnersPledgeCheckpoint) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnersPledgeCheckpoint[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.NodesSlashInfo) > 0 {
+		for iNdEx := len(m.NodesSlashInfo) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodesSlashInfo[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.NodesDivisionHistory) > 0 {
+		for iNdEx := len(m.NodesDivisionHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodesDivisionHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if len(m.Batches) > 0 {
 		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -505,6 +608,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.NodesDivisionHistory) > 0 {
+		for _, e := range m.NodesDivisionHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NodesSlashInfo) > 0 {
+		for _, e := range m.NodesSlashInfo {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OwnersPledgeCheckpoint) > 0 {
+		for _, e := range m.OwnersPledgeCheckpoint {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OwnersDelegationPledge) > 0 {
+		for _, e := range m.OwnersDelegationPledge {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -983,6 +1110,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodesDivisionHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodesDivisionHistory = append(m.NodesDivisionHistory, NodeDivisionChange{})
+			if err := m.NodesDivisionHistory[len(m.NodesDivisionHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodesSlashInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodesSlashInfo = append(m.NodesSlashInfo, NodeSlashInfo{})
+			if err := m.NodesSlashInfo[len(m.NodesSlashInfo)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnersPledgeCheckpoint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnersPledgeCheckpoint = append(m.OwnersPledgeCheckpoint, OwnerPledgeCheckpoint{})
+			if err := m.OwnersPledgeCheckpoint[len(m.OwnersPledgeCheckpoint)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnersDelegationPledge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnersDelegationPledge = append(m.OwnersDelegationPledge, OwnerDelegationPledge{})
+			if err := m.OwnersDelegationPledge[len(m.OwnersDelegationPledge)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
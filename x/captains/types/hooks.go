@@ -0,0 +1,34 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CaptainsHooks event hooks for the captain nodes
+type CaptainsHooks interface {
+	// AfterNodeMisbehavior is called after a node is penalized for a misbehavior, with the
+	// emission confiscated from the node and whether the node is jailed.
+	AfterNodeMisbehavior(ctx sdk.Context, nodeID string, confiscated sdk.Dec, jailed bool) error
+}
+
+var _ CaptainsHooks = MultiCaptainsHooks{}
+
+// MultiCaptainsHooks combine multiple captains hooks, all hook functions are run in array sequence
+type MultiCaptainsHooks []CaptainsHooks
+
+// NewMultiCaptainsHooks combine multiple captains hooks
+func NewMultiCaptainsHooks(hooks ...CaptainsHooks) MultiCaptainsHooks {
+	return hooks
+}
+
+// AfterNodeMisbehavior delegate the call to underlying hooks
+func (mh MultiCaptainsHooks) AfterNodeMisbehavior(ctx sdk.Context, nodeID string, confiscated sdk.Dec, jailed bool) error {
+	for i := range mh {
+		if err := mh[i].AfterNodeMisbehavior(ctx, nodeID, confiscated, jailed); err != nil {
+			return errorsmod.Wrapf(err, "captains hook %T failed", mh[i])
+		}
+	}
+	return nil
+}
@@ -37,6 +37,16 @@ const (
 	prefixEndOnEpoch
 	prefixStandByOver
 	prefixNodeEpochEmission
+	prefixNodeDivisionHistory
+	prefixLastHalvingEraEpoch
+	prefixNodeSlashInfo
+	prefixCommunityPoolEmission
+	prefixOwnerPledgeCheckpoint
+	prefixOwnerDelegationPledge
+	prefixFeePoolEmission
+	prefixEpochFeePoolEmission
+	prefixGlobalCommittedComputingPower
+	prefixGlobalClaimedComputingPower
 )
 
 var (
@@ -61,6 +71,16 @@ var (
 	EndOnEpochKey                    = []byte{prefixEndOnEpoch}
 	StandByOverKey                   = []byte{prefixStandByOver}
 	NodeEpochEmissionKey             = []byte{prefixNodeEpochEmission}
+	NodeDivisionHistoryKey           = []byte{prefixNodeDivisionHistory}
+	LastHalvingEraEpochKey           = []byte{prefixLastHalvingEraEpoch}
+	NodeSlashInfoKey                 = []byte{prefixNodeSlashInfo}
+	CommunityPoolEmissionKey         = []byte{prefixCommunityPoolEmission}
+	OwnerPledgeCheckpointKey         = []byte{prefixOwnerPledgeCheckpoint}
+	OwnerDelegationPledgeKey         = []byte{prefixOwnerDelegationPledge}
+	FeePoolEmissionKey               = []byte{prefixFeePoolEmission}
+	EpochFeePoolEmissionKey          = []byte{prefixEpochFeePoolEmission}
+	GlobalCommittedComputingPowerKey = []byte{prefixGlobalCommittedComputingPower}
+	GlobalClaimedComputingPowerKey   = []byte{prefixGlobalClaimedComputingPower}
 	Delimiter                        = []byte{0x00}
 	PlaceHolder                      = []byte{0x01}
 )
@@ -122,6 +142,30 @@ func DivisionByNodeStoreKey(divisionID, nodeID string) []byte {
 	return key
 }
 
+// NodeDivisionHistoryStoreKey returns the byte representation of the node division history key
+// Items are stored with the following key: values
+// <prefix_key><node_id><delimiter><sequence> -> <division_change_bz>
+func NodeDivisionHistoryStoreKey(nodeID string, sequence uint64) []byte {
+	seqBz := sdk.Uint64ToBigEndian(sequence)
+	key := make([]byte, len(NodeDivisionHistoryKey)+len(nodeID)+len(Delimiter)+len(seqBz))
+	copy(key, NodeDivisionHistoryKey)
+	copy(key[len(NodeDivisionHistoryKey):], nodeID)
+	copy(key[len(NodeDivisionHistoryKey)+len(nodeID):], Delimiter)
+	copy(key[len(NodeDivisionHistoryKey)+len(nodeID)+len(Delimiter):], seqBz)
+	return key
+}
+
+// NodeDivisionHistoryPrefixStoreKey returns the byte representation of the node division history prefix key
+// Items are stored with the following key
+// <prefix_key><node_id><delimiter>
+func NodeDivisionHistoryPrefixStoreKey(nodeID string) []byte {
+	key := make([]byte, len(NodeDivisionHistoryKey)+len(nodeID)+len(Delimiter))
+	copy(key, NodeDivisionHistoryKey)
+	copy(key[len(NodeDivisionHistoryKey):], nodeID)
+	copy(key[len(NodeDivisionHistoryKey)+len(nodeID):], Delimiter)
+	return key
+}
+
 // EpochEmissionStoreKey returns the byte representation of the emission sum on epoch key
 // Items are stored with the following key: values
 // <prefix_key><epoch_id> -> <emission>
@@ -133,6 +177,17 @@ func EpochEmissionStoreKey(epochID uint64) []byte {
 	return key
 }
 
+// EpochFeePoolEmissionStoreKey returns the byte representation of the fee pool emission on epoch key
+// Items are stored with the following key: values
+// <prefix_key><epoch_id> -> <emission>
+func EpochFeePoolEmissionStoreKey(epochID uint64) []byte {
+	epochBz := sdk.Uint64ToBigEndian(epochID)
+	key := make([]byte, len(EpochFeePoolEmissionKey)+len(epochBz))
+	copy(key, EpochFeePoolEmissionKey)
+	copy(key[len(EpochFeePoolEmissionKey):], epochBz)
+	return key
+}
+
 // NodeCumulativeEmissionByEpochStoreKey returns the byte representation of cumulative emission by node on epoch key
 // Items are stored with the following key: values
 // <prefix_key><epoch_id><delimiter><node_id> -> <emission>
@@ -166,6 +221,49 @@ func NodeClaimedEmissionStoreKey(nodeID string) []byte {
 	return key
 }
 
+// NodeSlashInfoStoreKey returns the byte representation of the node slash info key
+// Items are stored with the following key: values
+// <prefix_key><node_id> -> <node_slash_info_bz>
+func NodeSlashInfoStoreKey(nodeID string) []byte {
+	key := make([]byte, len(NodeSlashInfoKey)+len(nodeID))
+	copy(key, NodeSlashInfoKey)
+	copy(key[len(NodeSlashInfoKey):], nodeID)
+	return key
+}
+
+// OwnerPledgeCheckpointStoreKey returns the byte representation of the owner pledge checkpoint key
+// Items are stored with the following key: values
+// <prefix_key><owner> -> <checkpoint_bz>
+func OwnerPledgeCheckpointStoreKey(owner sdk.AccAddress) []byte {
+	key := make([]byte, len(OwnerPledgeCheckpointKey)+len(owner))
+	copy(key, OwnerPledgeCheckpointKey)
+	copy(key[len(OwnerPledgeCheckpointKey):], owner)
+	return key
+}
+
+// OwnerDelegationPledgeStoreKey returns the byte representation of the owner delegation pledge key
+// Items are stored with the following key: values
+// <prefix_key><validator_len><validator><owner> -> <pledge>
+func OwnerDelegationPledgeStoreKey(validator sdk.ValAddress, owner sdk.AccAddress) []byte {
+	prefix := OwnerDelegationPledgePrefixStoreKey(validator)
+	key := make([]byte, len(prefix)+len(owner))
+	copy(key, prefix)
+	copy(key[len(prefix):], owner)
+	return key
+}
+
+// OwnerDelegationPledgePrefixStoreKey returns the byte representation of the owner delegation pledge prefix key
+// Items are stored with the following key
+// <prefix_key><validator_len><validator>
+func OwnerDelegationPledgePrefixStoreKey(validator sdk.ValAddress) []byte {
+	validator = address.MustLengthPrefix(validator)
+
+	key := make([]byte, len(OwnerDelegationPledgeKey)+len(validator))
+	copy(key, OwnerDelegationPledgeKey)
+	copy(key[len(OwnerDelegationPledgeKey):], validator)
+	return key
+}
+
 // ClaimableComputingPowerStoreKey returns the byte representation of claimable computing power key
 // Items are stored with the following key: values
 // <prefix_key><owner> -> <computing_power>
@@ -8,6 +8,19 @@ import (
 	errorsmod "cosmossdk.io/errors"
 )
 
+const (
+	TypeMsgUpdateParams            = "update_params"
+	TypeMsgCreateCaptainNode       = "create_captain_node"
+	TypeMsgCommitReport            = "commit_report"
+	TypeMsgAddAuthorizedMembers    = "add_authorized_members"
+	TypeMsgRemoveAuthorizedMembers = "remove_authorized_members"
+	TypeMsgUpdateSaleLevel         = "update_sale_level"
+	TypeMsgCommitComputingPower    = "commit_computing_power"
+	TypeMsgClaimComputingPower     = "claim_computing_power"
+	TypeMsgSubmitNodeMisbehavior   = "submit_node_misbehavior"
+	TypeMsgUnjailNode              = "unjail_node"
+)
+
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgCreateCaptainNode{}
@@ -17,6 +30,10 @@ var (
 	_ sdk.Msg = &MsgUpdateSaleLevel{}
 	_ sdk.Msg = &MsgCommitComputingPower{}
 	_ sdk.Msg = &MsgClaimComputingPower{}
+	_ sdk.Msg = &MsgSubmitNodeMisbehavior{}
+	_ sdk.Msg = &MsgUnjailNode{}
+
+	_ types.UnpackInterfacesMessage = &MsgCommitReport{}
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -207,6 +224,12 @@ func (msg *MsgCommitReport) ValidateBasic() error {
 	return nil
 }
 
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg *MsgCommitReport) UnpackInterfaces(unpacker types.AnyUnpacker) error {
+	var report ReportContent
+	return unpacker.UnpackAny(msg.Report, &report)
+}
+
 // GetSigners Implements Msg.
 func (msg *MsgCommitReport) GetSigners() []sdk.AccAddress {
 	fromAddress, _ := sdk.AccAddressFromBech32(msg.Authority)
@@ -385,3 +408,192 @@ func (msg *MsgClaimComputingPower) GetSigners() []sdk.AccAddress {
 	fromAddress, _ := sdk.AccAddressFromBech32(msg.Sender)
 	return []sdk.AccAddress{fromAddress}
 }
+
+// NewMsgSubmitNodeMisbehavior creates a new MsgSubmitNodeMisbehavior instance
+func NewMsgSubmitNodeMisbehavior(
+	authority, nodeId string,
+	misbehaviorType MisbehaviorType,
+	evidence string,
+	penaltyEpochs uint64,
+	slashFraction sdk.Dec,
+	jail bool,
+) *MsgSubmitNodeMisbehavior {
+	return &MsgSubmitNodeMisbehavior{
+		Authority:       authority,
+		NodeId:          nodeId,
+		MisbehaviorType: misbehaviorType,
+		Evidence:        evidence,
+		PenaltyEpochs:   penaltyEpochs,
+		SlashFraction:   slashFraction,
+		Jail:            jail,
+	}
+}
+
+// ValidateBasic Implements Msg.
+func (msg *MsgSubmitNodeMisbehavior) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if len(msg.NodeId) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "node id cannot be empty")
+	}
+	if msg.MisbehaviorType == MisbehaviorType_MISBEHAVIOR_TYPE_UNSPECIFIED {
+		return errorsmod.Wrap(ErrInvalidMisbehavior, "misbehavior type cannot be unspecified")
+	}
+	if _, ok := MisbehaviorType_name[int32(msg.MisbehaviorType)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidMisbehavior, "unknown misbehavior type: %d", msg.MisbehaviorType)
+	}
+	if len(msg.Evidence) == 0 {
+		return errorsmod.Wrap(ErrInvalidMisbehavior, "evidence cannot be empty")
+	}
+	if msg.SlashFraction.IsNil() || msg.SlashFraction.IsNegative() || msg.SlashFraction.GT(sdk.OneDec()) {
+		return errorsmod.Wrap(ErrInvalidMisbehavior, "slash fraction must be between 0 and 1")
+	}
+	if msg.PenaltyEpochs == 0 && msg.SlashFraction.IsZero() && !msg.Jail {
+		return errorsmod.Wrap(ErrInvalidMisbehavior, "no penalty specified")
+	}
+
+	return nil
+}
+
+// GetSigners Implements Msg.
+func (msg *MsgSubmitNodeMisbehavior) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgUnjailNode creates a new MsgUnjailNode instance
+func NewMsgUnjailNode(authority, nodeId string) *MsgUnjailNode {
+	return &MsgUnjailNode{
+		Authority: authority,
+		NodeId:    nodeId,
+	}
+}
+
+// ValidateBasic Implements Msg.
+func (msg *MsgUnjailNode) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if len(msg.NodeId) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "node id cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSigners Implements Msg.
+func (msg *MsgUnjailNode) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgCreateCaptainNode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgCreateCaptainNode) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgCreateCaptainNode) Type() string { return TypeMsgCreateCaptainNode }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgCommitReport) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgCommitReport) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgCommitReport) Type() string { return TypeMsgCommitReport }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgAddAuthorizedMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgAddAuthorizedMembers) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgAddAuthorizedMembers) Type() string { return TypeMsgAddAuthorizedMembers }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgRemoveAuthorizedMembers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgRemoveAuthorizedMembers) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgRemoveAuthorizedMembers) Type() string { return TypeMsgRemoveAuthorizedMembers }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgUpdateSaleLevel) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgUpdateSaleLevel) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgUpdateSaleLevel) Type() string { return TypeMsgUpdateSaleLevel }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgCommitComputingPower) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgCommitComputingPower) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgCommitComputingPower) Type() string { return TypeMsgCommitComputingPower }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgClaimComputingPower) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgClaimComputingPower) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgClaimComputingPower) Type() string { return TypeMsgClaimComputingPower }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgSubmitNodeMisbehavior) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgSubmitNodeMisbehavior) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgSubmitNodeMisbehavior) Type() string { return TypeMsgSubmitNodeMisbehavior }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgUnjailNode) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgUnjailNode) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgUnjailNode) Type() string { return TypeMsgUnjailNode }
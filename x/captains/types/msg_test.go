@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -500,3 +501,65 @@ func (suite *MsgTestSuite) TestMsgCommitReportValidateBasic() {
 		})
 	}
 }
+
+func (suite *MsgTestSuite) TestMsgSubmitNodeMisbehaviorValidateBasic() {
+	authority := sdk.AccAddress([]byte("authority")).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgSubmitNodeMisbehavior
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgSubmitNodeMisbehavior(
+				authority, "node-1",
+				MisbehaviorType_MISBEHAVIOR_TYPE_FRAUD, "evidence", 3, sdk.NewDecWithPrec(5, 1), true,
+			),
+			true,
+		},
+		{
+			"fail - invalid authority address",
+			NewMsgSubmitNodeMisbehavior(
+				"invalid", "node-1",
+				MisbehaviorType_MISBEHAVIOR_TYPE_FRAUD, "evidence", 3, sdk.ZeroDec(), false,
+			),
+			false,
+		},
+		{
+			"fail - unspecified misbehavior type",
+			NewMsgSubmitNodeMisbehavior(
+				authority, "node-1",
+				MisbehaviorType_MISBEHAVIOR_TYPE_UNSPECIFIED, "evidence", 3, sdk.ZeroDec(), false,
+			),
+			false,
+		},
+		{
+			"fail - invalid slash fraction",
+			NewMsgSubmitNodeMisbehavior(
+				authority, "node-1",
+				MisbehaviorType_MISBEHAVIOR_TYPE_DOWNTIME, "evidence", 0, sdk.NewDec(2), false,
+			),
+			false,
+		},
+		{
+			"fail - no penalty",
+			NewMsgSubmitNodeMisbehavior(
+				authority, "node-1",
+				MisbehaviorType_MISBEHAVIOR_TYPE_DOWNTIME, "evidence", 0, sdk.ZeroDec(), false,
+			),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
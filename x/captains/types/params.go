@@ -74,5 +74,49 @@ func (p *Params) Validate() error {
 			return fmt.Errorf("memeber address is invalid: %s", err)
 		}
 	}
+
+	if err := validateSaleLevelSchedule(p.SaleLevelSchedule); err != nil {
+		return err
+	}
+
+	return validateHalvingEraSchedule(p.HalvingEraSchedule)
+}
+
+// validateSaleLevelSchedule validates the sale level milestones.
+func validateSaleLevelSchedule(schedule []SaleLevelMilestone) error {
+	seenMap := make(map[uint64]bool)
+	for _, milestone := range schedule {
+		if milestone.SaleLevel <= 1 || milestone.SaleLevel > 5 {
+			return fmt.Errorf("scheduled sale level should be greater than 1 and less than or equal to 5, is %d", milestone.SaleLevel)
+		}
+		if milestone.DivisionId == "" {
+			return fmt.Errorf("division id is empty, in scheduled sale level %d", milestone.SaleLevel)
+		}
+		if milestone.SoldCount == 0 {
+			return fmt.Errorf("sold count should be greater than zero, in scheduled sale level %d", milestone.SaleLevel)
+		}
+		if seenMap[milestone.SaleLevel] {
+			return fmt.Errorf("duplicate scheduled sale level %d", milestone.SaleLevel)
+		}
+		seenMap[milestone.SaleLevel] = true
+	}
+	return nil
+}
+
+// validateHalvingEraSchedule validates the halving eras.
+func validateHalvingEraSchedule(schedule []HalvingEra) error {
+	seenMap := make(map[uint64]bool)
+	for _, era := range schedule {
+		if era.EpochId == 0 {
+			return fmt.Errorf("halving era epoch id should be greater than zero")
+		}
+		if era.Coefficient.IsNil() || !era.Coefficient.IsPositive() || era.Coefficient.GT(sdk.OneDec()) {
+			return fmt.Errorf("halving era coefficient should be positive and less than or equal to 1, in epoch %d", era.EpochId)
+		}
+		if seenMap[era.EpochId] {
+			return fmt.Errorf("duplicate halving era epoch id %d", era.EpochId)
+		}
+		seenMap[era.EpochId] = true
+	}
 	return nil
 }
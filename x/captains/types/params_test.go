@@ -57,6 +57,46 @@ func (suite *ParamsTestSuite) TestParamsValidation() {
 			params: NewParams(100000, 24, 6, sdk.NewDec(300000), sdk.NewDecWithPrec(16, 1), sdk.OneDec(), 100001, nil),
 			expErr: true,
 		},
+		{
+			name: "NewParamsWithInvalidSaleLevelSchedule",
+			params: func() Params {
+				params := DefaultParams()
+				params.SaleLevelSchedule = []SaleLevelMilestone{{SaleLevel: 1, DivisionId: GenDivisionsId(1), SoldCount: 100}}
+				return params
+			}(),
+			expErr: true,
+		},
+		{
+			name: "NewParamsWithDuplicatedSaleLevelSchedule",
+			params: func() Params {
+				params := DefaultParams()
+				params.SaleLevelSchedule = []SaleLevelMilestone{
+					{SaleLevel: 2, DivisionId: GenDivisionsId(1), SoldCount: 100},
+					{SaleLevel: 2, DivisionId: GenDivisionsId(1), SoldCount: 200},
+				}
+				return params
+			}(),
+			expErr: true,
+		},
+		{
+			name: "NewParamsWithInvalidHalvingEraSchedule",
+			params: func() Params {
+				params := DefaultParams()
+				params.HalvingEraSchedule = []HalvingEra{{EpochId: 10, Coefficient: sdk.NewDec(2)}}
+				return params
+			}(),
+			expErr: true,
+		},
+		{
+			name: "NewParamsWithValidSchedule",
+			params: func() Params {
+				params := DefaultParams()
+				params.SaleLevelSchedule = []SaleLevelMilestone{{SaleLevel: 2, DivisionId: GenDivisionsId(1), SoldCount: 100}}
+				params.HalvingEraSchedule = []HalvingEra{{EpochId: 10, Coefficient: sdk.NewDecWithPrec(5, 1)}}
+				return params
+			}(),
+			expErr: false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -316,6 +317,116 @@ func (m *QueryNodesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryNodeDivisionHistoryRequest is the request type for the Query/NodeDivisionHistory RPC method
+type QueryNodeDivisionHistoryRequest struct {
+	// node_id
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// pagination
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNodeDivisionHistoryRequest) Reset()         { *m = QueryNodeDivisionHistoryRequest{} }
+func (m *QueryNodeDivisionHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNodeDivisionHistoryRequest) ProtoMessage()    {}
+func (*QueryNodeDivisionHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{6}
+}
+func (m *QueryNodeDivisionHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNodeDivisionHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNodeDivisionHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNodeDivisionHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodeDivisionHistoryRequest.Merge(m, src)
+}
+func (m *QueryNodeDivisionHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNodeDivisionHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodeDivisionHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodeDivisionHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryNodeDivisionHistoryRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
+	}
+	return ""
+}
+
+func (m *QueryNodeDivisionHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryNodeDivisionHistoryResponse is the response type for the Query/NodeDivisionHistory RPC method
+type QueryNodeDivisionHistoryResponse struct {
+	// history
+	History []NodeDivisionChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	// pagination
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryNodeDivisionHistoryResponse) Reset()         { *m = QueryNodeDivisionHistoryResponse{} }
+func (m *QueryNodeDivisionHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNodeDivisionHistoryResponse) ProtoMessage()    {}
+func (*QueryNodeDivisionHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{7}
+}
+func (m *QueryNodeDivisionHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNodeDivisionHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNodeDivisionHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNodeDivisionHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNodeDivisionHistoryResponse.Merge(m, src)
+}
+func (m *QueryNodeDivisionHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNodeDivisionHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNodeDivisionHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNodeDivisionHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryNodeDivisionHistoryResponse) GetHistory() []NodeDivisionChange {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryNodeDivisionHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryDivisionRequest is the request type for the Query/Division RPC method
 type QueryDivisionRequest struct {
 	// division_id
@@ -326,7 +437,7 @@ func (m *QueryDivisionRequest) Reset()         { *m = QueryDivisionRequest{} }
 func (m *QueryDivisionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDivisionRequest) ProtoMessage()    {}
 func (*QueryDivisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{6}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{8}
 }
 func (m *QueryDivisionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -372,7 +483,7 @@ func (m *QueryDivisionResponse) Reset()         { *m = QueryDivisionResponse{} }
 func (m *QueryDivisionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDivisionResponse) ProtoMessage()    {}
 func (*QueryDivisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{7}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{9}
 }
 func (m *QueryDivisionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -418,7 +529,7 @@ func (m *QueryDivisionsRequest) Reset()         { *m = QueryDivisionsRequest{} }
 func (m *QueryDivisionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryDivisionsRequest) ProtoMessage()    {}
 func (*QueryDivisionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{8}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{10}
 }
 func (m *QueryDivisionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -466,7 +577,7 @@ func (m *QueryDivisionsResponse) Reset()         { *m = QueryDivisionsResponse{}
 func (m *QueryDivisionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryDivisionsResponse) ProtoMessage()    {}
 func (*QueryDivisionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{9}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{11}
 }
 func (m *QueryDivisionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +630,7 @@ func (m *QuerySupplyRequest) Reset()         { *m = QuerySupplyRequest{} }
 func (m *QuerySupplyRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyRequest) ProtoMessage()    {}
 func (*QuerySupplyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{10}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{12}
 }
 func (m *QuerySupplyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -565,7 +676,7 @@ func (m *QuerySupplyResponse) Reset()         { *m = QuerySupplyResponse{} }
 func (m *QuerySupplyResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySupplyResponse) ProtoMessage()    {}
 func (*QuerySupplyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{11}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{13}
 }
 func (m *QuerySupplyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +720,7 @@ func (m *QuerySaleLevelRequest) Reset()         { *m = QuerySaleLevelRequest{} }
 func (m *QuerySaleLevelRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySaleLevelRequest) ProtoMessage()    {}
 func (*QuerySaleLevelRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{12}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{14}
 }
 func (m *QuerySaleLevelRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -648,7 +759,7 @@ func (m *QuerySaleLevelResponse) Reset()         { *m = QuerySaleLevelResponse{}
 func (m *QuerySaleLevelResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySaleLevelResponse) ProtoMessage()    {}
 func (*QuerySaleLevelResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{13}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{15}
 }
 func (m *QuerySaleLevelResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -692,7 +803,7 @@ func (m *QueryAuthorizedMembersRequest) Reset()         { *m = QueryAuthorizedMe
 func (m *QueryAuthorizedMembersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorizedMembersRequest) ProtoMessage()    {}
 func (*QueryAuthorizedMembersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{14}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{16}
 }
 func (m *QueryAuthorizedMembersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -731,7 +842,7 @@ func (m *QueryAuthorizedMembersResponse) Reset()         { *m = QueryAuthorizedM
 func (m *QueryAuthorizedMembersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAuthorizedMembersResponse) ProtoMessage()    {}
 func (*QueryAuthorizedMembersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{15}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{17}
 }
 func (m *QueryAuthorizedMembersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -775,7 +886,7 @@ func (m *QueryCurrentEpochRequest) Reset()         { *m = QueryCurrentEpochReque
 func (m *QueryCurrentEpochRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochRequest) ProtoMessage()    {}
 func (*QueryCurrentEpochRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{16}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{18}
 }
 func (m *QueryCurrentEpochRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -816,7 +927,7 @@ func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResp
 func (m *QueryCurrentEpochResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochResponse) ProtoMessage()    {}
 func (*QueryCurrentEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{17}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{19}
 }
 func (m *QueryCurrentEpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -869,7 +980,7 @@ func (m *QueryNodeLastEpochInfoRequest) Reset()         { *m = QueryNodeLastEpoc
 func (m *QueryNodeLastEpochInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeLastEpochInfoRequest) ProtoMessage()    {}
 func (*QueryNodeLastEpochInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{18}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{20}
 }
 func (m *QueryNodeLastEpochInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -925,7 +1036,7 @@ func (m *QueryNodeLastEpochInfoResponse) Reset()         { *m = QueryNodeLastEpo
 func (m *QueryNodeLastEpochInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeLastEpochInfoResponse) ProtoMessage()    {}
 func (*QueryNodeLastEpochInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{19}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{21}
 }
 func (m *QueryNodeLastEpochInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1006,7 +1117,7 @@ func (m *QueryEpochStatusRequest) Reset()         { *m = QueryEpochStatusRequest
 func (m *QueryEpochStatusRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochStatusRequest) ProtoMessage()    {}
 func (*QueryEpochStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{20}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{22}
 }
 func (m *QueryEpochStatusRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1058,7 +1169,7 @@ func (m *QueryEpochStatusResponse) Reset()         { *m = QueryEpochStatusRespon
 func (m *QueryEpochStatusResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochStatusResponse) ProtoMessage()    {}
 func (*QueryEpochStatusResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{21}
+	return fileDescriptor_93d1b070fd3dd5cc, []int{23}
 }
 func (m *QueryEpochStatusResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1115,24 +1226,24 @@ func (m *QueryEpochStatusResponse) GetEpochEmission() string {
 	return ""
 }
 
-// QueryClaimableComputingPowerRequest
-type QueryClaimableComputingPowerRequest struct {
-	// owner
-	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+// QueryReportProgressRequest is the request type for the Query/ReportProgress RPC method
+type QueryReportProgressRequest struct {
+	// epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
 }
 
-func (m *QueryClaimableComputingPowerRequest) Reset()         { *m = QueryClaimableComputingPowerRequest{} }
-func (m *QueryClaimableComputingPowerRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryClaimableComputingPowerRequest) ProtoMessage()    {}
-func (*QueryClaimableComputingPowerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{22}
+func (m *QueryReportProgressRequest) Reset()         { *m = QueryReportProgressRequest{} }
+func (m *QueryReportProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryReportProgressRequest) ProtoMessage()    {}
+func (*QueryReportProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{24}
 }
-func (m *QueryClaimableComputingPowerRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryReportProgressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryClaimableComputingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReportProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryClaimableComputingPowerRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReportProgressRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1142,43 +1253,53 @@ func (m *QueryClaimableComputingPowerRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryClaimableComputingPowerRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryClaimableComputingPowerRequest.Merge(m, src)
+func (m *QueryReportProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportProgressRequest.Merge(m, src)
 }
-func (m *QueryClaimableComputingPowerRequest) XXX_Size() int {
+func (m *QueryReportProgressRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryClaimableComputingPowerRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryClaimableComputingPowerRequest.DiscardUnknown(m)
+func (m *QueryReportProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportProgressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryClaimableComputingPowerRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryReportProgressRequest proto.InternalMessageInfo
 
-func (m *QueryClaimableComputingPowerRequest) GetOwner() string {
+func (m *QueryReportProgressRequest) GetEpoch() uint64 {
 	if m != nil {
-		return m.Owner
+		return m.Epoch
 	}
-	return ""
-}
-
-// QueryClaimableComputingPowerResponse
-type QueryClaimableComputingPowerResponse struct {
-	// claimable_computing_power
-	ClaimableComputingPower uint64 `protobuf:"varint,1,opt,name=claimable_computing_power,json=claimableComputingPower,proto3" json:"claimable_computing_power,omitempty"`
+	return 0
 }
 
-func (m *QueryClaimableComputingPowerResponse) Reset()         { *m = QueryClaimableComputingPowerResponse{} }
-func (m *QueryClaimableComputingPowerResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryClaimableComputingPowerResponse) ProtoMessage()    {}
-func (*QueryClaimableComputingPowerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93d1b070fd3dd5cc, []int{23}
-}
-func (m *QueryClaimableComputingPowerResponse) XXX_Unmarshal(b []byte) error {
+// QueryReportProgressResponse is the response type for the Query/ReportProgress RPC method
+type QueryReportProgressResponse struct {
+	// epoch
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// digest is empty until the digest of the epoch is committed
+	Digest *ReportDigest `protobuf:"bytes,2,opt,name=digest,proto3" json:"digest,omitempty"`
+	// stand_by is false once the digest is executed and the epoch is busy
+	StandBy bool `protobuf:"varint,3,opt,name=stand_by,json=standBy,proto3" json:"stand_by,omitempty"`
+	// epoch_emission is set once the epoch is busy
+	EpochEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=epoch_emission,json=epochEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_emission"`
+	// batches are the emission batches committed on the epoch
+	Batches []BatchBase `protobuf:"bytes,5,rep,name=batches,proto3" json:"batches"`
+	// ended is true once the end report of the epoch is committed
+	Ended bool `protobuf:"varint,6,opt,name=ended,proto3" json:"ended,omitempty"`
+}
+
+func (m *QueryReportProgressResponse) Reset()         { *m = QueryReportProgressResponse{} }
+func (m *QueryReportProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryReportProgressResponse) ProtoMessage()    {}
+func (*QueryReportProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{25}
+}
+func (m *QueryReportProgressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryClaimableComputingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryReportProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryClaimableComputingPowerResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryReportProgressResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1188,1110 +1309,1304 @@ func (m *QueryClaimableComputingPowerResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryClaimableComputingPowerResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryClaimableComputingPowerResponse.Merge(m, src)
+func (m *QueryReportProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryReportProgressResponse.Merge(m, src)
 }
-func (m *QueryClaimableComputingPowerResponse) XXX_Size() int {
+func (m *QueryReportProgressResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryClaimableComputingPowerResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryClaimableComputingPowerResponse.DiscardUnknown(m)
+func (m *QueryReportProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryReportProgressResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryClaimableComputingPowerResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryReportProgressResponse proto.InternalMessageInfo
 
-func (m *QueryClaimableComputingPowerResponse) GetClaimableComputingPower() uint64 {
+func (m *QueryReportProgressResponse) GetEpoch() uint64 {
 	if m != nil {
-		return m.ClaimableComputingPower
+		return m.Epoch
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "tabi.captains.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "tabi.captains.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryNodeRequest)(nil), "tabi.captains.v1.QueryNodeRequest")
-	proto.RegisterType((*QueryNodeResponse)(nil), "tabi.captains.v1.QueryNodeResponse")
-	proto.RegisterType((*QueryNodesRequest)(nil), "tabi.captains.v1.QueryNodesRequest")
-	proto.RegisterType((*QueryNodesResponse)(nil), "tabi.captains.v1.QueryNodesResponse")
-	proto.RegisterType((*QueryDivisionRequest)(nil), "tabi.captains.v1.QueryDivisionRequest")
-	proto.RegisterType((*QueryDivisionResponse)(nil), "tabi.captains.v1.QueryDivisionResponse")
-	proto.RegisterType((*QueryDivisionsRequest)(nil), "tabi.captains.v1.QueryDivisionsRequest")
-	proto.RegisterType((*QueryDivisionsResponse)(nil), "tabi.captains.v1.QueryDivisionsResponse")
-	proto.RegisterType((*QuerySupplyRequest)(nil), "tabi.captains.v1.QuerySupplyRequest")
-	proto.RegisterType((*QuerySupplyResponse)(nil), "tabi.captains.v1.QuerySupplyResponse")
-	proto.RegisterType((*QuerySaleLevelRequest)(nil), "tabi.captains.v1.QuerySaleLevelRequest")
-	proto.RegisterType((*QuerySaleLevelResponse)(nil), "tabi.captains.v1.QuerySaleLevelResponse")
-	proto.RegisterType((*QueryAuthorizedMembersRequest)(nil), "tabi.captains.v1.QueryAuthorizedMembersRequest")
-	proto.RegisterType((*QueryAuthorizedMembersResponse)(nil), "tabi.captains.v1.QueryAuthorizedMembersResponse")
-	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "tabi.captains.v1.QueryCurrentEpochRequest")
-	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "tabi.captains.v1.QueryCurrentEpochResponse")
-	proto.RegisterType((*QueryNodeLastEpochInfoRequest)(nil), "tabi.captains.v1.QueryNodeLastEpochInfoRequest")
-	proto.RegisterType((*QueryNodeLastEpochInfoResponse)(nil), "tabi.captains.v1.QueryNodeLastEpochInfoResponse")
-	proto.RegisterType((*QueryEpochStatusRequest)(nil), "tabi.captains.v1.QueryEpochStatusRequest")
-	proto.RegisterType((*QueryEpochStatusResponse)(nil), "tabi.captains.v1.QueryEpochStatusResponse")
-	proto.RegisterType((*QueryClaimableComputingPowerRequest)(nil), "tabi.captains.v1.QueryClaimableComputingPowerRequest")
-	proto.RegisterType((*QueryClaimableComputingPowerResponse)(nil), "tabi.captains.v1.QueryClaimableComputingPowerResponse")
+func (m *QueryReportProgressResponse) GetDigest() *ReportDigest {
+	if m != nil {
+		return m.Digest
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("tabi/captains/v1/query.proto", fileDescriptor_93d1b070fd3dd5cc) }
-
-var fileDescriptor_93d1b070fd3dd5cc = []byte{
-	// 1283 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x51, 0x6f, 0xdb, 0x54,
-	0x14, 0xae, 0xb7, 0x24, 0x5b, 0x4e, 0xb7, 0xb1, 0xde, 0x65, 0x4d, 0xea, 0xb5, 0xe9, 0xe6, 0x74,
-	0x6d, 0xd7, 0x29, 0xf6, 0x3a, 0xd8, 0x86, 0x86, 0x04, 0xda, 0xba, 0x81, 0x2a, 0x6d, 0x50, 0xd2,
-	0x37, 0x5e, 0xa2, 0x9b, 0xe4, 0xe2, 0x58, 0x72, 0x7c, 0x3d, 0xdb, 0x69, 0x29, 0xd3, 0x24, 0xd8,
-	0x1b, 0x9a, 0x90, 0x40, 0xfc, 0x82, 0xfd, 0x01, 0x84, 0x10, 0x4f, 0xfc, 0x82, 0x3d, 0x4e, 0xf0,
-	0xc2, 0x13, 0x42, 0x2d, 0xff, 0x82, 0x17, 0xe4, 0x7b, 0x8f, 0x1d, 0xc7, 0x89, 0x93, 0x32, 0xf1,
-	0xd6, 0x7b, 0xef, 0x77, 0xce, 0xf7, 0x9d, 0x73, 0xee, 0xf5, 0x97, 0xc2, 0x62, 0x40, 0x5b, 0x96,
-	0xd1, 0xa6, 0x6e, 0x40, 0x2d, 0xc7, 0x37, 0xf6, 0x36, 0x8d, 0x27, 0x7d, 0xe6, 0x1d, 0xe8, 0xae,
-	0xc7, 0x03, 0x4e, 0xce, 0x87, 0xa7, 0x7a, 0x74, 0xaa, 0xef, 0x6d, 0xaa, 0x25, 0x93, 0x9b, 0x5c,
-	0x1c, 0x1a, 0xe1, 0x5f, 0x12, 0xa7, 0x2e, 0x9a, 0x9c, 0x9b, 0x36, 0x33, 0xa8, 0x6b, 0x19, 0xd4,
-	0x71, 0x78, 0x40, 0x03, 0x8b, 0x3b, 0x3e, 0x9e, 0x2e, 0xb4, 0xb9, 0xdf, 0xe3, 0x7e, 0x53, 0x86,
-	0xc9, 0x05, 0x1e, 0x6d, 0xc8, 0x95, 0xd1, 0xa2, 0x3e, 0x93, 0xcc, 0xc6, 0xde, 0x66, 0x8b, 0x05,
-	0x74, 0xd3, 0x70, 0xa9, 0x69, 0x39, 0x22, 0x0f, 0x62, 0x97, 0x47, 0xa4, 0xc6, 0xc2, 0x04, 0x40,
-	0x2b, 0x01, 0xf9, 0x34, 0x4c, 0xb1, 0x43, 0x3d, 0xda, 0xf3, 0x1b, 0xec, 0x49, 0x9f, 0xf9, 0x81,
-	0xf6, 0x18, 0x2e, 0x0c, 0xed, 0xfa, 0x2e, 0x77, 0x7c, 0x46, 0x6e, 0x43, 0xc1, 0x15, 0x3b, 0x15,
-	0xe5, 0xb2, 0xb2, 0x3e, 0x7b, 0xb3, 0xa2, 0xa7, 0x6b, 0xd5, 0x65, 0xc4, 0xfd, 0xdc, 0xab, 0x3f,
-	0x97, 0x67, 0x1a, 0x88, 0xd6, 0xae, 0xc3, 0x79, 0x91, 0xee, 0x63, 0xde, 0x61, 0x48, 0x41, 0xca,
-	0x70, 0xca, 0xe1, 0x1d, 0xd6, 0xb4, 0x3a, 0x22, 0x59, 0xb1, 0x51, 0x08, 0x97, 0xdb, 0x1d, 0xed,
-	0x03, 0x98, 0x4b, 0x80, 0x91, 0x79, 0x03, 0x72, 0xe1, 0x31, 0xf2, 0xce, 0x8f, 0xf2, 0x0a, 0xb4,
-	0xc0, 0x68, 0x2f, 0x94, 0x44, 0x86, 0xa8, 0x24, 0xa2, 0x43, 0x9e, 0xef, 0x3b, 0xcc, 0x93, 0x6c,
-	0xf7, 0x2b, 0xbf, 0xfd, 0x52, 0x2f, 0x61, 0x5b, 0xef, 0x75, 0x3a, 0x1e, 0xf3, 0xfd, 0xdd, 0xc0,
-	0xb3, 0x1c, 0xb3, 0x21, 0x61, 0xe4, 0x43, 0x80, 0x41, 0x37, 0x2b, 0x27, 0x04, 0xef, 0xaa, 0x8e,
-	0x11, 0x61, 0xeb, 0x75, 0x39, 0x74, 0x6c, 0xbd, 0xbe, 0x43, 0xcd, 0xa8, 0xb6, 0x46, 0x22, 0x52,
-	0xfb, 0x5e, 0xc1, 0x0e, 0xa3, 0x1a, 0x2c, 0xe8, 0x26, 0xe4, 0x43, 0xb1, 0x61, 0x27, 0x4f, 0x66,
-	0x57, 0x84, 0x7d, 0x94, 0x50, 0xf2, 0xd1, 0x18, 0x49, 0x6b, 0x53, 0x25, 0x49, 0xc2, 0x21, 0x4d,
-	0x77, 0xa0, 0x24, 0x24, 0x3d, 0xb0, 0xf6, 0x2c, 0xdf, 0xe2, 0x4e, 0xd4, 0xa3, 0x65, 0x98, 0xed,
-	0xe0, 0xd6, 0x60, 0x2e, 0x10, 0x6d, 0x6d, 0x77, 0xb4, 0x4f, 0xe0, 0x62, 0x2a, 0x30, 0xbe, 0x19,
-	0xa7, 0x23, 0x18, 0xce, 0x48, 0x1d, 0xad, 0x28, 0x8e, 0x8a, 0xb1, 0x5a, 0x33, 0x95, 0x30, 0x1e,
-	0xd7, 0x70, 0xfb, 0x95, 0x37, 0x6e, 0xff, 0x4b, 0x05, 0xe6, 0xd3, 0x0c, 0xa8, 0xf9, 0x7d, 0x28,
-	0x46, 0x3a, 0xa2, 0x31, 0x4c, 0x10, 0x8d, 0xa3, 0x18, 0x84, 0xfc, 0x7f, 0xe3, 0xb8, 0x85, 0x37,
-	0x64, 0xb7, 0xef, 0xba, 0xf6, 0xc1, 0xb1, 0x87, 0x51, 0xc7, 0x47, 0x1a, 0x85, 0x61, 0x59, 0xf3,
-	0x50, 0xa0, 0x3d, 0xde, 0x77, 0x02, 0x11, 0x92, 0x6b, 0xe0, 0x4a, 0x2b, 0x63, 0xab, 0x77, 0xa9,
-	0xcd, 0x1e, 0xb1, 0x3d, 0x66, 0x47, 0x8f, 0xfd, 0x0e, 0x76, 0x28, 0x71, 0x80, 0xa9, 0x96, 0x00,
-	0x7c, 0x6a, 0xb3, 0xa6, 0x1d, 0xee, 0x62, 0xba, 0xa2, 0x1f, 0xc1, 0xb4, 0x65, 0x58, 0x12, 0x81,
-	0xf7, 0xfa, 0x41, 0x97, 0x7b, 0xd6, 0x97, 0xac, 0xf3, 0x98, 0xf5, 0x5a, 0xcc, 0x8b, 0x3f, 0x23,
-	0x77, 0xa1, 0x9a, 0x05, 0x40, 0x86, 0x0a, 0x9c, 0xea, 0xc9, 0x2d, 0x31, 0x81, 0x62, 0x23, 0x5a,
-	0x6a, 0x2a, 0x54, 0x44, 0xec, 0x56, 0xdf, 0xf3, 0x98, 0x13, 0x3c, 0x74, 0x79, 0xbb, 0x1b, 0xe5,
-	0xdd, 0x86, 0x85, 0x31, 0x67, 0x98, 0xb2, 0x04, 0x79, 0x16, 0x6e, 0xa0, 0x5e, 0xb9, 0x08, 0xbb,
-	0xd2, 0x65, 0x96, 0xd9, 0x0d, 0xc4, 0xa0, 0x72, 0x0d, 0x5c, 0x69, 0xef, 0x62, 0x0d, 0xe1, 0x6b,
-	0x7b, 0x44, 0x7d, 0x99, 0x6b, 0xdb, 0xf9, 0x9c, 0x4f, 0xfd, 0x4e, 0xfd, 0xa3, 0x60, 0x75, 0x63,
-	0x42, 0xdf, 0x44, 0x0a, 0xd1, 0xe1, 0x82, 0x4d, 0xfd, 0xa0, 0x29, 0x50, 0x4d, 0xd6, 0xb3, 0x7c,
-	0xf1, 0x9c, 0x4e, 0x0a, 0xd6, 0x39, 0x3b, 0x62, 0x78, 0x88, 0x07, 0xc4, 0x80, 0x0b, 0x5d, 0xcb,
-	0x0f, 0xb8, 0x67, 0xb5, 0xa9, 0x3d, 0xc0, 0xe7, 0x04, 0x9e, 0x0c, 0x8e, 0xe2, 0x80, 0x2b, 0x70,
-	0xc6, 0xb5, 0x59, 0xc7, 0x64, 0x4d, 0x2f, 0xbc, 0x78, 0x95, 0xbc, 0x40, 0xce, 0xca, 0xbd, 0x46,
-	0xb8, 0x45, 0xd6, 0xe0, 0xad, 0x36, 0xef, 0xb9, 0xfd, 0xc0, 0x72, 0xcc, 0xa6, 0xcb, 0xf7, 0x99,
-	0x57, 0x29, 0x08, 0xd4, 0xb9, 0x78, 0x7b, 0x27, 0xdc, 0xd5, 0x0c, 0x28, 0x8b, 0xe2, 0x85, 0xa4,
-	0xdd, 0x80, 0x06, 0xfd, 0xf8, 0xe9, 0x8e, 0xad, 0x5a, 0xfb, 0x59, 0xc1, 0x81, 0x0e, 0x45, 0x4c,
-	0x6c, 0xd4, 0x3b, 0x30, 0x6f, 0xda, 0xbc, 0x45, 0xed, 0x66, 0x5a, 0xd3, 0x09, 0xa1, 0xa9, 0x24,
-	0x4f, 0xb7, 0x86, 0x94, 0x91, 0x1a, 0x9c, 0xf5, 0x98, 0xcb, 0xbd, 0xa0, 0xd9, 0xb1, 0x4c, 0xe6,
-	0x07, 0xd8, 0xc0, 0x33, 0x72, 0xf3, 0x81, 0xd8, 0x23, 0x57, 0xe1, 0x5c, 0xaa, 0xcd, 0xb2, 0x6d,
-	0x67, 0x59, 0xb2, 0xc5, 0xda, 0x7b, 0x50, 0x93, 0x17, 0xcd, 0xa6, 0x56, 0x8f, 0xb6, 0x6c, 0x36,
-	0xcc, 0x95, 0xa8, 0x38, 0xe1, 0x2d, 0xe8, 0x20, 0x5a, 0x0b, 0x56, 0x26, 0x07, 0x63, 0xf1, 0x77,
-	0x61, 0xa1, 0x1d, 0x41, 0x46, 0x2a, 0x95, 0x0d, 0x29, 0xb7, 0xc7, 0xe7, 0xb8, 0xf9, 0xd3, 0x59,
-	0xc8, 0x0b, 0x12, 0x12, 0x40, 0x41, 0x7a, 0x2f, 0x59, 0x19, 0xfd, 0x88, 0x8d, 0x5a, 0xbc, 0x7a,
-	0x75, 0x0a, 0x4a, 0x8a, 0xd3, 0x96, 0x9e, 0xff, 0xfe, 0xf7, 0x0f, 0x27, 0xca, 0xe4, 0xa2, 0xf1,
-	0xc5, 0xd0, 0xcf, 0x08, 0xe9, 0xec, 0x64, 0x1f, 0x72, 0xe1, 0xf5, 0x27, 0x5a, 0x46, 0xb6, 0x84,
-	0xe3, 0xab, 0xb5, 0x89, 0x18, 0xe4, 0x5b, 0x15, 0x7c, 0x97, 0x49, 0x35, 0xc5, 0x27, 0x1c, 0xd0,
-	0x78, 0x8a, 0x4f, 0xf1, 0x19, 0x71, 0x21, 0x2f, 0x0c, 0x95, 0x4c, 0xca, 0x1a, 0x17, 0xbb, 0x32,
-	0x19, 0x84, 0xdc, 0x8b, 0x82, 0x7b, 0x9e, 0x94, 0xc6, 0x71, 0x93, 0x1f, 0x15, 0x98, 0x1b, 0x79,
-	0xea, 0xc4, 0x98, 0x90, 0x79, 0xdc, 0xf7, 0x44, 0xbd, 0x71, 0xfc, 0x00, 0x94, 0x75, 0x5b, 0xc8,
-	0xba, 0x41, 0xf4, 0xc9, 0x2d, 0x31, 0xc2, 0x2f, 0x44, 0x5d, 0xdc, 0xdf, 0xba, 0x15, 0x4a, 0xfb,
-	0x4a, 0x81, 0x62, 0xec, 0x7a, 0x64, 0x2d, 0x83, 0x37, 0xed, 0xbc, 0xea, 0xfa, 0x74, 0x20, 0x0a,
-	0xbb, 0x2c, 0x84, 0xa9, 0xa4, 0x92, 0x12, 0x36, 0xb0, 0xc8, 0x17, 0x0a, 0x9c, 0x8e, 0xe2, 0xc8,
-	0xea, 0x94, 0xc4, 0x91, 0x80, 0xb5, 0xa9, 0x38, 0xe4, 0xd7, 0x05, 0xff, 0x3a, 0x59, 0xcd, 0xe2,
-	0x37, 0x9e, 0x26, 0x1c, 0xf4, 0x19, 0x79, 0xae, 0x40, 0x41, 0x9a, 0x65, 0xe6, 0x1b, 0x19, 0xb2,
-	0xe0, 0xcc, 0x37, 0x32, 0xec, 0xb8, 0xda, 0x75, 0xa1, 0xe3, 0x2a, 0xa9, 0xa5, 0x74, 0xf8, 0x02,
-	0x96, 0x12, 0xf1, 0xb5, 0x02, 0xc5, 0xd8, 0x69, 0x33, 0xa7, 0x92, 0x36, 0xe9, 0xcc, 0xa9, 0x8c,
-	0x98, 0xb6, 0x76, 0x45, 0xa8, 0xb9, 0x44, 0x16, 0xd2, 0x6a, 0xa8, 0xcd, 0xea, 0xc2, 0xc9, 0xc9,
-	0x4b, 0x05, 0xe6, 0x46, 0x3c, 0x39, 0xf3, 0x2a, 0x67, 0xd9, 0x7b, 0xe6, 0x55, 0xce, 0xb4, 0x7b,
-	0xed, 0x9a, 0xd0, 0x56, 0x23, 0x57, 0x52, 0xda, 0x68, 0x1c, 0x51, 0x47, 0xff, 0x27, 0xdf, 0x2a,
-	0x70, 0x26, 0xe9, 0xef, 0x64, 0x23, 0x83, 0x6d, 0xcc, 0x0f, 0x04, 0xf5, 0xfa, 0xb1, 0xb0, 0x28,
-	0x6a, 0x45, 0x88, 0xaa, 0x92, 0xc5, 0x94, 0xa8, 0xb6, 0x04, 0xcb, 0x27, 0x45, 0xbe, 0x51, 0x60,
-	0x36, 0x61, 0x5d, 0xe4, 0x5a, 0x06, 0xc5, 0xa8, 0x21, 0xaa, 0x1b, 0xc7, 0x81, 0xa2, 0x98, 0x9a,
-	0x10, 0xb3, 0x44, 0x2e, 0xa5, 0xc4, 0xc8, 0x77, 0xed, 0x4b, 0xee, 0x5f, 0x15, 0x28, 0x67, 0xb8,
-	0x0a, 0xb9, 0x95, 0x55, 0xfa, 0x44, 0x0b, 0x53, 0x6f, 0xff, 0xd7, 0x30, 0xd4, 0x7b, 0x43, 0xe8,
-	0xdd, 0x20, 0xeb, 0xe9, 0xe6, 0x45, 0x71, 0xf5, 0xd8, 0xd1, 0xea, 0xc2, 0xd1, 0xee, 0x6f, 0xbd,
-	0x3a, 0xac, 0x2a, 0xaf, 0x0f, 0xab, 0xca, 0x5f, 0x87, 0x55, 0xe5, 0xbb, 0xa3, 0xea, 0xcc, 0xeb,
-	0xa3, 0xea, 0xcc, 0x1f, 0x47, 0xd5, 0x99, 0xcf, 0xae, 0x99, 0x56, 0xd0, 0xed, 0xb7, 0xf4, 0x36,
-	0xef, 0x19, 0xa1, 0x1a, 0x9b, 0xb6, 0x7c, 0xf1, 0x47, 0x32, 0x77, 0x70, 0xe0, 0x32, 0xbf, 0x55,
-	0x10, 0xff, 0xbd, 0xbe, 0xfd, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xc8, 0x04, 0x0b, 0x8b,
-	0x0f, 0x00, 0x00,
+func (m *QueryReportProgressResponse) GetStandBy() bool {
+	if m != nil {
+		return m.StandBy
+	}
+	return false
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the captains module parameters
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Node queries an Node based on its id.
-	Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error)
-	// Nodes queries all node of a given owner
-	Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error)
-	// NodeLastEpochInfo queries the node last epoch emission, historical emission and pledge ratio.
-	NodeLastEpochInfo(ctx context.Context, in *QueryNodeLastEpochInfoRequest, opts ...grpc.CallOption) (*QueryNodeLastEpochInfoResponse, error)
-	// Divisions queries all Node divisions
-	Divisions(ctx context.Context, in *QueryDivisionsRequest, opts ...grpc.CallOption) (*QueryDivisionsResponse, error)
-	// Division queries a division by its id.
-	Division(ctx context.Context, in *QueryDivisionRequest, opts ...grpc.CallOption) (*QueryDivisionResponse, error)
-	// Supply queries the number of nodes under the division
-	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
-	// SaleLevel queries current sale level
-	SaleLevel(ctx context.Context, in *QuerySaleLevelRequest, opts ...grpc.CallOption) (*QuerySaleLevelResponse, error)
-	// AuthorizedMembers queries all the callers
-	AuthorizedMembers(ctx context.Context, in *QueryAuthorizedMembersRequest, opts ...grpc.CallOption) (*QueryAuthorizedMembersResponse, error)
-	// CurrentEpoch queries the current epoch as well as its height;
-	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
-	// EpochStatus queries the current epoch status
-	EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error)
-	// ClaimableComputingPower queries the claimable computing power of an address
-	ClaimableComputingPower(ctx context.Context, in *QueryClaimableComputingPowerRequest, opts ...grpc.CallOption) (*QueryClaimableComputingPowerResponse, error)
+func (m *QueryReportProgressResponse) GetBatches() []BatchBase {
+	if m != nil {
+		return m.Batches
+	}
+	return nil
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryReportProgressResponse) GetEnded() bool {
+	if m != nil {
+		return m.Ended
+	}
+	return false
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryScheduleRequest is the request type for the Query/Schedule RPC method
+type QueryScheduleRequest struct {
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleRequest) Reset()         { *m = QueryScheduleRequest{} }
+func (m *QueryScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleRequest) ProtoMessage()    {}
+func (*QueryScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{26}
+}
+func (m *QueryScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleRequest.Merge(m, src)
+}
+func (m *QueryScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error) {
-	out := new(QueryNodeResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Node", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryScheduleRequest proto.InternalMessageInfo
+
+// QueryScheduleResponse is the response type for the Query/Schedule RPC method
+type QueryScheduleResponse struct {
+	// current_epoch
+	CurrentEpoch uint64 `protobuf:"varint,1,opt,name=current_epoch,json=currentEpoch,proto3" json:"current_epoch,omitempty"`
+	// current_sale_level
+	CurrentSaleLevel uint64 `protobuf:"varint,2,opt,name=current_sale_level,json=currentSaleLevel,proto3" json:"current_sale_level,omitempty"`
+	// current_halving_era_coefficient
+	CurrentHalvingEraCoefficient github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=current_halving_era_coefficient,json=currentHalvingEraCoefficient,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_halving_era_coefficient"`
+	// sale_levels is the sale level timeline ordered by sale level
+	SaleLevels []SaleLevelMilestoneStatus `protobuf:"bytes,4,rep,name=sale_levels,json=saleLevels,proto3" json:"sale_levels"`
+	// halving_eras is the halving era timeline ordered by epoch
+	HalvingEras []HalvingEraStatus `protobuf:"bytes,5,rep,name=halving_eras,json=halvingEras,proto3" json:"halving_eras"`
+}
+
+func (m *QueryScheduleResponse) Reset()         { *m = QueryScheduleResponse{} }
+func (m *QueryScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleResponse) ProtoMessage()    {}
+func (*QueryScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{27}
+}
+func (m *QueryScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleResponse.Merge(m, src)
+}
+func (m *QueryScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error) {
-	out := new(QueryNodesResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Nodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleResponse) GetCurrentEpoch() uint64 {
+	if m != nil {
+		return m.CurrentEpoch
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) NodeLastEpochInfo(ctx context.Context, in *QueryNodeLastEpochInfoRequest, opts ...grpc.CallOption) (*QueryNodeLastEpochInfoResponse, error) {
-	out := new(QueryNodeLastEpochInfoResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/NodeLastEpochInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleResponse) GetCurrentSaleLevel() uint64 {
+	if m != nil {
+		return m.CurrentSaleLevel
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) Divisions(ctx context.Context, in *QueryDivisionsRequest, opts ...grpc.CallOption) (*QueryDivisionsResponse, error) {
-	out := new(QueryDivisionsResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Divisions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleResponse) GetSaleLevels() []SaleLevelMilestoneStatus {
+	if m != nil {
+		return m.SaleLevels
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Division(ctx context.Context, in *QueryDivisionRequest, opts ...grpc.CallOption) (*QueryDivisionResponse, error) {
-	out := new(QueryDivisionResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Division", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryScheduleResponse) GetHalvingEras() []HalvingEraStatus {
+	if m != nil {
+		return m.HalvingEras
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
-	out := new(QuerySupplyResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Supply", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryEmissionForecastRequest is the request type for the Query/EmissionForecast RPC method
+type QueryEmissionForecastRequest struct {
+	// node_id is the node to forecast
+	NodeId string `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// power_on_ratio is the hypothetical power-on ratio of the node, defaults to 1
+	PowerOnRatio string `protobuf:"bytes,2,opt,name=power_on_ratio,json=powerOnRatio,proto3" json:"power_on_ratio,omitempty"`
+	// pledge_amount is the hypothetical pledge of the node owner, defaults to the sampled pledge
+	PledgeAmount string `protobuf:"bytes,3,opt,name=pledge_amount,json=pledgeAmount,proto3" json:"pledge_amount,omitempty"`
+	// extra_computing_power is the hypothetical computing power added to the node
+	ExtraComputingPower uint64 `protobuf:"varint,4,opt,name=extra_computing_power,json=extraComputingPower,proto3" json:"extra_computing_power,omitempty"`
+	// sale_level is the hypothetical sale level, defaults to the current sale level
+	SaleLevel uint64 `protobuf:"varint,5,opt,name=sale_level,json=saleLevel,proto3" json:"sale_level,omitempty"`
+	// global_on_operation_ratio is the hypothetical global on-operation ratio, defaults to 1
+	GlobalOnOperationRatio string `protobuf:"bytes,6,opt,name=global_on_operation_ratio,json=globalOnOperationRatio,proto3" json:"global_on_operation_ratio,omitempty"`
+	// epochs is the number of epochs to forecast, defaults to 1
+	Epochs uint64 `protobuf:"varint,7,opt,name=epochs,proto3" json:"epochs,omitempty"`
+}
+
+func (m *QueryEmissionForecastRequest) Reset()         { *m = QueryEmissionForecastRequest{} }
+func (m *QueryEmissionForecastRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionForecastRequest) ProtoMessage()    {}
+func (*QueryEmissionForecastRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{28}
+}
+func (m *QueryEmissionForecastRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEmissionForecastRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionForecastRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryEmissionForecastRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionForecastRequest.Merge(m, src)
+}
+func (m *QueryEmissionForecastRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEmissionForecastRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionForecastRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) SaleLevel(ctx context.Context, in *QuerySaleLevelRequest, opts ...grpc.CallOption) (*QuerySaleLevelResponse, error) {
-	out := new(QuerySaleLevelResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/SaleLevel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryEmissionForecastRequest proto.InternalMessageInfo
+
+func (m *QueryEmissionForecastRequest) GetNodeId() string {
+	if m != nil {
+		return m.NodeId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) AuthorizedMembers(ctx context.Context, in *QueryAuthorizedMembersRequest, opts ...grpc.CallOption) (*QueryAuthorizedMembersResponse, error) {
-	out := new(QueryAuthorizedMembersResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/AuthorizedMembers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEmissionForecastRequest) GetPowerOnRatio() string {
+	if m != nil {
+		return m.PowerOnRatio
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
-	out := new(QueryCurrentEpochResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/CurrentEpoch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEmissionForecastRequest) GetPledgeAmount() string {
+	if m != nil {
+		return m.PledgeAmount
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error) {
-	out := new(QueryEpochStatusResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/EpochStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEmissionForecastRequest) GetExtraComputingPower() uint64 {
+	if m != nil {
+		return m.ExtraComputingPower
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ClaimableComputingPower(ctx context.Context, in *QueryClaimableComputingPowerRequest, opts ...grpc.CallOption) (*QueryClaimableComputingPowerResponse, error) {
-	out := new(QueryClaimableComputingPowerResponse)
-	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/ClaimableComputingPower", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryEmissionForecastRequest) GetSaleLevel() uint64 {
+	if m != nil {
+		return m.SaleLevel
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the captains module parameters
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Node queries an Node based on its id.
-	Node(context.Context, *QueryNodeRequest) (*QueryNodeResponse, error)
-	// Nodes queries all node of a given owner
-	Nodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error)
-	// NodeLastEpochInfo queries the node last epoch emission, historical emission and pledge ratio.
-	NodeLastEpochInfo(context.Context, *QueryNodeLastEpochInfoRequest) (*QueryNodeLastEpochInfoResponse, error)
-	// Divisions queries all Node divisions
-	Divisions(context.Context, *QueryDivisionsRequest) (*QueryDivisionsResponse, error)
-	// Division queries a division by its id.
-	Division(context.Context, *QueryDivisionRequest) (*QueryDivisionResponse, error)
-	// Supply queries the number of nodes under the division
-	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
-	// SaleLevel queries current sale level
-	SaleLevel(context.Context, *QuerySaleLevelRequest) (*QuerySaleLevelResponse, error)
-	// AuthorizedMembers queries all the callers
-	AuthorizedMembers(context.Context, *QueryAuthorizedMembersRequest) (*QueryAuthorizedMembersResponse, error)
-	// CurrentEpoch queries the current epoch as well as its height;
-	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
-	// EpochStatus queries the current epoch status
-	EpochStatus(context.Context, *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error)
-	// ClaimableComputingPower queries the claimable computing power of an address
-	ClaimableComputingPower(context.Context, *QueryClaimableComputingPowerRequest) (*QueryClaimableComputingPowerResponse, error)
+func (m *QueryEmissionForecastRequest) GetGlobalOnOperationRatio() string {
+	if m != nil {
+		return m.GlobalOnOperationRatio
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryEmissionForecastRequest) GetEpochs() uint64 {
+	if m != nil {
+		return m.Epochs
+	}
+	return 0
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryEmissionForecastResponse is the response type for the Query/EmissionForecast RPC method
+type QueryEmissionForecastResponse struct {
+	// forecasts is the projection of each epoch
+	Forecasts []EpochEmissionForecast `protobuf:"bytes,1,rep,name=forecasts,proto3" json:"forecasts"`
+	// total_node_emission is the projected node emission of all epochs
+	TotalNodeEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=total_node_emission,json=totalNodeEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_node_emission"`
 }
-func (*UnimplementedQueryServer) Node(ctx context.Context, req *QueryNodeRequest) (*QueryNodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Node not implemented")
+
+func (m *QueryEmissionForecastResponse) Reset()         { *m = QueryEmissionForecastResponse{} }
+func (m *QueryEmissionForecastResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEmissionForecastResponse) ProtoMessage()    {}
+func (*QueryEmissionForecastResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{29}
 }
-func (*UnimplementedQueryServer) Nodes(ctx context.Context, req *QueryNodesRequest) (*QueryNodesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Nodes not implemented")
+func (m *QueryEmissionForecastResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) NodeLastEpochInfo(ctx context.Context, req *QueryNodeLastEpochInfoRequest) (*QueryNodeLastEpochInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NodeLastEpochInfo not implemented")
+func (m *QueryEmissionForecastResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEmissionForecastResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Divisions(ctx context.Context, req *QueryDivisionsRequest) (*QueryDivisionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Divisions not implemented")
+func (m *QueryEmissionForecastResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEmissionForecastResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Division(ctx context.Context, req *QueryDivisionRequest) (*QueryDivisionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Division not implemented")
+func (m *QueryEmissionForecastResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyRequest) (*QuerySupplyResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+func (m *QueryEmissionForecastResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEmissionForecastResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) SaleLevel(ctx context.Context, req *QuerySaleLevelRequest) (*QuerySaleLevelResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SaleLevel not implemented")
+
+var xxx_messageInfo_QueryEmissionForecastResponse proto.InternalMessageInfo
+
+func (m *QueryEmissionForecastResponse) GetForecasts() []EpochEmissionForecast {
+	if m != nil {
+		return m.Forecasts
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) AuthorizedMembers(ctx context.Context, req *QueryAuthorizedMembersRequest) (*QueryAuthorizedMembersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedMembers not implemented")
+
+// EpochEmissionForecast is the projected emission of a node on an epoch
+type EpochEmissionForecast struct {
+	// epoch_id
+	EpochId uint64 `protobuf:"varint,1,opt,name=epoch_id,json=epochId,proto3" json:"epoch_id,omitempty"`
+	// node_computing_power is the projected computing power of the node
+	NodeComputingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=node_computing_power,json=nodeComputingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"node_computing_power"`
+	// global_computing_power is the projected computing power of all nodes
+	GlobalComputingPower github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=global_computing_power,json=globalComputingPower,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"global_computing_power"`
+	// emission_share is the projected share of the node in the epoch emission
+	EmissionShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=emission_share,json=emissionShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_share"`
+	// epoch_emission is the projected emission of the epoch
+	EpochEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=epoch_emission,json=epochEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_emission"`
+	// node_emission is the projected emission of the node
+	NodeEmission github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=node_emission,json=nodeEmission,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"node_emission"`
+}
+
+func (m *EpochEmissionForecast) Reset()         { *m = EpochEmissionForecast{} }
+func (m *EpochEmissionForecast) String() string { return proto.CompactTextString(m) }
+func (*EpochEmissionForecast) ProtoMessage()    {}
+func (*EpochEmissionForecast) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{30}
+}
+func (m *EpochEmissionForecast) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+func (m *EpochEmissionForecast) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochEmissionForecast.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) EpochStatus(ctx context.Context, req *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EpochStatus not implemented")
+func (m *EpochEmissionForecast) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochEmissionForecast.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ClaimableComputingPower(ctx context.Context, req *QueryClaimableComputingPowerRequest) (*QueryClaimableComputingPowerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableComputingPower not implemented")
+func (m *EpochEmissionForecast) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *EpochEmissionForecast) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochEmissionForecast.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_EpochEmissionForecast proto.InternalMessageInfo
+
+func (m *EpochEmissionForecast) GetEpochId() uint64 {
+	if m != nil {
+		return m.EpochId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Node_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNodeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Node(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/Node",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Node(ctx, req.(*QueryNodeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryClaimableComputingPowerRequest
+type QueryClaimableComputingPowerRequest struct {
+	// owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 }
 
-func _Query_Nodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNodesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Nodes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/Nodes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Nodes(ctx, req.(*QueryNodesRequest))
+func (m *QueryClaimableComputingPowerRequest) Reset()         { *m = QueryClaimableComputingPowerRequest{} }
+func (m *QueryClaimableComputingPowerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableComputingPowerRequest) ProtoMessage()    {}
+func (*QueryClaimableComputingPowerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{31}
+}
+func (m *QueryClaimableComputingPowerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableComputingPowerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableComputingPowerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryClaimableComputingPowerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableComputingPowerRequest.Merge(m, src)
+}
+func (m *QueryClaimableComputingPowerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableComputingPowerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableComputingPowerRequest.DiscardUnknown(m)
 }
 
-func _Query_NodeLastEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryNodeLastEpochInfoRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).NodeLastEpochInfo(ctx, in)
+var xxx_messageInfo_QueryClaimableComputingPowerRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableComputingPowerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/NodeLastEpochInfo",
+	return ""
+}
+
+// QueryClaimableComputingPowerResponse
+type QueryClaimableComputingPowerResponse struct {
+	// claimable_computing_power
+	ClaimableComputingPower uint64 `protobuf:"varint,1,opt,name=claimable_computing_power,json=claimableComputingPower,proto3" json:"claimable_computing_power,omitempty"`
+}
+
+func (m *QueryClaimableComputingPowerResponse) Reset()         { *m = QueryClaimableComputingPowerResponse{} }
+func (m *QueryClaimableComputingPowerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableComputingPowerResponse) ProtoMessage()    {}
+func (*QueryClaimableComputingPowerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_93d1b070fd3dd5cc, []int{32}
+}
+func (m *QueryClaimableComputingPowerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableComputingPowerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableComputingPowerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).NodeLastEpochInfo(ctx, req.(*QueryNodeLastEpochInfoRequest))
+}
+func (m *QueryClaimableComputingPowerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableComputingPowerResponse.Merge(m, src)
+}
+func (m *QueryClaimableComputingPowerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableComputingPowerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableComputingPowerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableComputingPowerResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableComputingPowerResponse) GetClaimableComputingPower() uint64 {
+	if m != nil {
+		return m.ClaimableComputingPower
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Divisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDivisionsRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "tabi.captains.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "tabi.captains.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryNodeRequest)(nil), "tabi.captains.v1.QueryNodeRequest")
+	proto.RegisterType((*QueryNodeResponse)(nil), "tabi.captains.v1.QueryNodeResponse")
+	proto.RegisterType((*QueryNodesRequest)(nil), "tabi.captains.v1.QueryNodesRequest")
+	proto.RegisterType((*QueryNodesResponse)(nil), "tabi.captains.v1.QueryNodesResponse")
+	proto.RegisterType((*QueryNodeDivisionHistoryRequest)(nil), "tabi.captains.v1.QueryNodeDivisionHistoryRequest")
+	proto.RegisterType((*QueryNodeDivisionHistoryResponse)(nil), "tabi.captains.v1.QueryNodeDivisionHistoryResponse")
+	proto.RegisterType((*QueryDivisionRequest)(nil), "tabi.captains.v1.QueryDivisionRequest")
+	proto.RegisterType((*QueryDivisionResponse)(nil), "tabi.captains.v1.QueryDivisionResponse")
+	proto.RegisterType((*QueryDivisionsRequest)(nil), "tabi.captains.v1.QueryDivisionsRequest")
+	proto.RegisterType((*QueryDivisionsResponse)(nil), "tabi.captains.v1.QueryDivisionsResponse")
+	proto.RegisterType((*QuerySupplyRequest)(nil), "tabi.captains.v1.QuerySupplyRequest")
+	proto.RegisterType((*QuerySupplyResponse)(nil), "tabi.captains.v1.QuerySupplyResponse")
+	proto.RegisterType((*QuerySaleLevelRequest)(nil), "tabi.captains.v1.QuerySaleLevelRequest")
+	proto.RegisterType((*QuerySaleLevelResponse)(nil), "tabi.captains.v1.QuerySaleLevelResponse")
+	proto.RegisterType((*QueryAuthorizedMembersRequest)(nil), "tabi.captains.v1.QueryAuthorizedMembersRequest")
+	proto.RegisterType((*QueryAuthorizedMembersResponse)(nil), "tabi.captains.v1.QueryAuthorizedMembersResponse")
+	proto.RegisterType((*QueryCurrentEpochRequest)(nil), "tabi.captains.v1.QueryCurrentEpochRequest")
+	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "tabi.captains.v1.QueryCurrentEpochResponse")
+	proto.RegisterType((*QueryNodeLastEpochInfoRequest)(nil), "tabi.captains.v1.QueryNodeLastEpochInfoRequest")
+	proto.RegisterType((*QueryNodeLastEpochInfoResponse)(nil), "tabi.captains.v1.QueryNodeLastEpochInfoResponse")
+	proto.RegisterType((*QueryEpochStatusRequest)(nil), "tabi.captains.v1.QueryEpochStatusRequest")
+	proto.RegisterType((*QueryEpochStatusResponse)(nil), "tabi.captains.v1.QueryEpochStatusResponse")
+	proto.RegisterType((*QueryReportProgressRequest)(nil), "tabi.captains.v1.QueryReportProgressRequest")
+	proto.RegisterType((*QueryReportProgressResponse)(nil), "tabi.captains.v1.QueryReportProgressResponse")
+	proto.RegisterType((*QueryScheduleRequest)(nil), "tabi.captains.v1.QueryScheduleRequest")
+	proto.RegisterType((*QueryScheduleResponse)(nil), "tabi.captains.v1.QueryScheduleResponse")
+	proto.RegisterType((*QueryEmissionForecastRequest)(nil), "tabi.captains.v1.QueryEmissionForecastRequest")
+	proto.RegisterType((*QueryEmissionForecastResponse)(nil), "tabi.captains.v1.QueryEmissionForecastResponse")
+	proto.RegisterType((*EpochEmissionForecast)(nil), "tabi.captains.v1.EpochEmissionForecast")
+	proto.RegisterType((*QueryClaimableComputingPowerRequest)(nil), "tabi.captains.v1.QueryClaimableComputingPowerRequest")
+	proto.RegisterType((*QueryClaimableComputingPowerResponse)(nil), "tabi.captains.v1.QueryClaimableComputingPowerResponse")
+}
+
+func init() { proto.RegisterFile("tabi/captains/v1/query.proto", fileDescriptor_93d1b070fd3dd5cc) }
+
+var fileDescriptor_93d1b070fd3dd5cc = []byte{
+	// 1988 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xf7, 0xca, 0x24, 0x25, 0x3d, 0x49, 0xae, 0x35, 0xfa, 0xa2, 0xd6, 0x12, 0x65, 0xaf, 0x64,
+	0x49, 0x96, 0x43, 0xae, 0xa4, 0x36, 0x76, 0x9a, 0x14, 0x2d, 0x2c, 0xc9, 0x69, 0x84, 0xc4, 0xb5,
+	0x43, 0xdd, 0x7a, 0x21, 0x86, 0xe4, 0x98, 0x5c, 0x74, 0xb9, 0xbb, 0xd9, 0x59, 0xca, 0x51, 0x5d,
+	0x03, 0xad, 0x7b, 0x2a, 0x82, 0x02, 0x29, 0x7a, 0xee, 0x21, 0xb7, 0x5e, 0x5a, 0xa0, 0x45, 0xda,
+	0x43, 0x0f, 0xbd, 0xf4, 0x92, 0x63, 0x90, 0x5e, 0x8a, 0x1c, 0x82, 0xc2, 0xee, 0x7f, 0xd1, 0x4b,
+	0xb0, 0x33, 0x6f, 0x96, 0xcb, 0xe5, 0x2e, 0xa9, 0xc4, 0x3a, 0x89, 0xf3, 0xe6, 0x7d, 0xfc, 0xe6,
+	0xcd, 0x7b, 0x6f, 0xdf, 0x1b, 0xc1, 0x4a, 0x40, 0xeb, 0x96, 0xd9, 0xa0, 0x5e, 0x40, 0x2d, 0x87,
+	0x9b, 0xa7, 0x7b, 0xe6, 0x07, 0x5d, 0xe6, 0x9f, 0x55, 0x3c, 0xdf, 0x0d, 0x5c, 0x72, 0x35, 0xdc,
+	0xad, 0xa8, 0xdd, 0xca, 0xe9, 0x9e, 0x3e, 0xdf, 0x72, 0x5b, 0xae, 0xd8, 0x34, 0xc3, 0x5f, 0x92,
+	0x4f, 0x5f, 0x69, 0xb9, 0x6e, 0xcb, 0x66, 0x26, 0xf5, 0x2c, 0x93, 0x3a, 0x8e, 0x1b, 0xd0, 0xc0,
+	0x72, 0x1d, 0x8e, 0xbb, 0xcb, 0x0d, 0x97, 0x77, 0x5c, 0x5e, 0x93, 0x62, 0x72, 0x81, 0x5b, 0x3b,
+	0x72, 0x65, 0xd6, 0x29, 0x67, 0xd2, 0xb2, 0x79, 0xba, 0x57, 0x67, 0x01, 0xdd, 0x33, 0x3d, 0xda,
+	0xb2, 0x1c, 0xa1, 0x07, 0x79, 0xd7, 0x06, 0xa0, 0x46, 0xc0, 0x24, 0xc3, 0xea, 0x00, 0x83, 0xcf,
+	0x3c, 0xd7, 0x0f, 0xe4, 0xb6, 0x31, 0x0f, 0xe4, 0xfd, 0xd0, 0xc2, 0x23, 0xea, 0xd3, 0x0e, 0xaf,
+	0xb2, 0x0f, 0xba, 0x8c, 0x07, 0xc6, 0x03, 0x98, 0xeb, 0xa3, 0x72, 0xcf, 0x75, 0x38, 0x23, 0x77,
+	0xa0, 0xe0, 0x09, 0x4a, 0x51, 0xbb, 0xae, 0x6d, 0x4f, 0xed, 0x17, 0x2b, 0x49, 0x57, 0x54, 0xa4,
+	0xc4, 0x41, 0xee, 0xb3, 0xaf, 0xd6, 0x2e, 0x55, 0x91, 0xdb, 0xb8, 0x0d, 0x57, 0x85, 0xba, 0x9f,
+	0xb8, 0x4d, 0x86, 0x26, 0xc8, 0x12, 0x8c, 0x3b, 0x6e, 0x93, 0xd5, 0xac, 0xa6, 0x50, 0x36, 0x59,
+	0x2d, 0x84, 0xcb, 0xe3, 0xa6, 0xf1, 0x23, 0x98, 0x8d, 0x31, 0xa3, 0xe5, 0x1d, 0xc8, 0x85, 0xdb,
+	0x68, 0x77, 0x71, 0xd0, 0xae, 0xe0, 0x16, 0x3c, 0xc6, 0x47, 0x5a, 0x4c, 0x83, 0x3a, 0x12, 0xa9,
+	0x40, 0xde, 0x7d, 0xe2, 0x30, 0x5f, 0x5a, 0x3b, 0x28, 0x7e, 0xf1, 0x69, 0x79, 0x1e, 0xbd, 0x7e,
+	0xaf, 0xd9, 0xf4, 0x19, 0xe7, 0x27, 0x81, 0x6f, 0x39, 0xad, 0xaa, 0x64, 0x23, 0x6f, 0x03, 0xf4,
+	0x9c, 0x5d, 0x1c, 0x13, 0x76, 0x37, 0x2b, 0x28, 0x11, 0xde, 0x4c, 0x45, 0xc6, 0x04, 0xde, 0x4c,
+	0xe5, 0x11, 0x6d, 0xa9, 0xb3, 0x55, 0x63, 0x92, 0xc6, 0xef, 0x34, 0x20, 0x71, 0x34, 0x78, 0xa0,
+	0x7d, 0xc8, 0x87, 0x60, 0x43, 0x4f, 0x5e, 0xce, 0x3e, 0x11, 0xfa, 0x51, 0xb2, 0x92, 0x1f, 0xa7,
+	0x40, 0xda, 0x1a, 0x09, 0x49, 0x1a, 0xec, 0xc3, 0xf4, 0x5c, 0x83, 0xb5, 0x08, 0xd3, 0x91, 0x75,
+	0x6a, 0x71, 0xcb, 0x75, 0xde, 0xb1, 0x78, 0xe0, 0xfa, 0x67, 0xa3, 0xee, 0xe7, 0xc2, 0x1c, 0xf3,
+	0x17, 0x0d, 0xae, 0x67, 0x83, 0x40, 0x37, 0x1d, 0xc1, 0x78, 0x5b, 0x92, 0xd0, 0x51, 0x1b, 0xe9,
+	0x8e, 0x52, 0xf2, 0x87, 0x6d, 0xea, 0xb4, 0x94, 0xdb, 0x94, 0xe8, 0xc5, 0x39, 0xee, 0x2e, 0xcc,
+	0x0b, 0xc8, 0xca, 0x9c, 0x72, 0xd6, 0x1a, 0x4c, 0x35, 0x91, 0xd4, 0x73, 0x18, 0x28, 0xd2, 0x71,
+	0xd3, 0x78, 0x08, 0x0b, 0x09, 0xc1, 0x28, 0xa5, 0x26, 0x14, 0x1b, 0x06, 0xb7, 0x3e, 0x78, 0xc2,
+	0x48, 0x2a, 0xe2, 0x35, 0x6a, 0x09, 0x85, 0x51, 0x9c, 0xf7, 0x5f, 0x8f, 0xf6, 0xad, 0xaf, 0xe7,
+	0x13, 0x0d, 0x16, 0x93, 0x16, 0x10, 0xf3, 0x0f, 0x61, 0x52, 0xe1, 0x50, 0xf1, 0x3b, 0x04, 0x34,
+	0x5e, 0x46, 0x4f, 0xe4, 0xe2, 0xae, 0xe3, 0x75, 0x4c, 0xad, 0x93, 0xae, 0xe7, 0xd9, 0x67, 0xe7,
+	0xbe, 0x8c, 0x32, 0xcc, 0xf5, 0x89, 0xe1, 0xb1, 0x16, 0xa1, 0x40, 0x3b, 0x6e, 0xd7, 0x09, 0x84,
+	0x48, 0xae, 0x8a, 0x2b, 0x63, 0x09, 0x5d, 0x7d, 0x42, 0x6d, 0xf6, 0x1e, 0x3b, 0x65, 0xb6, 0xaa,
+	0x92, 0x77, 0x61, 0x31, 0xb9, 0x81, 0xaa, 0x56, 0x01, 0x38, 0xb5, 0x59, 0xcd, 0x0e, 0xa9, 0xa8,
+	0x6e, 0x92, 0x2b, 0x36, 0x63, 0x0d, 0x56, 0x85, 0xe0, 0xbd, 0x6e, 0xd0, 0x76, 0x7d, 0xeb, 0xe7,
+	0xac, 0xf9, 0x80, 0x75, 0xea, 0xcc, 0x8f, 0xea, 0xef, 0x9b, 0x50, 0xca, 0x62, 0x40, 0x0b, 0x45,
+	0x18, 0xef, 0x48, 0x92, 0xb8, 0x81, 0xc9, 0xaa, 0x5a, 0x1a, 0x3a, 0x14, 0x85, 0xec, 0x61, 0xd7,
+	0xf7, 0x99, 0x13, 0xdc, 0xf7, 0xdc, 0x46, 0x5b, 0xe9, 0x3d, 0x86, 0xe5, 0x94, 0x3d, 0x54, 0x39,
+	0x0f, 0x79, 0x16, 0x12, 0x10, 0xaf, 0x5c, 0x84, 0x5e, 0x69, 0x33, 0xab, 0xd5, 0x0e, 0xc4, 0x45,
+	0xe5, 0xaa, 0xb8, 0x32, 0xde, 0xc0, 0x33, 0x84, 0xd9, 0xf7, 0x1e, 0xe5, 0x52, 0xd7, 0xb1, 0xf3,
+	0xd8, 0x1d, 0x59, 0xe0, 0xff, 0xaf, 0x41, 0x29, 0x4b, 0xf4, 0xdb, 0x40, 0x21, 0x15, 0x98, 0xb3,
+	0x29, 0x0f, 0x6a, 0x82, 0xab, 0xc6, 0x3a, 0x16, 0x17, 0xe9, 0x74, 0x59, 0x58, 0x9d, 0xb5, 0x95,
+	0x85, 0xfb, 0xb8, 0x41, 0x4c, 0x98, 0x93, 0x95, 0xc1, 0x6a, 0x50, 0xbb, 0xc7, 0x9f, 0x13, 0xfc,
+	0xa4, 0xb7, 0x15, 0x09, 0xdc, 0x80, 0x69, 0xcf, 0x66, 0xcd, 0x16, 0xab, 0xf9, 0x61, 0xe0, 0x15,
+	0xf3, 0x82, 0x73, 0x4a, 0xd2, 0xaa, 0x21, 0x89, 0x6c, 0xc1, 0x77, 0x1a, 0x6e, 0xc7, 0xeb, 0x06,
+	0x96, 0xd3, 0xaa, 0x79, 0xee, 0x13, 0xe6, 0x17, 0x0b, 0x82, 0xeb, 0x4a, 0x44, 0x7e, 0x14, 0x52,
+	0x0d, 0x13, 0x96, 0xc4, 0xe1, 0x05, 0xa4, 0x93, 0x80, 0x06, 0xdd, 0x28, 0x75, 0x53, 0x4f, 0x6d,
+	0xfc, 0x55, 0x83, 0xe2, 0xa0, 0xc4, 0x50, 0x47, 0x7d, 0x0f, 0x16, 0x5b, 0xb6, 0x5b, 0xa7, 0x76,
+	0x2d, 0x89, 0x69, 0x4c, 0x60, 0x9a, 0x97, 0xbb, 0x87, 0x7d, 0xc8, 0xc8, 0x3a, 0xcc, 0xc8, 0xd6,
+	0xa0, 0xd6, 0xb4, 0x5a, 0x8c, 0x07, 0xe8, 0xc0, 0x69, 0x49, 0x3c, 0x12, 0x34, 0x72, 0x13, 0xae,
+	0x24, 0xdc, 0x2c, 0xdd, 0x36, 0xc3, 0xe2, 0x2e, 0x36, 0xf6, 0x41, 0x17, 0x98, 0xab, 0x42, 0xf6,
+	0x91, 0xef, 0xb6, 0xc2, 0x2f, 0xec, 0xf0, 0x83, 0xfe, 0x73, 0x0c, 0xae, 0xa5, 0x0a, 0x0d, 0x3d,
+	0xeb, 0x1d, 0x28, 0x20, 0x5c, 0x59, 0x48, 0x4a, 0x83, 0x95, 0xa8, 0x1a, 0x3b, 0x40, 0x15, 0xb9,
+	0xc9, 0x32, 0x4c, 0xf0, 0x80, 0x3a, 0xcd, 0x5a, 0xfd, 0x4c, 0x1c, 0x74, 0xa2, 0x3a, 0x2e, 0xd6,
+	0x07, 0x67, 0xa4, 0x91, 0x7e, 0xc6, 0x83, 0x1f, 0x84, 0x85, 0xec, 0xcb, 0xaf, 0xd6, 0x36, 0x5b,
+	0x56, 0xd0, 0xee, 0xd6, 0x2b, 0x0d, 0xb7, 0x83, 0x8d, 0x1b, 0xfe, 0x29, 0xf3, 0xe6, 0xcf, 0xcc,
+	0xe0, 0xcc, 0x63, 0xbc, 0x72, 0xc4, 0x1a, 0x5f, 0x7c, 0x5a, 0x06, 0x49, 0x0f, 0x57, 0x09, 0x0f,
+	0x91, 0xb7, 0x60, 0xbc, 0x4e, 0x83, 0x46, 0x9b, 0xf1, 0x62, 0x5e, 0x94, 0xd0, 0x6b, 0x83, 0xc0,
+	0x0f, 0x42, 0x86, 0x03, 0xca, 0xa3, 0x0f, 0x1a, 0x4a, 0x08, 0x57, 0x38, 0x4d, 0xd6, 0x14, 0x31,
+	0x36, 0x51, 0x95, 0x0b, 0x63, 0x11, 0xbf, 0x4e, 0x27, 0x8d, 0x36, 0x6b, 0x76, 0x6d, 0x55, 0xd6,
+	0x8d, 0x8f, 0x2f, 0xc3, 0x42, 0x62, 0x03, 0x5d, 0xba, 0x0e, 0x33, 0x0d, 0x59, 0x0a, 0x6a, 0x71,
+	0xd7, 0x4e, 0x37, 0x62, 0xf5, 0x81, 0xbc, 0x06, 0x44, 0x31, 0xc5, 0x8a, 0x9a, 0x4c, 0xc1, 0xab,
+	0xb8, 0x13, 0x95, 0x40, 0xf2, 0x6b, 0x0d, 0xd6, 0x14, 0x7b, 0x9b, 0xda, 0xa7, 0x61, 0xec, 0x31,
+	0x9f, 0xd6, 0x1a, 0x2e, 0x7b, 0xfc, 0xd8, 0x6a, 0x58, 0xcc, 0xc1, 0xc0, 0x7a, 0x45, 0x77, 0xae,
+	0xa0, 0x91, 0x77, 0xa4, 0x8d, 0xfb, 0x3e, 0x3d, 0xec, 0x59, 0x20, 0xef, 0xc3, 0x54, 0x0f, 0x2b,
+	0x2f, 0xe6, 0x84, 0x87, 0x77, 0x06, 0x3d, 0x1c, 0xe1, 0x7e, 0x60, 0xd9, 0x8c, 0x07, 0xae, 0xc3,
+	0x64, 0x82, 0xa1, 0xc3, 0x21, 0xaa, 0xd9, 0x9c, 0xbc, 0x0b, 0xd3, 0xb1, 0xf3, 0xa8, 0x5b, 0x33,
+	0x06, 0x75, 0xf6, 0x10, 0xf5, 0xe9, 0x9a, 0x6a, 0x47, 0x74, 0x6e, 0xfc, 0x71, 0x0c, 0x56, 0x64,
+	0x52, 0x63, 0x3c, 0xbc, 0xed, 0xfa, 0xac, 0x41, 0x79, 0x30, 0xb2, 0xfd, 0xda, 0x80, 0x2b, 0x22,
+	0x95, 0x6b, 0xae, 0x83, 0xd5, 0x48, 0xe6, 0xf4, 0xb4, 0xa0, 0x3e, 0x74, 0x64, 0x39, 0x5a, 0x87,
+	0x19, 0xac, 0x58, 0xf8, 0x49, 0xc3, 0x5c, 0x96, 0xc4, 0x7b, 0x82, 0x46, 0xf6, 0x61, 0x81, 0x7d,
+	0x18, 0x88, 0xbb, 0xe9, 0xaf, 0x12, 0x39, 0x71, 0xb7, 0x73, 0x62, 0x33, 0x51, 0x24, 0xfa, 0xbf,
+	0x6c, 0xf9, 0xc4, 0x97, 0x8d, 0x7c, 0x1f, 0x96, 0xb1, 0xf2, 0xb8, 0x4e, 0xcd, 0xf5, 0x98, 0x40,
+	0xa8, 0x80, 0xca, 0x82, 0x88, 0xa5, 0xe9, 0xa1, 0xf3, 0x50, 0x6d, 0x4b, 0xc8, 0x8b, 0x50, 0x10,
+	0x31, 0xc8, 0x8b, 0xe3, 0xb2, 0xba, 0xcb, 0x95, 0xf1, 0xa5, 0x06, 0xab, 0x19, 0xae, 0xc2, 0x28,
+	0x7e, 0x17, 0x26, 0x1f, 0x23, 0x4d, 0xf5, 0x23, 0x5b, 0x83, 0xd7, 0xd2, 0xf7, 0x0d, 0x50, 0x3a,
+	0x54, 0x73, 0x12, 0xc9, 0x13, 0x1b, 0xe6, 0x02, 0x37, 0xa0, 0x76, 0x4d, 0xb8, 0x3f, 0xaa, 0x00,
+	0x63, 0x17, 0x10, 0xb2, 0xb3, 0x42, 0x71, 0xf8, 0xd5, 0x8b, 0xea, 0xe4, 0xbf, 0x72, 0xb0, 0x90,
+	0x0a, 0x2c, 0xac, 0x4f, 0xb2, 0x08, 0x61, 0x04, 0xe4, 0xaa, 0xe3, 0x62, 0x7d, 0xdc, 0x24, 0x0e,
+	0xcc, 0x0b, 0x70, 0xa9, 0xc5, 0xfd, 0x15, 0x31, 0x92, 0x50, 0x73, 0xe2, 0xce, 0xfd, 0xcc, 0xcf,
+	0xc9, 0x45, 0x24, 0x72, 0xfa, 0xc7, 0x28, 0xac, 0xc1, 0xe8, 0x92, 0x1a, 0x6f, 0x53, 0x9f, 0x5d,
+	0x50, 0x0d, 0x46, 0x9d, 0x27, 0xa1, 0xca, 0x94, 0x42, 0x9f, 0xbf, 0xf8, 0x42, 0x4f, 0x61, 0xa6,
+	0x3f, 0x94, 0x0a, 0x17, 0x60, 0x63, 0xda, 0x89, 0x47, 0xd1, 0x5b, 0xb0, 0x2e, 0xdb, 0x3a, 0x9b,
+	0x5a, 0x1d, 0x5a, 0xb7, 0x13, 0x17, 0x18, 0xfb, 0xec, 0xc6, 0x46, 0x60, 0x1c, 0x74, 0x8d, 0x3a,
+	0x6c, 0x0c, 0x17, 0xc6, 0x2c, 0x7b, 0x13, 0x96, 0x1b, 0x8a, 0x65, 0x20, 0x10, 0x64, 0x84, 0x2e,
+	0x35, 0xd2, 0x75, 0xec, 0xff, 0x9d, 0x40, 0x5e, 0x18, 0x21, 0x01, 0x14, 0xe4, 0x13, 0x01, 0x49,
+	0x99, 0xe4, 0x06, 0x5f, 0x22, 0xf4, 0x9b, 0x23, 0xb8, 0x24, 0x38, 0x63, 0xf5, 0xf9, 0xbf, 0xff,
+	0xf7, 0xfb, 0xb1, 0x25, 0xb2, 0x60, 0x7e, 0xd8, 0xf7, 0xd6, 0x21, 0x1f, 0x20, 0xc8, 0x13, 0xc8,
+	0x85, 0x69, 0x47, 0x8c, 0x0c, 0x6d, 0xb1, 0x87, 0x09, 0x7d, 0x7d, 0x28, 0x0f, 0xda, 0xdb, 0x14,
+	0xf6, 0xae, 0x93, 0x52, 0xc2, 0x9e, 0x18, 0xd4, 0xcd, 0xa7, 0x58, 0xba, 0x9f, 0x11, 0x0f, 0xf2,
+	0xa1, 0x1c, 0x27, 0xc3, 0xb4, 0x46, 0x87, 0xdd, 0x18, 0xce, 0x84, 0xb6, 0x57, 0x84, 0xed, 0x45,
+	0x32, 0x9f, 0x66, 0x9b, 0xfc, 0x59, 0x83, 0xd9, 0x81, 0xc6, 0x9a, 0x98, 0x43, 0x34, 0xa7, 0x75,
+	0xef, 0xfa, 0xee, 0xf9, 0x05, 0x10, 0xd6, 0x1d, 0x01, 0x6b, 0x97, 0x54, 0x86, 0xbb, 0xc4, 0x0c,
+	0xfb, 0xf1, 0xb2, 0x48, 0x91, 0xb2, 0x15, 0x42, 0xfb, 0x9b, 0x06, 0x73, 0x29, 0x4f, 0x00, 0x64,
+	0x6f, 0x08, 0x82, 0xf4, 0x37, 0x0b, 0x7d, 0xff, 0x9b, 0x88, 0x20, 0xec, 0xbb, 0x02, 0xf6, 0x1e,
+	0x31, 0x47, 0xc0, 0x56, 0xf3, 0x63, 0x59, 0x3d, 0x2a, 0xfc, 0x52, 0x83, 0xc9, 0xa3, 0x68, 0xa6,
+	0xdd, 0xca, 0x30, 0x9d, 0x9c, 0xcf, 0xf5, 0xed, 0xd1, 0x8c, 0x88, 0xec, 0xba, 0x40, 0xa6, 0x93,
+	0x62, 0x02, 0x59, 0x6f, 0x90, 0xfe, 0x48, 0x83, 0x09, 0x25, 0x47, 0x36, 0x47, 0x28, 0x56, 0x00,
+	0xb6, 0x46, 0xf2, 0xa1, 0xfd, 0x8a, 0xb0, 0xbf, 0x4d, 0x36, 0xb3, 0xec, 0x9b, 0x4f, 0x63, 0x73,
+	0xf6, 0x33, 0xf2, 0x5c, 0x83, 0x82, 0x1c, 0xa9, 0x33, 0x73, 0xbb, 0x6f, 0x50, 0xd7, 0x6f, 0x8e,
+	0xe0, 0x42, 0x1c, 0xb7, 0x05, 0x8e, 0x9b, 0x64, 0x3d, 0x81, 0x83, 0x0b, 0xb6, 0x04, 0x88, 0x5f,
+	0x69, 0x30, 0xd9, 0x6b, 0x46, 0xb3, 0xce, 0x9a, 0x1c, 0xe5, 0xf5, 0xed, 0xd1, 0x8c, 0x88, 0xe6,
+	0x86, 0x40, 0x73, 0x8d, 0x2c, 0x27, 0xd1, 0x50, 0x9b, 0x95, 0x45, 0x57, 0x44, 0x3e, 0xd1, 0x60,
+	0x76, 0x60, 0x72, 0xcf, 0x4c, 0xc1, 0xac, 0x47, 0x00, 0x7d, 0xf7, 0xfc, 0x02, 0x88, 0xed, 0x96,
+	0xc0, 0xb6, 0x4e, 0x6e, 0x24, 0xb0, 0xd1, 0x48, 0xa2, 0x8c, 0xaf, 0x04, 0xe4, 0xb7, 0x1a, 0x4c,
+	0xc7, 0x5f, 0x01, 0xc8, 0x4e, 0x86, 0xb5, 0x94, 0x67, 0x04, 0xfd, 0xf6, 0xb9, 0x78, 0x11, 0xd4,
+	0x86, 0x00, 0x55, 0x22, 0x2b, 0x09, 0x50, 0xd8, 0xbf, 0xcb, 0x52, 0x40, 0x7e, 0xa3, 0xc1, 0x54,
+	0x6c, 0xc0, 0x25, 0xb7, 0x32, 0x4c, 0x0c, 0x8e, 0xcd, 0xfa, 0xce, 0x79, 0x58, 0x11, 0xcc, 0xba,
+	0x00, 0xb3, 0x4a, 0xae, 0x25, 0xc0, 0xc8, 0x7a, 0xc4, 0xa5, 0xed, 0x3f, 0x68, 0x70, 0xa5, 0x7f,
+	0x06, 0x25, 0xaf, 0x65, 0xd8, 0x48, 0x9d, 0x6f, 0xf5, 0xf2, 0x39, 0xb9, 0x47, 0x24, 0x9a, 0x1c,
+	0xbc, 0xcb, 0x1e, 0xf2, 0x9b, 0x4f, 0x05, 0xca, 0x67, 0xe4, 0x17, 0x30, 0xa1, 0x26, 0xb9, 0xcc,
+	0xac, 0x4f, 0xcc, 0x80, 0xfa, 0xd6, 0x48, 0x3e, 0x04, 0xb3, 0x26, 0xc0, 0x2c, 0x93, 0xa5, 0x64,
+	0x7c, 0x2b, 0x8b, 0x7f, 0xd2, 0xe0, 0xea, 0x40, 0xb7, 0x5a, 0xc9, 0xba, 0x83, 0xf4, 0xf1, 0x46,
+	0x37, 0xcf, 0xcd, 0x8f, 0xb0, 0xde, 0x10, 0xb0, 0xf6, 0xc9, 0xee, 0x88, 0x32, 0xad, 0xba, 0xac,
+	0xb2, 0xea, 0xe8, 0xc9, 0x3f, 0x34, 0x58, 0xca, 0xe8, 0x6d, 0xc8, 0xeb, 0x59, 0x81, 0x3c, 0xb4,
+	0x91, 0xd2, 0xef, 0x7c, 0x53, 0x31, 0x3c, 0xc4, 0xae, 0x38, 0xc4, 0x0e, 0xd9, 0x4e, 0xa6, 0x82,
+	0x92, 0x2b, 0x47, 0x7d, 0x55, 0x59, 0xf4, 0x55, 0x07, 0x87, 0x9f, 0xbd, 0x28, 0x69, 0x9f, 0xbf,
+	0x28, 0x69, 0xff, 0x7d, 0x51, 0xd2, 0x3e, 0x7e, 0x59, 0xba, 0xf4, 0xf9, 0xcb, 0xd2, 0xa5, 0xff,
+	0xbc, 0x2c, 0x5d, 0xfa, 0xe9, 0xad, 0x58, 0xdf, 0x18, 0xa2, 0xb1, 0x69, 0x9d, 0x8b, 0x1f, 0x71,
+	0xdd, 0xa2, 0x7d, 0xac, 0x17, 0xc4, 0xbf, 0x7a, 0xbe, 0xfb, 0xf5, 0x00, 0x48, 0x5d, 0x68, 0x00,
+	0xd7, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the captains module parameters
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Node queries an Node based on its id.
+	Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error)
+	// Nodes queries all node of a given owner
+	Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error)
+	// NodeLastEpochInfo queries the node last epoch emission, historical emission and pledge ratio.
+	NodeLastEpochInfo(ctx context.Context, in *QueryNodeLastEpochInfoRequest, opts ...grpc.CallOption) (*QueryNodeLastEpochInfoResponse, error)
+	// NodeDivisionHistory queries the division changes of a node.
+	NodeDivisionHistory(ctx context.Context, in *QueryNodeDivisionHistoryRequest, opts ...grpc.CallOption) (*QueryNodeDivisionHistoryResponse, error)
+	// Divisions queries all Node divisions
+	Divisions(ctx context.Context, in *QueryDivisionsRequest, opts ...grpc.CallOption) (*QueryDivisionsResponse, error)
+	// Division queries a division by its id.
+	Division(ctx context.Context, in *QueryDivisionRequest, opts ...grpc.CallOption) (*QueryDivisionResponse, error)
+	// Supply queries the number of nodes under the division
+	Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error)
+	// SaleLevel queries current sale level
+	SaleLevel(ctx context.Context, in *QuerySaleLevelRequest, opts ...grpc.CallOption) (*QuerySaleLevelResponse, error)
+	// AuthorizedMembers queries all the callers
+	AuthorizedMembers(ctx context.Context, in *QueryAuthorizedMembersRequest, opts ...grpc.CallOption) (*QueryAuthorizedMembersResponse, error)
+	// CurrentEpoch queries the current epoch as well as its height;
+	CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error)
+	// EpochStatus queries the current epoch status
+	EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error)
+	// ReportProgress queries the reports committed on an epoch
+	ReportProgress(ctx context.Context, in *QueryReportProgressRequest, opts ...grpc.CallOption) (*QueryReportProgressResponse, error)
+	// Schedule queries the sale level and halving era timeline
+	Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error)
+	// EmissionForecast projects the emission of a node under hypothetical inputs
+	EmissionForecast(ctx context.Context, in *QueryEmissionForecastRequest, opts ...grpc.CallOption) (*QueryEmissionForecastResponse, error)
+	// ClaimableComputingPower queries the claimable computing power of an address
+	ClaimableComputingPower(ctx context.Context, in *QueryClaimableComputingPowerRequest, opts ...grpc.CallOption) (*QueryClaimableComputingPowerResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Divisions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/Divisions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Divisions(ctx, req.(*QueryDivisionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Division_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDivisionRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Node(ctx context.Context, in *QueryNodeRequest, opts ...grpc.CallOption) (*QueryNodeResponse, error) {
+	out := new(QueryNodeResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Node", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Division(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/Division",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Division(ctx, req.(*QueryDivisionRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySupplyRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Nodes(ctx context.Context, in *QueryNodesRequest, opts ...grpc.CallOption) (*QueryNodesResponse, error) {
+	out := new(QueryNodesResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Nodes", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Supply(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/Supply",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_SaleLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySaleLevelRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) NodeLastEpochInfo(ctx context.Context, in *QueryNodeLastEpochInfoRequest, opts ...grpc.CallOption) (*QueryNodeLastEpochInfoResponse, error) {
+	out := new(QueryNodeLastEpochInfoResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/NodeLastEpochInfo", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).SaleLevel(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/SaleLevel",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).SaleLevel(ctx, req.(*QuerySaleLevelRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_AuthorizedMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAuthorizedMembersRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) NodeDivisionHistory(ctx context.Context, in *QueryNodeDivisionHistoryRequest, opts ...grpc.CallOption) (*QueryNodeDivisionHistoryResponse, error) {
+	out := new(QueryNodeDivisionHistoryResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/NodeDivisionHistory", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).AuthorizedMembers(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/AuthorizedMembers",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AuthorizedMembers(ctx, req.(*QueryAuthorizedMembersRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCurrentEpochRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Divisions(ctx context.Context, in *QueryDivisionsRequest, opts ...grpc.CallOption) (*QueryDivisionsResponse, error) {
+	out := new(QueryDivisionsResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Divisions", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).CurrentEpoch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/CurrentEpoch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_EpochStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEpochStatusRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Division(ctx context.Context, in *QueryDivisionRequest, opts ...grpc.CallOption) (*QueryDivisionResponse, error) {
+	out := new(QueryDivisionResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Division", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).EpochStatus(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/EpochStatus",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EpochStatus(ctx, req.(*QueryEpochStatusRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ClaimableComputingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimableComputingPowerRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) Supply(ctx context.Context, in *QuerySupplyRequest, opts ...grpc.CallOption) (*QuerySupplyResponse, error) {
+	out := new(QuerySupplyResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Supply", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableComputingPower(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.captains.v1.Query/ClaimableComputingPower",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableComputingPower(ctx, req.(*QueryClaimableComputingPowerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tabi.captains.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Node",
-			Handler:    _Query_Node_Handler,
-		},
-		{
-			MethodName: "Nodes",
-			Handler:    _Query_Nodes_Handler,
-		},
-		{
-			MethodName: "NodeLastEpochInfo",
-			Handler:    _Query_NodeLastEpochInfo_Handler,
-		},
-		{
-			MethodName: "Divisions",
-			Handler:    _Query_Divisions_Handler,
-		},
-		{
-			MethodName: "Division",
-			Handler:    _Query_Division_Handler,
-		},
-		{
-			MethodName: "Supply",
-			Handler:    _Query_Supply_Handler,
-		},
-		{
-			MethodName: "SaleLevel",
-			Handler:    _Query_SaleLevel_Handler,
-		},
-		{
-			MethodName: "AuthorizedMembers",
-			Handler:    _Query_AuthorizedMembers_Handler,
-		},
-		{
-			MethodName: "CurrentEpoch",
-			Handler:    _Query_CurrentEpoch_Handler,
-		},
-		{
-			MethodName: "EpochStatus",
-			Handler:    _Query_EpochStatus_Handler,
-		},
-		{
-			MethodName: "ClaimableComputingPower",
-			Handler:    _Query_ClaimableComputingPower_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tabi/captains/v1/query.proto",
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) SaleLevel(ctx context.Context, in *QuerySaleLevelRequest, opts ...grpc.CallOption) (*QuerySaleLevelResponse, error) {
+	out := new(QuerySaleLevelResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/SaleLevel", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AuthorizedMembers(ctx context.Context, in *QueryAuthorizedMembersRequest, opts ...grpc.CallOption) (*QueryAuthorizedMembersResponse, error) {
+	out := new(QueryAuthorizedMembersResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/AuthorizedMembers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) CurrentEpoch(ctx context.Context, in *QueryCurrentEpochRequest, opts ...grpc.CallOption) (*QueryCurrentEpochResponse, error) {
+	out := new(QueryCurrentEpochResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/CurrentEpoch", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNodeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) EpochStatus(ctx context.Context, in *QueryEpochStatusRequest, opts ...grpc.CallOption) (*QueryEpochStatusResponse, error) {
+	out := new(QueryEpochStatusResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/EpochStatus", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NodeId) > 0 {
-		i -= len(m.NodeId)
-		copy(dAtA[i:], m.NodeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ReportProgress(ctx context.Context, in *QueryReportProgressRequest, opts ...grpc.CallOption) (*QueryReportProgressResponse, error) {
+	out := new(QueryReportProgressResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/ReportProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNodeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Schedule(ctx context.Context, in *QueryScheduleRequest, opts ...grpc.CallOption) (*QueryScheduleResponse, error) {
+	out := new(QueryScheduleResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/Schedule", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Node != nil {
-		{
-			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) EmissionForecast(ctx context.Context, in *QueryEmissionForecastRequest, opts ...grpc.CallOption) (*QueryEmissionForecastResponse, error) {
+	out := new(QueryEmissionForecastResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/EmissionForecast", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryNodesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClaimableComputingPower(ctx context.Context, in *QueryClaimableComputingPowerRequest, opts ...grpc.CallOption) (*QueryClaimableComputingPowerResponse, error) {
+	out := new(QueryClaimableComputingPowerResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Query/ClaimableComputingPower", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the captains module parameters
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Node queries an Node based on its id.
+	Node(context.Context, *QueryNodeRequest) (*QueryNodeResponse, error)
+	// Nodes queries all node of a given owner
+	Nodes(context.Context, *QueryNodesRequest) (*QueryNodesResponse, error)
+	// NodeLastEpochInfo queries the node last epoch emission, historical emission and pledge ratio.
+	NodeLastEpochInfo(context.Context, *QueryNodeLastEpochInfoRequest) (*QueryNodeLastEpochInfoResponse, error)
+	// NodeDivisionHistory queries the division changes of a node.
+	NodeDivisionHistory(context.Context, *QueryNodeDivisionHistoryRequest) (*QueryNodeDivisionHistoryResponse, error)
+	// Divisions queries all Node divisions
+	Divisions(context.Context, *QueryDivisionsRequest) (*QueryDivisionsResponse, error)
+	// Division queries a division by its id.
+	Division(context.Context, *QueryDivisionRequest) (*QueryDivisionResponse, error)
+	// Supply queries the number of nodes under the division
+	Supply(context.Context, *QuerySupplyRequest) (*QuerySupplyResponse, error)
+	// SaleLevel queries current sale level
+	SaleLevel(context.Context, *QuerySaleLevelRequest) (*QuerySaleLevelResponse, error)
+	// AuthorizedMembers queries all the callers
+	AuthorizedMembers(context.Context, *QueryAuthorizedMembersRequest) (*QueryAuthorizedMembersResponse, error)
+	// CurrentEpoch queries the current epoch as well as its height;
+	CurrentEpoch(context.Context, *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error)
+	// EpochStatus queries the current epoch status
+	EpochStatus(context.Context, *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error)
+	// ReportProgress queries the reports committed on an epoch
+	ReportProgress(context.Context, *QueryReportProgressRequest) (*QueryReportProgressResponse, error)
+	// Schedule queries the sale level and halving era timeline
+	Schedule(context.Context, *QueryScheduleRequest) (*QueryScheduleResponse, error)
+	// EmissionForecast projects the emission of a node under hypothetical inputs
+	EmissionForecast(context.Context, *QueryEmissionForecastRequest) (*QueryEmissionForecastResponse, error)
+	// ClaimableComputingPower queries the claimable computing power of an address
+	ClaimableComputingPower(context.Context, *QueryClaimableComputingPowerRequest) (*QueryClaimableComputingPowerResponse, error)
 }
 
-func (m *QueryNodesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Node(ctx context.Context, req *QueryNodeRequest) (*QueryNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Node not implemented")
+}
+func (*UnimplementedQueryServer) Nodes(ctx context.Context, req *QueryNodesRequest) (*QueryNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Nodes not implemented")
+}
+func (*UnimplementedQueryServer) NodeLastEpochInfo(ctx context.Context, req *QueryNodeLastEpochInfoRequest) (*QueryNodeLastEpochInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeLastEpochInfo not implemented")
+}
+func (*UnimplementedQueryServer) NodeDivisionHistory(ctx context.Context, req *QueryNodeDivisionHistoryRequest) (*QueryNodeDivisionHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NodeDivisionHistory not implemented")
+}
+func (*UnimplementedQueryServer) Divisions(ctx context.Context, req *QueryDivisionsRequest) (*QueryDivisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Divisions not implemented")
+}
+func (*UnimplementedQueryServer) Division(ctx context.Context, req *QueryDivisionRequest) (*QueryDivisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Division not implemented")
+}
+func (*UnimplementedQueryServer) Supply(ctx context.Context, req *QuerySupplyRequest) (*QuerySupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Supply not implemented")
+}
+func (*UnimplementedQueryServer) SaleLevel(ctx context.Context, req *QuerySaleLevelRequest) (*QuerySaleLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaleLevel not implemented")
+}
+func (*UnimplementedQueryServer) AuthorizedMembers(ctx context.Context, req *QueryAuthorizedMembersRequest) (*QueryAuthorizedMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuthorizedMembers not implemented")
+}
+func (*UnimplementedQueryServer) CurrentEpoch(ctx context.Context, req *QueryCurrentEpochRequest) (*QueryCurrentEpochResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrentEpoch not implemented")
+}
+func (*UnimplementedQueryServer) EpochStatus(ctx context.Context, req *QueryEpochStatusRequest) (*QueryEpochStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EpochStatus not implemented")
+}
+func (*UnimplementedQueryServer) ReportProgress(ctx context.Context, req *QueryReportProgressRequest) (*QueryReportProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportProgress not implemented")
+}
+func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QueryScheduleRequest) (*QueryScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Schedule not implemented")
+}
+func (*UnimplementedQueryServer) EmissionForecast(ctx context.Context, req *QueryEmissionForecastRequest) (*QueryEmissionForecastResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmissionForecast not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableComputingPower(ctx context.Context, req *QueryClaimableComputingPowerRequest) (*QueryClaimableComputingPowerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableComputingPower not implemented")
 }
 
-func (m *QueryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDivisionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Node_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Node(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/Node",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Node(ctx, req.(*QueryNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDivisionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Nodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Nodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/Nodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Nodes(ctx, req.(*QueryNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDivisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DivisionId) > 0 {
-		i -= len(m.DivisionId)
-		copy(dAtA[i:], m.DivisionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DivisionId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_NodeLastEpochInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodeLastEpochInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).NodeLastEpochInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/NodeLastEpochInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeLastEpochInfo(ctx, req.(*QueryNodeLastEpochInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDivisionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_NodeDivisionHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNodeDivisionHistoryRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).NodeDivisionHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/NodeDivisionHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NodeDivisionHistory(ctx, req.(*QueryNodeDivisionHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDivisionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Divisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDivisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Divisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/Divisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Divisions(ctx, req.(*QueryDivisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDivisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Division != nil {
-		{
-			size, err := m.Division.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_Division_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDivisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).Division(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/Division",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Division(ctx, req.(*QueryDivisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDivisionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Supply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDivisionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDivisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Supply(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/Supply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Supply(ctx, req.(*QuerySupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDivisionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_SaleLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySaleLevelRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryDivisionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryDivisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).SaleLevel(ctx, in)
 	}
-	if len(m.Divisions) > 0 {
-		for iNdEx := len(m.Divisions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Divisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/SaleLevel",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SaleLevel(ctx, req.(*QuerySaleLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AuthorizedMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthorizedMembersRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DivisionId) > 0 {
-		i -= len(m.DivisionId)
-		copy(dAtA[i:], m.DivisionId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DivisionId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).AuthorizedMembers(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/AuthorizedMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuthorizedMembers(ctx, req.(*QueryAuthorizedMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_CurrentEpoch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCurrentEpochRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
-		i--
-		dAtA[i] = 0x8
+	if interceptor == nil {
+		return srv.(QueryServer).CurrentEpoch(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/CurrentEpoch",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CurrentEpoch(ctx, req.(*QueryCurrentEpochRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySaleLevelRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_EpochStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEpochStatusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySaleLevelRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).EpochStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/EpochStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EpochStatus(ctx, req.(*QueryEpochStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySaleLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Query_ReportProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryReportProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ReportProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/ReportProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ReportProgress(ctx, req.(*QueryReportProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySaleLevelResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Schedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Schedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/Schedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Schedule(ctx, req.(*QueryScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySaleLevelResponse) MarshalTo(dAtA []byte) (int, error) {
+func _Query_EmissionForecast_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEmissionForecastRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EmissionForecast(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/EmissionForecast",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EmissionForecast(ctx, req.(*QueryEmissionForecastRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableComputingPower_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableComputingPowerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableComputingPower(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Query/ClaimableComputingPower",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableComputingPower(ctx, req.(*QueryClaimableComputingPowerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tabi.captains.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Node",
+			Handler:    _Query_Node_Handler,
+		},
+		{
+			MethodName: "Nodes",
+			Handler:    _Query_Nodes_Handler,
+		},
+		{
+			MethodName: "NodeLastEpochInfo",
+			Handler:    _Query_NodeLastEpochInfo_Handler,
+		},
+		{
+			MethodName: "NodeDivisionHistory",
+			Handler:    _Query_NodeDivisionHistory_Handler,
+		},
+		{
+			MethodName: "Divisions",
+			Handler:    _Query_Divisions_Handler,
+		},
+		{
+			MethodName: "Division",
+			Handler:    _Query_Division_Handler,
+		},
+		{
+			MethodName: "Supply",
+			Handler:    _Query_Supply_Handler,
+		},
+		{
+			MethodName: "SaleLevel",
+			Handler:    _Query_SaleLevel_Handler,
+		},
+		{
+			MethodName: "AuthorizedMembers",
+			Handler:    _Query_AuthorizedMembers_Handler,
+		},
+		{
+			MethodName: "CurrentEpoch",
+			Handler:    _Query_CurrentEpoch_Handler,
+		},
+		{
+			MethodName: "EpochStatus",
+			Handler:    _Query_EpochStatus_Handler,
+		},
+		{
+			MethodName: "ReportProgress",
+			Handler:    _Query_ReportProgress_Handler,
+		},
+		{
+			MethodName: "Schedule",
+			Handler:    _Query_Schedule_Handler,
+		},
+		{
+			MethodName: "EmissionForecast",
+			Handler:    _Query_EmissionForecast_Handler,
+		},
+		{
+			MethodName: "ClaimableComputingPower",
+			Handler:    _Query_ClaimableComputingPower_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tabi/captains/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySaleLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.SaleLevel != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.SaleLevel))
-		i--
-		dAtA[i] = 0x8
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuthorizedMembersRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2301,20 +2616,30 @@ func (m *QueryAuthorizedMembersRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAuthorizedMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuthorizedMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryAuthorizedMembersResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNodeRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2324,29 +2649,27 @@ func (m *QueryAuthorizedMembersResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryAuthorizedMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNodeRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuthorizedMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Members[iNdEx])
-			copy(dAtA[i:], m.Members[iNdEx])
-			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNodeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2356,20 +2679,32 @@ func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNodeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Node != nil {
+		{
+			size, err := m.Node.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNodesRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2379,30 +2714,39 @@ func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNodesRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodeLastEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryNodesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2412,27 +2756,46 @@ func (m *QueryNodeLastEpochInfoRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNodeLastEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNodesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNodeLastEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NodeId) > 0 {
-		i -= len(m.NodeId)
-		copy(dAtA[i:], m.NodeId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodeLastEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryNodeDivisionHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2442,58 +2805,118 @@ func (m *QueryNodeLastEpochInfoResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryNodeLastEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryNodeDivisionHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNodeLastEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryNodeDivisionHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.ComputingPower) > 0 {
-		i -= len(m.ComputingPower)
-		copy(dAtA[i:], m.ComputingPower)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComputingPower)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x12
 	}
-	if len(m.PledgeRatio) > 0 {
-		i -= len(m.PledgeRatio)
-		copy(dAtA[i:], m.PledgeRatio)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PledgeRatio)))
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
-	if len(m.HistoricalEmission) > 0 {
-		i -= len(m.HistoricalEmission)
-		copy(dAtA[i:], m.HistoricalEmission)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HistoricalEmission)))
-		i--
-		dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNodeDivisionHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.LastEpochEmission) > 0 {
-		i -= len(m.LastEpochEmission)
-		copy(dAtA[i:], m.LastEpochEmission)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastEpochEmission)))
+	return dAtA[:n], nil
+}
+
+func (m *QueryNodeDivisionHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNodeDivisionHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if m.Height != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDivisionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDivisionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDivisionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DivisionId) > 0 {
+		i -= len(m.DivisionId)
+		copy(dAtA[i:], m.DivisionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DivisionId)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochStatusRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryDivisionResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2503,25 +2926,32 @@ func (m *QueryEpochStatusRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEpochStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDivisionResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDivisionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+	if m.Division != nil {
+		{
+			size, err := m.Division.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochStatusResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryDivisionsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2531,46 +2961,81 @@ func (m *QueryEpochStatusResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryEpochStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryDivisionsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryEpochStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryDivisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.EpochEmission) > 0 {
-		i -= len(m.EpochEmission)
-		copy(dAtA[i:], m.EpochEmission)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochEmission)))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0xa
 	}
-	if len(m.ReportDigest) > 0 {
-		i -= len(m.ReportDigest)
-		copy(dAtA[i:], m.ReportDigest)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReportDigest)))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDivisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.GlobalComputingPower) > 0 {
-		i -= len(m.GlobalComputingPower)
-		copy(dAtA[i:], m.GlobalComputingPower)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.GlobalComputingPower)))
+	return dAtA[:n], nil
+}
+
+func (m *QueryDivisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDivisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Epoch != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Divisions) > 0 {
+		for iNdEx := len(m.Divisions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Divisions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimableComputingPowerRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySupplyRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2580,27 +3045,27 @@ func (m *QueryClaimableComputingPowerRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimableComputingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySupplyRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimableComputingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+	if len(m.DivisionId) > 0 {
+		i -= len(m.DivisionId)
+		copy(dAtA[i:], m.DivisionId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DivisionId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimableComputingPowerResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySupplyResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2610,381 +3075,2300 @@ func (m *QueryClaimableComputingPowerResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimableComputingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySupplyResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimableComputingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ClaimableComputingPower != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimableComputingPower))
+	if m.Amount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Amount))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QuerySaleLevelRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QuerySaleLevelRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySaleLevelRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySaleLevelResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryNodeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Node != nil {
-		l = m.Node.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QuerySaleLevelResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNodesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySaleLevelResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.SaleLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SaleLevel))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAuthorizedMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDivisionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DivisionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryAuthorizedMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDivisionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAuthorizedMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Division != nil {
-		l = m.Division.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDivisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryAuthorizedMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryDivisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAuthorizedMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuthorizedMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Divisions) > 0 {
-		for _, e := range m.Divisions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySupplyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.DivisionId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryCurrentEpochRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QuerySupplyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Amount != 0 {
-		n += 1 + sovQuery(uint64(m.Amount))
-	}
-	return n
+func (m *QueryCurrentEpochRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySaleLevelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCurrentEpochRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySaleLevelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.SaleLevel != 0 {
-		n += 1 + sovQuery(uint64(m.SaleLevel))
+func (m *QueryCurrentEpochResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAuthorizedMembersRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryCurrentEpochResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryAuthorizedMembersResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryCurrentEpochResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Members) > 0 {
-		for _, s := range m.Members {
-			l = len(s)
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCurrentEpochRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNodeLastEpochInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCurrentEpochResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
-	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
-	}
-	return n
+func (m *QueryNodeLastEpochInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryNodeLastEpochInfoRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNodeLastEpochInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryNodeLastEpochInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNodeLastEpochInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNodeLastEpochInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNodeLastEpochInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
+	if len(m.ComputingPower) > 0 {
+		i -= len(m.ComputingPower)
+		copy(dAtA[i:], m.ComputingPower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ComputingPower)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Height != 0 {
-		n += 1 + sovQuery(uint64(m.Height))
+	if len(m.PledgeRatio) > 0 {
+		i -= len(m.PledgeRatio)
+		copy(dAtA[i:], m.PledgeRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PledgeRatio)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.LastEpochEmission)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.HistoricalEmission) > 0 {
+		i -= len(m.HistoricalEmission)
+		copy(dAtA[i:], m.HistoricalEmission)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HistoricalEmission)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.HistoricalEmission)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.LastEpochEmission) > 0 {
+		i -= len(m.LastEpochEmission)
+		copy(dAtA[i:], m.LastEpochEmission)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.LastEpochEmission)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.PledgeRatio)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ComputingPower)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEpochStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEpochStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryEpochStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEpochStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEpochStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Epoch != 0 {
-		n += 1 + sovQuery(uint64(m.Epoch))
+	if len(m.EpochEmission) > 0 {
+		i -= len(m.EpochEmission)
+		copy(dAtA[i:], m.EpochEmission)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EpochEmission)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.GlobalComputingPower)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ReportDigest) > 0 {
+		i -= len(m.ReportDigest)
+		copy(dAtA[i:], m.ReportDigest)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReportDigest)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.ReportDigest)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.GlobalComputingPower) > 0 {
+		i -= len(m.GlobalComputingPower)
+		copy(dAtA[i:], m.GlobalComputingPower)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GlobalComputingPower)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.EpochEmission)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimableComputingPowerRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReportProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimableComputingPowerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryReportProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryReportProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryReportProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ClaimableComputingPower != 0 {
-		n += 1 + sovQuery(uint64(m.ClaimableComputingPower))
+	if m.Ended {
+		i--
+		if m.Ended {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
+	if len(m.Batches) > 0 {
+		for iNdEx := len(m.Batches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Batches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.EpochEmission.Size()
+		i -= size
+		if _, err := m.EpochEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.StandBy {
+		i--
+		if m.StandBy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Digest != nil {
+		{
+			size, err := m.Digest.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HalvingEras) > 0 {
+		for iNdEx := len(m.HalvingEras) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HalvingEras[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.SaleLevels) > 0 {
+		for iNdEx := len(m.SaleLevels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SaleLevels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.CurrentHalvingEraCoefficient.Size()
+		i -= size
+		if _, err := m.CurrentHalvingEraCoefficient.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentSaleLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentSaleLevel))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CurrentEpoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentEpoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionForecastRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionForecastRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionForecastRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epochs != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epochs))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.GlobalOnOperationRatio) > 0 {
+		i -= len(m.GlobalOnOperationRatio)
+		copy(dAtA[i:], m.GlobalOnOperationRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GlobalOnOperationRatio)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SaleLevel != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SaleLevel))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExtraComputingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ExtraComputingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PledgeAmount) > 0 {
+		i -= len(m.PledgeAmount)
+		copy(dAtA[i:], m.PledgeAmount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PledgeAmount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PowerOnRatio) > 0 {
+		i -= len(m.PowerOnRatio)
+		copy(dAtA[i:], m.PowerOnRatio)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PowerOnRatio)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEmissionForecastResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEmissionForecastResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEmissionForecastResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalNodeEmission.Size()
+		i -= size
+		if _, err := m.TotalNodeEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Forecasts) > 0 {
+		for iNdEx := len(m.Forecasts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forecasts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochEmissionForecast) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochEmissionForecast) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochEmissionForecast) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NodeEmission.Size()
+		i -= size
+		if _, err := m.NodeEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EpochEmission.Size()
+		i -= size
+		if _, err := m.EpochEmission.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.EmissionShare.Size()
+		i -= size
+		if _, err := m.EmissionShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.GlobalComputingPower.Size()
+		i -= size
+		if _, err := m.GlobalComputingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.NodeComputingPower.Size()
+		i -= size
+		if _, err := m.NodeComputingPower.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EpochId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EpochId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableComputingPowerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableComputingPowerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableComputingPowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableComputingPowerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableComputingPowerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableComputingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimableComputingPower != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ClaimableComputingPower))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Node != nil {
+		l = m.Node.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodeDivisionHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodeDivisionHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDivisionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DivisionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDivisionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Division != nil {
+		l = m.Division.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDivisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDivisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Divisions) > 0 {
+		for _, e := range m.Divisions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DivisionId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		n += 1 + sovQuery(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *QuerySaleLevelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySaleLevelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SaleLevel != 0 {
+		n += 1 + sovQuery(uint64(m.SaleLevel))
+	}
+	return n
+}
+
+func (m *QueryAuthorizedMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAuthorizedMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCurrentEpochRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCurrentEpochResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *QueryNodeLastEpochInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNodeLastEpochInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.LastEpochEmission)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.HistoricalEmission)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PledgeRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ComputingPower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEpochStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryEpochStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	l = len(m.GlobalComputingPower)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReportDigest)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EpochEmission)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryReportProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryReportProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Digest != nil {
+		l = m.Digest.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StandBy {
+		n += 2
+	}
+	l = m.EpochEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Batches) > 0 {
+		for _, e := range m.Batches {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Ended {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CurrentEpoch != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentEpoch))
+	}
+	if m.CurrentSaleLevel != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentSaleLevel))
+	}
+	l = m.CurrentHalvingEraCoefficient.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.SaleLevels) > 0 {
+		for _, e := range m.SaleLevels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.HalvingEras) > 0 {
+		for _, e := range m.HalvingEras {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEmissionForecastRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PowerOnRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PledgeAmount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExtraComputingPower != 0 {
+		n += 1 + sovQuery(uint64(m.ExtraComputingPower))
+	}
+	if m.SaleLevel != 0 {
+		n += 1 + sovQuery(uint64(m.SaleLevel))
+	}
+	l = len(m.GlobalOnOperationRatio)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epochs != 0 {
+		n += 1 + sovQuery(uint64(m.Epochs))
+	}
+	return n
+}
+
+func (m *QueryEmissionForecastResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Forecasts) > 0 {
+		for _, e := range m.Forecasts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TotalNodeEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *EpochEmissionForecast) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochId != 0 {
+		n += 1 + sovQuery(uint64(m.EpochId))
+	}
+	l = m.NodeComputingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.GlobalComputingPower.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EmissionShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EpochEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.NodeEmission.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClaimableComputingPowerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimableComputingPowerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimableComputingPower != 0 {
+		n += 1 + sovQuery(uint64(m.ClaimableComputingPower))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Node == nil {
+				m.Node = &Node{}
+			}
+			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, Node{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodeDivisionHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodeDivisionHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodeDivisionHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNodeDivisionHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodeDivisionHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodeDivisionHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, NodeDivisionChange{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryDivisionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDivisionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDivisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DivisionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DivisionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDivisionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDivisionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDivisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Division", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Division == nil {
+				m.Division = &Division{}
+			}
+			if err := m.Division.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDivisionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3007,12 +5391,48 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDivisionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDivisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3034,7 +5454,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDivisionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3057,15 +5477,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDivisionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDivisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Divisions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3092,7 +5512,44 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Divisions = append(m.Divisions, Division{})
+			if err := m.Divisions[len(m.Divisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3117,7 +5574,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNodeRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3140,15 +5597,15 @@ func (m *QueryNodeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNodeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DivisionId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3176,7 +5633,7 @@ func (m *QueryNodeRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeId = string(dAtA[iNdEx:postIndex])
+			m.DivisionId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3199,7 +5656,7 @@ func (m *QueryNodeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNodeResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3222,17 +5679,17 @@ func (m *QueryNodeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNodeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3242,28 +5699,61 @@ func (m *QueryNodeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Amount |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Node == nil {
-				m.Node = &Node{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySaleLevelRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if err := m.Node.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySaleLevelRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySaleLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3285,7 +5775,7 @@ func (m *QueryNodeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNodesRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySaleLevelResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3308,17 +5798,17 @@ func (m *QueryNodesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNodesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySaleLevelResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySaleLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleLevel", wireType)
 			}
-			var stringLen uint64
+			m.SaleLevel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3328,60 +5818,61 @@ func (m *QueryNodesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.SaleLevel |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAuthorizedMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuthorizedMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuthorizedMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3403,7 +5894,7 @@ func (m *QueryNodesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNodesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuthorizedMembersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3426,51 +5917,17 @@ func (m *QueryNodesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNodesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuthorizedMembersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuthorizedMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Nodes = append(m.Nodes, Node{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3480,27 +5937,23 @@ func (m *QueryNodesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3523,7 +5976,7 @@ func (m *QueryNodesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDivisionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3546,44 +5999,12 @@ func (m *QueryDivisionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDivisionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDivisionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DivisionId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.DivisionId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3605,7 +6026,7 @@ func (m *QueryDivisionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDivisionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3628,17 +6049,17 @@ func (m *QueryDivisionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDivisionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDivisionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Division", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var msglen int
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3648,28 +6069,30 @@ func (m *QueryDivisionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Division == nil {
-				m.Division = &Division{}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			if err := m.Division.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3691,7 +6114,7 @@ func (m *QueryDivisionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDivisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNodeLastEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3714,17 +6137,17 @@ func (m *QueryDivisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDivisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNodeLastEpochInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDivisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNodeLastEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3734,27 +6157,23 @@ func (m *QueryDivisionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3777,7 +6196,7 @@ func (m *QueryDivisionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDivisionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3796,21 +6215,91 @@ func (m *QueryDivisionsResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDivisionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDivisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNodeLastEpochInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNodeLastEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastEpochEmission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Divisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEmission", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3820,31 +6309,29 @@ func (m *QueryDivisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Divisions = append(m.Divisions, Division{})
-			if err := m.Divisions[len(m.Divisions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.HistoricalEmission = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PledgeRatio", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3854,81 +6341,27 @@ func (m *QueryDivisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PledgeRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DivisionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3956,7 +6389,7 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DivisionId = string(dAtA[iNdEx:postIndex])
+			m.ComputingPower = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3979,7 +6412,7 @@ func (m *QuerySupplyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4002,17 +6435,17 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySupplyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			m.Amount = 0
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4022,7 +6455,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4048,7 +6481,7 @@ func (m *QuerySupplyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySaleLevelRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4071,67 +6504,100 @@ func (m *QuerySaleLevelRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySaleLevelRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEpochStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySaleLevelRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEpochStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalComputingPower", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySaleLevelResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.GlobalComputingPower = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportDigest", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySaleLevelResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySaleLevelResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SaleLevel", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.SaleLevel = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReportDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEmission", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4141,11 +6607,24 @@ func (m *QuerySaleLevelResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SaleLevel |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochEmission = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4167,7 +6646,7 @@ func (m *QuerySaleLevelResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuthorizedMembersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryReportProgressRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4190,12 +6669,31 @@ func (m *QueryAuthorizedMembersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuthorizedMembersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReportProgressRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuthorizedMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReportProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4217,7 +6715,7 @@ func (m *QueryAuthorizedMembersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAuthorizedMembersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryReportProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4240,15 +6738,90 @@ func (m *QueryAuthorizedMembersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuthorizedMembersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryReportProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuthorizedMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryReportProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Digest == nil {
+				m.Digest = &ReportDigest{}
+			}
+			if err := m.Digest.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StandBy", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StandBy = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochEmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4276,8 +6849,64 @@ func (m *QueryAuthorizedMembersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			if err := m.EpochEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Batches", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Batches = append(m.Batches, BatchBase{})
+			if err := m.Batches[len(m.Batches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ended", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ended = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4299,7 +6928,7 @@ func (m *QueryAuthorizedMembersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4322,10 +6951,10 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduleRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4349,7 +6978,7 @@ func (m *QueryCurrentEpochRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4372,17 +7001,17 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentEpochResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentEpoch", wireType)
 			}
-			m.Epoch = 0
+			m.CurrentEpoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4392,16 +7021,16 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.CurrentEpoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSaleLevel", wireType)
 			}
-			m.Height = 0
+			m.CurrentSaleLevel = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4411,66 +7040,84 @@ func (m *QueryCurrentEpochResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
+				m.CurrentSaleLevel |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentHalvingEraCoefficient", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryNodeLastEpochInfoRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if err := m.CurrentHalvingEraCoefficient.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleLevels", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNodeLastEpochInfoRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNodeLastEpochInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SaleLevels = append(m.SaleLevels, SaleLevelMilestoneStatus{})
+			if err := m.SaleLevels[len(m.SaleLevels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingEras", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4480,23 +7127,25 @@ func (m *QueryNodeLastEpochInfoRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeId = string(dAtA[iNdEx:postIndex])
+			m.HalvingEras = append(m.HalvingEras, HalvingEraStatus{})
+			if err := m.HalvingEras[len(m.HalvingEras)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4519,7 +7168,7 @@ func (m *QueryNodeLastEpochInfoRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryEmissionForecastRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4542,17 +7191,17 @@ func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNodeLastEpochInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmissionForecastRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNodeLastEpochInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmissionForecastRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
-			m.Epoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4562,33 +7211,27 @@ func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.Height = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Height |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastEpochEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerOnRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4616,11 +7259,11 @@ func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastEpochEmission = string(dAtA[iNdEx:postIndex])
+			m.PowerOnRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HistoricalEmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PledgeAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4648,13 +7291,13 @@ func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HistoricalEmission = string(dAtA[iNdEx:postIndex])
+			m.PledgeAmount = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PledgeRatio", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraComputingPower", wireType)
 			}
-			var stringLen uint64
+			m.ExtraComputingPower = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4664,27 +7307,33 @@ func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExtraComputingPower |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SaleLevel", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SaleLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SaleLevel |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.PledgeRatio = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComputingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalOnOperationRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4712,8 +7361,27 @@ func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ComputingPower = string(dAtA[iNdEx:postIndex])
+			m.GlobalOnOperationRatio = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			m.Epochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4735,7 +7403,7 @@ func (m *QueryNodeLastEpochInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryEmissionForecastResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4758,17 +7426,17 @@ func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEmissionForecastResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEmissionForecastResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forecasts", wireType)
 			}
-			m.Epoch = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4778,11 +7446,60 @@ func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forecasts = append(m.Forecasts, EpochEmissionForecast{})
+			if err := m.Forecasts[len(m.Forecasts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalNodeEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalNodeEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4804,7 +7521,7 @@ func (m *QueryEpochStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *EpochEmissionForecast) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4827,17 +7544,17 @@ func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEpochStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: EpochEmissionForecast: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEpochStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EpochEmissionForecast: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochId", wireType)
 			}
-			m.Epoch = 0
+			m.EpochId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4847,14 +7564,14 @@ func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.EpochId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GlobalComputingPower", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeComputingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4882,11 +7599,13 @@ func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GlobalComputingPower = string(dAtA[iNdEx:postIndex])
+			if err := m.NodeComputingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReportDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GlobalComputingPower", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4914,9 +7633,45 @@ func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReportDigest = string(dAtA[iNdEx:postIndex])
+			if err := m.GlobalComputingPower.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field EpochEmission", wireType)
 			}
@@ -4946,7 +7701,43 @@ func (m *QueryEpochStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EpochEmission = string(dAtA[iNdEx:postIndex])
+			if err := m.EpochEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeEmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NodeEmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
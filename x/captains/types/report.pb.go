@@ -223,7 +223,7 @@ type ReportEmission struct {
 	// node_count is the number of nodes in the batch
 	NodeCount uint64 `protobuf:"varint,3,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
 	// nodes is the list of node in the batch
-	Nodes []NodeEpochEmission `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes"`
+	Nodes []NodeEpochEmission `protobuf:"bytes,4,rep,name=nodes,proto3" json:"nodes"`
 }
 
 func (m *ReportEmission) Reset()         { *m = ReportEmission{} }
@@ -266,6 +266,20 @@ func (m *ReportEmission) GetEpochId() uint64 {
 	return 0
 }
 
+func (m *ReportEmission) GetBatchId() uint64 {
+	if m != nil {
+		return m.BatchId
+	}
+	return 0
+}
+
+func (m *ReportEmission) GetNodeCount() uint64 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
 func (m *ReportEmission) GetNodes() []NodeEpochEmission {
 	if m != nil {
 		return m.Nodes
@@ -491,50 +505,50 @@ func init() {
 func init() { proto.RegisterFile("tabi/captains/v1/report.proto", fileDescriptor_2b04da73fb1305c0) }
 
 var fileDescriptor_2b04da73fb1305c0 = []byte{
-	// 678 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x6f, 0xd3, 0x4a,
-	0x14, 0x8d, 0xd3, 0xf4, 0x23, 0xb7, 0x7d, 0xad, 0x3b, 0xaf, 0xaf, 0x75, 0xfa, 0xa8, 0x5b, 0x05,
-	0xa9, 0x0a, 0x95, 0x6a, 0x93, 0xb2, 0xad, 0x40, 0x38, 0x31, 0xe0, 0x05, 0x49, 0xe4, 0x84, 0x05,
-	0x6c, 0x2c, 0x7f, 0x8c, 0x52, 0x43, 0xe2, 0xb1, 0xec, 0xe9, 0xd7, 0x2f, 0x60, 0xcb, 0x8e, 0x1d,
-	0x0b, 0x96, 0xac, 0x59, 0xf0, 0x13, 0xba, 0xac, 0x58, 0x21, 0x16, 0x05, 0xb5, 0x7f, 0x04, 0xcd,
-	0x8c, 0x03, 0x4e, 0x5b, 0x2a, 0x16, 0x6c, 0x12, 0xdf, 0x7b, 0xce, 0xcc, 0x39, 0x3e, 0x73, 0xc7,
-	0xb0, 0x46, 0x5d, 0x2f, 0xd4, 0x7d, 0x37, 0xa6, 0x6e, 0x18, 0xa5, 0xfa, 0x41, 0x5d, 0x4f, 0x70,
-	0x4c, 0x12, 0xaa, 0xc5, 0x09, 0xa1, 0x04, 0xc9, 0x0c, 0xd6, 0x46, 0xb0, 0x76, 0x50, 0x5f, 0x5d,
-	0xea, 0x93, 0x3e, 0xe1, 0xa0, 0xce, 0x9e, 0x04, 0x6f, 0xb5, 0xe2, 0x93, 0x74, 0x48, 0x52, 0x47,
-	0x00, 0xa2, 0xc8, 0x20, 0x55, 0x54, 0xba, 0xe7, 0xa6, 0x58, 0x3f, 0xa8, 0x7b, 0x98, 0xba, 0x75,
-	0xdd, 0x27, 0x61, 0x24, 0xf0, 0xea, 0xa7, 0x22, 0xcc, 0xd9, 0x5c, 0xb3, 0x19, 0xf6, 0x71, 0x4a,
-	0x51, 0x05, 0x66, 0x70, 0x4c, 0xfc, 0x3d, 0x27, 0x0c, 0x14, 0x69, 0x43, 0xaa, 0x95, 0xec, 0x69,
-	0x5e, 0x5b, 0x01, 0xda, 0x82, 0x45, 0x4a, 0xa8, 0x3b, 0x70, 0x3c, 0x97, 0xfa, 0x7b, 0x8e, 0x4f,
-	0xf6, 0x23, 0xaa, 0x14, 0x39, 0x67, 0x81, 0x03, 0x06, 0xeb, 0x37, 0x58, 0x1b, 0xd5, 0x40, 0x16,
-	0xdc, 0x88, 0x04, 0x38, 0xa3, 0x4e, 0x70, 0xea, 0x3c, 0xef, 0xb7, 0x48, 0x80, 0x05, 0xf3, 0x3e,
-	0xdc, 0x1a, 0xba, 0x47, 0xe1, 0x70, 0x7f, 0x98, 0xe3, 0x3a, 0x31, 0x4e, 0x84, 0x8c, 0x52, 0xe2,
-	0xab, 0x94, 0x8c, 0xf3, 0x73, 0x5d, 0x07, 0x27, 0x5c, 0x0e, 0x1d, 0x42, 0xa5, 0x3f, 0x20, 0x9e,
-	0x3b, 0x70, 0x48, 0xe4, 0x90, 0x18, 0x27, 0x2e, 0x0d, 0x49, 0xe4, 0xf0, 0x3f, 0x65, 0x72, 0x43,
-	0xaa, 0x95, 0x8d, 0xdd, 0x93, 0xb3, 0xf5, 0xc2, 0xd7, 0xb3, 0xf5, 0xcd, 0x7e, 0x48, 0xf7, 0xf6,
-	0x3d, 0xcd, 0x27, 0xc3, 0x2c, 0xa5, 0xec, 0x6f, 0x3b, 0x0d, 0x5e, 0xe9, 0xf4, 0x38, 0xc6, 0xa9,
-	0xd6, 0xc4, 0xfe, 0xe7, 0x8f, 0xdb, 0x90, 0x85, 0xd8, 0xc4, 0xbe, 0xbd, 0x2c, 0xb6, 0x6f, 0x47,
-	0xed, 0xd1, 0xe6, 0x36, 0xfb, 0xad, 0xbe, 0x97, 0x60, 0x56, 0x44, 0x27, 0x8c, 0xdc, 0x90, 0x5c,
-	0x05, 0x66, 0x44, 0x66, 0x61, 0x90, 0x05, 0x36, 0xcd, 0x6b, 0x2b, 0x40, 0x6b, 0x00, 0x57, 0x22,
-	0x2a, 0x47, 0xb9, 0x74, 0x26, 0x59, 0x91, 0x2a, 0xa5, 0x8d, 0x89, 0xda, 0xec, 0x4e, 0x55, 0xbb,
-	0x3c, 0x12, 0x1a, 0x4b, 0xa4, 0x43, 0x0e, 0x71, 0xd2, 0x16, 0xbe, 0x8c, 0x12, 0x7b, 0x5b, 0x5b,
-	0x2c, 0xab, 0x0e, 0x60, 0x5e, 0x78, 0x34, 0x87, 0x61, 0x9a, 0x86, 0x24, 0xba, 0xc9, 0xe6, 0x83,
-	0x91, 0x58, 0x91, 0x8b, 0xdd, 0xbe, 0x5e, 0xcc, 0x64, 0xec, 0xd1, 0x76, 0xe3, 0x6a, 0x9b, 0x50,
-	0xce, 0xd4, 0xa2, 0xe0, 0x06, 0xa1, 0xea, 0x5b, 0x09, 0xe4, 0xcb, 0xbe, 0xd1, 0x0a, 0x4c, 0xf3,
-	0x24, 0x32, 0x7a, 0xd9, 0x9e, 0x62, 0xa5, 0x15, 0xa0, 0x97, 0x80, 0xae, 0x39, 0xda, 0xe2, 0x5f,
-	0x38, 0x5a, 0x99, 0x5c, 0x3e, 0xd4, 0x77, 0x12, 0x2c, 0x5e, 0x79, 0xc9, 0xdf, 0x5b, 0x8b, 0xe1,
-	0x1f, 0x0e, 0xe0, 0x8c, 0xc9, 0x5d, 0xcd, 0xee, 0x54, 0xb4, 0x4c, 0x84, 0x5d, 0x3b, 0x2d, 0xbb,
-	0x76, 0x5a, 0x83, 0x84, 0x91, 0x71, 0x97, 0x19, 0xfe, 0xf0, 0x6d, 0xbd, 0xf6, 0x07, 0x86, 0xd9,
-	0x82, 0xd4, 0x9e, 0x63, 0x0a, 0x23, 0x2b, 0xd5, 0x5d, 0x28, 0xf3, 0x71, 0x33, 0xdc, 0x14, 0x8f,
-	0xcd, 0x95, 0x34, 0x3e, 0x57, 0x4b, 0x30, 0x99, 0xbf, 0xa0, 0xa2, 0xd8, 0x7a, 0x2d, 0x01, 0x88,
-	0x13, 0xea, 0x1d, 0xc7, 0x18, 0xfd, 0x0f, 0x2b, 0xb6, 0xd9, 0x69, 0xdb, 0x3d, 0xa7, 0xf7, 0xbc,
-	0x63, 0x3a, 0xcf, 0x5a, 0xdd, 0x8e, 0xd9, 0xb0, 0x1e, 0x59, 0x66, 0x53, 0x2e, 0xa0, 0x65, 0x40,
-	0x79, 0xb0, 0x69, 0x3d, 0x36, 0xbb, 0x3d, 0x59, 0x42, 0xff, 0xc1, 0x62, 0xbe, 0x6f, 0x3c, 0xec,
-	0x35, 0x9e, 0xc8, 0x45, 0xa4, 0xc0, 0x52, 0xbe, 0x6d, 0x3e, 0xb5, 0xba, 0x5d, 0xab, 0xdd, 0x92,
-	0x27, 0xd0, 0xbf, 0xb0, 0x30, 0x86, 0xb4, 0x9a, 0x72, 0xc9, 0x68, 0x9c, 0x9c, 0xab, 0xd2, 0xe9,
-	0xb9, 0x2a, 0x7d, 0x3f, 0x57, 0xa5, 0x37, 0x17, 0x6a, 0xe1, 0xf4, 0x42, 0x2d, 0x7c, 0xb9, 0x50,
-	0x0b, 0x2f, 0xee, 0xe4, 0x92, 0x61, 0x03, 0x38, 0x70, 0xbd, 0x94, 0x3f, 0xe8, 0x47, 0xbf, 0x3e,
-	0x95, 0x3c, 0x20, 0x6f, 0x8a, 0x7f, 0xc4, 0xee, 0xfd, 0x08, 0x00, 0x00, 0xff, 0xff, 0x7a, 0x83,
-	0x95, 0x86, 0x48, 0x05, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xd3, 0xf4, 0x23, 0xd3, 0xd2, 0xba, 0x4b, 0x69, 0x9d, 0xd2, 0xba, 0x55, 0x90, 0xaa,
+	0x50, 0xa9, 0xb6, 0x52, 0xae, 0x15, 0x08, 0x27, 0x06, 0x7c, 0x20, 0x89, 0x9c, 0x70, 0x80, 0x8b,
+	0xe5, 0x8f, 0x55, 0x6a, 0x48, 0xbc, 0x96, 0xed, 0xf4, 0xe3, 0x17, 0x70, 0xe5, 0xc6, 0x1d, 0x24,
+	0x0e, 0x9c, 0x39, 0xf0, 0x13, 0x7a, 0xac, 0x38, 0x21, 0x0e, 0x05, 0xb5, 0x7f, 0x04, 0xed, 0xae,
+	0x03, 0x4e, 0x5a, 0x2a, 0x0e, 0x70, 0xb1, 0x3d, 0xf3, 0xde, 0x78, 0xde, 0xbe, 0x9d, 0x5d, 0x58,
+	0x4f, 0x6c, 0xc7, 0x57, 0x5d, 0x3b, 0x4c, 0x6c, 0x3f, 0x88, 0xd5, 0x83, 0xaa, 0x1a, 0xe1, 0x90,
+	0x44, 0x89, 0x12, 0x46, 0x24, 0x21, 0x48, 0xa4, 0xb0, 0x32, 0x84, 0x95, 0x83, 0xea, 0xea, 0x52,
+	0x97, 0x74, 0x09, 0x03, 0x55, 0xfa, 0xc5, 0x79, 0xab, 0x25, 0x97, 0xc4, 0x7d, 0x12, 0x5b, 0x1c,
+	0xe0, 0x41, 0x0a, 0xc9, 0x3c, 0x52, 0x1d, 0x3b, 0xc6, 0xea, 0x41, 0xd5, 0xc1, 0x89, 0x5d, 0x55,
+	0x5d, 0xe2, 0x07, 0x1c, 0x2f, 0x7f, 0xce, 0xc3, 0x9c, 0xc9, 0x7a, 0xd6, 0xfd, 0x2e, 0x8e, 0x13,
+	0x54, 0x82, 0x19, 0x1c, 0x12, 0x77, 0xdf, 0xf2, 0x3d, 0x49, 0xd8, 0x14, 0x2a, 0x05, 0x73, 0x9a,
+	0xc5, 0x86, 0x87, 0xb6, 0x61, 0x31, 0x21, 0x89, 0xdd, 0xb3, 0x1c, 0x3b, 0x71, 0xf7, 0x2d, 0x97,
+	0x0c, 0x82, 0x44, 0xca, 0x33, 0xce, 0x02, 0x03, 0x34, 0x9a, 0xaf, 0xd1, 0x34, 0xaa, 0x80, 0xc8,
+	0xb9, 0x01, 0xf1, 0x70, 0x4a, 0x9d, 0x60, 0xd4, 0x79, 0x96, 0x6f, 0x10, 0x0f, 0x73, 0xe6, 0x7d,
+	0x58, 0xeb, 0xdb, 0x47, 0x7e, 0x7f, 0xd0, 0xcf, 0x70, 0xad, 0x10, 0x47, 0xbc, 0x8d, 0x54, 0x60,
+	0x55, 0x52, 0xca, 0xf9, 0x55, 0xd7, 0xc2, 0x11, 0x6b, 0x87, 0x0e, 0xa1, 0xd4, 0xed, 0x11, 0xc7,
+	0xee, 0x59, 0x24, 0xb0, 0x48, 0x88, 0x23, 0x3b, 0xf1, 0x49, 0x60, 0xb1, 0x97, 0x34, 0xb9, 0x29,
+	0x54, 0x8a, 0xda, 0xde, 0xc9, 0xd9, 0x46, 0xee, 0xdb, 0xd9, 0xc6, 0x56, 0xd7, 0x4f, 0xf6, 0x07,
+	0x8e, 0xe2, 0x92, 0x7e, 0xea, 0x52, 0xfa, 0xda, 0x89, 0xbd, 0x57, 0x6a, 0x72, 0x1c, 0xe2, 0x58,
+	0xa9, 0x63, 0xf7, 0xcb, 0xa7, 0x1d, 0x48, 0x4d, 0xac, 0x63, 0xd7, 0x5c, 0xe6, 0xbf, 0x6f, 0x06,
+	0xcd, 0xe1, 0xcf, 0x4d, 0xfa, 0x2c, 0xbf, 0x13, 0x60, 0x96, 0x5b, 0xc7, 0x85, 0x5c, 0xe3, 0x5c,
+	0x09, 0x66, 0xb8, 0x67, 0xbe, 0x97, 0x1a, 0x36, 0xcd, 0x62, 0xc3, 0x43, 0xeb, 0x00, 0x97, 0x2c,
+	0x2a, 0x06, 0x19, 0x77, 0x26, 0x69, 0x10, 0x4b, 0x85, 0xcd, 0x89, 0xca, 0xec, 0x6e, 0x59, 0x19,
+	0x1f, 0x09, 0x85, 0x3a, 0xd2, 0x22, 0x87, 0x38, 0x6a, 0x72, 0x5d, 0x5a, 0x81, 0xae, 0xd6, 0xe4,
+	0x65, 0xe5, 0x0f, 0x02, 0xcc, 0x73, 0x91, 0x7a, 0xdf, 0x8f, 0x63, 0x9f, 0x04, 0xff, 0x47, 0xe7,
+	0x83, 0x51, 0x9d, 0x77, 0xae, 0xd6, 0xa9, 0xd3, 0x3e, 0x43, 0x21, 0xa3, 0x42, 0xb7, 0xa0, 0x98,
+	0xea, 0x0c, 0xbc, 0x6b, 0x24, 0x96, 0xdf, 0x0a, 0x20, 0x8e, 0x2f, 0x19, 0xad, 0xc0, 0x34, 0x13,
+	0x97, 0xd2, 0x8b, 0xe6, 0x14, 0x0d, 0x0d, 0x0f, 0xbd, 0x04, 0x74, 0xc5, 0x54, 0xe4, 0xff, 0xc1,
+	0x54, 0x88, 0x64, 0x7c, 0x1e, 0xde, 0x0b, 0xb0, 0x78, 0x69, 0x91, 0x7f, 0x96, 0x36, 0x80, 0x1b,
+	0x0c, 0xc0, 0x29, 0x93, 0xa9, 0x9a, 0xdd, 0x5d, 0x53, 0xd2, 0x26, 0xf4, 0xc4, 0x2a, 0xe9, 0x89,
+	0xa5, 0x1d, 0x6b, 0xc4, 0x0f, 0xb4, 0x5d, 0xaa, 0xf9, 0xe3, 0xf7, 0x8d, 0xed, 0xbf, 0xd3, 0x4c,
+	0x6b, 0xcc, 0x39, 0xda, 0x66, 0xa8, 0xa7, 0xbc, 0x07, 0x45, 0x36, 0xae, 0x9a, 0x1d, 0xe3, 0x91,
+	0xfd, 0x16, 0x46, 0xf7, 0x7b, 0x09, 0x26, 0xb3, 0x07, 0x9c, 0x07, 0xdb, 0xaf, 0x05, 0x00, 0xbe,
+	0x4d, 0x9d, 0xe3, 0x10, 0xa3, 0xdb, 0xb0, 0x62, 0xea, 0xad, 0xa6, 0xd9, 0xb1, 0x3a, 0xcf, 0x5b,
+	0xba, 0xf5, 0xac, 0xd1, 0x6e, 0xe9, 0x35, 0xe3, 0x91, 0xa1, 0xd7, 0xc5, 0x1c, 0x5a, 0x06, 0x94,
+	0x05, 0xeb, 0xc6, 0x63, 0xbd, 0xdd, 0x11, 0x05, 0x74, 0x0b, 0x16, 0xb3, 0x79, 0xed, 0x61, 0xa7,
+	0xf6, 0x44, 0xcc, 0x23, 0x09, 0x96, 0xb2, 0x69, 0xfd, 0xa9, 0xd1, 0x6e, 0x1b, 0xcd, 0x86, 0x38,
+	0x81, 0x6e, 0xc2, 0xc2, 0x08, 0xd2, 0xa8, 0x8b, 0x05, 0xad, 0x76, 0x72, 0x2e, 0x0b, 0xa7, 0xe7,
+	0xb2, 0xf0, 0xe3, 0x5c, 0x16, 0xde, 0x5c, 0xc8, 0xb9, 0xd3, 0x0b, 0x39, 0xf7, 0xf5, 0x42, 0xce,
+	0xbd, 0xb8, 0x9b, 0xf1, 0x86, 0x4e, 0x61, 0xcf, 0x76, 0x62, 0xf6, 0xa1, 0x1e, 0xfd, 0xbe, 0x6a,
+	0x99, 0x45, 0xce, 0x14, 0xbb, 0x04, 0xef, 0xfd, 0x1c, 0x00, 0x02, 0xa4, 0x21, 0x13, 0x88, 0x05,
+	0x00, 0x00,
 }
 
 func (m *ReportDigest) Marshal() (dAtA []byte, err error) {
@@ -673,9 +687,19 @@ func (m *ReportEmission) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintReport(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
+	if m.NodeCount != 0 {
+		i = encodeVarintReport(dAtA, i, uint64(m.NodeCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BatchId != 0 {
+		i = encodeVarintReport(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.EpochId != 0 {
 		i = encodeVarintReport(dAtA, i, uint64(m.EpochId))
 		i--
@@ -892,6 +916,12 @@ func (m *ReportEmission) Size() (n int) {
 	if m.EpochId != 0 {
 		n += 1 + sovReport(uint64(m.EpochId))
 	}
+	if m.BatchId != 0 {
+		n += 1 + sovReport(uint64(m.BatchId))
+	}
+	if m.NodeCount != 0 {
+		n += 1 + sovReport(uint64(m.NodeCount))
+	}
 	if len(m.Nodes) > 0 {
 		for _, e := range m.Nodes {
 			l = e.Size()
@@ -1314,6 +1344,44 @@ func (m *ReportEmission) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCount", wireType)
+			}
+			m.NodeCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NodeCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
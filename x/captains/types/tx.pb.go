@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -663,6 +664,172 @@ func (m *MsgClaimComputingPowerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimComputingPowerResponse proto.InternalMessageInfo
 
+// MsgSubmitNodeMisbehavior defines the Msg/SubmitNodeMisbehavior request type.
+type MsgSubmitNodeMisbehavior struct {
+	// authority is an authorized member or the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// node_id
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	// misbehavior_type
+	MisbehaviorType MisbehaviorType `protobuf:"varint,3,opt,name=misbehavior_type,json=misbehaviorType,proto3,enum=tabi.captains.v1.MisbehaviorType" json:"misbehavior_type,omitempty"`
+	// evidence describes the proof of the misbehavior
+	Evidence string `protobuf:"bytes,4,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// penalty_epochs is the number of epochs the node earns no emission
+	PenaltyEpochs uint64 `protobuf:"varint,5,opt,name=penalty_epochs,json=penaltyEpochs,proto3" json:"penalty_epochs,omitempty"`
+	// slash_fraction is the fraction of unclaimed emission to confiscate
+	SlashFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=slash_fraction,json=slashFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"slash_fraction"`
+	// jail defines whether to exclude the node from power calculation
+	Jail bool `protobuf:"varint,7,opt,name=jail,proto3" json:"jail,omitempty"`
+}
+
+func (m *MsgSubmitNodeMisbehavior) Reset()         { *m = MsgSubmitNodeMisbehavior{} }
+func (m *MsgSubmitNodeMisbehavior) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitNodeMisbehavior) ProtoMessage()    {}
+func (*MsgSubmitNodeMisbehavior) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c8063cf8a41f43, []int{16}
+}
+func (m *MsgSubmitNodeMisbehavior) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitNodeMisbehavior) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitNodeMisbehavior.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitNodeMisbehavior) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitNodeMisbehavior.Merge(m, src)
+}
+func (m *MsgSubmitNodeMisbehavior) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitNodeMisbehavior) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitNodeMisbehavior.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitNodeMisbehavior proto.InternalMessageInfo
+
+// MsgSubmitNodeMisbehaviorResponse defines the Msg/SubmitNodeMisbehavior response type.
+type MsgSubmitNodeMisbehaviorResponse struct {
+}
+
+func (m *MsgSubmitNodeMisbehaviorResponse) Reset()         { *m = MsgSubmitNodeMisbehaviorResponse{} }
+func (m *MsgSubmitNodeMisbehaviorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitNodeMisbehaviorResponse) ProtoMessage()    {}
+func (*MsgSubmitNodeMisbehaviorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c8063cf8a41f43, []int{17}
+}
+func (m *MsgSubmitNodeMisbehaviorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitNodeMisbehaviorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitNodeMisbehaviorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSubmitNodeMisbehaviorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitNodeMisbehaviorResponse.Merge(m, src)
+}
+func (m *MsgSubmitNodeMisbehaviorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitNodeMisbehaviorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitNodeMisbehaviorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSubmitNodeMisbehaviorResponse proto.InternalMessageInfo
+
+// MsgUnjailNode defines the Msg/UnjailNode request type.
+type MsgUnjailNode struct {
+	// authority is an authorized member or the governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// node_id
+	NodeId string `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+}
+
+func (m *MsgUnjailNode) Reset()         { *m = MsgUnjailNode{} }
+func (m *MsgUnjailNode) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailNode) ProtoMessage()    {}
+func (*MsgUnjailNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c8063cf8a41f43, []int{18}
+}
+func (m *MsgUnjailNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailNode.Merge(m, src)
+}
+func (m *MsgUnjailNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailNode proto.InternalMessageInfo
+
+// MsgUnjailNodeResponse defines the Msg/UnjailNode response type.
+type MsgUnjailNodeResponse struct {
+}
+
+func (m *MsgUnjailNodeResponse) Reset()         { *m = MsgUnjailNodeResponse{} }
+func (m *MsgUnjailNodeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnjailNodeResponse) ProtoMessage()    {}
+func (*MsgUnjailNodeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c8063cf8a41f43, []int{19}
+}
+func (m *MsgUnjailNodeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnjailNodeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnjailNodeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnjailNodeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnjailNodeResponse.Merge(m, src)
+}
+func (m *MsgUnjailNodeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnjailNodeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnjailNodeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnjailNodeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "tabi.captains.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "tabi.captains.v1.MsgUpdateParamsResponse")
@@ -680,65 +847,83 @@ func init() {
 	proto.RegisterType((*MsgCommitComputingPowerResponse)(nil), "tabi.captains.v1.MsgCommitComputingPowerResponse")
 	proto.RegisterType((*MsgClaimComputingPower)(nil), "tabi.captains.v1.MsgClaimComputingPower")
 	proto.RegisterType((*MsgClaimComputingPowerResponse)(nil), "tabi.captains.v1.MsgClaimComputingPowerResponse")
+	proto.RegisterType((*MsgSubmitNodeMisbehavior)(nil), "tabi.captains.v1.MsgSubmitNodeMisbehavior")
+	proto.RegisterType((*MsgSubmitNodeMisbehaviorResponse)(nil), "tabi.captains.v1.MsgSubmitNodeMisbehaviorResponse")
+	proto.RegisterType((*MsgUnjailNode)(nil), "tabi.captains.v1.MsgUnjailNode")
+	proto.RegisterType((*MsgUnjailNodeResponse)(nil), "tabi.captains.v1.MsgUnjailNodeResponse")
 }
 
 func init() { proto.RegisterFile("tabi/captains/v1/tx.proto", fileDescriptor_37c8063cf8a41f43) }
 
 var fileDescriptor_37c8063cf8a41f43 = []byte{
-	// 844 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xc1, 0x6e, 0xf3, 0x44,
-	0x10, 0x8e, 0x9b, 0x92, 0x2a, 0x93, 0xaa, 0x05, 0x13, 0x9a, 0xd4, 0x6a, 0x9d, 0x34, 0xaa, 0x50,
-	0x8a, 0x8a, 0xdd, 0x86, 0xaa, 0x48, 0x95, 0x38, 0xa4, 0x39, 0xa0, 0x4a, 0x04, 0x55, 0x2e, 0x5c,
-	0x50, 0xa5, 0x68, 0x13, 0x2f, 0xae, 0x85, 0xed, 0x35, 0xde, 0x4d, 0xda, 0x80, 0xe0, 0xc0, 0x13,
-	0xc0, 0x4b, 0x70, 0x85, 0x03, 0xcf, 0x80, 0x2a, 0x4e, 0x15, 0x27, 0x4e, 0x08, 0xda, 0x03, 0x0f,
-	0xc0, 0x0b, 0x20, 0x7b, 0x6d, 0x37, 0x89, 0xed, 0x34, 0xe4, 0xf2, 0xdf, 0xbc, 0x99, 0x6f, 0xbf,
-	0xf9, 0xbe, 0x99, 0xf1, 0x38, 0xb0, 0xcd, 0x50, 0xdf, 0x54, 0x07, 0xc8, 0x65, 0xc8, 0x74, 0xa8,
-	0x3a, 0x3a, 0x56, 0xd9, 0x9d, 0xe2, 0x7a, 0x84, 0x11, 0xf1, 0x75, 0x3f, 0xa4, 0x44, 0x21, 0x65,
-	0x74, 0x2c, 0x95, 0x0d, 0x62, 0x90, 0x20, 0xa8, 0xfa, 0x4f, 0x1c, 0x27, 0x55, 0x06, 0x84, 0xda,
-	0x84, 0xaa, 0x36, 0x35, 0xfc, 0xfb, 0x36, 0x35, 0xc2, 0xc0, 0x36, 0x0f, 0xf4, 0xf8, 0x0d, 0x7e,
-	0x88, 0x42, 0x06, 0x21, 0x86, 0x85, 0xd5, 0xe0, 0xd4, 0x1f, 0x7e, 0xae, 0x22, 0x67, 0x1c, 0x86,
-	0x6a, 0x09, 0x45, 0xb1, 0x04, 0x0e, 0xd8, 0x4d, 0x00, 0x3c, 0xec, 0x12, 0x8f, 0xf1, 0x70, 0xe3,
-	0x07, 0x01, 0x36, 0xbb, 0xd4, 0xf8, 0xd4, 0xd5, 0x11, 0xc3, 0x97, 0xc8, 0x43, 0x36, 0x15, 0x4f,
-	0xa1, 0x88, 0x86, 0xec, 0x86, 0x78, 0x26, 0x1b, 0x57, 0x85, 0xba, 0xd0, 0x2c, 0x9e, 0x57, 0x7f,
-	0xff, 0xe5, 0xdd, 0x72, 0xa8, 0xa9, 0xad, 0xeb, 0x1e, 0xa6, 0xf4, 0x8a, 0x79, 0xa6, 0x63, 0x68,
-	0xcf, 0x50, 0xf1, 0x14, 0x0a, 0x6e, 0xc0, 0x50, 0x5d, 0xa9, 0x0b, 0xcd, 0x52, 0xab, 0xaa, 0xcc,
-	0xd6, 0x44, 0xe1, 0x19, 0xce, 0x57, 0xef, 0xff, 0xac, 0xe5, 0xb4, 0x10, 0x7d, 0xb6, 0xf1, 0xdd,
-	0x3f, 0x3f, 0xbf, 0xf3, 0xcc, 0xd3, 0xd8, 0x86, 0xca, 0x8c, 0x24, 0x0d, 0x53, 0x97, 0x38, 0x14,
-	0x37, 0x7e, 0x12, 0xa0, 0xdc, 0xa5, 0x46, 0xc7, 0xc3, 0x88, 0xe1, 0x0e, 0x27, 0xfe, 0x98, 0xe8,
-	0x78, 0x69, 0xcd, 0x0a, 0xbc, 0x46, 0x6e, 0x1d, 0xec, 0x05, 0x92, 0xe7, 0xdd, 0xe1, 0x30, 0xb1,
-	0x06, 0x25, 0xdd, 0x1c, 0x99, 0xd4, 0x24, 0x4e, 0xcf, 0xd4, 0xab, 0x79, 0xff, 0x96, 0x06, 0xd1,
-	0x4f, 0x17, 0x7a, 0xc2, 0xcc, 0xfb, 0xb0, 0x93, 0x26, 0x38, 0x72, 0x24, 0x56, 0x60, 0xcd, 0x21,
-	0x3a, 0xf6, 0xc9, 0x02, 0xd9, 0x5a, 0xc1, 0x3f, 0x5e, 0xe8, 0x8d, 0x5f, 0x79, 0x67, 0x3a, 0xc4,
-	0xb6, 0x4d, 0xa6, 0x05, 0x3d, 0x5b, 0xda, 0xe5, 0x07, 0x50, 0xe2, 0x5d, 0xef, 0xb1, 0xb1, 0x8b,
-	0x03, 0xaf, 0x1b, 0xad, 0x9d, 0x64, 0x7b, 0x78, 0x9a, 0x4f, 0xc6, 0x2e, 0xd6, 0xc0, 0x8b, 0x9f,
-	0xc5, 0x43, 0x28, 0xf0, 0x53, 0xe0, 0xb7, 0xd4, 0x2a, 0x2b, 0x7c, 0x20, 0x95, 0x68, 0x20, 0x95,
-	0xb6, 0x33, 0xd6, 0x42, 0x4c, 0x46, 0x3b, 0x27, 0x7d, 0xc4, 0xed, 0xfc, 0x3a, 0x08, 0xb5, 0x75,
-	0xbd, 0xcd, 0xd1, 0x5f, 0x61, 0xbd, 0x8b, 0xed, 0x3e, 0xf6, 0x96, 0x1f, 0xc2, 0x2a, 0xac, 0xd9,
-	0x9c, 0xa2, 0xba, 0x52, 0xcf, 0x37, 0x8b, 0x5a, 0x74, 0x4c, 0xe8, 0xda, 0x83, 0x5a, 0x46, 0xf2,
-	0x58, 0xdf, 0xb7, 0x20, 0x75, 0xa9, 0xa1, 0x61, 0x9b, 0x8c, 0xf0, 0xab, 0x90, 0xb8, 0x0f, 0x8d,
-	0xec, 0xfc, 0x13, 0x55, 0x14, 0xe3, 0xf7, 0xe5, 0x0a, 0x59, 0xf8, 0x23, 0x3c, 0xc2, 0xd6, 0xd2,
-	0xea, 0x76, 0x01, 0x28, 0xb2, 0x70, 0xcf, 0xf2, 0x59, 0x82, 0x51, 0x59, 0xd5, 0x8a, 0x34, 0xa2,
-	0x4d, 0x48, 0xdc, 0x09, 0x4a, 0x34, 0x93, 0x3c, 0x96, 0xf6, 0x9b, 0x30, 0xd1, 0xfc, 0x0e, 0xb1,
-	0xdd, 0x21, 0x33, 0x1d, 0xe3, 0x92, 0xdc, 0x62, 0x6f, 0x69, 0x81, 0x06, 0x54, 0x06, 0x11, 0x53,
-	0xcf, 0xf5, 0xa9, 0x7a, 0x1e, 0xbe, 0x45, 0x9e, 0xce, 0xcb, 0x59, 0x6a, 0x1d, 0x24, 0x07, 0xbb,
-	0x63, 0x21, 0xd3, 0x46, 0x7d, 0x0b, 0x4f, 0x6b, 0x08, 0x17, 0xd1, 0x5b, 0x83, 0xa9, 0x5f, 0x35,
-	0xce, 0x96, 0x31, 0x30, 0x69, 0x5e, 0x62, 0xbf, 0x3f, 0x0a, 0xb0, 0xe5, 0x63, 0xfc, 0x74, 0x33,
-	0x76, 0x8f, 0xa0, 0x40, 0xb1, 0xa3, 0x63, 0xef, 0x45, 0xaf, 0x21, 0x4e, 0x3c, 0x81, 0xad, 0x59,
-	0xa3, 0xc8, 0x26, 0x43, 0x87, 0x85, 0x5d, 0x29, 0x4f, 0xcb, 0x6e, 0x07, 0xb1, 0xc9, 0x85, 0x92,
-	0x9f, 0x5c, 0x28, 0x67, 0x25, 0xdf, 0x4e, 0xc8, 0xdd, 0xa8, 0x83, 0x9c, 0xae, 0x33, 0xb2, 0xd2,
-	0xfa, 0xb7, 0x00, 0xf9, 0x2e, 0x35, 0xc4, 0x6b, 0x58, 0x9f, 0xfa, 0x3a, 0xec, 0x25, 0xab, 0x3b,
-	0xb3, 0xad, 0xa5, 0x83, 0x17, 0x21, 0xf1, 0xfa, 0xfb, 0x02, 0xde, 0x48, 0x2e, 0xf3, 0xb7, 0x53,
-	0xef, 0x27, 0x70, 0x92, 0xb2, 0x18, 0x2e, 0x4e, 0x76, 0x0d, 0xeb, 0x53, 0xeb, 0x34, 0xdd, 0xca,
-	0x24, 0x24, 0xc3, 0x4a, 0xda, 0x32, 0x13, 0x19, 0x94, 0x53, 0x37, 0x59, 0x3a, 0x45, 0x1a, 0x54,
-	0x3a, 0x5e, 0x18, 0x1a, 0x67, 0xfd, 0x06, 0x2a, 0x59, 0xfb, 0xe9, 0x30, 0x95, 0x2d, 0x03, 0x2d,
-	0x9d, 0xfc, 0x1f, 0x74, 0x9c, 0x1e, 0xc3, 0xe6, 0xec, 0xe2, 0xd9, 0x9f, 0xd3, 0xfd, 0x18, 0x25,
-	0x1d, 0x2e, 0x82, 0x9a, 0xac, 0x6d, 0xea, 0x0e, 0x99, 0xd7, 0x9e, 0x69, 0x68, 0x46, 0x6d, 0xe7,
-	0xbd, 0xcd, 0xe2, 0x97, 0xf0, 0x66, 0xda, 0x9b, 0xdc, 0x4c, 0x67, 0x4a, 0x22, 0xa5, 0xa3, 0x45,
-	0x91, 0x51, 0xca, 0xf3, 0x0f, 0xef, 0xff, 0x96, 0x73, 0xf7, 0x8f, 0xb2, 0xf0, 0xf0, 0x28, 0x0b,
-	0x7f, 0x3d, 0xca, 0xc2, 0xf7, 0x4f, 0x72, 0xee, 0xe1, 0x49, 0xce, 0xfd, 0xf1, 0x24, 0xe7, 0x3e,
-	0x3b, 0x30, 0x4c, 0x76, 0x33, 0xec, 0x2b, 0x03, 0x62, 0xab, 0x3e, 0xb3, 0x85, 0xfa, 0x34, 0x78,
-	0x50, 0xef, 0x9e, 0xff, 0xe2, 0xf9, 0x9f, 0x78, 0xda, 0x2f, 0x04, 0xdf, 0xe6, 0xf7, 0xfe, 0x0b,
-	0x00, 0x00, 0xff, 0xff, 0xdf, 0x3c, 0xe2, 0xe8, 0xb3, 0x0a, 0x00, 0x00,
+	// 1071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xd1, 0x6f, 0xdb, 0xd4,
+	0x17, 0xae, 0x9b, 0x2e, 0x6d, 0x4f, 0xd6, 0x76, 0x3f, 0xff, 0xd2, 0xc5, 0xb5, 0xba, 0x24, 0x8b,
+	0xc6, 0x48, 0xa7, 0xd6, 0x59, 0xc3, 0x34, 0xa4, 0x09, 0x1e, 0xd2, 0x0c, 0xd0, 0xa4, 0x05, 0x4d,
+	0x2e, 0xf0, 0x80, 0x26, 0x45, 0x37, 0xf6, 0x9d, 0x63, 0x66, 0xfb, 0x1a, 0x5f, 0x27, 0x5d, 0x40,
+	0xf0, 0x80, 0xc4, 0x3b, 0xfc, 0x13, 0xbc, 0xc2, 0xc3, 0xfe, 0x04, 0x84, 0x2a, 0x9e, 0xa6, 0x3d,
+	0x21, 0x1e, 0x26, 0x68, 0x1f, 0xf8, 0x37, 0x90, 0xaf, 0xed, 0x5b, 0x27, 0x76, 0x96, 0x10, 0x1e,
+	0x78, 0xaa, 0xaf, 0xcf, 0x77, 0xbf, 0xf3, 0x9d, 0xfb, 0xdd, 0x9c, 0xe3, 0xc2, 0x8e, 0x8f, 0x7a,
+	0x66, 0x43, 0x43, 0xae, 0x8f, 0x4c, 0x87, 0x36, 0x86, 0x87, 0x0d, 0xff, 0x99, 0xe2, 0x7a, 0xc4,
+	0x27, 0xe2, 0x95, 0x20, 0xa4, 0xc4, 0x21, 0x65, 0x78, 0x28, 0x17, 0x0d, 0x62, 0x10, 0x16, 0x6c,
+	0x04, 0x4f, 0x21, 0x4e, 0x2e, 0x69, 0x84, 0xda, 0x84, 0x36, 0x6c, 0x6a, 0x04, 0xfb, 0x6d, 0x6a,
+	0x44, 0x81, 0x9d, 0x30, 0xd0, 0x0d, 0x77, 0x84, 0x8b, 0x38, 0x64, 0x10, 0x62, 0x58, 0xb8, 0xc1,
+	0x56, 0xbd, 0xc1, 0x93, 0x06, 0x72, 0x46, 0x51, 0xa8, 0x92, 0x52, 0xc4, 0x25, 0x84, 0x80, 0x6b,
+	0x29, 0x80, 0x87, 0x5d, 0xe2, 0xf9, 0x61, 0xb8, 0xf6, 0xbd, 0x00, 0x5b, 0x1d, 0x6a, 0x7c, 0xec,
+	0xea, 0xc8, 0xc7, 0x8f, 0x90, 0x87, 0x6c, 0x2a, 0xde, 0x85, 0x75, 0x34, 0xf0, 0xfb, 0xc4, 0x33,
+	0xfd, 0x91, 0x24, 0x54, 0x85, 0xfa, 0xfa, 0x91, 0xf4, 0xf2, 0xf9, 0x41, 0x31, 0xd2, 0xd4, 0xd2,
+	0x75, 0x0f, 0x53, 0x7a, 0xec, 0x7b, 0xa6, 0x63, 0xa8, 0x17, 0x50, 0xf1, 0x2e, 0xe4, 0x5d, 0xc6,
+	0x20, 0x2d, 0x57, 0x85, 0x7a, 0xa1, 0x29, 0x29, 0x93, 0x67, 0xa2, 0x84, 0x19, 0x8e, 0x56, 0x4e,
+	0x5f, 0x55, 0x96, 0xd4, 0x08, 0x7d, 0x6f, 0xf3, 0x9b, 0xbf, 0x7e, 0xba, 0x75, 0xc1, 0x53, 0xdb,
+	0x81, 0xd2, 0x84, 0x24, 0x15, 0x53, 0x97, 0x38, 0x14, 0xd7, 0x7e, 0x14, 0xa0, 0xd8, 0xa1, 0x46,
+	0xdb, 0xc3, 0xc8, 0xc7, 0xed, 0x90, 0xf8, 0x43, 0xa2, 0xe3, 0x85, 0x35, 0x2b, 0x70, 0x89, 0x9c,
+	0x38, 0xd8, 0x93, 0x96, 0x67, 0xec, 0x09, 0x61, 0x62, 0x05, 0x0a, 0xba, 0x39, 0x34, 0xa9, 0x49,
+	0x9c, 0xae, 0xa9, 0x4b, 0xb9, 0x60, 0x97, 0x0a, 0xf1, 0xab, 0x07, 0x7a, 0xaa, 0x98, 0xb7, 0x61,
+	0x37, 0x4b, 0x70, 0x5c, 0x91, 0x58, 0x82, 0x55, 0x87, 0xe8, 0x38, 0x20, 0x63, 0xb2, 0xd5, 0x7c,
+	0xb0, 0x7c, 0xa0, 0xd7, 0x7e, 0x09, 0x9d, 0x69, 0x13, 0xdb, 0x36, 0x7d, 0x95, 0x79, 0xb6, 0x70,
+	0x95, 0xef, 0x42, 0x21, 0x74, 0xbd, 0xeb, 0x8f, 0x5c, 0xcc, 0x6a, 0xdd, 0x6c, 0xee, 0xa6, 0xed,
+	0x09, 0xd3, 0x7c, 0x34, 0x72, 0xb1, 0x0a, 0x1e, 0x7f, 0x16, 0xf7, 0x21, 0x1f, 0xae, 0x58, 0xbd,
+	0x85, 0x66, 0x51, 0x09, 0x2f, 0xa4, 0x12, 0x5f, 0x48, 0xa5, 0xe5, 0x8c, 0xd4, 0x08, 0x33, 0xc5,
+	0xce, 0x64, 0x1d, 0xdc, 0xce, 0x2f, 0x59, 0xa8, 0xa5, 0xeb, 0xad, 0x10, 0xfd, 0x05, 0xd6, 0x3b,
+	0xd8, 0xee, 0x61, 0x6f, 0xf1, 0x4b, 0x28, 0xc1, 0xaa, 0x1d, 0x52, 0x48, 0xcb, 0xd5, 0x5c, 0x7d,
+	0x5d, 0x8d, 0x97, 0x29, 0x5d, 0xd7, 0xa1, 0x32, 0x25, 0x39, 0xd7, 0xf7, 0x35, 0xc8, 0x1d, 0x6a,
+	0xa8, 0xd8, 0x26, 0x43, 0xfc, 0x5f, 0x48, 0xbc, 0x01, 0xb5, 0xe9, 0xf9, 0x13, 0xa7, 0x28, 0xf2,
+	0xdf, 0xcb, 0x31, 0xb2, 0xf0, 0x43, 0x3c, 0xc4, 0xd6, 0xc2, 0xea, 0xae, 0x01, 0x50, 0x64, 0xe1,
+	0xae, 0x15, 0xb0, 0xb0, 0xab, 0xb2, 0xa2, 0xae, 0xd3, 0x98, 0x36, 0x25, 0x71, 0x17, 0xe4, 0x74,
+	0x72, 0x2e, 0xed, 0x57, 0x21, 0x61, 0x7e, 0x9b, 0xd8, 0xee, 0xc0, 0x37, 0x1d, 0xe3, 0x11, 0x39,
+	0xc1, 0xde, 0xc2, 0x02, 0x0d, 0x28, 0x69, 0x31, 0x53, 0xd7, 0x0d, 0xa8, 0xba, 0x1e, 0x3e, 0x41,
+	0x9e, 0x1e, 0x1e, 0x67, 0xa1, 0xb9, 0x97, 0xbe, 0xd8, 0x6d, 0x0b, 0x99, 0x36, 0xea, 0x59, 0x78,
+	0x5c, 0x43, 0xd4, 0x88, 0xb6, 0xb5, 0xb1, 0xb7, 0x6a, 0xc8, 0x36, 0xe5, 0xc2, 0x64, 0xd5, 0xc2,
+	0xeb, 0xfd, 0x41, 0x80, 0xab, 0x01, 0x26, 0x48, 0x37, 0x51, 0xee, 0x6d, 0xc8, 0x53, 0xec, 0xe8,
+	0xd8, 0x9b, 0x59, 0x6b, 0x84, 0x13, 0xef, 0xc0, 0xd5, 0xc9, 0x42, 0x91, 0x4d, 0x06, 0x8e, 0x1f,
+	0xb9, 0x52, 0x1c, 0x97, 0xdd, 0x62, 0xb1, 0x64, 0x43, 0xc9, 0x25, 0x1b, 0xca, 0xbd, 0x42, 0x50,
+	0x4e, 0xc4, 0x5d, 0xab, 0x42, 0x39, 0x5b, 0x27, 0x2f, 0xe5, 0xdb, 0x1c, 0x48, 0x1d, 0x6a, 0x1c,
+	0x0f, 0x7a, 0xb6, 0xe9, 0x07, 0x2d, 0xab, 0x63, 0xd2, 0x1e, 0xee, 0xa3, 0xa1, 0x49, 0x16, 0xf7,
+	0x2e, 0x21, 0x6e, 0x39, 0x29, 0x4e, 0x7c, 0x08, 0x57, 0xec, 0x0b, 0xfe, 0xb0, 0x4d, 0xe5, 0x58,
+	0x9b, 0xba, 0x9e, 0x76, 0x33, 0xa1, 0x84, 0xf5, 0xaa, 0x2d, 0x7b, 0xfc, 0x85, 0x28, 0xc3, 0x1a,
+	0x1e, 0x9a, 0x3a, 0x76, 0x34, 0x2c, 0xad, 0xb0, 0x3c, 0x7c, 0x2d, 0xbe, 0x01, 0x9b, 0x2e, 0x76,
+	0x90, 0xe5, 0x8f, 0xba, 0xd8, 0x25, 0x5a, 0x9f, 0x4a, 0x97, 0xd8, 0x69, 0x6e, 0x44, 0x6f, 0xdf,
+	0x63, 0x2f, 0x45, 0x0d, 0x36, 0xa9, 0x85, 0x68, 0xbf, 0xfb, 0xc4, 0x43, 0x9a, 0x6f, 0x12, 0x47,
+	0xca, 0xb3, 0x32, 0xdf, 0x09, 0x6e, 0xcc, 0xef, 0xaf, 0x2a, 0x37, 0x0d, 0xd3, 0xef, 0x0f, 0x7a,
+	0x8a, 0x46, 0xec, 0x68, 0x58, 0x47, 0x7f, 0x0e, 0xa8, 0xfe, 0xb4, 0x11, 0xe8, 0xa7, 0xca, 0x7d,
+	0xac, 0xbd, 0x7c, 0x7e, 0x00, 0xd1, 0xa1, 0xdc, 0xc7, 0x9a, 0xba, 0xc1, 0x38, 0xdf, 0x8f, 0x28,
+	0x45, 0x11, 0x56, 0x3e, 0x43, 0xa6, 0x25, 0xad, 0x56, 0x85, 0xfa, 0x9a, 0xca, 0x9e, 0x53, 0xb7,
+	0xae, 0x06, 0xd5, 0x69, 0x36, 0x70, 0xaf, 0x5c, 0xd8, 0x08, 0x7e, 0x84, 0x4e, 0x40, 0xf0, 0xaf,
+	0xc6, 0xe1, 0x34, 0x7f, 0x52, 0xaa, 0x4a, 0xb0, 0x3d, 0x96, 0x31, 0x96, 0xd2, 0xfc, 0x79, 0x0d,
+	0x72, 0x1d, 0x6a, 0x88, 0x8f, 0xe1, 0xf2, 0xd8, 0x47, 0x45, 0x96, 0x8d, 0xe3, 0x43, 0x5e, 0xde,
+	0x9b, 0x09, 0xe1, 0x53, 0xf3, 0x29, 0xfc, 0x2f, 0xfd, 0x0d, 0x70, 0x33, 0x73, 0x7f, 0x0a, 0x27,
+	0x2b, 0xf3, 0xe1, 0x78, 0xb2, 0xc7, 0x70, 0x79, 0x6c, 0x0a, 0x67, 0x97, 0x92, 0x84, 0xc8, 0x7b,
+	0x33, 0x21, 0x9c, 0xdd, 0x87, 0x62, 0xe6, 0x00, 0xcc, 0xa6, 0xc8, 0x82, 0xca, 0x87, 0x73, 0x43,
+	0x79, 0xd6, 0xaf, 0xa0, 0x34, 0x6d, 0xac, 0xed, 0x67, 0xb2, 0x4d, 0x41, 0xcb, 0x77, 0xfe, 0x09,
+	0x9a, 0xa7, 0xc7, 0xb0, 0x35, 0x39, 0xaf, 0x6e, 0xbc, 0xc6, 0x7d, 0x8e, 0x92, 0xf7, 0xe7, 0x41,
+	0x25, 0xcf, 0x36, 0x73, 0xf4, 0xbc, 0xce, 0x9e, 0x71, 0xa8, 0x7c, 0x38, 0x37, 0x94, 0x67, 0xfd,
+	0x1c, 0xfe, 0x9f, 0x35, 0x00, 0xea, 0xd9, 0x4c, 0x69, 0xa4, 0x7c, 0x7b, 0x5e, 0x24, 0x4f, 0x79,
+	0x02, 0xdb, 0xd9, 0x8d, 0xfa, 0x56, 0x26, 0x55, 0x26, 0x56, 0x6e, 0xce, 0x8f, 0xe5, 0x89, 0x3f,
+	0x01, 0x48, 0xb4, 0x9d, 0x4a, 0xb6, 0x3b, 0x1c, 0x20, 0xbf, 0x39, 0x03, 0x10, 0xf3, 0x1e, 0x7d,
+	0x70, 0xfa, 0x67, 0x79, 0xe9, 0xf4, 0xac, 0x2c, 0xbc, 0x38, 0x2b, 0x0b, 0x7f, 0x9c, 0x95, 0x85,
+	0xef, 0xce, 0xcb, 0x4b, 0x2f, 0xce, 0xcb, 0x4b, 0xbf, 0x9d, 0x97, 0x97, 0x3e, 0xdd, 0x4b, 0x34,
+	0xdf, 0x80, 0xd0, 0x42, 0x3d, 0xca, 0x1e, 0x1a, 0xcf, 0x2e, 0xfe, 0xd5, 0x61, 0x3d, 0xb8, 0x97,
+	0x67, 0xdf, 0xa8, 0x6f, 0xfd, 0x3d, 0x00, 0xd8, 0x96, 0x09, 0x74, 0xbb, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -769,6 +954,10 @@ type MsgClient interface {
 	CommitComputingPower(ctx context.Context, in *MsgCommitComputingPower, opts ...grpc.CallOption) (*MsgCommitComputingPowerResponse, error)
 	// ClaimComputingPower allows captain node owner to claim and increase node's computing power.
 	ClaimComputingPower(ctx context.Context, in *MsgClaimComputingPower, opts ...grpc.CallOption) (*MsgClaimComputingPowerResponse, error)
+	// SubmitNodeMisbehavior submits the evidence of a captain node misbehavior and penalizes the node.
+	SubmitNodeMisbehavior(ctx context.Context, in *MsgSubmitNodeMisbehavior, opts ...grpc.CallOption) (*MsgSubmitNodeMisbehaviorResponse, error)
+	// UnjailNode releases a jailed captain node.
+	UnjailNode(ctx context.Context, in *MsgUnjailNode, opts ...grpc.CallOption) (*MsgUnjailNodeResponse, error)
 }
 
 type msgClient struct {
@@ -851,6 +1040,24 @@ func (c *msgClient) ClaimComputingPower(ctx context.Context, in *MsgClaimComputi
 	return out, nil
 }
 
+func (c *msgClient) SubmitNodeMisbehavior(ctx context.Context, in *MsgSubmitNodeMisbehavior, opts ...grpc.CallOption) (*MsgSubmitNodeMisbehaviorResponse, error) {
+	out := new(MsgSubmitNodeMisbehaviorResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Msg/SubmitNodeMisbehavior", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnjailNode(ctx context.Context, in *MsgUnjailNode, opts ...grpc.CallOption) (*MsgUnjailNodeResponse, error) {
+	out := new(MsgUnjailNodeResponse)
+	err := c.cc.Invoke(ctx, "/tabi.captains.v1.Msg/UnjailNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams updates the module params.
@@ -869,6 +1076,10 @@ type MsgServer interface {
 	CommitComputingPower(context.Context, *MsgCommitComputingPower) (*MsgCommitComputingPowerResponse, error)
 	// ClaimComputingPower allows captain node owner to claim and increase node's computing power.
 	ClaimComputingPower(context.Context, *MsgClaimComputingPower) (*MsgClaimComputingPowerResponse, error)
+	// SubmitNodeMisbehavior submits the evidence of a captain node misbehavior and penalizes the node.
+	SubmitNodeMisbehavior(context.Context, *MsgSubmitNodeMisbehavior) (*MsgSubmitNodeMisbehaviorResponse, error)
+	// UnjailNode releases a jailed captain node.
+	UnjailNode(context.Context, *MsgUnjailNode) (*MsgUnjailNodeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -899,6 +1110,12 @@ func (*UnimplementedMsgServer) CommitComputingPower(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) ClaimComputingPower(ctx context.Context, req *MsgClaimComputingPower) (*MsgClaimComputingPowerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimComputingPower not implemented")
 }
+func (*UnimplementedMsgServer) SubmitNodeMisbehavior(ctx context.Context, req *MsgSubmitNodeMisbehavior) (*MsgSubmitNodeMisbehaviorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitNodeMisbehavior not implemented")
+}
+func (*UnimplementedMsgServer) UnjailNode(ctx context.Context, req *MsgUnjailNode) (*MsgUnjailNodeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnjailNode not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1048,6 +1265,42 @@ func _Msg_ClaimComputingPower_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SubmitNodeMisbehavior_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitNodeMisbehavior)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitNodeMisbehavior(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Msg/SubmitNodeMisbehavior",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitNodeMisbehavior(ctx, req.(*MsgSubmitNodeMisbehavior))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnjailNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnjailNode)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnjailNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.captains.v1.Msg/UnjailNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnjailNode(ctx, req.(*MsgUnjailNode))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tabi.captains.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1084,6 +1337,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimComputingPower",
 			Handler:    _Msg_ClaimComputingPower_Handler,
 		},
+		{
+			MethodName: "SubmitNodeMisbehavior",
+			Handler:    _Msg_SubmitNodeMisbehavior_Handler,
+		},
+		{
+			MethodName: "UnjailNode",
+			Handler:    _Msg_UnjailNode_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tabi/captains/v1/tx.proto",
@@ -1610,6 +1871,163 @@ func (m *MsgClaimComputingPowerResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSubmitNodeMisbehavior) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitNodeMisbehavior) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitNodeMisbehavior) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Jail {
+		i--
+		if m.Jail {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.SlashFraction.Size()
+		i -= size
+		if _, err := m.SlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.PenaltyEpochs != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PenaltyEpochs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MisbehaviorType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MisbehaviorType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitNodeMisbehaviorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitNodeMisbehaviorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitNodeMisbehaviorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NodeId) > 0 {
+		i -= len(m.NodeId)
+		copy(dAtA[i:], m.NodeId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NodeId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnjailNodeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnjailNodeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnjailNodeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1846,8 +2264,75 @@ func (m *MsgClaimComputingPowerResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgSubmitNodeMisbehavior) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MisbehaviorType != 0 {
+		n += 1 + sovTx(uint64(m.MisbehaviorType))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PenaltyEpochs != 0 {
+		n += 1 + sovTx(uint64(m.PenaltyEpochs))
+	}
+	l = m.SlashFraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Jail {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitNodeMisbehaviorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnjailNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnjailNodeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
 	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
@@ -3260,6 +3745,458 @@ func (m *MsgClaimComputingPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSubmitNodeMisbehavior) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNodeMisbehavior: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNodeMisbehavior: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MisbehaviorType", wireType)
+			}
+			m.MisbehaviorType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MisbehaviorType |= MisbehaviorType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyEpochs", wireType)
+			}
+			m.PenaltyEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PenaltyEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Jail", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Jail = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitNodeMisbehaviorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitNodeMisbehaviorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitNodeMisbehaviorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnjailNodeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnjailNodeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnjailNodeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -14,4 +14,11 @@ import (
 // BeginBlock updates node block reward
 func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.FundCommunityPoolWithConfiscatedEmission(cacheCtx); err != nil {
+		k.Logger(ctx).Error("failed to fund community pool", "error", err.Error())
+		return
+	}
+	writeCache()
 }
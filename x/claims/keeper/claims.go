@@ -105,3 +105,29 @@ func (k Keeper) ClaimedRewards(ctx sdk.Context, owner sdk.Address) (sdk.DecCoins
 
 	return sdk.NewDecCoins(sdk.NewDecCoinFromDec(tabitypes.AttoVeTabi, totalClaimedRewards)), nil
 }
+
+// FundCommunityPoolWithConfiscatedEmission mints the emission confiscated from captain nodes into the community pool.
+func (k Keeper) FundCommunityPoolWithConfiscatedEmission(ctx sdk.Context) error {
+	emission := k.captainsKeeper.GetCommunityPoolEmission(ctx)
+	truncated := emission.TruncateInt()
+	if !truncated.IsPositive() {
+		return nil
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(tabitypes.AttoVeTabi, truncated))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.distrKeeper.FundCommunityPool(ctx, coins, moduleAddr); err != nil {
+		return errorsmod.Wrapf(
+			types.ErrSendCoins,
+			"error while funding community pool from module(%s)",
+			types.ModuleName)
+	}
+
+	// keep the decimal remainder for the next round.
+	k.captainsKeeper.SetCommunityPoolEmission(ctx, emission.Sub(sdk.NewDecFromInt(truncated)))
+	return nil
+}
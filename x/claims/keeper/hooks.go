@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	captainnodetypes "github.com/tabilabs/tabi/x/captains/types"
+)
+
+var _ captainnodetypes.CaptainsHooks = Hooks{}
+
+// Hooks wrapper struct for claims keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the captains hooks which settle the claims reacting to the captain nodes.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterNodeMisbehavior funds the community pool with the emission confiscated from the
+// node right away, rather than on the next begin block.
+func (h Hooks) AfterNodeMisbehavior(ctx sdk.Context, _ string, confiscated sdk.Dec, _ bool) error {
+	if !confiscated.IsPositive() {
+		return nil
+	}
+	return h.k.FundCommunityPoolWithConfiscatedEmission(ctx)
+}
@@ -0,0 +1,41 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+)
+
+func (suite *ClaimsTestSuite) TestAfterNodeMisbehavior() {
+	communityPool := func() sdk.Dec {
+		return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(tabitypes.AttoVeTabi)
+	}
+	before := communityPool()
+
+	// nothing is funded without confiscated emission
+	suite.app.CaptainsKeeper.SetCommunityPoolEmission(suite.ctx, sdk.MustNewDecFromStr("100.5"))
+	suite.Require().NoError(suite.keeper.Hooks().AfterNodeMisbehavior(suite.ctx, "node", sdk.ZeroDec(), true))
+	suite.Require().Equal(before, communityPool())
+
+	// the confiscated emission is funded to the community pool, the decimal remainder is kept
+	suite.Require().NoError(suite.keeper.Hooks().AfterNodeMisbehavior(suite.ctx, "node", sdk.MustNewDecFromStr("100.5"), true))
+	suite.Require().Equal(before.Add(sdk.NewDec(100)), communityPool())
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.5"), suite.app.CaptainsKeeper.GetCommunityPoolEmission(suite.ctx))
+}
+
+func (suite *ClaimsTestSuite) TestBeginBlock() {
+	communityPool := func() sdk.Dec {
+		return suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(tabitypes.AttoVeTabi)
+	}
+	before := communityPool()
+
+	suite.app.CaptainsKeeper.SetCommunityPoolEmission(suite.ctx, sdk.MustNewDecFromStr("100.5"))
+	suite.keeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+	suite.Require().Equal(before.Add(sdk.NewDec(100)), communityPool())
+
+	// nothing is funded below one unit
+	suite.keeper.BeginBlock(suite.ctx, abci.RequestBeginBlock{})
+	suite.Require().Equal(before.Add(sdk.NewDec(100)), communityPool())
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.5"), suite.app.CaptainsKeeper.GetCommunityPoolEmission(suite.ctx))
+}
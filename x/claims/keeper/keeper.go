@@ -17,8 +17,9 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 
 	// cosmos keepers
-	authKeeper types.AccountKeeper
-	bankKeeper types.BankKeeper
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistrKeeper
 
 	// self module keepers
 	captainsKeeper types.CaptainsKeeper
@@ -30,7 +31,7 @@ type Keeper struct {
 // NewKeeper returns a mint keeper
 func NewKeeper(cdc codec.Codec, authority sdk.AccAddress,
 	key storetypes.StoreKey, ak types.AccountKeeper,
-	bk types.BankKeeper, ck types.CaptainsKeeper,
+	bk types.BankKeeper, dk types.DistrKeeper, ck types.CaptainsKeeper,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -42,6 +43,7 @@ func NewKeeper(cdc codec.Codec, authority sdk.AccAddress,
 		cdc:            cdc,
 		authKeeper:     ak,
 		bankKeeper:     bk,
+		distrKeeper:    dk,
 		captainsKeeper: ck,
 		authority:      authority,
 	}
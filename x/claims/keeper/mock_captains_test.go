@@ -364,3 +364,11 @@ func (mock *MockCaptains) UpdateGlobalAndNodeClaimedEmission(ctx sdk.Context, no
 		return nil
 	}
 }
+
+// GetCommunityPoolEmission returns the confiscated emission pending for the community pool.
+func (mock *MockCaptains) GetCommunityPoolEmission(ctx sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+// SetCommunityPoolEmission sets the confiscated emission pending for the community pool.
+func (mock *MockCaptains) SetCommunityPoolEmission(ctx sdk.Context, amount sdk.Dec) {}
@@ -87,6 +87,14 @@ func (m msgServer) Claims(goCtx context.Context, msg *types.MsgClaims) (*types.M
 		),
 	)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventClaims{
+		Sender:   msg.Sender,
+		Receiver: msg.Receiver,
+		Amount:   amount,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgClaimsResponse{
 		Amount: amount,
 	}, nil
@@ -5,6 +5,7 @@ import (
 
 	"github.com/tabilabs/tabi/x/claims/types"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
@@ -144,11 +145,22 @@ func (suite *ClaimsTestSuite) TestClaims() {
 				mockCaptains := tc.setup()
 				suite.app.ClaimsKeeper.SetCaptainsKeeper(mockCaptains)
 			}
-			_, err := suite.msgServer.Claims(suite.ctx, tc.request)
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			resp, err := suite.msgServer.Claims(suite.ctx, tc.request)
 			if tc.expectErr {
 				suite.Require().Error(err)
 			} else {
 				suite.Require().NoError(err)
+
+				// the typed event follows the legacy message event
+				events := suite.ctx.EventManager().ABCIEvents()
+				event, err := sdk.ParseTypedEvent(events[len(events)-1])
+				suite.Require().NoError(err)
+				suite.Require().Equal(&types.EventClaims{
+					Sender:   tc.request.Sender,
+					Receiver: tc.request.Receiver,
+					Amount:   resp.Amount,
+				}, event)
 			}
 		})
 	}
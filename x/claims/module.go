@@ -148,7 +148,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
-// BeginBlock performs a no-op.
+// BeginBlock funds the community pool with the confiscated emission.
 func (am AppModule) BeginBlock(ctx sdk.Context, request abci.RequestBeginBlock) {
 	am.keeper.BeginBlock(ctx, request)
 }
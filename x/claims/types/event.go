@@ -1,5 +1,7 @@
 package types
 
+// The events below are the legacy untyped events, kept for a deprecation window
+// next to the typed events of events.proto.
 const (
 	AttributeValueCategory = ModuleName
 	AttributeValueReceiver = "receiver"
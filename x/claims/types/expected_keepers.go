@@ -69,6 +69,12 @@ type CaptainsKeeper interface {
 
 	// UpdateGlobalAndNodeClaimedEmission updates the node_historical_emission_on_last_claim.
 	UpdateGlobalAndNodeClaimedEmission(ctx sdk.Context, nodeID string) error
+
+	// GetCommunityPoolEmission returns the confiscated emission pending for the community pool.
+	GetCommunityPoolEmission(ctx sdk.Context) sdk.Dec
+
+	// SetCommunityPoolEmission sets the confiscated emission pending for the community pool.
+	SetCommunityPoolEmission(ctx sdk.Context, amount sdk.Dec)
 }
 
 type MintKeeper interface {
@@ -6,7 +6,8 @@ import (
 )
 
 const (
-	TypeMsgClaims = "claims"
+	TypeMsgUpdateParams = "update_params"
+	TypeMsgClaims       = "claims"
 )
 
 var (
@@ -21,6 +22,9 @@ func (m *MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(bz)
 }
 
+func (m *MsgUpdateParams) Route() string { return ModuleName }
+func (m *MsgUpdateParams) Type() string  { return TypeMsgUpdateParams }
+
 // ValidateBasic executes sanity validation on the provided data
 func (m *MsgUpdateParams) ValidateBasic() error {
 	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
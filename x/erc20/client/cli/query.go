@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+// GetQueryCmd returns the cli query commands for the erc20 module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for erc20",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryTokenPairs(),
+		GetCmdQueryTokenPair(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements a command to fetch erc20 parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current erc20 parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTokenPairs implements a command to fetch all the registered token pairs.
+func GetCmdQueryTokenPairs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pairs",
+		Short: "Query all the registered token pairs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TokenPairs(cmd.Context(), &types.QueryTokenPairsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-pairs")
+	return cmd
+}
+
+// GetCmdQueryTokenPair implements a command to fetch the token pair of a denom or an ERC-20 contract.
+func GetCmdQueryTokenPair() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pair [token]",
+		Short: "Query the token pair of a denom or an ERC-20 contract address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TokenPair(cmd.Context(), &types.QueryTokenPairRequest{Token: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.TokenPair)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
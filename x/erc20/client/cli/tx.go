@@ -0,0 +1,130 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/erc20 transaction commands.
+func NewTxCmd() *cobra.Command {
+	erc20TxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "ERC-20 conversion transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	erc20TxCmd.AddCommand(
+		NewConvertCoinCmd(),
+		NewConvertERC20Cmd(),
+	)
+
+	return erc20TxCmd
+}
+
+// NewConvertCoinCmd returns a CLI command handler for creating a MsgConvertCoin transaction.
+func NewConvertCoinCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-coin [coin] [receiver]",
+		Short: "Convert a native coin into its ERC-20 representation",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert a native coin into its ERC-20 representation.
+The receiver is the hex address receiving the ERC-20 tokens and defaults to the sender when omitted.
+Example:
+$ %s tx erc20 convert-coin 1000atabi --from mykey
+$ %s tx erc20 convert-coin 1000atabi 0x5f6659B6F712c729c46786bA9562eC50907c67CF --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coin, err := sdk.ParseCoinNormalized(args[0])
+			if err != nil {
+				return err
+			}
+
+			receiver := common.BytesToAddress(clientCtx.GetFromAddress())
+			if len(args) == 2 {
+				if !common.IsHexAddress(args[1]) {
+					return fmt.Errorf("invalid receiver address %s", args[1])
+				}
+				receiver = common.HexToAddress(args[1])
+			}
+
+			msg := types.NewMsgConvertCoin(coin, receiver, clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewConvertERC20Cmd returns a CLI command handler for creating a MsgConvertERC20 transaction.
+func NewConvertERC20Cmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "convert-erc20 [contract] [amount] [receiver]",
+		Short: "Convert ERC-20 tokens back into the native coin",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert ERC-20 tokens held by the sender back into the native coin.
+The receiver is the bech32 address receiving the coin and defaults to the sender when omitted.
+Example:
+$ %s tx erc20 convert-erc20 0x5f6659B6F712c729c46786bA9562eC50907c67CF 1000 --from mykey
+$ %s tx erc20 convert-erc20 0x5f6659B6F712c729c46786bA9562eC50907c67CF 1000 tabi1... --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address %s", args[0])
+			}
+
+			amount, ok := sdkmath.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid amount %s", args[1])
+			}
+
+			receiver := clientCtx.GetFromAddress()
+			if len(args) == 3 {
+				if receiver, err = sdk.AccAddressFromBech32(args[2]); err != nil {
+					return err
+				}
+			}
+
+			sender := common.BytesToAddress(clientCtx.GetFromAddress())
+			msg := types.NewMsgConvertERC20(amount, receiver, common.HexToAddress(args[0]), sender)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
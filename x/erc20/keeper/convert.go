@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+// RegisterCoin deploys the ERC-20 representation of a native coin and
+// registers the token pair.
+func (k Keeper) RegisterCoin(ctx sdk.Context, denom, name, symbol string, decimals uint8, transferable bool) (types.TokenPair, error) {
+	if k.IsDenomRegistered(ctx, denom) {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairAlreadyExists, "denom %s", denom)
+	}
+
+	contract, err := k.DeployERC20Contract(ctx, name, symbol, decimals, transferable)
+	if err != nil {
+		return types.TokenPair{}, err
+	}
+
+	pair := types.NewTokenPair(contract, denom, transferable)
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterCoin,
+		sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		sdk.NewAttribute(types.AttributeKeyTransferable, strconv.FormatBool(pair.Transferable)),
+	))
+
+	return pair, nil
+}
+
+// convertERC20 burns the ERC-20 tokens of the sender and releases the same
+// amount of the escrowed native coin to the receiver.
+func (k Keeper) convertERC20(ctx sdk.Context, pair types.TokenPair, sender common.Address, receiver sdk.AccAddress, amount sdkmath.Int) error {
+	if _, err := k.CallEVM(ctx, pair.GetERC20Contract(), "burn", sender, amount.BigInt()); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(sdk.NewCoin(pair.Denom, amount))
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConvertERC20,
+		sdk.NewAttribute(types.AttributeKeySender, sender.Hex()),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver.String()),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+	))
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+	"github.com/tabilabs/tabi/x/erc20/types/contracts"
+	evmtypes "github.com/tabilabs/tabi/x/evm/types"
+)
+
+// DefaultGasCap is the gas limit of the EVM messages sent by the module.
+const DefaultGasCap uint64 = 25_000_000
+
+// DeployERC20Contract deploys the ERC-20 representation of a native coin,
+// owned by the module account, and returns the address of the contract.
+func (k Keeper) DeployERC20Contract(ctx sdk.Context, name, symbol string, decimals uint8, transferable bool) (common.Address, error) {
+	args, err := contracts.ERC20NativeCoinContract.ABI.Pack("", name, symbol, decimals, transferable)
+	if err != nil {
+		return common.Address{}, errorsmod.Wrapf(types.ErrInvalidTokenMetadata, "failed to pack the constructor arguments: %s", err)
+	}
+
+	// the module account must exist before the EVM updates its nonce
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	nonce := k.getModuleNonce(ctx)
+	data := append(append([]byte{}, contracts.ERC20NativeCoinContract.Bin...), args...)
+	if _, err := k.applyMessage(ctx, nil, nonce, data); err != nil {
+		return common.Address{}, errorsmod.Wrap(err, "failed to deploy the contract")
+	}
+
+	return crypto.CreateAddress(types.ModuleAddress, nonce), nil
+}
+
+// CallEVM calls a method of a contract deployed by the module, with the module
+// account as the sender, and commits the resulting state.
+func (k Keeper) CallEVM(ctx sdk.Context, contract common.Address, method string, args ...interface{}) (*evmtypes.MsgEthereumTxResponse, error) {
+	data, err := contracts.ERC20NativeCoinContract.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrEVMCall, "failed to pack the arguments of %s: %s", method, err)
+	}
+
+	res, err := k.applyMessage(ctx, &contract, k.getModuleNonce(ctx), data)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "contract %s, method %s", contract, method)
+	}
+
+	return res, nil
+}
+
+// applyMessage applies an EVM message sent by the module account.
+func (k Keeper) applyMessage(ctx sdk.Context, to *common.Address, nonce uint64, data []byte) (*evmtypes.MsgEthereumTxResponse, error) {
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		to,
+		nonce,
+		big.NewInt(0), // amount
+		DefaultGasCap, // gasLimit
+		big.NewInt(0), // gasPrice
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		data,
+		ethtypes.AccessList{},
+		true, // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrEVMCall, err.Error())
+	}
+
+	if res.Failed() {
+		return nil, errorsmod.Wrap(types.ErrEVMCall, res.VmError)
+	}
+
+	return res, nil
+}
+
+// getModuleNonce returns the EVM nonce of the module account.
+func (k Keeper) getModuleNonce(ctx sdk.Context) uint64 {
+	account := k.evmKeeper.GetAccountWithoutBalance(ctx, types.ModuleAddress)
+	if account == nil {
+		return 0
+	}
+	return account.Nonce
+}
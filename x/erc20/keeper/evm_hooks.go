@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+	"github.com/tabilabs/tabi/x/erc20/types/contracts"
+	evmtypes "github.com/tabilabs/tabi/x/evm/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for the erc20 keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct of the erc20 keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. The ERC-20 tokens of
+// a registered token pair transferred to the module address are burned and
+// the same amount of the escrowed native coin is released to the sender of
+// the tokens.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, _ core.Message, receipt *ethtypes.Receipt) error {
+	params := h.k.GetParams(ctx)
+	if !params.EnableErc20 || !params.EnableEvmHook {
+		return nil
+	}
+
+	transferEvent := contracts.ERC20NativeCoinContract.ABI.Events["Transfer"]
+
+	for _, log := range receipt.Logs {
+		// Transfer(address indexed from, address indexed to, uint256 value)
+		if len(log.Topics) != 3 || log.Topics[0] != transferEvent.ID {
+			continue
+		}
+
+		if common.BytesToAddress(log.Topics[2].Bytes()) != types.ModuleAddress {
+			continue
+		}
+
+		pair, found := h.k.GetTokenPair(ctx, log.Address)
+		if !found || !pair.Enabled {
+			continue
+		}
+
+		amount := sdkmath.NewIntFromBigInt(new(big.Int).SetBytes(log.Data))
+		if !amount.IsPositive() {
+			continue
+		}
+
+		sender := common.BytesToAddress(log.Topics[1].Bytes())
+		if err := h.k.convertERC20(ctx, pair, types.ModuleAddress, sdk.AccAddress(sender.Bytes()), amount); err != nil {
+			return errorsmod.Wrapf(err, "failed to convert the tokens of %s sent to the module", sender)
+		}
+	}
+
+	return nil
+}
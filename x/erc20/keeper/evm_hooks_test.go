@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/tabilabs/tabi/testutil/tx"
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+func (suite *IntegrationTestSuite) TestPostTxProcessing() {
+	testCases := []struct {
+		name       string
+		malleate   func()
+		pair       func() types.TokenPair
+		to         func() common.Address
+		expConvert bool
+		expErr     bool
+	}{
+		{
+			name:       "success: tokens sent to the module are converted",
+			malleate:   func() {},
+			pair:       func() types.TokenPair { return suite.tabiPair },
+			to:         func() common.Address { return types.ModuleAddress },
+			expConvert: true,
+		},
+		{
+			name:       "success: non transferable tokens sent to the module are converted",
+			malleate:   func() {},
+			pair:       func() types.TokenPair { return suite.veTabiPair },
+			to:         func() common.Address { return types.ModuleAddress },
+			expConvert: true,
+		},
+		{
+			name:     "no conversion: tokens sent to another account",
+			malleate: func() {},
+			pair:     func() types.TokenPair { return suite.tabiPair },
+			to:       utiltx.GenerateAddress,
+		},
+		{
+			name: "no conversion: evm hook disabled",
+			malleate: func() {
+				suite.Require().NoError(suite.App.Erc20Keeper.SetParams(suite.Ctx, types.NewParams(true, false)))
+			},
+			pair: func() types.TokenPair { return suite.tabiPair },
+			to:   func() common.Address { return types.ModuleAddress },
+		},
+		{
+			name: "no conversion: token pair disabled",
+			malleate: func() {
+				_, err := suite.MsgServer.ToggleConversion(suite.Ctx, types.NewMsgToggleConversion(authority, tabitypes.AttoTabi))
+				suite.Require().NoError(err)
+			},
+			pair: func() types.TokenPair { return suite.tabiPair },
+			to:   func() common.Address { return types.ModuleAddress },
+		},
+		{
+			name:     "failure: non transferable tokens sent to another account",
+			malleate: func() {},
+			pair:     func() types.TokenPair { return suite.veTabiPair },
+			to:       utiltx.GenerateAddress,
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			pair := tc.pair()
+			suite.convertCoin(sdk.NewInt64Coin(pair.Denom, 100))
+			tc.malleate()
+
+			contract := pair.GetERC20Contract()
+			receipt, err := suite.callContract(suite.holder, contract, "transfer", tc.to(), big.NewInt(30))
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			err = suite.App.Erc20Keeper.Hooks().PostTxProcessing(suite.Ctx, nil, receipt)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(big.NewInt(70), suite.balanceOf(contract, suite.holder))
+
+			balance := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.holder.Bytes(), pair.Denom)
+			moduleTokens := suite.balanceOf(contract, types.ModuleAddress)
+			if tc.expConvert {
+				suite.Require().Equal(sdkmath.NewInt(930), balance.Amount)
+				suite.Require().Zero(moduleTokens.Sign())
+			} else {
+				suite.Require().Equal(sdkmath.NewInt(900), balance.Amount)
+			}
+		})
+	}
+}
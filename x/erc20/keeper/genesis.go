@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+// InitGenesis sets the erc20 module's parameters and token pairs.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+
+	// create the module account escrowing the converted coins
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+
+	for _, pair := range gs.TokenPairs {
+		k.SetTokenPair(ctx, pair)
+	}
+}
+
+// ExportGenesis returns the erc20 module's parameters and token pairs.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:     k.GetParams(ctx),
+		TokenPairs: k.GetTokenPairs(ctx),
+	}
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+type Querier struct {
+	k *Keeper
+}
+
+// NewQuerierImpl returns an implementation of the erc20 QueryServer interface.
+func NewQuerierImpl(keeper *Keeper) Querier {
+	return Querier{keeper}
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the params of erc20.
+func (q Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// TokenPairs queries all the registered token pairs.
+func (q Querier) TokenPairs(goCtx context.Context, req *types.QueryTokenPairsRequest) (*types.QueryTokenPairsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var pairs []types.TokenPair
+	pairStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.TokenPairKey)
+	pageRes, err := query.Paginate(pairStore, req.Pagination, func(_, value []byte) error {
+		var pair types.TokenPair
+		q.k.cdc.MustUnmarshal(value, &pair)
+		pairs = append(pairs, pair)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryTokenPairsResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// TokenPair queries the token pair of a denom or an ERC-20 contract.
+func (q Querier) TokenPair(goCtx context.Context, req *types.QueryTokenPairRequest) (*types.QueryTokenPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateToken(req.Token); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, found := q.k.GetTokenPairByToken(ctx, req.Token)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "token pair not found for token %s", req.Token)
+	}
+
+	return &types.QueryTokenPairResponse{TokenPair: pair}, nil
+}
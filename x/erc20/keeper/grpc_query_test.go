@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"github.com/tabilabs/tabi/x/erc20/types"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+)
+
+func (suite *IntegrationTestSuite) TestQueryTokenPairs() {
+	res, err := suite.QueryClient.TokenPairs(suite.Ctx, &types.QueryTokenPairsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch([]types.TokenPair{suite.tabiPair, suite.veTabiPair}, res.TokenPairs)
+
+	pairRes, err := suite.QueryClient.TokenPair(suite.Ctx, &types.QueryTokenPairRequest{Token: tabitypes.AttoVeTabi})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.veTabiPair, pairRes.TokenPair)
+
+	pairRes, err = suite.QueryClient.TokenPair(suite.Ctx, &types.QueryTokenPairRequest{Token: suite.tabiPair.Erc20Address})
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.tabiPair, pairRes.TokenPair)
+
+	_, err = suite.QueryClient.TokenPair(suite.Ctx, &types.QueryTokenPairRequest{Token: "unknown"})
+	suite.Require().Error(err)
+
+	paramsRes, err := suite.QueryClient.Params(suite.Ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), paramsRes.Params)
+}
@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+type msgServer struct {
+	k *Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the erc20 MsgServer interface
+func NewMsgServerImpl(keeper *Keeper) msgServer {
+	return msgServer{keeper}
+}
+
+// ConvertCoin escrows the native coin in the module account and mints the
+// same amount of ERC-20 tokens to the receiver
+func (m msgServer) ConvertCoin(goCtx context.Context, msg *types.MsgConvertCoin) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := m.getEnabledTokenPair(ctx, msg.Coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", msg.Sender)
+	}
+
+	receiver := common.HexToAddress(msg.Receiver)
+	if receiver == types.ModuleAddress {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive tokens", receiver)
+	}
+
+	if err := m.k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, sdk.NewCoins(msg.Coin)); err != nil {
+		return nil, err
+	}
+
+	if _, err := m.k.CallEVM(ctx, pair.GetERC20Contract(), "mint", receiver, msg.Coin.Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeConvertCoin,
+		sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, receiver.Hex()),
+		sdk.NewAttribute(types.AttributeKeyAmount, msg.Coin.Amount.String()),
+		sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+	))
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// ConvertERC20 burns the ERC-20 tokens of the sender and releases the same
+// amount of the escrowed native coin to the receiver
+func (m msgServer) ConvertERC20(goCtx context.Context, msg *types.MsgConvertERC20) (*types.MsgConvertERC20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	pair, err := m.getEnabledTokenPair(ctx, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(msg.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address: %s", msg.Receiver)
+	}
+
+	if m.k.bankKeeper.BlockedAddr(receiver) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
+	}
+
+	sender := common.HexToAddress(msg.Sender)
+	if err := m.k.convertERC20(ctx, pair, sender, receiver, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgConvertERC20Response{}, nil
+}
+
+// RegisterCoin deploys the ERC-20 representation of a native coin
+func (m msgServer) RegisterCoin(goCtx context.Context, msg *types.MsgRegisterCoin) (*types.MsgRegisterCoinResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := m.k.RegisterCoin(ctx, msg.Denom, msg.Name, msg.Symbol, uint8(msg.Decimals), msg.Transferable)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCoinResponse{TokenPair: pair}, nil
+}
+
+// ToggleConversion enables or disables the conversions of a token pair
+func (m msgServer) ToggleConversion(goCtx context.Context, msg *types.MsgToggleConversion) (*types.MsgToggleConversionResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, found := m.k.GetTokenPairByToken(ctx, msg.Token)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token %s", msg.Token)
+	}
+
+	pair.Enabled = !pair.Enabled
+	m.k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeToggleConversion,
+		sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(pair.Enabled)),
+	))
+
+	return &types.MsgToggleConversionResponse{}, nil
+}
+
+// SetTransferable enables or disables the transfers of the ERC-20 tokens of a
+// token pair between holders
+func (m msgServer) SetTransferable(goCtx context.Context, msg *types.MsgSetTransferable) (*types.MsgSetTransferableResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, found := m.k.GetTokenPairByToken(ctx, msg.Token)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token %s", msg.Token)
+	}
+
+	if pair.Transferable == msg.Transferable {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "transferable is already %t", msg.Transferable)
+	}
+
+	if _, err := m.k.CallEVM(ctx, pair.GetERC20Contract(), "setTransferable", msg.Transferable); err != nil {
+		return nil, err
+	}
+
+	pair.Transferable = msg.Transferable
+	m.k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetTransferable,
+		sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyERC20Token, pair.Erc20Address),
+		sdk.NewAttribute(types.AttributeKeyTransferable, strconv.FormatBool(pair.Transferable)),
+	))
+
+	return &types.MsgSetTransferableResponse{}, nil
+}
+
+// UpdateParams defines a method that allows to update the parameters of the module
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set params")
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkAuthority checks that the signer of a governance message is the keeper authority.
+func (m msgServer) checkAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", authority)
+	}
+
+	if m.k.authority.String() != authority {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"invalid authority: expected %s, got %s", m.k.authority, authority)
+	}
+
+	return nil
+}
+
+// getEnabledTokenPair returns the token pair of the token, checking that the conversions are enabled.
+func (m msgServer) getEnabledTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	if !m.k.GetParams(ctx).EnableErc20 {
+		return types.TokenPair{}, types.ErrERC20Disabled
+	}
+
+	pair, found := m.k.GetTokenPairByToken(ctx, token)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token %s", token)
+	}
+
+	if !pair.Enabled {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairDisabled, "token %s", token)
+	}
+
+	return pair, nil
+}
@@ -0,0 +1,273 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	utiltx "github.com/tabilabs/tabi/testutil/tx"
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+func (suite *IntegrationTestSuite) TestConvertCoin() {
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgConvertCoin
+		expErr   error
+	}{
+		{
+			name: "success",
+			malleate: func() *types.MsgConvertCoin {
+				return types.NewMsgConvertCoin(sdk.NewInt64Coin(tabitypes.AttoTabi, 100), suite.holder, suite.holder.Bytes())
+			},
+		},
+		{
+			name: "success: non transferable token",
+			malleate: func() *types.MsgConvertCoin {
+				return types.NewMsgConvertCoin(sdk.NewInt64Coin(tabitypes.AttoVeTabi, 100), suite.holder, suite.holder.Bytes())
+			},
+		},
+		{
+			name: "failure: conversions disabled",
+			malleate: func() *types.MsgConvertCoin {
+				suite.Require().NoError(suite.App.Erc20Keeper.SetParams(suite.Ctx, types.NewParams(false, true)))
+				return types.NewMsgConvertCoin(sdk.NewInt64Coin(tabitypes.AttoTabi, 100), suite.holder, suite.holder.Bytes())
+			},
+			expErr: types.ErrERC20Disabled,
+		},
+		{
+			name: "failure: token pair not registered",
+			malleate: func() *types.MsgConvertCoin {
+				return types.NewMsgConvertCoin(sdk.NewInt64Coin("unknown", 100), suite.holder, suite.holder.Bytes())
+			},
+			expErr: types.ErrTokenPairNotFound,
+		},
+		{
+			name: "failure: token pair disabled",
+			malleate: func() *types.MsgConvertCoin {
+				_, err := suite.MsgServer.ToggleConversion(suite.Ctx, types.NewMsgToggleConversion(authority, tabitypes.AttoTabi))
+				suite.Require().NoError(err)
+				return types.NewMsgConvertCoin(sdk.NewInt64Coin(tabitypes.AttoTabi, 100), suite.holder, suite.holder.Bytes())
+			},
+			expErr: types.ErrTokenPairDisabled,
+		},
+		{
+			name: "failure: receiver is the module address",
+			malleate: func() *types.MsgConvertCoin {
+				return types.NewMsgConvertCoin(sdk.NewInt64Coin(tabitypes.AttoTabi, 100), types.ModuleAddress, suite.holder.Bytes())
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "failure: insufficient funds",
+			malleate: func() *types.MsgConvertCoin {
+				return types.NewMsgConvertCoin(sdk.NewInt64Coin(tabitypes.AttoTabi, 1001), suite.holder, suite.holder.Bytes())
+			},
+			expErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg := tc.malleate()
+			_, err := suite.MsgServer.ConvertCoin(suite.Ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			pair, found := suite.App.Erc20Keeper.GetTokenPairByDenom(suite.Ctx, msg.Coin.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(msg.Coin.Amount.BigInt(), suite.balanceOf(pair.GetERC20Contract(), suite.holder))
+
+			balance := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.holder.Bytes(), msg.Coin.Denom)
+			suite.Require().Equal(sdkmath.NewInt(900), balance.Amount)
+			escrow := suite.App.BankKeeper.GetBalance(suite.Ctx, types.ModuleAddress.Bytes(), msg.Coin.Denom)
+			suite.Require().Equal(msg.Coin.Amount, escrow.Amount)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestConvertERC20() {
+	receiver := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func() *types.MsgConvertERC20
+		expErr   error
+	}{
+		{
+			name: "success",
+			malleate: func() *types.MsgConvertERC20 {
+				return types.NewMsgConvertERC20(sdkmath.NewInt(60), receiver, suite.tabiPair.GetERC20Contract(), suite.holder)
+			},
+		},
+		{
+			name: "success: non transferable token",
+			malleate: func() *types.MsgConvertERC20 {
+				return types.NewMsgConvertERC20(sdkmath.NewInt(60), receiver, suite.veTabiPair.GetERC20Contract(), suite.holder)
+			},
+		},
+		{
+			name: "failure: token pair not registered",
+			malleate: func() *types.MsgConvertERC20 {
+				return types.NewMsgConvertERC20(sdkmath.NewInt(60), receiver, utiltx.GenerateAddress(), suite.holder)
+			},
+			expErr: types.ErrTokenPairNotFound,
+		},
+		{
+			name: "failure: insufficient balance",
+			malleate: func() *types.MsgConvertERC20 {
+				return types.NewMsgConvertERC20(sdkmath.NewInt(101), receiver, suite.tabiPair.GetERC20Contract(), suite.holder)
+			},
+			expErr: types.ErrEVMCall,
+		},
+		{
+			name: "failure: blocked receiver",
+			malleate: func() *types.MsgConvertERC20 {
+				return types.NewMsgConvertERC20(sdkmath.NewInt(60), types.ModuleAddress.Bytes(), suite.tabiPair.GetERC20Contract(), suite.holder)
+			},
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.convertCoin(sdk.NewInt64Coin(tabitypes.AttoTabi, 100))
+			suite.convertCoin(sdk.NewInt64Coin(tabitypes.AttoVeTabi, 100))
+
+			msg := tc.malleate()
+			_, err := suite.MsgServer.ConvertERC20(suite.Ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			contract := common.HexToAddress(msg.ContractAddress)
+			pair, found := suite.App.Erc20Keeper.GetTokenPair(suite.Ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(big.NewInt(40), suite.balanceOf(contract, suite.holder))
+
+			balance := suite.App.BankKeeper.GetBalance(suite.Ctx, receiver, pair.Denom)
+			suite.Require().Equal(sdkmath.NewInt(60), balance.Amount)
+			escrow := suite.App.BankKeeper.GetBalance(suite.Ctx, types.ModuleAddress.Bytes(), pair.Denom)
+			suite.Require().Equal(sdkmath.NewInt(40), escrow.Amount)
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestRegisterCoin() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRegisterCoin
+		expErr error
+	}{
+		{
+			name: "success",
+			msg:  types.NewMsgRegisterCoin(authority, "acoin", "Coin", "COIN", 6, true),
+		},
+		{
+			name:   "failure: invalid authority",
+			msg:    types.NewMsgRegisterCoin(sdk.AccAddress(suite.holder.Bytes()).String(), "acoin", "Coin", "COIN", 6, true),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "failure: already registered",
+			msg:    types.NewMsgRegisterCoin(authority, tabitypes.AttoTabi, "Tabi", "TABI", 18, true),
+			expErr: types.ErrTokenPairAlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			res, err := suite.MsgServer.RegisterCoin(suite.Ctx, tc.msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			pair, found := suite.App.Erc20Keeper.GetTokenPairByDenom(suite.Ctx, tc.msg.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(res.TokenPair, pair)
+			suite.Require().True(pair.Enabled)
+
+			contract := pair.GetERC20Contract()
+			for method, expected := range map[string]interface{}{
+				"name":         tc.msg.Name,
+				"symbol":       tc.msg.Symbol,
+				"decimals":     uint8(tc.msg.Decimals),
+				"owner":        types.ModuleAddress,
+				"transferable": tc.msg.Transferable,
+			} {
+				suite.Require().Equal(expected, suite.call(contract, method), method)
+			}
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestToggleConversion() {
+	_, err := suite.MsgServer.ToggleConversion(suite.Ctx, types.NewMsgToggleConversion(sdk.AccAddress(suite.holder.Bytes()).String(), tabitypes.AttoTabi))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.MsgServer.ToggleConversion(suite.Ctx, types.NewMsgToggleConversion(authority, "unknown"))
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	_, err = suite.MsgServer.ToggleConversion(suite.Ctx, types.NewMsgToggleConversion(authority, suite.tabiPair.Erc20Address))
+	suite.Require().NoError(err)
+	pair, _ := suite.App.Erc20Keeper.GetTokenPairByDenom(suite.Ctx, tabitypes.AttoTabi)
+	suite.Require().False(pair.Enabled)
+
+	_, err = suite.MsgServer.ToggleConversion(suite.Ctx, types.NewMsgToggleConversion(authority, tabitypes.AttoTabi))
+	suite.Require().NoError(err)
+	pair, _ = suite.App.Erc20Keeper.GetTokenPairByDenom(suite.Ctx, tabitypes.AttoTabi)
+	suite.Require().True(pair.Enabled)
+}
+
+func (suite *IntegrationTestSuite) TestSetTransferable() {
+	recipient := utiltx.GenerateAddress()
+	contract := suite.veTabiPair.GetERC20Contract()
+	suite.convertCoin(sdk.NewInt64Coin(tabitypes.AttoVeTabi, 100))
+
+	_, err := suite.callContract(suite.holder, contract, "transfer", recipient, big.NewInt(10))
+	suite.Require().Error(err)
+
+	_, err = suite.MsgServer.SetTransferable(suite.Ctx, types.NewMsgSetTransferable(authority, tabitypes.AttoVeTabi, false))
+	suite.Require().ErrorIs(err, sdkerrors.ErrInvalidRequest)
+
+	_, err = suite.MsgServer.SetTransferable(suite.Ctx, types.NewMsgSetTransferable(sdk.AccAddress(suite.holder.Bytes()).String(), tabitypes.AttoVeTabi, true))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.MsgServer.SetTransferable(suite.Ctx, types.NewMsgSetTransferable(authority, tabitypes.AttoVeTabi, true))
+	suite.Require().NoError(err)
+
+	pair, _ := suite.App.Erc20Keeper.GetTokenPair(suite.Ctx, contract)
+	suite.Require().True(pair.Transferable)
+	suite.Require().Equal(true, suite.call(contract, "transferable"))
+
+	_, err = suite.callContract(suite.holder, contract, "transfer", recipient, big.NewInt(10))
+	suite.Require().NoError(err)
+	suite.Require().Equal(big.NewInt(10), suite.balanceOf(contract, recipient))
+}
+
+func (suite *IntegrationTestSuite) TestUpdateParams() {
+	params := types.NewParams(true, false)
+
+	_, err := suite.MsgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(sdk.AccAddress(suite.holder.Bytes()).String(), params))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	_, err = suite.MsgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(authority, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.App.Erc20Keeper.GetParams(suite.Ctx))
+}
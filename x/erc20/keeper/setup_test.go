@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tabilabs/tabi/app"
+	"github.com/tabilabs/tabi/crypto/ethsecp256k1"
+	"github.com/tabilabs/tabi/testutil"
+	utiltx "github.com/tabilabs/tabi/testutil/tx"
+	tabitypes "github.com/tabilabs/tabi/types"
+	erc20keeper "github.com/tabilabs/tabi/x/erc20/keeper"
+	"github.com/tabilabs/tabi/x/erc20/types"
+	"github.com/tabilabs/tabi/x/erc20/types/contracts"
+	evmtypes "github.com/tabilabs/tabi/x/evm/types"
+	feemarkettypes "github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	App *app.Tabi
+	Ctx sdk.Context
+
+	MsgServer   types.MsgServer
+	QueryClient types.QueryClient
+
+	holder     common.Address
+	tabiPair   types.TokenPair
+	veTabiPair types.TokenPair
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (suite *IntegrationTestSuite) SetupTest() {
+	t := suite.T()
+
+	// consensus key
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	consAddress := sdk.ConsAddress(privCons.PubKey().Address())
+
+	suite.App = app.Setup(false, feemarkettypes.DefaultGenesisState())
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), "tabi_9788-1", consAddress, nil, nil,
+	)
+	suite.Ctx = suite.App.NewContext(false, header)
+
+	// the EVM resolves the coinbase from the block proposer
+	validator, err := stakingtypes.NewValidator(sdk.ValAddress(consAddress), privCons.PubKey(), stakingtypes.Description{})
+	require.NoError(t, err)
+	require.NoError(t, suite.App.StakingKeeper.SetValidatorByConsAddr(suite.Ctx, validator))
+	suite.App.StakingKeeper.SetValidator(suite.Ctx, validator)
+
+	suite.MsgServer = erc20keeper.NewMsgServerImpl(&suite.App.Erc20Keeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, erc20keeper.NewQuerierImpl(&suite.App.Erc20Keeper))
+	suite.QueryClient = types.NewQueryClient(queryHelper)
+
+	res, err := suite.MsgServer.RegisterCoin(suite.Ctx, types.NewMsgRegisterCoin(authority, tabitypes.AttoTabi, "Tabi", "TABI", 18, true))
+	require.NoError(t, err)
+	suite.tabiPair = res.TokenPair
+
+	res, err = suite.MsgServer.RegisterCoin(suite.Ctx, types.NewMsgRegisterCoin(authority, tabitypes.AttoVeTabi, "veTabi", "VETABI", 18, false))
+	require.NoError(t, err)
+	suite.veTabiPair = res.TokenPair
+
+	suite.holder = utiltx.GenerateAddress()
+	coins := sdk.NewCoins(sdk.NewInt64Coin(tabitypes.AttoTabi, 1000), sdk.NewInt64Coin(tabitypes.AttoVeTabi, 1000))
+	require.NoError(t, testutil.FundAccount(suite.Ctx, suite.App.BankKeeper, suite.holder.Bytes(), coins))
+}
+
+// convertCoin converts the coins of the holder of the suite into ERC-20 tokens.
+func (suite *IntegrationTestSuite) convertCoin(coin sdk.Coin) {
+	_, err := suite.MsgServer.ConvertCoin(suite.Ctx, types.NewMsgConvertCoin(coin, suite.holder, suite.holder.Bytes()))
+	suite.Require().NoError(err)
+}
+
+// call calls a method of the contract from the module account and returns its single output.
+func (suite *IntegrationTestSuite) call(contract common.Address, method string, args ...interface{}) interface{} {
+	res, err := suite.App.Erc20Keeper.CallEVM(suite.Ctx, contract, method, args...)
+	suite.Require().NoError(err)
+
+	out, err := contracts.ERC20NativeCoinContract.ABI.Unpack(method, res.Ret)
+	suite.Require().NoError(err)
+	suite.Require().Len(out, 1)
+	return out[0]
+}
+
+// balanceOf returns the ERC-20 balance of the account.
+func (suite *IntegrationTestSuite) balanceOf(contract, account common.Address) *big.Int {
+	return suite.call(contract, "balanceOf", account).(*big.Int)
+}
+
+// callContract calls the contract from the account and returns the receipt
+// passed to the EVM hooks.
+func (suite *IntegrationTestSuite) callContract(from, contract common.Address, method string, args ...interface{}) (*ethtypes.Receipt, error) {
+	data, err := contracts.ERC20NativeCoinContract.ABI.Pack(method, args...)
+	suite.Require().NoError(err)
+
+	msg := ethtypes.NewMessage(from, &contract, 0, big.NewInt(0), erc20keeper.DefaultGasCap, big.NewInt(0), big.NewInt(0), big.NewInt(0), data, nil, true)
+	res, err := suite.App.EvmKeeper.ApplyMessage(suite.Ctx, msg, evmtypes.NewNoOpTracer(), true)
+	suite.Require().NoError(err)
+	if res.Failed() {
+		return nil, errors.New(res.VmError)
+	}
+
+	return &ethtypes.Receipt{
+		Status:  ethtypes.ReceiptStatusSuccessful,
+		GasUsed: res.GasUsed,
+		Logs:    evmtypes.LogsToEthereum(res.Logs),
+	}, nil
+}
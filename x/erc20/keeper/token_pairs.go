@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tabilabs/tabi/x/erc20/types"
+)
+
+// GetTokenPairs returns all the registered token pairs.
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	var pairs []types.TokenPair
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.TokenPairKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var pair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &pair)
+		pairs = append(pairs, pair)
+	}
+
+	return pairs
+}
+
+// GetTokenPair returns the token pair of the given ERC-20 contract.
+func (k Keeper) GetTokenPair(ctx sdk.Context, contract common.Address) (types.TokenPair, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TokenPairStoreKey(contract))
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	var pair types.TokenPair
+	k.cdc.MustUnmarshal(bz, &pair)
+	return pair, true
+}
+
+// GetTokenPairByDenom returns the token pair of the given denom.
+func (k Keeper) GetTokenPairByDenom(ctx sdk.Context, denom string) (types.TokenPair, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TokenPairByDenomStoreKey(denom))
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	return k.GetTokenPair(ctx, common.BytesToAddress(bz))
+}
+
+// GetTokenPairByToken returns the token pair of the given token, which is
+// either the hex address of the ERC-20 contract or the denom.
+func (k Keeper) GetTokenPairByToken(ctx sdk.Context, token string) (types.TokenPair, bool) {
+	if common.IsHexAddress(token) {
+		return k.GetTokenPair(ctx, common.HexToAddress(token))
+	}
+
+	return k.GetTokenPairByDenom(ctx, token)
+}
+
+// SetTokenPair stores the token pair and indexes it under its denom.
+func (k Keeper) SetTokenPair(ctx sdk.Context, pair types.TokenPair) {
+	store := ctx.KVStore(k.storeKey)
+	contract := pair.GetERC20Contract()
+	bz := k.cdc.MustMarshal(&pair)
+	store.Set(types.TokenPairStoreKey(contract), bz)
+	store.Set(types.TokenPairByDenomStoreKey(pair.Denom), contract.Bytes())
+}
+
+// IsTokenPairRegistered checks if a token pair is registered for the ERC-20 contract.
+func (k Keeper) IsTokenPairRegistered(ctx sdk.Context, contract common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.TokenPairStoreKey(contract))
+}
+
+// IsDenomRegistered checks if a token pair is registered for the denom.
+func (k Keeper) IsDenomRegistered(ctx sdk.Context, denom string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.TokenPairByDenomStoreKey(denom))
+}
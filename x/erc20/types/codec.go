@@ -0,0 +1,54 @@
+package types
+
+import (
+	cryptocodec "github.com/tabilabs/tabi/crypto/codec"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	convertCoinName      = "erc20/MsgConvertCoin"
+	convertERC20Name     = "erc20/MsgConvertERC20"
+	registerCoinName     = "erc20/MsgRegisterCoin"
+	toggleConversionName = "erc20/MsgToggleConversion"
+	setTransferableName  = "erc20/MsgSetTransferable"
+	updateParamsName     = "erc20/MsgUpdateParams"
+)
+
+func init() {
+	cryptocodec.RegisterCrypto(amino)
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgConvertCoin{},
+		&MsgConvertERC20{},
+		&MsgRegisterCoin{},
+		&MsgToggleConversion{},
+		&MsgSetTransferable{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgConvertCoin{}, convertCoinName, nil)
+	cdc.RegisterConcrete(&MsgConvertERC20{}, convertERC20Name, nil)
+	cdc.RegisterConcrete(&MsgRegisterCoin{}, registerCoinName, nil)
+	cdc.RegisterConcrete(&MsgToggleConversion{}, toggleConversionName, nil)
+	cdc.RegisterConcrete(&MsgSetTransferable{}, setTransferableName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+}
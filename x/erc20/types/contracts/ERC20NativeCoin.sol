@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+
+pragma solidity ^0.8.0;
+
+import "@openzeppelin/contracts/access/Ownable.sol";
+import "@openzeppelin/contracts/token/ERC20/ERC20.sol";
+
+// ERC20NativeCoin is the ERC-20 representation of a native coin, deployed and
+// owned by the erc20 module account. Only the owner mints and burns tokens, when
+// coins are converted to and from the EVM. While the token is not transferable,
+// holders can only send their tokens to the owner, and receive them from it.
+//
+// ERC20NativeCoin.json is built with the solc and @openzeppelin/contracts versions
+// pinned in package.json, targeting the paris EVM version since the chain may not
+// have activated Shanghai yet, see compile.js:
+//
+//   make contracts-erc20-compile
+contract ERC20NativeCoin is ERC20, Ownable {
+    uint8 private immutable _decimals;
+    bool private _transferable;
+
+    constructor(
+        string memory name_,
+        string memory symbol_,
+        uint8 decimals_,
+        bool transferable_
+    ) ERC20(name_, symbol_) {
+        _decimals = decimals_;
+        _transferable = transferable_;
+    }
+
+    function decimals() public view virtual override returns (uint8) {
+        return _decimals;
+    }
+
+    function transferable() public view returns (bool) {
+        return _transferable;
+    }
+
+    function mint(address to, uint256 amount) public onlyOwner {
+        _mint(to, amount);
+    }
+
+    function burn(address from, uint256 amount) public onlyOwner {
+        _burn(from, amount);
+    }
+
+    function setTransferable(bool transferable_) public onlyOwner {
+        _transferable = transferable_;
+    }
+
+    function _beforeTokenTransfer(
+        address from,
+        address to,
+        uint256 amount
+    ) internal virtual override {
+        super._beforeTokenTransfer(from, to, amount);
+
+        // mints and burns are already restricted to the owner
+        if (from == address(0) || to == address(0)) {
+            return;
+        }
+
+        require(
+            _transferable || from == owner() || to == owner(),
+            "ERC20NativeCoin: token is not transferable"
+        );
+    }
+}
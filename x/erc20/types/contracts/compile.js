@@ -0,0 +1,74 @@
+// Compiles ERC20NativeCoin.sol into ERC20NativeCoin.json with the pinned solc and
+// OpenZeppelin versions of package.json. With --check, the output is compared to the
+// checked-in JSON instead, and the script fails if the bytecode cannot be reproduced.
+//
+//   npm install && npm run compile
+const fs = require("fs");
+const path = require("path");
+const solc = require("solc");
+
+const contractName = "ERC20NativeCoin";
+const solcVersion = "0.8.21";
+
+// the chain may not have activated Shanghai yet, so no PUSH0, and no metadata hash
+// so the bytecode does not depend on the source comments and paths.
+const settings = {
+  evmVersion: "paris",
+  optimizer: { enabled: false, runs: 200 },
+  metadata: { bytecodeHash: "none" },
+  outputSelection: { "*": { "*": ["abi", "evm.bytecode.object"] } },
+};
+
+function findImports(importPath) {
+  try {
+    return { contents: fs.readFileSync(require.resolve(importPath), "utf8") };
+  } catch (err) {
+    return { error: `${importPath}: ${err.message}` };
+  }
+}
+
+function compile() {
+  if (!solc.version().startsWith(`${solcVersion}+`)) {
+    throw new Error(`solc ${solcVersion} is required, got ${solc.version()}`);
+  }
+
+  const source = `${contractName}.sol`;
+  const input = {
+    language: "Solidity",
+    sources: { [source]: { content: fs.readFileSync(path.join(__dirname, source), "utf8") } },
+    settings,
+  };
+
+  const output = JSON.parse(solc.compile(JSON.stringify(input), { import: findImports }));
+  const errors = (output.errors || []).filter((e) => e.severity === "error");
+  if (errors.length > 0) {
+    throw new Error(errors.map((e) => e.formattedMessage).join("\n"));
+  }
+
+  const contract = output.contracts[source][contractName];
+  return (
+    JSON.stringify(
+      { abi: JSON.stringify(contract.abi), bin: contract.evm.bytecode.object, contractName },
+      null,
+      2
+    ) + "\n"
+  );
+}
+
+const target = path.join(__dirname, `${contractName}.json`);
+const compiled = compile();
+
+if (process.argv.includes("--check")) {
+  const expected = JSON.parse(fs.readFileSync(target, "utf8"));
+  const actual = JSON.parse(compiled);
+  for (const field of ["abi", "bin"]) {
+    if (expected[field] !== actual[field]) {
+      console.error(`${contractName}.json: ${field} differs from the compiled contract, run npm run compile`);
+      process.exit(1);
+    }
+  }
+  console.log(`${contractName}.json is up to date`);
+} else {
+  fs.writeFileSync(target, compiled);
+  console.log(`wrote ${contractName}.json`);
+}
@@ -0,0 +1,27 @@
+package contracts
+
+import (
+	_ "embed" // embed compiled smart contract
+	"encoding/json"
+
+	evmtypes "github.com/tabilabs/tabi/x/evm/types"
+)
+
+var (
+	//go:embed ERC20NativeCoin.json
+	erc20NativeCoinJSON []byte
+
+	// ERC20NativeCoinContract is the compiled ERC-20 representation of a native coin
+	ERC20NativeCoinContract evmtypes.CompiledContract
+)
+
+func init() {
+	err := json.Unmarshal(erc20NativeCoinJSON, &ERC20NativeCoinContract)
+	if err != nil {
+		panic(err)
+	}
+
+	if len(ERC20NativeCoinContract.Bin) == 0 {
+		panic("load contract failed")
+	}
+}
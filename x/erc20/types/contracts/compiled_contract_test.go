@@ -0,0 +1,153 @@
+package contracts
+
+import (
+	"math/big"
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/stretchr/testify/require"
+)
+
+type contractTester struct {
+	t    *testing.T
+	cfg  *runtime.Config
+	addr common.Address
+}
+
+func (c contractTester) call(from common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	abi := ERC20NativeCoinContract.ABI
+	data, err := abi.Pack(method, args...)
+	require.NoError(c.t, err)
+
+	cfg := *c.cfg
+	cfg.Origin = from
+	ret, _, err := runtime.Call(c.addr, data, &cfg)
+	if err != nil {
+		return nil, err
+	}
+	return abi.Unpack(method, ret)
+}
+
+func (c contractTester) mustCall(from common.Address, method string, args ...interface{}) interface{} {
+	res, err := c.call(from, method, args...)
+	require.NoError(c.t, err, method)
+	if len(res) == 0 {
+		return nil
+	}
+	return res[0]
+}
+
+func (c contractTester) requireBalance(account common.Address, expected int64) {
+	balance := c.mustCall(account, "balanceOf", account).(*big.Int)
+	require.Zero(c.t, big.NewInt(expected).Cmp(balance), "balance of %s: %s", account, balance)
+}
+
+func TestERC20NativeCoinContract(t *testing.T) {
+	var (
+		owner = common.HexToAddress("0x1000000000000000000000000000000000000001")
+		alice = common.HexToAddress("0x2000000000000000000000000000000000000002")
+		bob   = common.HexToAddress("0x3000000000000000000000000000000000000003")
+	)
+
+	db, err := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	cfg := &runtime.Config{State: db, Origin: owner, GasLimit: 10_000_000}
+
+	ctorArgs, err := ERC20NativeCoinContract.ABI.Pack("", "Tabi", "TABI", uint8(18), false)
+	require.NoError(t, err)
+	_, addr, _, err := runtime.Create(append(append([]byte{}, ERC20NativeCoinContract.Bin...), ctorArgs...), cfg)
+	require.NoError(t, err)
+
+	c := contractTester{t: t, cfg: cfg, addr: addr}
+
+	// metadata
+	require.Equal(t, "Tabi", c.mustCall(alice, "name"))
+	require.Equal(t, "TABI", c.mustCall(alice, "symbol"))
+	require.Equal(t, uint8(18), c.mustCall(alice, "decimals"))
+	require.Equal(t, owner, c.mustCall(alice, "owner"))
+	require.Equal(t, false, c.mustCall(alice, "transferable"))
+
+	// only the owner mints
+	_, err = c.call(alice, "mint", alice, big.NewInt(100))
+	require.Error(t, err)
+	c.mustCall(owner, "mint", alice, big.NewInt(100))
+	c.requireBalance(alice, 100)
+	require.Equal(t, big.NewInt(100), c.mustCall(alice, "totalSupply"))
+
+	// non transferable tokens only move to and from the owner
+	_, err = c.call(alice, "transfer", bob, big.NewInt(10))
+	require.Error(t, err)
+	require.Equal(t, true, c.mustCall(alice, "transfer", owner, big.NewInt(10)))
+	require.Equal(t, true, c.mustCall(owner, "transfer", bob, big.NewInt(5)))
+	c.requireBalance(alice, 90)
+	c.requireBalance(owner, 5)
+	c.requireBalance(bob, 5)
+
+	// only the owner toggles transfers
+	_, err = c.call(alice, "setTransferable", true)
+	require.Error(t, err)
+	c.mustCall(owner, "setTransferable", true)
+	require.Equal(t, true, c.mustCall(alice, "transferable"))
+
+	require.Equal(t, true, c.mustCall(alice, "transfer", bob, big.NewInt(10)))
+	c.requireBalance(alice, 80)
+	c.requireBalance(bob, 15)
+
+	_, err = c.call(alice, "transfer", bob, big.NewInt(81))
+	require.Error(t, err)
+	_, err = c.call(alice, "transfer", common.Address{}, big.NewInt(1))
+	require.Error(t, err)
+
+	// allowances
+	require.Equal(t, true, c.mustCall(alice, "approve", bob, big.NewInt(30)))
+	require.Equal(t, big.NewInt(30), c.mustCall(alice, "allowance", alice, bob))
+	_, err = c.call(bob, "transferFrom", alice, bob, big.NewInt(31))
+	require.Error(t, err)
+	require.Equal(t, true, c.mustCall(bob, "transferFrom", alice, bob, big.NewInt(20)))
+	require.Equal(t, big.NewInt(10), c.mustCall(alice, "allowance", alice, bob))
+	c.requireBalance(alice, 60)
+	c.requireBalance(bob, 35)
+
+	require.Equal(t, true, c.mustCall(alice, "approve", bob, math.MaxBig256))
+	require.Equal(t, true, c.mustCall(bob, "transferFrom", alice, bob, big.NewInt(10)))
+	require.Equal(t, math.MaxBig256, c.mustCall(alice, "allowance", alice, bob))
+	c.requireBalance(alice, 50)
+
+	// only the owner burns
+	_, err = c.call(alice, "burn", alice, big.NewInt(1))
+	require.Error(t, err)
+	_, err = c.call(owner, "burn", alice, big.NewInt(51))
+	require.Error(t, err)
+	c.mustCall(owner, "burn", alice, big.NewInt(50))
+	c.requireBalance(alice, 0)
+	require.Equal(t, big.NewInt(50), c.mustCall(alice, "totalSupply"))
+
+	// unknown selectors revert
+	_, _, err = runtime.Call(addr, []byte{0xde, 0xad, 0xbe, 0xef}, cfg)
+	require.Error(t, err)
+
+	// ownership, mint, 3 transfers, 2 approvals, 2 transfers from with the
+	// decreased allowance of the first one and burn
+	require.Len(t, db.Logs(), 11)
+}
+
+// TestERC20NativeCoinReproducible recompiles the contract with the pinned solc and
+// OpenZeppelin versions of package.json, and checks the bytecode matches the embedded one.
+// It needs node and the contracts dependencies, installed with npm install.
+func TestERC20NativeCoinReproducible(t *testing.T) {
+	if _, err := exec.LookPath("node"); err != nil {
+		t.Skip("node not installed")
+	}
+	if _, err := os.Stat("node_modules"); err != nil {
+		t.Skip("contracts dependencies not installed, run npm install")
+	}
+
+	out, err := exec.Command("node", "compile.js", "--check").CombinedOutput()
+	require.NoError(t, err, string(out))
+}
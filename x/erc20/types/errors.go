@@ -0,0 +1,13 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrERC20Disabled             = errorsmod.Register(ModuleName, 2, "erc20 module is disabled by governance")
+	ErrTokenPairAlreadyExists    = errorsmod.Register(ModuleName, 3, "token pair already exists")
+	ErrTokenPairNotFound         = errorsmod.Register(ModuleName, 4, "token pair not found")
+	ErrTokenPairDisabled         = errorsmod.Register(ModuleName, 5, "token pair conversion is disabled")
+	ErrEVMCall                   = errorsmod.Register(ModuleName, 6, "EVM call failed")
+	ErrInvalidTokenMetadata      = errorsmod.Register(ModuleName, 7, "invalid token metadata")
+	ErrUnexpectedContractAddress = errorsmod.Register(ModuleName, 8, "deployed contract address mismatch")
+)
@@ -0,0 +1,17 @@
+package types
+
+const (
+	EventTypeConvertCoin      = "convert_coin"
+	EventTypeConvertERC20     = "convert_erc20"
+	EventTypeRegisterCoin     = "register_coin"
+	EventTypeToggleConversion = "toggle_conversion"
+	EventTypeSetTransferable  = "set_transferable"
+
+	AttributeKeyCosmosCoin   = "cosmos_coin"
+	AttributeKeyERC20Token   = "erc20_token"
+	AttributeKeySender       = "sender"
+	AttributeKeyReceiver     = "receiver"
+	AttributeKeyAmount       = "amount"
+	AttributeKeyEnabled      = "enabled"
+	AttributeKeyTransferable = "transferable"
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, pairs []TokenPair) GenesisState {
+	return GenesisState{
+		Params:     params,
+		TokenPairs: pairs,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis performs basic validation of genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenContracts := make(map[common.Address]bool)
+	seenDenoms := make(map[string]bool)
+	for _, pair := range data.TokenPairs {
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+
+		contract := pair.GetERC20Contract()
+		if seenContracts[contract] {
+			return fmt.Errorf("duplicate token pair for contract %s", contract)
+		}
+		if seenDenoms[pair.Denom] {
+			return fmt.Errorf("duplicate token pair for denom %s", pair.Denom)
+		}
+		seenContracts[contract] = true
+		seenDenoms[pair.Denom] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	pair := NewTokenPair(common.HexToAddress("0x5f6659B6F712c729c46786bA9562eC50907c67CF"), "atabi", true)
+	other := NewTokenPair(common.HexToAddress("0x1f9090aaE28b8a3dCeaDf281B0F12828e676c326"), "avetabi", false)
+
+	testCases := []struct {
+		name      string
+		genState  GenesisState
+		expectErr bool
+	}{
+		{
+			name:     "success: default genesis state",
+			genState: *DefaultGenesisState(),
+		},
+		{
+			name:     "success: with token pairs",
+			genState: NewGenesisState(DefaultParams(), []TokenPair{pair, other}),
+		},
+		{
+			name:      "fail: duplicate contract",
+			genState:  NewGenesisState(DefaultParams(), []TokenPair{pair, {Erc20Address: pair.Erc20Address, Denom: "avetabi"}}),
+			expectErr: true,
+		},
+		{
+			name:      "fail: duplicate denom",
+			genState:  NewGenesisState(DefaultParams(), []TokenPair{pair, {Erc20Address: other.Erc20Address, Denom: pair.Denom}}),
+			expectErr: true,
+		},
+		{
+			name:      "fail: invalid denom",
+			genState:  NewGenesisState(DefaultParams(), []TokenPair{{Erc20Address: pair.Erc20Address, Denom: "1"}}),
+			expectErr: true,
+		},
+		{
+			name:      "fail: zero contract address",
+			genState:  NewGenesisState(DefaultParams(), []TokenPair{{Erc20Address: common.Address{}.Hex(), Denom: "atabi"}}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := ValidateGenesis(tc.genState)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
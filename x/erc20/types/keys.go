@@ -0,0 +1,58 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "erc20"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the native module address for the erc20 module. It escrows
+// the converted coins and owns the deployed ERC-20 contracts.
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
+const (
+	prefixParams = iota + 1
+	prefixTokenPair
+	prefixTokenPairByDenom
+)
+
+// KVStore keys
+var (
+	ParamsKey           = []byte{prefixParams}
+	TokenPairKey        = []byte{prefixTokenPair}
+	TokenPairByDenomKey = []byte{prefixTokenPairByDenom}
+)
+
+// TokenPairStoreKey returns the byte representation of the token pair key
+// Items are stored with key as follows:
+// 0x02<erc20>
+func TokenPairStoreKey(contract common.Address) []byte {
+	bz := make([]byte, len(TokenPairKey)+common.AddressLength)
+
+	copy(bz, TokenPairKey)
+	copy(bz[len(TokenPairKey):], contract.Bytes())
+
+	return bz
+}
+
+// TokenPairByDenomStoreKey returns the byte representation of the erc20 address by denom key
+// Items are stored with key as follows:
+// 0x03<denom>
+func TokenPairByDenomStoreKey(denom string) []byte {
+	return append(append([]byte{}, TokenPairByDenomKey...), denom...)
+}
@@ -0,0 +1,294 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	TypeMsgConvertCoin      = "convert_coin"
+	TypeMsgConvertERC20     = "convert_erc20"
+	TypeMsgRegisterCoin     = "register_coin"
+	TypeMsgToggleConversion = "toggle_conversion"
+	TypeMsgSetTransferable  = "set_transferable"
+	TypeMsgUpdateParams     = "update_params"
+
+	// MaxMetadataLength bounds the length of the token name and symbol.
+	MaxMetadataLength = 31
+	// MaxDecimals is the largest number of decimals of an ERC-20 token.
+	MaxDecimals = 255
+)
+
+var (
+	_ sdk.Msg = &MsgConvertCoin{}
+	_ sdk.Msg = &MsgConvertERC20{}
+	_ sdk.Msg = &MsgRegisterCoin{}
+	_ sdk.Msg = &MsgToggleConversion{}
+	_ sdk.Msg = &MsgSetTransferable{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgConvertCoin defines a message to convert a native coin into its ERC-20 representation
+func NewMsgConvertCoin(coin sdk.Coin, receiver common.Address, sender sdk.AccAddress) *MsgConvertCoin {
+	return &MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.Hex(),
+		Sender:   sender.String(),
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgConvertCoin) ValidateBasic() error {
+	if !msg.Coin.IsValid() || !msg.Coin.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid coin %s", msg.Coin)
+	}
+
+	if !common.IsHexAddress(msg.Receiver) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiver address %s", msg.Receiver)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgConvertERC20 defines a message to convert ERC-20 tokens back into the native coin
+func NewMsgConvertERC20(amount sdkmath.Int, receiver sdk.AccAddress, contract, sender common.Address) *MsgConvertERC20 {
+	return &MsgConvertERC20{
+		ContractAddress: contract.Hex(),
+		Amount:          amount,
+		Receiver:        receiver.String(),
+		Sender:          sender.Hex(),
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgConvertERC20) ValidateBasic() error {
+	if err := validateContractAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+
+	if !common.IsHexAddress(msg.Sender) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address %s", msg.Sender)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgConvertERC20) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{common.HexToAddress(msg.Sender).Bytes()}
+}
+
+// NewMsgRegisterCoin defines a message to deploy the ERC-20 representation of a native coin
+func NewMsgRegisterCoin(authority, denom, name, symbol string, decimals uint32, transferable bool) *MsgRegisterCoin {
+	return &MsgRegisterCoin{
+		Authority:    authority,
+		Denom:        denom,
+		Name:         name,
+		Symbol:       symbol,
+		Decimals:     decimals,
+		Transferable: transferable,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRegisterCoin) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := sdk.ValidateDenom(msg.Denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidTokenMetadata, err.Error())
+	}
+
+	if len(msg.Name) == 0 || len(msg.Name) > MaxMetadataLength {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "name length must be between 1 and %d: %d", MaxMetadataLength, len(msg.Name))
+	}
+
+	if len(msg.Symbol) == 0 || len(msg.Symbol) > MaxMetadataLength {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "symbol length must be between 1 and %d: %d", MaxMetadataLength, len(msg.Symbol))
+	}
+
+	if msg.Decimals > MaxDecimals {
+		return errorsmod.Wrapf(ErrInvalidTokenMetadata, "decimals cannot be greater than %d: %d", MaxDecimals, msg.Decimals)
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRegisterCoin) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgToggleConversion defines a message to enable or disable the conversions of a token pair
+func NewMsgToggleConversion(authority, token string) *MsgToggleConversion {
+	return &MsgToggleConversion{
+		Authority: authority,
+		Token:     token,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgToggleConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateToken(msg.Token)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgToggleConversion) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgSetTransferable defines a message to enable or disable the transfers of the ERC-20 tokens of a token pair
+func NewMsgSetTransferable(authority, token string, transferable bool) *MsgSetTransferable {
+	return &MsgSetTransferable{
+		Authority:    authority,
+		Token:        token,
+		Transferable: transferable,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetTransferable) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateToken(msg.Token)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSetTransferable) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgUpdateParams defines a message to update the params of the erc20 module
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// ValidateToken checks that the token is either a hex contract address or a
+// valid denom.
+func ValidateToken(token string) error {
+	if common.IsHexAddress(token) {
+		return validateContractAddress(token)
+	}
+
+	if err := sdk.ValidateDenom(token); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "token must be a contract address or a denom: %s", token)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgConvertCoin) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgConvertERC20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgConvertERC20) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgConvertERC20) Type() string { return TypeMsgConvertERC20 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgRegisterCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgRegisterCoin) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgRegisterCoin) Type() string { return TypeMsgRegisterCoin }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgToggleConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgToggleConversion) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgToggleConversion) Type() string { return TypeMsgToggleConversion }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgSetTransferable) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgSetTransferable) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgSetTransferable) Type() string { return TypeMsgSetTransferable }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
@@ -0,0 +1,251 @@
+package types
+
+import (
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertCoinValidateBasic() {
+	receiver := common.HexToAddress("0x5f6659B6F712c729c46786bA9562eC50907c67CF")
+	sender := sdk.AccAddress("sender______________")
+
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertCoin
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgConvertCoin(sdk.NewInt64Coin("atabi", 100), receiver, sender),
+			true,
+		},
+		{
+			"fail - zero amount",
+			NewMsgConvertCoin(sdk.NewInt64Coin("atabi", 0), receiver, sender),
+			false,
+		},
+		{
+			"fail - invalid denom",
+			NewMsgConvertCoin(sdk.Coin{Denom: "1", Amount: sdk.NewInt(100)}, receiver, sender),
+			false,
+		},
+		{
+			"fail - invalid receiver address",
+			&MsgConvertCoin{Coin: sdk.NewInt64Coin("atabi", 100), Receiver: "invalid", Sender: sender.String()},
+			false,
+		},
+		{
+			"fail - invalid sender address",
+			&MsgConvertCoin{Coin: sdk.NewInt64Coin("atabi", 100), Receiver: receiver.Hex(), Sender: "invalid"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgConvertERC20ValidateBasic() {
+	contract := common.HexToAddress("0x5f6659B6F712c729c46786bA9562eC50907c67CF")
+	sender := common.HexToAddress("0x1f9090aaE28b8a3dCeaDf281B0F12828e676c326")
+	receiver := sdk.AccAddress("receiver____________")
+
+	testCases := []struct {
+		name    string
+		msg     *MsgConvertERC20
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgConvertERC20(sdkmath.NewInt(100), receiver, contract, sender),
+			true,
+		},
+		{
+			"fail - zero amount",
+			NewMsgConvertERC20(sdkmath.ZeroInt(), receiver, contract, sender),
+			false,
+		},
+		{
+			"fail - zero contract address",
+			NewMsgConvertERC20(sdkmath.NewInt(100), receiver, common.Address{}, sender),
+			false,
+		},
+		{
+			"fail - invalid receiver address",
+			&MsgConvertERC20{ContractAddress: contract.Hex(), Amount: sdkmath.NewInt(100), Receiver: "invalid", Sender: sender.Hex()},
+			false,
+		},
+		{
+			"fail - invalid sender address",
+			&MsgConvertERC20{ContractAddress: contract.Hex(), Amount: sdkmath.NewInt(100), Receiver: receiver.String(), Sender: "invalid"},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterCoinValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterCoin
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgRegisterCoin(authority, "atabi", "Tabi", "TABI", 18, true),
+			true,
+		},
+		{
+			"fail - invalid authority address",
+			NewMsgRegisterCoin("invalid", "atabi", "Tabi", "TABI", 18, true),
+			false,
+		},
+		{
+			"fail - invalid denom",
+			NewMsgRegisterCoin(authority, "1", "Tabi", "TABI", 18, true),
+			false,
+		},
+		{
+			"fail - empty name",
+			NewMsgRegisterCoin(authority, "atabi", "", "TABI", 18, true),
+			false,
+		},
+		{
+			"fail - name too long",
+			NewMsgRegisterCoin(authority, "atabi", "Tabi Tabi Tabi Tabi Tabi Tabi Tabi", "TABI", 18, true),
+			false,
+		},
+		{
+			"fail - empty symbol",
+			NewMsgRegisterCoin(authority, "atabi", "Tabi", "", 18, true),
+			false,
+		},
+		{
+			"fail - too many decimals",
+			NewMsgRegisterCoin(authority, "atabi", "Tabi", "TABI", MaxDecimals+1, true),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgToggleConversionValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name    string
+		msg     *MsgToggleConversion
+		expPass bool
+	}{
+		{
+			"pass - denom",
+			NewMsgToggleConversion(authority, "atabi"),
+			true,
+		},
+		{
+			"pass - contract address",
+			NewMsgToggleConversion(authority, "0x5f6659B6F712c729c46786bA9562eC50907c67CF"),
+			true,
+		},
+		{
+			"fail - zero contract address",
+			NewMsgToggleConversion(authority, common.Address{}.Hex()),
+			false,
+		},
+		{
+			"fail - invalid token",
+			NewMsgToggleConversion(authority, "1"),
+			false,
+		},
+		{
+			"fail - invalid authority address",
+			NewMsgToggleConversion("invalid", "atabi"),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			NewMsgUpdateParams("invalid", DefaultParams()),
+			false,
+		},
+		{
+			"pass - valid msg",
+			NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), DefaultParams()),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
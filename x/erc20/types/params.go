@@ -0,0 +1,29 @@
+package types
+
+var (
+	// DefaultEnableERC20 is true, the conversions are enabled
+	DefaultEnableERC20 = true
+	// DefaultEnableEVMHook is true, the tokens sent to the module address are converted back
+	DefaultEnableEVMHook = true
+)
+
+// NewParams returns a new Params object
+func NewParams(enableERC20, enableEVMHook bool) Params {
+	return Params{
+		EnableErc20:   enableERC20,
+		EnableEvmHook: enableEVMHook,
+	}
+}
+
+// DefaultParams returns the default erc20 module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableErc20:   DefaultEnableERC20,
+		EnableEvmHook: DefaultEnableEVMHook,
+	}
+}
+
+// Validate performs basic validation on erc20 parameters.
+func (p Params) Validate() error {
+	return nil
+}
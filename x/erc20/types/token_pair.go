@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewTokenPair returns a new enabled TokenPair.
+func NewTokenPair(contract common.Address, denom string, transferable bool) TokenPair {
+	return TokenPair{
+		Erc20Address: contract.Hex(),
+		Denom:        denom,
+		Enabled:      true,
+		Transferable: transferable,
+	}
+}
+
+// GetERC20Contract returns the ERC-20 contract address
+func (tp TokenPair) GetERC20Contract() common.Address {
+	return common.HexToAddress(tp.Erc20Address)
+}
+
+// Validate performs a stateless validation of a TokenPair
+func (tp TokenPair) Validate() error {
+	if err := sdk.ValidateDenom(tp.Denom); err != nil {
+		return err
+	}
+
+	return validateContractAddress(tp.Erc20Address)
+}
+
+// validateContractAddress checks that the contract address is a non zero hex address.
+func validateContractAddress(contract string) error {
+	if !common.IsHexAddress(contract) {
+		return fmt.Errorf("invalid contract address %s", contract)
+	}
+
+	if common.HexToAddress(contract) == (common.Address{}) {
+		return fmt.Errorf("contract address cannot be the zero address")
+	}
+
+	return nil
+}
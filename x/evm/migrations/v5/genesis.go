@@ -0,0 +1,57 @@
+package v5
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	v4types "github.com/tabilabs/tabi/x/evm/migrations/v4/types"
+	"github.com/tabilabs/tabi/x/evm/types"
+)
+
+// V4GenesisState mirrors the JSON layout of the x/evm genesis state exported
+// at consensus version 4. The params are kept raw so they can be decoded with
+// the proto JSON codec into the legacy V4Params type.
+type V4GenesisState struct {
+	Accounts []types.GenesisAccount `json:"accounts"`
+	Params   json.RawMessage        `json:"params"`
+}
+
+// MigrateJSON accepts the exported x/evm genesis state from consensus version
+// 4 and migrates it to version 5. The extra EIPs, wrapped in a message in
+// version 4, are flattened into a plain list while every other field is kept
+// as is.
+func MigrateJSON(cdc codec.JSONCodec, oldState json.RawMessage) (*types.GenesisState, error) {
+	var v4State V4GenesisState
+	if err := json.Unmarshal(oldState, &v4State); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal v4 evm genesis state: %w", err)
+	}
+
+	var v4Params v4types.V4Params
+	if err := cdc.UnmarshalJSON(v4State.Params, &v4Params); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal v4 evm params: %w", err)
+	}
+
+	newState := &types.GenesisState{
+		Accounts: v4State.Accounts,
+		Params: types.Params{
+			EvmDenom:            v4Params.EvmDenom,
+			EnableCreate:        v4Params.EnableCreate,
+			EnableCall:          v4Params.EnableCall,
+			ExtraEIPs:           v4Params.ExtraEIPs.EIPs,
+			ChainConfig:         types.ChainConfig(v4Params.V4ChainConfig),
+			AllowUnprotectedTxs: v4Params.AllowUnprotectedTxs,
+		},
+	}
+
+	if newState.Accounts == nil {
+		newState.Accounts = []types.GenesisAccount{}
+	}
+
+	if err := newState.Validate(); err != nil {
+		return nil, err
+	}
+
+	return newState, nil
+}
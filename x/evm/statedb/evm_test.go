@@ -0,0 +1,210 @@
+package statedb_test
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tabilabs/tabi/x/evm/statedb"
+	"github.com/tabilabs/tabi/x/evm/types"
+)
+
+const (
+	testGas = 100_000
+
+	shanghaiHeight = 10
+	cancunHeight   = 20
+)
+
+var (
+	caller    = common.BigToAddress(big.NewInt(0x0fff))
+	contract  = common.BigToAddress(big.NewInt(0x1000))
+	slot      = common.BigToHash(big.NewInt(1))
+	slotValue = common.BigToHash(big.NewInt(42))
+)
+
+// newEVM deploys code at the contract address and returns an EVM at the given
+// height of a chain which activates Shanghai and Cancun at shanghaiHeight and
+// cancunHeight.
+func newEVM(code []byte, height int64) (*vm.EVM, *statedb.StateDB) {
+	db := statedb.New(sdk.Context{}, NewMockKeeper(), emptyTxConfig)
+	db.SetCode(contract, code)
+
+	shanghaiBlock := sdk.NewInt(shanghaiHeight)
+	cancunBlock := sdk.NewInt(cancunHeight)
+	chainConfig := types.DefaultChainConfig()
+	chainConfig.ShanghaiBlock = &shanghaiBlock
+	chainConfig.CancunBlock = &cancunBlock
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(height),
+		Time:        big.NewInt(1),
+		Difficulty:  big.NewInt(0),
+		BaseFee:     big.NewInt(0),
+	}
+
+	evm := vm.NewEVM(blockCtx, vm.TxContext{}, db, chainConfig.EthereumConfig(big.NewInt(9000)), vm.Config{})
+	return evm, db
+}
+
+func TestForkOpCodes(t *testing.T) {
+	testCases := []struct {
+		name      string
+		height    int64
+		push0     bool
+		transient bool
+	}{
+		{"before shanghai", shanghaiHeight - 1, false, false},
+		{"shanghai", shanghaiHeight, true, false},
+		{"cancun", cancunHeight, true, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// PUSH0 STOP
+			evm, _ := newEVM(common.FromHex("5f00"), tc.height)
+			_, _, err := evm.Call(vm.AccountRef(caller), contract, nil, testGas, big.NewInt(0))
+			if tc.push0 {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "invalid opcode")
+			}
+
+			// PUSH1 0x01 TLOAD STOP
+			evm, _ = newEVM(common.FromHex("60015c00"), tc.height)
+			_, _, err = evm.Call(vm.AccountRef(caller), contract, nil, testGas, big.NewInt(0))
+			if tc.transient {
+				require.NoError(t, err)
+			} else {
+				require.ErrorContains(t, err, "invalid opcode")
+			}
+		})
+	}
+}
+
+func TestPush0(t *testing.T) {
+	// PUSH1 0x2a PUSH0 MSTORE PUSH1 0x20 PUSH0 RETURN
+	evm, _ := newEVM(common.FromHex("602a5f5260205ff3"), shanghaiHeight)
+	ret, gasLeft, err := evm.Call(vm.AccountRef(caller), contract, nil, testGas, big.NewInt(0))
+	require.NoError(t, err)
+	require.Equal(t, slotValue.Bytes(), ret)
+	// PUSH1, MSTORE and its memory expansion cost 3 gas each, PUSH0 2
+	require.Equal(t, uint64(3+2+3+3+3+2), testGas-gasLeft)
+}
+
+func TestTransientStorageOpCodes(t *testing.T) {
+	// PUSH1 0x2a PUSH1 0x01 TSTORE PUSH1 0x01 TLOAD PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+	code := common.FromHex("602a60015d60015c60005260206000f3")
+
+	t.Run("store and load", func(t *testing.T) {
+		evm, db := newEVM(code, cancunHeight)
+		ret, gasLeft, err := evm.Call(vm.AccountRef(caller), contract, nil, testGas, big.NewInt(0))
+		require.NoError(t, err)
+		require.Equal(t, slotValue.Bytes(), ret)
+		// TSTORE and TLOAD cost a warm storage read each, without any refund
+		require.Equal(t, uint64(3*6+2*params.WarmStorageReadCostEIP2929+3+3), testGas-gasLeft)
+		require.Zero(t, db.GetRefund())
+
+		require.Equal(t, slotValue, db.GetTransientState(contract, slot))
+		require.Equal(t, common.Hash{}, db.GetState(contract, slot))
+
+		// the transient storage is cleared for the next transaction
+		db.PrepareAccessList(caller, &contract, nil, nil)
+		require.Equal(t, common.Hash{}, db.GetTransientState(contract, slot))
+	})
+
+	t.Run("reverted call", func(t *testing.T) {
+		// TSTORE followed by PUSH1 0x00 PUSH1 0x00 REVERT
+		evm, db := newEVM(common.FromHex("602a60015d60006000fd"), cancunHeight)
+		_, _, err := evm.Call(vm.AccountRef(caller), contract, nil, testGas, big.NewInt(0))
+		require.ErrorIs(t, err, vm.ErrExecutionReverted)
+		require.Equal(t, common.Hash{}, db.GetTransientState(contract, slot))
+	})
+
+	t.Run("static call", func(t *testing.T) {
+		evm, db := newEVM(code, cancunHeight)
+		_, _, err := evm.StaticCall(vm.AccountRef(caller), contract, nil, testGas)
+		require.ErrorIs(t, err, vm.ErrWriteProtection)
+		require.Equal(t, common.Hash{}, db.GetTransientState(contract, slot))
+	})
+
+	t.Run("load only", func(t *testing.T) {
+		// PUSH1 0x01 TLOAD PUSH1 0x00 MSTORE PUSH1 0x20 PUSH1 0x00 RETURN
+		evm, db := newEVM(common.FromHex("60015c60005260206000f3"), cancunHeight)
+		db.SetTransientState(contract, slot, slotValue)
+		ret, _, err := evm.StaticCall(vm.AccountRef(caller), contract, nil, testGas)
+		require.NoError(t, err)
+		require.Equal(t, slotValue.Bytes(), ret)
+	})
+}
+
+func TestMcopy(t *testing.T) {
+	testCases := []struct {
+		name    string
+		code    string
+		expRet  []byte
+		expGas  uint64
+		expFail bool
+	}{
+		{
+			// PUSH1 0x2a PUSH1 0x00 MSTORE
+			// PUSH1 0x20 PUSH1 0x00 PUSH1 0x20 MCOPY
+			// PUSH1 0x20 PUSH1 0x20 RETURN
+			"copy to new memory",
+			"602a600052" + "602060006020" + "5e" + "60206020f3",
+			slotValue.Bytes(),
+			// MSTORE expands memory to 1 word and MCOPY to 2 words,
+			// charging 3 for the expansion and 3 for the copied word
+			3*2 + 3 + 3 + 3*3 + 3 + 3 + 3 + 3*2,
+			false,
+		},
+		{
+			// PUSH1 0x2a PUSH1 0x00 MSTORE
+			// PUSH1 0x20 PUSH1 0x00 PUSH1 0x01 MCOPY
+			// PUSH1 0x20 PUSH1 0x01 RETURN
+			"overlapping areas",
+			"602a600052" + "602060006001" + "5e" + "60206001f3",
+			slotValue.Bytes(),
+			3*2 + 3 + 3 + 3*3 + 3 + 3 + 3 + 3*2,
+			false,
+		},
+		{
+			// PUSH1 0x00 PUSH1 0x00 PUSH1 0xff MCOPY STOP
+			"zero length",
+			"6000600060ff" + "5e00",
+			nil,
+			3*3 + 3,
+			false,
+		},
+		{
+			// PUSH1 0x20 PUSH1 0x00 PUSH32 2^256-1 MCOPY
+			"offset overflow",
+			"602060007f" + "ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff" + "5e",
+			nil,
+			testGas,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			evm, _ := newEVM(common.FromHex(tc.code), cancunHeight)
+			ret, gasLeft, err := evm.Call(vm.AccountRef(caller), contract, nil, testGas, big.NewInt(0))
+			if tc.expFail {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expRet, ret)
+			require.Equal(t, tc.expGas, testGas-gasLeft)
+		})
+	}
+}
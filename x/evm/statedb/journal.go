@@ -122,6 +122,12 @@ type (
 		address *common.Address
 		slot    *common.Hash
 	}
+
+	// Changes to the transient storage
+	transientStorageChange struct {
+		account       *common.Address
+		key, prevalue common.Hash
+	}
 )
 
 func (ch createObjectChange) Revert(s *StateDB) {
@@ -224,3 +230,11 @@ func (ch accessListAddSlotChange) Revert(s *StateDB) {
 func (ch accessListAddSlotChange) Dirtied() *common.Address {
 	return nil
 }
+
+func (ch transientStorageChange) Revert(s *StateDB) {
+	s.setTransientState(*ch.account, ch.key, ch.prevalue)
+}
+
+func (ch transientStorageChange) Dirtied() *common.Address {
+	return nil
+}
@@ -327,6 +327,9 @@ func (suite *StateDBTestSuite) TestRevertSnapshot() {
 			db.AddAddressToAccessList(address)
 			db.AddSlotToAccessList(address, v1)
 		}},
+		{"transient storage", func(db vm.StateDB) {
+			db.(*statedb.StateDB).SetTransientState(address, v1, v3)
+		}},
 	}
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
@@ -566,6 +569,41 @@ func (suite *StateDBTestSuite) TestIterateStorage() {
 	suite.Require().Equal(1, len(storage))
 }
 
+func (suite *StateDBTestSuite) TestTransientStorage() {
+	key := common.BigToHash(big.NewInt(1))
+	value1 := common.BigToHash(big.NewInt(1))
+	value2 := common.BigToHash(big.NewInt(2))
+
+	keeper := NewMockKeeper()
+	db := statedb.New(sdk.Context{}, keeper, emptyTxConfig)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	rev1 := db.Snapshot()
+	db.SetTransientState(address, key, value1)
+
+	rev2 := db.Snapshot()
+	db.SetTransientState(address, key, value2)
+	suite.Require().Equal(value2, db.GetTransientState(address, key))
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address2, key))
+	// transient storage is separated from the persistent storage
+	suite.Require().Equal(common.Hash{}, db.GetState(address, key))
+
+	db.RevertToSnapshot(rev2)
+	suite.Require().Equal(value1, db.GetTransientState(address, key))
+
+	db.RevertToSnapshot(rev1)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+
+	// transient storage is never committed
+	db.SetTransientState(address, key, value1)
+	suite.Require().NoError(db.Commit())
+	suite.Require().Empty(keeper.accounts)
+
+	// and it is reset when a new transaction is prepared
+	db.PrepareAccessList(address, &address2, nil, nil)
+	suite.Require().Equal(common.Hash{}, db.GetTransientState(address, key))
+}
+
 func CollectContractStorage(db vm.StateDB) statedb.Storage {
 	storage := make(statedb.Storage)
 	err := db.ForEachStorage(address, func(k, v common.Hash) bool {
@@ -43,7 +43,9 @@ func (cc ChainConfig) EthereumConfig(chainID *big.Int) *params.ChainConfig {
 	}
 }
 
-// DefaultChainConfig returns default evm parameters.
+// DefaultChainConfig returns default evm parameters. A new network runs all the
+// forks from genesis, the chains started before the Shanghai and Cancun forks
+// schedule them with the v2 upgrade.
 func DefaultChainConfig() ChainConfig {
 	homesteadBlock := sdk.ZeroInt()
 	daoForkBlock := sdk.ZeroInt()
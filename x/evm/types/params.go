@@ -28,7 +28,7 @@ var (
 // instruction sets from the latest hard fork enabled by the ChainConfig. For
 // more info check:
 // https://github.com/ethereum/go-ethereum/blob/master/core/vm/interpreter.go#L97
-var AvailableExtraEIPs = []int64{1344, 1884, 2200, 2929, 3198, 3529}
+var AvailableExtraEIPs = []int64{1153, 1344, 1884, 2200, 2929, 3198, 3529, 3855, 5656}
 
 // NewParams creates a new Params instance
 func NewParams(evmDenom string, allowUnprotectedTxs, enableCreate, enableCall bool, config ChainConfig, extraEIPs []int64) Params {
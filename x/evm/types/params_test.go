@@ -69,6 +69,7 @@ func TestParamsValidatePriv(t *testing.T) {
 	require.NoError(t, validateBool(true))
 	require.Error(t, validateEIPs(""))
 	require.NoError(t, validateEIPs([]int64{1884}))
+	require.NoError(t, validateEIPs(AvailableExtraEIPs))
 }
 
 func TestValidateChainConfig(t *testing.T) {
@@ -23,6 +23,8 @@ func GetQueryCmd() *cobra.Command {
 		GetBlockGasCmd(),
 		GetBaseFeeCmd(),
 		GetParamsCmd(),
+		GetMinGasPriceControllerCmd(),
+		GetFeeDistributionCmd(),
 	)
 	return cmd
 }
@@ -114,3 +116,63 @@ If the height is not provided, it will use the latest height from context.`,
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetMinGasPriceControllerCmd queries the utilization window and the last output
+// of the min gas price controller
+func GetMinGasPriceControllerCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-gas-price-controller",
+		Short: "Get the utilization window and the output of the min gas price controller",
+		Long: `Get the gas usage of the recent blocks kept in the utilization window of the
+min gas price controller, and the min gas price and base fee change denominator it last set.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MinGasPriceController(cmd.Context(), &types.QueryMinGasPriceControllerRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetFeeDistributionCmd queries the cumulative amounts of fees burned and
+// redirected to the captains reward pool
+func GetFeeDistributionCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fee-distribution",
+		Short: "Get the cumulative amounts of fees burned and redirected to the captains reward pool",
+		Long: `Get the total amounts of collected fees burned and redirected to the captains reward
+pool by the fee split since it was enabled.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.FeeDistribution(cmd.Context(), &types.QueryFeeDistributionRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
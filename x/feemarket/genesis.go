@@ -21,6 +21,9 @@ func InitGenesis(
 	}
 
 	k.SetBlockGasWanted(ctx, data.BlockGas)
+	k.SetGasWindow(ctx, data.GasWindow, data.Params.MinGasPriceController.WindowSize)
+	k.SetCumulativeBurned(ctx, data.CumulativeBurned)
+	k.SetCumulativeCaptainsPool(ctx, data.CumulativeCaptainsPool)
 
 	return []abci.ValidatorUpdate{}
 }
@@ -28,7 +31,10 @@ func InitGenesis(
 // ExportGenesis exports genesis state of the fee market module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
-		Params:   k.GetParams(ctx),
-		BlockGas: k.GetBlockGasWanted(ctx),
+		Params:                 k.GetParams(ctx),
+		BlockGas:               k.GetBlockGasWanted(ctx),
+		GasWindow:              k.GetGasWindow(ctx),
+		CumulativeBurned:       k.GetCumulativeBurned(ctx),
+		CumulativeCaptainsPool: k.GetCumulativeCaptainsPool(ctx),
 	}
 }
@@ -0,0 +1,225 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Min Gas Price Controller
+// Adjusts the min gas price and the base fee change denominator from the gas
+// used over a moving window of recent blocks.
+// ----------------------------------------------------------------------------
+
+// UpdateMinGasPrice records the gas used by the current block in the
+// utilization window and, if the controller is enabled, adjusts the min gas
+// price and the base fee change denominator from the window utilization.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) UpdateMinGasPrice(ctx sdk.Context, gasUsed uint64) {
+	params := k.GetParams(ctx)
+	controller := params.MinGasPriceController
+	if !controller.Enabled {
+		return
+	}
+
+	// NOTE: a MaxGas equal to -1 means that block gas is unlimited, and thus
+	// the utilization can't be computed
+	consParams := ctx.ConsensusParams()
+	if consParams == nil || consParams.Block == nil || consParams.Block.MaxGas <= 0 || params.ElasticityMultiplier == 0 {
+		k.Logger(ctx).Debug("skipping min gas price controller, block gas target is unknown")
+		return
+	}
+
+	gasTarget := uint64(consParams.Block.MaxGas) / uint64(params.ElasticityMultiplier)
+	if gasTarget == 0 {
+		return
+	}
+
+	window := k.recordBlockGasUsage(ctx, types.BlockGasUsage{
+		Height:    ctx.BlockHeight(),
+		GasUsed:   gasUsed,
+		GasTarget: gasTarget,
+	}, controller.WindowSize)
+
+	output := ComputeMinGasPriceControllerOutput(controller, params.MinGasPrice, window)
+	output.Height = ctx.BlockHeight()
+
+	params.MinGasPrice = output.MinGasPrice
+	params.BaseFeeChangeDenominator = output.BaseFeeChangeDenominator
+	if err := k.SetParams(ctx, params); err != nil {
+		k.Logger(ctx).Error("failed to set the min gas price controller output", "error", err.Error())
+		return
+	}
+
+	k.SetMinGasPriceControllerOutput(ctx, output)
+
+	defer func() {
+		telemetry.SetGauge(float32(output.MinGasPrice.MustFloat64()), "feemarket", "min_gas_price")
+		telemetry.SetGauge(float32(output.BaseFeeChangeDenominator), "feemarket", "base_fee_change_denominator")
+	}()
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeMinGasPriceController,
+		sdk.NewAttribute(types.AttributeKeyUtilization, output.Utilization.String()),
+		sdk.NewAttribute(types.AttributeKeyMinGasPrice, output.MinGasPrice.String()),
+		sdk.NewAttribute(types.AttributeKeyBaseFeeChangeDenominator, fmt.Sprintf("%d", output.BaseFeeChangeDenominator)),
+	))
+}
+
+// ComputeMinGasPriceControllerOutput computes the min gas price and the base
+// fee change denominator from the utilization of the given window, i.e the gas
+// used over the gas target of its blocks.
+//
+// The deviation of the utilization from the target, bounded to [-1, 1], moves
+// the min gas price by up to the adjustment rate, within the floor and the
+// ceiling. The further the utilization is from the target, the lower the base
+// fee change denominator, so that the base fee follows sustained load faster
+// while it is damped against single block spikes.
+func ComputeMinGasPriceControllerOutput(
+	controller types.MinGasPriceController,
+	minGasPrice sdk.Dec,
+	window []types.BlockGasUsage,
+) types.MinGasPriceControllerOutput {
+	gasUsed, gasTarget := sdkmath.ZeroInt(), sdkmath.ZeroInt()
+	for _, usage := range window {
+		gasUsed = gasUsed.Add(sdkmath.NewIntFromUint64(usage.GasUsed))
+		gasTarget = gasTarget.Add(sdkmath.NewIntFromUint64(usage.GasTarget))
+	}
+
+	// an empty window is on target
+	utilization := sdk.OneDec()
+	if gasTarget.IsPositive() {
+		utilization = sdk.NewDecFromInt(gasUsed).QuoInt(gasTarget)
+	}
+
+	deviation := utilization.Sub(sdk.OneDec())
+	deviation = sdk.MinDec(sdk.MaxDec(deviation, sdk.OneDec().Neg()), sdk.OneDec())
+
+	if minGasPrice.IsNil() {
+		minGasPrice = sdk.ZeroDec()
+	}
+	minGasPrice = sdk.MaxDec(minGasPrice, controller.MinGasPriceFloor)
+	minGasPrice = minGasPrice.Mul(sdk.OneDec().Add(controller.AdjustmentRate.Mul(deviation)))
+	minGasPrice = sdk.MinDec(sdk.MaxDec(minGasPrice, controller.MinGasPriceFloor), controller.MinGasPriceCeiling)
+
+	denominatorRange := sdk.NewDec(int64(controller.MaxBaseFeeChangeDenominator - controller.MinBaseFeeChangeDenominator))
+	denominatorDelta := denominatorRange.Mul(deviation.Abs()).RoundInt64()
+
+	return types.MinGasPriceControllerOutput{
+		Utilization:              utilization,
+		MinGasPrice:              minGasPrice,
+		BaseFeeChangeDenominator: controller.MaxBaseFeeChangeDenominator - uint32(denominatorDelta),
+	}
+}
+
+// recordBlockGasUsage stores the gas usage of a block in the utilization window
+// and returns the window ending at that block. The window is a ring buffer of
+// windowSize slots indexed by block height, so blocks that fall out of the
+// window are overwritten or pruned, and the entries are moved to their new
+// slot when the window size is changed.
+func (k Keeper) recordBlockGasUsage(ctx sdk.Context, usage types.BlockGasUsage, windowSize uint32) []types.BlockGasUsage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasWindow)
+	size := uint64(windowSize)
+
+	var (
+		window    []types.BlockGasUsage
+		misplaced []types.BlockGasUsage
+		stale     [][]byte
+	)
+
+	iterator := store.Iterator(nil, nil)
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.BlockGasUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+
+		key := append([]byte(nil), iterator.Key()...)
+		slot := sdk.BigEndianToUint64(key)
+		switch {
+		case entry.Height <= usage.Height-int64(size) || entry.Height >= usage.Height:
+			stale = append(stale, key)
+		case slot != uint64(entry.Height)%size:
+			stale = append(stale, key)
+			misplaced = append(misplaced, entry)
+			window = append(window, entry)
+		default:
+			window = append(window, entry)
+		}
+	}
+	iterator.Close()
+
+	for _, key := range stale {
+		store.Delete(key)
+	}
+	for _, entry := range misplaced {
+		k.setBlockGasUsage(ctx, entry, windowSize)
+	}
+
+	k.setBlockGasUsage(ctx, usage, windowSize)
+	window = append(window, usage)
+
+	sort.Slice(window, func(i, j int) bool {
+		return window[i].Height < window[j].Height
+	})
+	return window
+}
+
+// setBlockGasUsage stores the gas usage of a block in its utilization window slot.
+func (k Keeper) setBlockGasUsage(ctx sdk.Context, usage types.BlockGasUsage, windowSize uint32) {
+	store := ctx.KVStore(k.storeKey)
+	slot := uint64(usage.Height) % uint64(windowSize)
+	store.Set(types.GasWindowKey(slot), k.cdc.MustMarshal(&usage))
+}
+
+// SetGasWindow stores the given block gas usages in the utilization window.
+func (k Keeper) SetGasWindow(ctx sdk.Context, window []types.BlockGasUsage, windowSize uint32) {
+	for _, usage := range window {
+		k.setBlockGasUsage(ctx, usage, windowSize)
+	}
+}
+
+// GetGasWindow returns the block gas usages of the utilization window, ordered
+// by height.
+func (k Keeper) GetGasWindow(ctx sdk.Context) []types.BlockGasUsage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasWindow)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var window []types.BlockGasUsage
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.BlockGasUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		window = append(window, usage)
+	}
+
+	sort.Slice(window, func(i, j int) bool {
+		return window[i].Height < window[j].Height
+	})
+	return window
+}
+
+// SetMinGasPriceControllerOutput stores the last output of the min gas price controller.
+func (k Keeper) SetMinGasPriceControllerOutput(ctx sdk.Context, output types.MinGasPriceControllerOutput) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyPrefixControllerOutput, k.cdc.MustMarshal(&output))
+}
+
+// GetMinGasPriceControllerOutput returns the last output of the min gas price
+// controller. It returns false if the controller has not run yet.
+func (k Keeper) GetMinGasPriceControllerOutput(ctx sdk.Context) (types.MinGasPriceControllerOutput, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPrefixControllerOutput)
+	if len(bz) == 0 {
+		return types.MinGasPriceControllerOutput{}, false
+	}
+
+	var output types.MinGasPriceControllerOutput
+	k.cdc.MustUnmarshal(bz, &output)
+	return output, true
+}
@@ -0,0 +1,210 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/tabilabs/tabi/x/feemarket/keeper"
+	"github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+func testMinGasPriceController(windowSize uint32) types.MinGasPriceController {
+	controller := types.DefaultMinGasPriceController()
+	controller.Enabled = true
+	controller.WindowSize = windowSize
+	controller.MinGasPriceFloor = sdk.NewDec(10)
+	controller.MinGasPriceCeiling = sdk.NewDec(1000)
+	return controller
+}
+
+// setMinGasPriceController enables the controller and sets a block gas target
+// of 50 through the MaxGas consensus param and the elasticity multiplier.
+func (suite *KeeperTestSuite) setMinGasPriceController(controller types.MinGasPriceController) {
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.ElasticityMultiplier = 2
+	params.MinGasPrice = sdk.NewDec(100)
+	params.MinGasPriceController = controller
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	blockParams := abci.BlockParams{
+		MaxGas:   100,
+		MaxBytes: 10,
+	}
+	suite.ctx = suite.ctx.WithConsensusParams(&abci.ConsensusParams{Block: &blockParams})
+}
+
+func windowHeights(window []types.BlockGasUsage) []int64 {
+	heights := make([]int64, len(window))
+	for i, usage := range window {
+		heights[i] = usage.Height
+	}
+	return heights
+}
+
+func (suite *KeeperTestSuite) TestComputeMinGasPriceControllerOutput() {
+	usage := func(gasUsed ...uint64) []types.BlockGasUsage {
+		window := make([]types.BlockGasUsage, len(gasUsed))
+		for i, gas := range gasUsed {
+			window[i] = types.BlockGasUsage{Height: int64(i + 1), GasUsed: gas, GasTarget: 50}
+		}
+		return window
+	}
+
+	testCases := []struct {
+		name           string
+		minGasPrice    sdk.Dec
+		window         []types.BlockGasUsage
+		expUtilization sdk.Dec
+		expMinGasPrice sdk.Dec
+		expDenominator uint32
+	}{
+		{
+			"empty window is on target",
+			sdk.NewDec(100),
+			nil,
+			sdk.OneDec(),
+			sdk.NewDec(100),
+			16,
+		},
+		{
+			"on target",
+			sdk.NewDec(100),
+			usage(50, 25, 75),
+			sdk.OneDec(),
+			sdk.NewDec(100),
+			16,
+		},
+		{
+			"single block spike is averaged",
+			sdk.NewDec(100),
+			usage(50, 50, 50, 150),
+			sdk.NewDecWithPrec(15, 1),
+			sdk.NewDecWithPrec(10625, 2),
+			10,
+		},
+		{
+			"sustained full blocks",
+			sdk.NewDec(100),
+			usage(100, 100, 100),
+			sdk.NewDec(2),
+			sdk.NewDecWithPrec(1125, 1),
+			4,
+		},
+		{
+			"deviation is bounded",
+			sdk.NewDec(100),
+			usage(500, 500),
+			sdk.NewDec(10),
+			sdk.NewDecWithPrec(1125, 1),
+			4,
+		},
+		{
+			"empty blocks",
+			sdk.NewDec(100),
+			usage(0, 0, 0),
+			sdk.ZeroDec(),
+			sdk.NewDecWithPrec(875, 1),
+			4,
+		},
+		{
+			"min gas price is capped by the ceiling",
+			sdk.NewDec(1000),
+			usage(100, 100),
+			sdk.NewDec(2),
+			sdk.NewDec(1000),
+			4,
+		},
+		{
+			"min gas price starts from the floor",
+			sdk.ZeroDec(),
+			usage(0, 0),
+			sdk.ZeroDec(),
+			sdk.NewDec(10),
+			4,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			output := keeper.ComputeMinGasPriceControllerOutput(testMinGasPriceController(10), tc.minGasPrice, tc.window)
+			suite.Require().Equal(tc.expUtilization, output.Utilization)
+			suite.Require().Equal(tc.expMinGasPrice, output.MinGasPrice)
+			suite.Require().Equal(tc.expDenominator, output.BaseFeeChangeDenominator)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateMinGasPriceDisabled() {
+	suite.SetupTest()
+	controller := testMinGasPriceController(3)
+	controller.Enabled = false
+	suite.setMinGasPriceController(controller)
+	paramsBefore := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+
+	suite.app.FeeMarketKeeper.UpdateMinGasPrice(suite.ctx, 100)
+
+	suite.Require().Empty(suite.app.FeeMarketKeeper.GetGasWindow(suite.ctx))
+	suite.Require().Equal(paramsBefore, suite.app.FeeMarketKeeper.GetParams(suite.ctx))
+	_, found := suite.app.FeeMarketKeeper.GetMinGasPriceControllerOutput(suite.ctx)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestUpdateMinGasPriceWindow() {
+	suite.SetupTest()
+	suite.setMinGasPriceController(testMinGasPriceController(3))
+
+	run := func(height int64) {
+		suite.ctx = suite.ctx.WithBlockHeight(height)
+		suite.app.FeeMarketKeeper.UpdateMinGasPrice(suite.ctx, 100)
+	}
+
+	for height := int64(1); height <= 5; height++ {
+		run(height)
+	}
+	suite.Require().Equal([]int64{3, 4, 5}, windowHeights(suite.app.FeeMarketKeeper.GetGasWindow(suite.ctx)))
+
+	// sustained full blocks raise the min gas price and lower the denominator
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	suite.Require().True(params.MinGasPrice.GT(sdk.NewDec(100)))
+	suite.Require().Equal(uint32(4), params.BaseFeeChangeDenominator)
+
+	output, found := suite.app.FeeMarketKeeper.GetMinGasPriceControllerOutput(suite.ctx)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(5), output.Height)
+	suite.Require().Equal(params.MinGasPrice, output.MinGasPrice)
+
+	// shrinking the window drops the oldest blocks
+	controller := params.MinGasPriceController
+	controller.WindowSize = 2
+	suite.setMinGasPriceController(controller)
+	run(6)
+	suite.Require().Equal([]int64{5, 6}, windowHeights(suite.app.FeeMarketKeeper.GetGasWindow(suite.ctx)))
+
+	// growing the window keeps the recorded blocks
+	controller.WindowSize = 4
+	suite.setMinGasPriceController(controller)
+	run(7)
+	run(8)
+	suite.Require().Equal([]int64{5, 6, 7, 8}, windowHeights(suite.app.FeeMarketKeeper.GetGasWindow(suite.ctx)))
+	run(9)
+	suite.Require().Equal([]int64{6, 7, 8, 9}, windowHeights(suite.app.FeeMarketKeeper.GetGasWindow(suite.ctx)))
+}
+
+func (suite *KeeperTestSuite) TestEndBlockMinGasPriceController() {
+	suite.SetupTest()
+	suite.setMinGasPriceController(testMinGasPriceController(10))
+
+	// empty blocks lower the min gas price
+	suite.ctx = suite.ctx.WithBlockGasMeter(sdk.NewGasMeter(100))
+	suite.app.FeeMarketKeeper.EndBlock(suite.ctx, abci.RequestEndBlock{Height: suite.ctx.BlockHeight()})
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	suite.Require().Equal(sdk.NewDecWithPrec(875, 1), params.MinGasPrice)
+
+	res, err := suite.queryClient.MinGasPriceController(suite.ctx.Context(), &types.QueryMinGasPriceControllerRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.BlockGasUsage{{Height: suite.ctx.BlockHeight(), GasUsed: 0, GasTarget: 50}}, res.Window)
+	suite.Require().Equal(sdk.ZeroDec(), res.Output.Utilization)
+	suite.Require().Equal(params.MinGasPrice, res.Output.MinGasPrice)
+	suite.Require().Equal(params.BaseFeeChangeDenominator, res.Output.BaseFeeChangeDenominator)
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tabilabs/tabi/utils"
+	"github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+// ----------------------------------------------------------------------------
+// Fee Split
+// Splits the fees collected on each block between burning, the validators and
+// the captains reward pool.
+// ----------------------------------------------------------------------------
+
+// SplitFees burns the burn share of the fees collected on the current block
+// and redirects the captains pool share of the base denom fees to the emission
+// of the next captains epoch. The remaining fees are left in the fee collector
+// and distributed to the validators on the next block.
+//
+// The captains pool share is burned as well, the claims module minting the
+// matching amount of vetabi when the captains withdraw their emission.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) SplitFees(ctx sdk.Context) {
+	split := k.GetParams(ctx).FeeSplit
+	if !split.BurnRatio.IsPositive() && !split.CaptainsPoolRatio.IsPositive() {
+		return
+	}
+
+	fees := k.bankKeeper.GetAllBalances(ctx, authtypes.NewModuleAddress(authtypes.FeeCollectorName))
+	burned, captainsPool := ComputeFeeSplit(split, fees)
+	if burned.IsZero() && captainsPool.IsZero() {
+		return
+	}
+
+	amount := burned.Add(captainsPool...)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, amount); err != nil {
+		k.Logger(ctx).Error("failed to collect the fee split", "error", err.Error())
+		return
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, amount); err != nil {
+		// NOTE: the fee market module account is a burner, this should never happen
+		panic(err)
+	}
+
+	if !captainsPool.IsZero() {
+		k.captainsKeeper.IncrFeePoolEmission(ctx, sdk.NewDecFromInt(captainsPool.AmountOf(utils.BaseDenom)))
+	}
+
+	k.SetCumulativeBurned(ctx, k.GetCumulativeBurned(ctx).Add(burned...))
+	k.SetCumulativeCaptainsPool(ctx, k.GetCumulativeCaptainsPool(ctx).Add(captainsPool...))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeSplit,
+		sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		sdk.NewAttribute(types.AttributeKeyCaptainsPool, captainsPool.String()),
+	))
+}
+
+// ComputeFeeSplit returns the shares of the given fees that are burned and
+// redirected to the captains reward pool. The burn ratio applies to all the
+// denoms, while the captains pool ratio only applies to the base denom, as the
+// captains emission is accounted in it. Shares are truncated, so that the
+// rounding remainder is left to the validators.
+func ComputeFeeSplit(split types.FeeSplit, fees sdk.Coins) (burned, captainsPool sdk.Coins) {
+	burned = sdk.NewCoins()
+	captainsPool = sdk.NewCoins()
+
+	for _, fee := range fees {
+		burned = burned.Add(sdk.NewCoin(fee.Denom, sdk.NewDecFromInt(fee.Amount).Mul(split.BurnRatio).TruncateInt()))
+		if fee.Denom == utils.BaseDenom {
+			captainsPool = captainsPool.Add(sdk.NewCoin(fee.Denom, sdk.NewDecFromInt(fee.Amount).Mul(split.CaptainsPoolRatio).TruncateInt()))
+		}
+	}
+
+	return burned, captainsPool
+}
+
+// GetCumulativeBurned returns the total amount of fees burned by the fee split.
+func (k Keeper) GetCumulativeBurned(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.KeyPrefixCumulativeBurned)
+}
+
+// SetCumulativeBurned sets the total amount of fees burned by the fee split.
+func (k Keeper) SetCumulativeBurned(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.KeyPrefixCumulativeBurned, coins)
+}
+
+// GetCumulativeCaptainsPool returns the total amount of fees redirected to the
+// captains reward pool by the fee split.
+func (k Keeper) GetCumulativeCaptainsPool(ctx sdk.Context) sdk.Coins {
+	return k.getCoins(ctx, types.KeyPrefixCumulativeCaptainsPool)
+}
+
+// SetCumulativeCaptainsPool sets the total amount of fees redirected to the
+// captains reward pool by the fee split.
+func (k Keeper) SetCumulativeCaptainsPool(ctx sdk.Context, coins sdk.Coins) {
+	k.setCoins(ctx, types.KeyPrefixCumulativeCaptainsPool, coins)
+}
+
+func (k Keeper) getCoins(ctx sdk.Context, key []byte) sdk.Coins {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.NewCoins()
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(string(bz))
+	if err != nil {
+		panic(err)
+	}
+	return coins
+}
+
+func (k Keeper) setCoins(ctx sdk.Context, key []byte, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(key, []byte(coins.String()))
+}
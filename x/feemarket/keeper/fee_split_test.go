@@ -0,0 +1,141 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/tabilabs/tabi/testutil"
+	"github.com/tabilabs/tabi/utils"
+	"github.com/tabilabs/tabi/x/feemarket/keeper"
+	"github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestComputeFeeSplit() {
+	split := types.FeeSplit{
+		BurnRatio:         sdk.NewDecWithPrec(5, 1),
+		CaptainsPoolRatio: sdk.NewDecWithPrec(3, 1),
+	}
+
+	testCases := []struct {
+		name            string
+		split           types.FeeSplit
+		fees            sdk.Coins
+		expBurned       sdk.Coins
+		expCaptainsPool sdk.Coins
+	}{
+		{
+			"no fees",
+			split,
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+		},
+		{
+			"default split leaves all the fees to the validators",
+			types.DefaultFeeSplit(),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)),
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+		},
+		{
+			"base denom fees",
+			split,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 500)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 300)),
+		},
+		{
+			"shares are truncated",
+			split,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 9)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 4)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 2)),
+		},
+		{
+			"captains pool only takes the base denom",
+			split,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000), sdk.NewInt64Coin("other", 100)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 500), sdk.NewInt64Coin("other", 50)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 300)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			burned, captainsPool := keeper.ComputeFeeSplit(tc.split, tc.fees)
+			suite.Require().Equal(tc.expBurned.String(), burned.String())
+			suite.Require().Equal(tc.expCaptainsPool.String(), captainsPool.String())
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSplitFees() {
+	testCases := []struct {
+		name            string
+		split           types.FeeSplit
+		fees            sdk.Coins
+		expBurned       sdk.Coins
+		expCaptainsPool sdk.Coins
+	}{
+		{
+			"default split",
+			types.DefaultFeeSplit(),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)),
+			sdk.NewCoins(),
+			sdk.NewCoins(),
+		},
+		{
+			"burn and captains pool",
+			types.FeeSplit{BurnRatio: sdk.NewDecWithPrec(5, 1), CaptainsPoolRatio: sdk.NewDecWithPrec(3, 1)},
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 500)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 300)),
+		},
+		{
+			"all burned",
+			types.FeeSplit{BurnRatio: sdk.OneDec(), CaptainsPoolRatio: sdk.ZeroDec()},
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000)),
+			sdk.NewCoins(),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+			params.FeeSplit = tc.split
+			suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+			feeCollector := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+			collected := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector)
+			suite.Require().NoError(testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, tc.fees))
+			collected = collected.Add(tc.fees...)
+
+			supply := suite.app.BankKeeper.GetSupply(suite.ctx, utils.BaseDenom)
+			poolEmission := suite.app.CaptainsKeeper.GetFeePoolEmission(suite.ctx)
+
+			suite.app.FeeMarketKeeper.SplitFees(suite.ctx)
+
+			// the validators share is left in the fee collector
+			remaining := collected.Sub(tc.expBurned...).Sub(tc.expCaptainsPool...)
+			suite.Require().Equal(remaining.String(), suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector).String())
+
+			// both the burned and the captains pool shares are removed from the supply
+			expSupply := supply.Amount.Sub(tc.expBurned.AmountOf(utils.BaseDenom)).Sub(tc.expCaptainsPool.AmountOf(utils.BaseDenom))
+			suite.Require().Equal(expSupply, suite.app.BankKeeper.GetSupply(suite.ctx, utils.BaseDenom).Amount)
+
+			expPoolEmission := poolEmission.Add(sdk.NewDecFromInt(tc.expCaptainsPool.AmountOf(utils.BaseDenom)))
+			suite.Require().Equal(expPoolEmission, suite.app.CaptainsKeeper.GetFeePoolEmission(suite.ctx))
+
+			suite.Require().Equal(tc.expBurned.String(), suite.app.FeeMarketKeeper.GetCumulativeBurned(suite.ctx).String())
+			suite.Require().Equal(tc.expCaptainsPool.String(), suite.app.FeeMarketKeeper.GetCumulativeCaptainsPool(suite.ctx).String())
+
+			res, err := suite.queryClient.FeeDistribution(suite.ctx.Context(), &types.QueryFeeDistributionRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBurned.String(), res.Burned.String())
+			suite.Require().Equal(tc.expCaptainsPool.String(), res.CaptainsPool.String())
+		})
+	}
+}
@@ -50,3 +50,30 @@ func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*typ
 		Gas: gas.Int64(),
 	}, nil
 }
+
+// MinGasPriceController implements the Query/MinGasPriceController gRPC method
+func (k Keeper) MinGasPriceController(
+	c context.Context,
+	_ *types.QueryMinGasPriceControllerRequest,
+) (*types.QueryMinGasPriceControllerResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	output, _ := k.GetMinGasPriceControllerOutput(ctx)
+
+	return &types.QueryMinGasPriceControllerResponse{
+		Window: k.GetGasWindow(ctx),
+		Output: output,
+	}, nil
+}
+
+// FeeDistribution implements the Query/FeeDistribution gRPC method
+func (k Keeper) FeeDistribution(
+	c context.Context,
+	_ *types.QueryFeeDistributionRequest,
+) (*types.QueryFeeDistributionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryFeeDistributionResponse{
+		Burned:       k.GetCumulativeBurned(ctx),
+		CaptainsPool: k.GetCumulativeCaptainsPool(ctx),
+	}, nil
+}
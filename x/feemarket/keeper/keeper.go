@@ -24,13 +24,17 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
+	// keepers used to split the collected fees
+	bankKeeper     types.BankKeeper
+	captainsKeeper types.CaptainsKeeper
 	// Legacy subspace
 	ss paramstypes.Subspace
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey, ss paramstypes.Subspace,
+	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper, captainsKeeper types.CaptainsKeeper, ss paramstypes.Subspace,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -38,11 +42,13 @@ func NewKeeper(
 	}
 
 	return Keeper{
-		cdc:          cdc,
-		storeKey:     storeKey,
-		authority:    authority,
-		transientKey: transientKey,
-		ss:           ss,
+		cdc:            cdc,
+		storeKey:       storeKey,
+		authority:      authority,
+		transientKey:   transientKey,
+		bankKeeper:     bankKeeper,
+		captainsKeeper: captainsKeeper,
+		ss:             ss,
 	}
 }
 
@@ -0,0 +1,30 @@
+package v5
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+// MigrateJSON accepts the exported x/feemarket genesis state from consensus
+// version 4 and migrates it to version 5. Like MigrateStore, it sets the default
+// min gas price controller parameters, which leave the controller disabled, and
+// the default fee split, which leaves all the collected fees to the validators.
+func MigrateJSON(cdc codec.JSONCodec, oldState json.RawMessage) (*types.GenesisState, error) {
+	var newState types.GenesisState
+	if err := cdc.UnmarshalJSON(oldState, &newState); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal v4 feemarket genesis state: %w", err)
+	}
+
+	newState.Params.MinGasPriceController = types.DefaultMinGasPriceController()
+	newState.Params.FeeSplit = types.DefaultFeeSplit()
+
+	if err := newState.Validate(); err != nil {
+		return nil, err
+	}
+
+	return &newState, nil
+}
@@ -0,0 +1,44 @@
+package v5
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+// MigrateStore migrates the x/feemarket module state from the consensus version 4 to
+// version 5. Specifically, it sets the default min gas price controller parameters,
+// which leave the controller disabled, and the default fee split, which leaves all
+// the collected fees to the validators, on the parameters stored in the module state.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	var (
+		store  = ctx.KVStore(storeKey)
+		params types.Params
+	)
+
+	bz := store.Get(types.ParamsKey)
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+
+	params.MinGasPriceController = types.DefaultMinGasPriceController()
+	params.FeeSplit = types.DefaultFeeSplit()
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package v5_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"github.com/tabilabs/tabi/app"
+	"github.com/tabilabs/tabi/encoding"
+	v5 "github.com/tabilabs/tabi/x/feemarket/migrations/v5"
+	"github.com/tabilabs/tabi/x/feemarket/types"
+)
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	// params stored at consensus version 4 have no min gas price controller nor fee split
+	v4Params := types.DefaultParams()
+	v4Params.MinGasPriceController = types.MinGasPriceController{}
+	v4Params.FeeSplit = types.FeeSplit{}
+	v4Params.MinGasPrice = sdk.NewDecWithPrec(25, 1)
+	kvStore.Set(types.ParamsKey, cdc.MustMarshal(&v4Params))
+
+	require.NoError(t, v5.MigrateStore(ctx, storeKey, cdc))
+
+	var params types.Params
+	cdc.MustUnmarshal(kvStore.Get(types.ParamsKey), &params)
+
+	expParams := v4Params
+	expParams.MinGasPriceController = types.DefaultMinGasPriceController()
+	expParams.FeeSplit = types.DefaultFeeSplit()
+	require.Equal(t, expParams, params)
+	require.False(t, params.MinGasPriceController.Enabled)
+	require.True(t, params.FeeSplit.BurnRatio.IsZero())
+}
+
+func TestMigrateJSON(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	// the genesis state exported at consensus version 4 has no min gas price controller nor fee split
+	v4State := `{
+		"params": {
+			"no_base_fee": false,
+			"base_fee_change_denominator": 8,
+			"elasticity_multiplier": 2,
+			"enable_height": "0",
+			"base_fee": "1000000000",
+			"min_gas_price": "2.500000000000000000",
+			"min_gas_multiplier": "0.500000000000000000"
+		},
+		"block_gas": "10"
+	}`
+
+	newState, err := v5.MigrateJSON(cdc, []byte(v4State))
+	require.NoError(t, err)
+
+	require.Equal(t, types.DefaultMinGasPriceController(), newState.Params.MinGasPriceController)
+	require.False(t, newState.Params.MinGasPriceController.Enabled)
+	require.Equal(t, types.DefaultFeeSplit(), newState.Params.FeeSplit)
+	require.True(t, newState.Params.FeeSplit.BurnRatio.IsZero())
+	require.Equal(t, sdk.NewDecWithPrec(25, 1), newState.Params.MinGasPrice)
+	require.Equal(t, uint64(10), newState.BlockGas)
+
+	_, err = v5.MigrateJSON(cdc, []byte(`{"params":`))
+	require.Error(t, err)
+}
@@ -2,7 +2,14 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket             = "fee_market"
+	EventTypeMinGasPriceController = "min_gas_price_controller"
+	EventTypeFeeSplit              = "fee_split"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee                  = "base_fee"
+	AttributeKeyUtilization              = "utilization"
+	AttributeKeyMinGasPrice              = "min_gas_price"
+	AttributeKeyBaseFeeChangeDenominator = "base_fee_change_denominator"
+	AttributeKeyBurned                   = "burned"
+	AttributeKeyCaptainsPool             = "captains_pool"
 )
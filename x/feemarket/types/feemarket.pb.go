@@ -43,6 +43,12 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// min_gas_price_controller defines the controller that adjusts the min gas price
+	// and the base fee change denominator from the recent block utilization.
+	MinGasPriceController MinGasPriceController `protobuf:"bytes,9,opt,name=min_gas_price_controller,json=minGasPriceController,proto3" json:"min_gas_price_controller"`
+	// fee_split defines how the fees collected on each block are split between
+	// burning, the validators and the captains reward pool.
+	FeeSplit FeeSplit `protobuf:"bytes,10,opt,name=fee_split,json=feeSplit,proto3" json:"fee_split"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,8 +112,282 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetMinGasPriceController() MinGasPriceController {
+	if m != nil {
+		return m.MinGasPriceController
+	}
+	return MinGasPriceController{}
+}
+
+func (m *Params) GetFeeSplit() FeeSplit {
+	if m != nil {
+		return m.FeeSplit
+	}
+	return FeeSplit{}
+}
+
+// FeeSplit defines the split of the fees collected on each block. The share
+// that is neither burned nor redirected to the captains reward pool is left to
+// the validators.
+type FeeSplit struct {
+	// burn_ratio is the share of the collected fees that is burned.
+	BurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=burn_ratio,json=burnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_ratio"`
+	// captains_pool_ratio is the share of the collected base denom fees that
+	// tops up the emission of the next captains epoch.
+	CaptainsPoolRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=captains_pool_ratio,json=captainsPoolRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"captains_pool_ratio"`
+}
+
+func (m *FeeSplit) Reset()         { *m = FeeSplit{} }
+func (m *FeeSplit) String() string { return proto.CompactTextString(m) }
+func (*FeeSplit) ProtoMessage()    {}
+func (*FeeSplit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{1}
+}
+func (m *FeeSplit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeSplit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeSplit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeSplit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeSplit.Merge(m, src)
+}
+func (m *FeeSplit) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeSplit) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeSplit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeSplit proto.InternalMessageInfo
+
+// MinGasPriceController defines the parameters of the controller that adjusts
+// the min_gas_price and the base_fee_change_denominator parameters from the
+// gas used over a moving window of recent blocks.
+type MinGasPriceController struct {
+	// enabled toggles the controller. When disabled, the min gas price and the
+	// base fee change denominator are only changed by governance.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// window_size is the number of recent blocks the utilization is averaged on.
+	WindowSize uint32 `protobuf:"varint,2,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	// min_gas_price_floor is the lowest min gas price the controller can set.
+	MinGasPriceFloor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_gas_price_floor,json=minGasPriceFloor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price_floor"`
+	// min_gas_price_ceiling is the highest min gas price the controller can set.
+	MinGasPriceCeiling github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=min_gas_price_ceiling,json=minGasPriceCeiling,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price_ceiling"`
+	// adjustment_rate is the maximum relative change of the min gas price per block.
+	AdjustmentRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=adjustment_rate,json=adjustmentRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"adjustment_rate"`
+	// min_base_fee_change_denominator is the base fee change denominator set when
+	// the window utilization is the furthest from the target, so that the base fee
+	// reacts faster to sustained load.
+	MinBaseFeeChangeDenominator uint32 `protobuf:"varint,6,opt,name=min_base_fee_change_denominator,json=minBaseFeeChangeDenominator,proto3" json:"min_base_fee_change_denominator,omitempty"`
+	// max_base_fee_change_denominator is the base fee change denominator set when
+	// the window utilization is on target, so that the base fee is damped against
+	// single block spikes.
+	MaxBaseFeeChangeDenominator uint32 `protobuf:"varint,7,opt,name=max_base_fee_change_denominator,json=maxBaseFeeChangeDenominator,proto3" json:"max_base_fee_change_denominator,omitempty"`
+}
+
+func (m *MinGasPriceController) Reset()         { *m = MinGasPriceController{} }
+func (m *MinGasPriceController) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceController) ProtoMessage()    {}
+func (*MinGasPriceController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{2}
+}
+func (m *MinGasPriceController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPriceController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceController.Merge(m, src)
+}
+func (m *MinGasPriceController) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceController) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinGasPriceController proto.InternalMessageInfo
+
+func (m *MinGasPriceController) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MinGasPriceController) GetWindowSize() uint32 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *MinGasPriceController) GetMinBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.MinBaseFeeChangeDenominator
+	}
+	return 0
+}
+
+func (m *MinGasPriceController) GetMaxBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.MaxBaseFeeChangeDenominator
+	}
+	return 0
+}
+
+// BlockGasUsage defines the gas usage of a block, as recorded in the
+// utilization window of the min gas price controller.
+type BlockGasUsage struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// gas_used is the block gas wanted, as used for the base fee calculation
+	GasUsed uint64 `protobuf:"varint,2,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// gas_target is the block gas target, i.e the block gas limit divided by the
+	// elasticity multiplier
+	GasTarget uint64 `protobuf:"varint,3,opt,name=gas_target,json=gasTarget,proto3" json:"gas_target,omitempty"`
+}
+
+func (m *BlockGasUsage) Reset()         { *m = BlockGasUsage{} }
+func (m *BlockGasUsage) String() string { return proto.CompactTextString(m) }
+func (*BlockGasUsage) ProtoMessage()    {}
+func (*BlockGasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{3}
+}
+func (m *BlockGasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockGasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockGasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockGasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockGasUsage.Merge(m, src)
+}
+func (m *BlockGasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockGasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockGasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockGasUsage proto.InternalMessageInfo
+
+func (m *BlockGasUsage) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockGasUsage) GetGasTarget() uint64 {
+	if m != nil {
+		return m.GasTarget
+	}
+	return 0
+}
+
+// MinGasPriceControllerOutput defines the values computed by the min gas price
+// controller on its last run.
+type MinGasPriceControllerOutput struct {
+	// height of the block the controller last ran on
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// utilization is the gas used over the gas target of the window
+	Utilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=utilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"utilization"`
+	// min_gas_price is the min gas price set by the controller
+	MinGasPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_price"`
+	// base_fee_change_denominator is the base fee change denominator set by the controller
+	BaseFeeChangeDenominator uint32 `protobuf:"varint,4,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3" json:"base_fee_change_denominator,omitempty"`
+}
+
+func (m *MinGasPriceControllerOutput) Reset()         { *m = MinGasPriceControllerOutput{} }
+func (m *MinGasPriceControllerOutput) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceControllerOutput) ProtoMessage()    {}
+func (*MinGasPriceControllerOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{4}
+}
+func (m *MinGasPriceControllerOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceControllerOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceControllerOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPriceControllerOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceControllerOutput.Merge(m, src)
+}
+func (m *MinGasPriceControllerOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceControllerOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceControllerOutput.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinGasPriceControllerOutput proto.InternalMessageInfo
+
+func (m *MinGasPriceControllerOutput) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MinGasPriceControllerOutput) GetBaseFeeChangeDenominator() uint32 {
+	if m != nil {
+		return m.BaseFeeChangeDenominator
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
+	proto.RegisterType((*FeeSplit)(nil), "ethermint.feemarket.v1.FeeSplit")
+	proto.RegisterType((*MinGasPriceController)(nil), "ethermint.feemarket.v1.MinGasPriceController")
+	proto.RegisterType((*BlockGasUsage)(nil), "ethermint.feemarket.v1.BlockGasUsage")
+	proto.RegisterType((*MinGasPriceControllerOutput)(nil), "ethermint.feemarket.v1.MinGasPriceControllerOutput")
 }
 
 func init() {
@@ -115,32 +395,54 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 391 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0x51, 0xab, 0xd3, 0x30,
-	0x14, 0xc7, 0x1b, 0x77, 0xef, 0x6e, 0x6f, 0xae, 0x83, 0x11, 0xae, 0x52, 0x14, 0x7a, 0x8b, 0xc2,
-	0xa5, 0x08, 0xb6, 0x8c, 0x3d, 0xfb, 0x32, 0x87, 0x3a, 0x41, 0x18, 0x7d, 0x14, 0x21, 0xa4, 0xdd,
-	0x59, 0x1b, 0xd6, 0x24, 0xa5, 0xc9, 0x86, 0xfb, 0x16, 0x7e, 0xac, 0x3d, 0xee, 0x49, 0xc4, 0x87,
-	0x21, 0xdb, 0x17, 0x91, 0xb5, 0x5b, 0xbb, 0x57, 0x7d, 0xca, 0xc9, 0xf9, 0xff, 0xf3, 0x3b, 0x27,
-	0xc9, 0xc1, 0x8f, 0x60, 0x32, 0x28, 0x05, 0x97, 0x26, 0x9c, 0x03, 0x08, 0x56, 0x2e, 0xc0, 0x84,
-	0xab, 0x41, 0xbb, 0x09, 0x8a, 0x52, 0x19, 0x45, 0x9e, 0x37, 0xbe, 0xa0, 0x95, 0x56, 0x83, 0x17,
-	0xf7, 0xa9, 0x4a, 0x55, 0x65, 0x09, 0x8f, 0x51, 0xed, 0x7e, 0xf5, 0xb3, 0x83, 0xbb, 0x53, 0x56,
-	0x32, 0xa1, 0x89, 0x8b, 0xef, 0xa4, 0xa2, 0x31, 0xd3, 0x40, 0xe7, 0x00, 0x0e, 0xf2, 0x90, 0x6f,
-	0x47, 0xb7, 0x52, 0x8d, 0x98, 0x86, 0x0f, 0x00, 0xe4, 0x1d, 0x7e, 0x79, 0x16, 0x69, 0x92, 0x31,
-	0x99, 0x02, 0x9d, 0x81, 0x54, 0x82, 0x4b, 0x66, 0x54, 0xe9, 0x3c, 0xf1, 0x90, 0xdf, 0x8b, 0x9c,
-	0xb8, 0x76, 0xbf, 0xaf, 0x0c, 0xe3, 0x56, 0x27, 0x43, 0xfc, 0x0c, 0x72, 0xa6, 0x0d, 0x4f, 0xb8,
-	0x59, 0x53, 0xb1, 0xcc, 0x0d, 0x2f, 0x72, 0x0e, 0xa5, 0xd3, 0xa9, 0x0e, 0xde, 0xb7, 0xe2, 0x97,
-	0x46, 0x23, 0xaf, 0x71, 0x0f, 0x24, 0x8b, 0x73, 0xa0, 0x19, 0xf0, 0x34, 0x33, 0xce, 0xb5, 0x87,
-	0xfc, 0x4e, 0xf4, 0xb4, 0x4e, 0x7e, 0xaa, 0x72, 0x64, 0x82, 0xed, 0xa6, 0xeb, 0xae, 0x87, 0xfc,
-	0xdb, 0x51, 0xb0, 0xd9, 0x3d, 0x58, 0xbf, 0x77, 0x0f, 0x8f, 0x29, 0x37, 0xd9, 0x32, 0x0e, 0x12,
-	0x25, 0xc2, 0x44, 0x69, 0xa1, 0xf4, 0x69, 0x79, 0xab, 0x67, 0x8b, 0xd0, 0xac, 0x0b, 0xd0, 0xc1,
-	0x44, 0x9a, 0xe8, 0xe6, 0xd4, 0x35, 0x89, 0x70, 0x4f, 0x70, 0x49, 0x53, 0xa6, 0x69, 0x51, 0xf2,
-	0x04, 0x9c, 0x9b, 0x7f, 0xe6, 0x8d, 0x21, 0x89, 0xee, 0x04, 0x97, 0x1f, 0x99, 0x9e, 0x1e, 0x11,
-	0xe4, 0x1b, 0x26, 0x67, 0xe6, 0xc5, 0xad, 0xed, 0xff, 0x02, 0xf7, 0x6b, 0x70, 0xfb, 0x42, 0x9f,
-	0xaf, 0xec, 0xab, 0xfe, 0x75, 0xd4, 0xe7, 0x92, 0x1b, 0xce, 0xf2, 0xe6, 0xfb, 0x46, 0xe3, 0xcd,
-	0xde, 0x45, 0xdb, 0xbd, 0x8b, 0xfe, 0xec, 0x5d, 0xf4, 0xe3, 0xe0, 0x5a, 0xdb, 0x83, 0x6b, 0xfd,
-	0x3a, 0xb8, 0xd6, 0xd7, 0x37, 0x17, 0xb5, 0x0c, 0x8b, 0x79, 0xce, 0x62, 0x5d, 0x05, 0xe1, 0xf7,
-	0x8b, 0xc9, 0xaa, 0x6a, 0xc6, 0xdd, 0x6a, 0x4a, 0x86, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x28,
-	0xfa, 0xd7, 0x07, 0x7d, 0x02, 0x00, 0x00,
+	// 743 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcb, 0x6e, 0x13, 0x3d,
+	0x14, 0xc7, 0x33, 0xcd, 0xdd, 0xf9, 0xf2, 0x7d, 0xfd, 0xdc, 0xa6, 0x1a, 0xa8, 0x48, 0xa2, 0x20,
+	0x55, 0x11, 0x52, 0x13, 0x95, 0xae, 0xd9, 0xa4, 0x51, 0x4b, 0x91, 0x2a, 0xa2, 0x29, 0x08, 0x09,
+	0x01, 0x23, 0xcf, 0xe4, 0x64, 0x62, 0xea, 0xb1, 0x47, 0x63, 0x4f, 0x6f, 0x6f, 0xc0, 0x8e, 0x35,
+	0x6f, 0xc2, 0x1b, 0x74, 0xd9, 0x25, 0x62, 0x51, 0xa1, 0xf6, 0x29, 0xd8, 0xa1, 0xb9, 0xe4, 0x52,
+	0x48, 0x8a, 0x08, 0xab, 0x8c, 0x7d, 0x8e, 0x7f, 0xfe, 0xe7, 0xf8, 0xef, 0x63, 0xb4, 0x01, 0x6a,
+	0x08, 0xbe, 0x4b, 0xb9, 0x6a, 0x0f, 0x00, 0x5c, 0xe2, 0x1f, 0x81, 0x6a, 0x1f, 0x6f, 0x4d, 0x06,
+	0x2d, 0xcf, 0x17, 0x4a, 0xe0, 0xb5, 0x71, 0x5e, 0x6b, 0x12, 0x3a, 0xde, 0xba, 0xbf, 0xea, 0x08,
+	0x47, 0x44, 0x29, 0xed, 0xf0, 0x2b, 0xce, 0x6e, 0x7c, 0xc8, 0xa2, 0x5c, 0x8f, 0xf8, 0xc4, 0x95,
+	0xb8, 0x8a, 0x4a, 0x5c, 0x98, 0x16, 0x91, 0x60, 0x0e, 0x00, 0x74, 0xad, 0xae, 0x35, 0x0b, 0x46,
+	0x91, 0x8b, 0x0e, 0x91, 0xb0, 0x0b, 0x80, 0x9f, 0xa0, 0xf5, 0x51, 0xd0, 0xb4, 0x87, 0x84, 0x3b,
+	0x60, 0xf6, 0x81, 0x0b, 0x97, 0x72, 0xa2, 0x84, 0xaf, 0x2f, 0xd5, 0xb5, 0x66, 0xd9, 0xd0, 0xad,
+	0x38, 0x7b, 0x27, 0x4a, 0xe8, 0x4e, 0xe2, 0x78, 0x1b, 0x55, 0x80, 0x11, 0xa9, 0xa8, 0x4d, 0xd5,
+	0x99, 0xe9, 0x06, 0x4c, 0x51, 0x8f, 0x51, 0xf0, 0xf5, 0x74, 0xb4, 0x70, 0x75, 0x12, 0x3c, 0x18,
+	0xc7, 0xf0, 0x43, 0x54, 0x06, 0x4e, 0x2c, 0x06, 0xe6, 0x10, 0xa8, 0x33, 0x54, 0x7a, 0xb6, 0xae,
+	0x35, 0xd3, 0xc6, 0x3f, 0xf1, 0xe4, 0xd3, 0x68, 0x0e, 0xef, 0xa3, 0xc2, 0x58, 0x75, 0xae, 0xae,
+	0x35, 0x8b, 0x9d, 0xd6, 0xc5, 0x55, 0x2d, 0xf5, 0xf5, 0xaa, 0xb6, 0xe1, 0x50, 0x35, 0x0c, 0xac,
+	0x96, 0x2d, 0xdc, 0xb6, 0x2d, 0xa4, 0x2b, 0x64, 0xf2, 0xb3, 0x29, 0xfb, 0x47, 0x6d, 0x75, 0xe6,
+	0x81, 0x6c, 0xed, 0x73, 0x65, 0xe4, 0x13, 0xd5, 0xd8, 0x40, 0x65, 0x97, 0x72, 0xd3, 0x21, 0xd2,
+	0xf4, 0x7c, 0x6a, 0x83, 0x9e, 0xff, 0x63, 0x5e, 0x17, 0x6c, 0xa3, 0xe4, 0x52, 0xbe, 0x47, 0x64,
+	0x2f, 0x44, 0xe0, 0x37, 0x08, 0x8f, 0x98, 0x53, 0xff, 0xba, 0xb0, 0x10, 0x78, 0x39, 0x06, 0x4f,
+	0x55, 0x88, 0x21, 0xfd, 0x96, 0x62, 0xd3, 0x16, 0x5c, 0xf9, 0x82, 0x31, 0xf0, 0xf5, 0x62, 0x5d,
+	0x6b, 0x96, 0x1e, 0x6f, 0xb6, 0x66, 0x3b, 0xa2, 0x75, 0x30, 0x11, 0xb9, 0x33, 0x5e, 0xd4, 0xc9,
+	0x84, 0x92, 0x8c, 0x8a, 0x3b, 0x2b, 0x88, 0x77, 0x50, 0x31, 0x3c, 0x7e, 0xe9, 0x31, 0xaa, 0x74,
+	0x14, 0xe1, 0xeb, 0xf3, 0xf0, 0xbb, 0x00, 0x87, 0x61, 0x5e, 0x42, 0x2c, 0x0c, 0x92, 0xf1, 0xb3,
+	0x4c, 0x21, 0xb3, 0x9c, 0x35, 0x96, 0x29, 0xa7, 0x8a, 0x12, 0x36, 0x76, 0x5c, 0xe3, 0xb3, 0x86,
+	0x0a, 0xa3, 0x45, 0xf8, 0x00, 0x21, 0x2b, 0xf0, 0xb9, 0xe9, 0x13, 0x45, 0x85, 0xae, 0x2d, 0x54,
+	0xad, 0x62, 0x48, 0x30, 0x42, 0x00, 0x7e, 0x87, 0x56, 0x6c, 0xe2, 0x29, 0x42, 0xb9, 0x34, 0x3d,
+	0x21, 0x58, 0xc2, 0x5d, 0x5a, 0x88, 0xfb, 0xff, 0x08, 0xd5, 0x13, 0x82, 0x45, 0xfc, 0xc6, 0xf7,
+	0x34, 0xaa, 0xcc, 0xac, 0x27, 0xd6, 0x51, 0x3e, 0x76, 0x6b, 0x3f, 0xb9, 0x52, 0xa3, 0x21, 0xae,
+	0xa1, 0xd2, 0x09, 0xe5, 0x7d, 0x71, 0x62, 0x4a, 0x7a, 0x0e, 0xc9, 0x05, 0x42, 0xf1, 0xd4, 0x21,
+	0x3d, 0x07, 0xfc, 0x16, 0xad, 0xdc, 0x3e, 0xdb, 0x01, 0x13, 0x22, 0xbe, 0x30, 0x0b, 0x5b, 0x27,
+	0x92, 0xb7, 0x1b, 0x72, 0x30, 0x41, 0x95, 0x9f, 0xac, 0x03, 0x94, 0x51, 0xee, 0xe8, 0x99, 0x85,
+	0x36, 0xc0, 0xd3, 0x96, 0x89, 0x49, 0xf8, 0x15, 0xfa, 0x8f, 0xf4, 0xdf, 0x07, 0x52, 0xb9, 0xc0,
+	0x55, 0x58, 0x73, 0xd0, 0xb3, 0x0b, 0xc1, 0xff, 0x9d, 0x60, 0x0c, 0xa2, 0x00, 0x77, 0x51, 0x2d,
+	0xd4, 0x7e, 0x57, 0x43, 0xca, 0x45, 0xf5, 0x5c, 0x77, 0x29, 0xef, 0xcc, 0xeb, 0x49, 0x21, 0x85,
+	0x9c, 0xde, 0x49, 0xc9, 0x27, 0x14, 0x72, 0x3a, 0x8f, 0xd2, 0x20, 0xa8, 0xdc, 0x61, 0xc2, 0x3e,
+	0xda, 0x23, 0xf2, 0xa5, 0x24, 0x0e, 0xe0, 0x35, 0x94, 0x4b, 0xda, 0x95, 0x16, 0xb5, 0xab, 0x64,
+	0x84, 0xef, 0xa1, 0x42, 0x58, 0xec, 0x40, 0x42, 0x3f, 0x3a, 0xed, 0x8c, 0x91, 0x77, 0xc2, 0x35,
+	0xd0, 0xc7, 0x0f, 0x10, 0x0a, 0x43, 0x8a, 0xf8, 0x0e, 0xa8, 0xe8, 0x84, 0x33, 0x46, 0xd1, 0x21,
+	0xf2, 0x45, 0x34, 0xd1, 0xf8, 0xb4, 0x84, 0xd6, 0x67, 0xda, 0xeb, 0x79, 0xa0, 0xbc, 0x40, 0xcd,
+	0xdd, 0xb1, 0x87, 0x4a, 0x81, 0xa2, 0x8c, 0x9e, 0x87, 0x26, 0xe5, 0x0b, 0xda, 0x7d, 0x1a, 0xf1,
+	0x6b, 0x87, 0x4c, 0xff, 0x7d, 0x87, 0xfc, 0xcd, 0xcb, 0x92, 0xb9, 0xfb, 0x65, 0xe9, 0x74, 0x2f,
+	0xae, 0xab, 0xda, 0xe5, 0x75, 0x55, 0xfb, 0x76, 0x5d, 0xd5, 0x3e, 0xde, 0x54, 0x53, 0x97, 0x37,
+	0xd5, 0xd4, 0x97, 0x9b, 0x6a, 0xea, 0xf5, 0xa3, 0x29, 0x35, 0x8a, 0x58, 0x94, 0x11, 0x4b, 0x46,
+	0x1f, 0xed, 0xd3, 0xa9, 0x47, 0x34, 0x52, 0x65, 0xe5, 0xa2, 0x07, 0x71, 0xfb, 0xc7, 0x00, 0x09,
+	0x28, 0x44, 0x06, 0x68, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +465,26 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.FeeSplit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size, err := m.MinGasPriceController.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -215,57 +537,992 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		} else {
 			dAtA[i] = 0
 		}
-		i--
-		dAtA[i] = 0x8
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeSplit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeSplit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeSplit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CaptainsPoolRatio.Size()
+		i -= size
+		if _, err := m.CaptainsPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MinGasPriceController) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPriceController) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPriceController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxBaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MaxBaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MinBaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.MinBaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.AdjustmentRate.Size()
+		i -= size
+		if _, err := m.AdjustmentRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.MinGasPriceCeiling.Size()
+		i -= size
+		if _, err := m.MinGasPriceCeiling.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MinGasPriceFloor.Size()
+		i -= size
+		if _, err := m.MinGasPriceFloor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.WindowSize != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockGasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockGasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockGasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasTarget != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasTarget))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinGasPriceControllerOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPriceControllerOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPriceControllerOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BaseFeeChangeDenominator != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeChangeDenominator))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeemarket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeemarket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoBaseFee {
+		n += 2
+	}
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	if m.ElasticityMultiplier != 0 {
+		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
+	}
+	if m.EnableHeight != 0 {
+		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasMultiplier.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPriceController.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.FeeSplit.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *FeeSplit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.CaptainsPoolRatio.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	return n
+}
+
+func (m *MinGasPriceController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovFeemarket(uint64(m.WindowSize))
+	}
+	l = m.MinGasPriceFloor.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPriceCeiling.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.AdjustmentRate.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.MinBaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.MinBaseFeeChangeDenominator))
+	}
+	if m.MaxBaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.MaxBaseFeeChangeDenominator))
+	}
+	return n
+}
+
+func (m *BlockGasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasUsed))
+	}
+	if m.GasTarget != 0 {
+		n += 1 + sovFeemarket(uint64(m.GasTarget))
+	}
+	return n
+}
+
+func (m *MinGasPriceControllerOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovFeemarket(uint64(m.Height))
+	}
+	l = m.Utilization.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeChangeDenominator != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
+	}
+	return n
+}
+
+func sovFeemarket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeemarket(x uint64) (n int) {
+	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NoBaseFee = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			m.BaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			m.ElasticityMultiplier = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+			}
+			m.EnableHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnableHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceController", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceController.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeSplit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeSplit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeSplit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeSplit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeSplit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaptainsPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CaptainsPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPriceController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
+			}
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceFloor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceFloor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPriceCeiling", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPriceCeiling.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdjustmentRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AdjustmentRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBaseFeeChangeDenominator", wireType)
+			}
+			m.MinBaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBaseFeeChangeDenominator", wireType)
+			}
+			m.MaxBaseFeeChangeDenominator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return len(dAtA) - i, nil
-}
 
-func encodeVarintFeemarket(dAtA []byte, offset int, v uint64) int {
-	offset -= sovFeemarket(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.NoBaseFee {
-		n += 2
-	}
-	if m.BaseFeeChangeDenominator != 0 {
-		n += 1 + sovFeemarket(uint64(m.BaseFeeChangeDenominator))
-	}
-	if m.ElasticityMultiplier != 0 {
-		n += 1 + sovFeemarket(uint64(m.ElasticityMultiplier))
-	}
-	if m.EnableHeight != 0 {
-		n += 1 + sovFeemarket(uint64(m.EnableHeight))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.BaseFee.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasPrice.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	l = m.MinGasMultiplier.Size()
-	n += 1 + l + sovFeemarket(uint64(l))
-	return n
-}
-
-func sovFeemarket(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozFeemarket(x uint64) (n int) {
-	return sovFeemarket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BlockGasUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -288,17 +1545,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockGasUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockGasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NoBaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var v int
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -308,17 +1565,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NoBaseFee = bool(v != 0)
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.BaseFeeChangeDenominator = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -328,16 +1584,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTarget", wireType)
 			}
-			m.ElasticityMultiplier = 0
+			m.GasTarget = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -347,16 +1603,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElasticityMultiplier |= uint32(b&0x7F) << shift
+				m.GasTarget |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeemarket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeemarket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPriceControllerOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeemarket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceControllerOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceControllerOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnableHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.EnableHeight = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -366,14 +1672,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EnableHeight |= int64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -401,11 +1707,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
 			}
@@ -439,11 +1745,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGasMultiplier", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
 			}
-			var stringLen uint64
+			m.BaseFeeChangeDenominator = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowFeemarket
@@ -453,26 +1759,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BaseFeeChangeDenominator |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthFeemarket
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthFeemarket
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinGasMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
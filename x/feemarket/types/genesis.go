@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -19,5 +21,37 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if len(gs.GasWindow) > int(gs.Params.MinGasPriceController.WindowSize) {
+		return fmt.Errorf(
+			"gas window length %d exceeds the controller window size %d",
+			len(gs.GasWindow), gs.Params.MinGasPriceController.WindowSize,
+		)
+	}
+
+	slots := make(map[int64]bool)
+	for _, usage := range gs.GasWindow {
+		if usage.Height <= 0 {
+			return fmt.Errorf("invalid gas window block height: %d", usage.Height)
+		}
+
+		slot := usage.Height % int64(gs.Params.MinGasPriceController.WindowSize)
+		if slots[slot] {
+			return fmt.Errorf("duplicate gas window slot for block height %d", usage.Height)
+		}
+		slots[slot] = true
+	}
+
+	if err := gs.CumulativeBurned.Validate(); err != nil {
+		return fmt.Errorf("invalid cumulative burned fees: %w", err)
+	}
+
+	if err := gs.CumulativeCaptainsPool.Validate(); err != nil {
+		return fmt.Errorf("invalid cumulative captains pool fees: %w", err)
+	}
+
+	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,6 +32,14 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// gas_window is the gas usage of the recent blocks recorded by the min gas
+	// price controller.
+	GasWindow []BlockGasUsage `protobuf:"bytes,4,rep,name=gas_window,json=gasWindow,proto3" json:"gas_window"`
+	// cumulative_burned is the total amount of fees burned by the fee split.
+	CumulativeBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=cumulative_burned,json=cumulativeBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cumulative_burned"`
+	// cumulative_captains_pool is the total amount of fees redirected to the
+	// captains reward pool by the fee split.
+	CumulativeCaptainsPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=cumulative_captains_pool,json=cumulativeCaptainsPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"cumulative_captains_pool"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +89,27 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetGasWindow() []BlockGasUsage {
+	if m != nil {
+		return m.GasWindow
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCumulativeBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CumulativeBurned
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCumulativeCaptainsPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CumulativeCaptainsPool
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
 }
@@ -88,23 +119,33 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 246 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc3, 0xa1, 0x1b, 0xa1,
-	0x08, 0xac, 0x5f, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a,
-	0x95, 0x5c, 0x3c, 0xee, 0x10, 0x6b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c, 0xb8, 0xd8, 0x0a,
-	0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0xb0, 0x5b,
-	0xab, 0x17, 0x00, 0x56, 0xe5, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x34,
-	0x17, 0x67, 0x52, 0x4e, 0x7e, 0x72, 0x76, 0x7c, 0x7a, 0x62, 0xb1, 0x04, 0xb3, 0x02, 0xa3, 0x06,
-	0x4b, 0x10, 0x07, 0x58, 0xc0, 0x3d, 0xb1, 0xd8, 0x8b, 0x85, 0x83, 0x49, 0x80, 0x39, 0x88, 0x23,
-	0x29, 0xb1, 0x38, 0x35, 0x3e, 0x2d, 0x35, 0xd5, 0xc9, 0xe5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x4b, 0x12, 0x93, 0x32, 0x73, 0x12, 0x93, 0x8a, 0xc1, 0x0c, 0xfd, 0x0a, 0x24, 0x3f, 0x96, 0x54,
-	0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xfd, 0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xbe, 0x09,
-	0x86, 0x7d, 0x45, 0x01, 0x00, 0x00,
+	// 405 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0x63, 0x12, 0xa2, 0x74, 0xcb, 0xa1, 0x58, 0xa8, 0x32, 0x45, 0xda, 0x46, 0x08, 0x50,
+	0x84, 0xc4, 0x2e, 0x29, 0x57, 0x4e, 0x2e, 0x52, 0xa5, 0x9e, 0xaa, 0x20, 0x84, 0xc4, 0xc5, 0x9a,
+	0x75, 0xa6, 0xdb, 0x55, 0x6c, 0xaf, 0xe5, 0xd9, 0xb8, 0xe5, 0x01, 0xb8, 0xf3, 0x1c, 0xbc, 0x08,
+	0x3d, 0xf6, 0xc8, 0x09, 0x50, 0xf2, 0x22, 0xc8, 0x6b, 0xab, 0xc9, 0x81, 0xde, 0x7a, 0xda, 0xd1,
+	0xec, 0xff, 0xcf, 0x37, 0xfa, 0x35, 0xec, 0x05, 0xba, 0x0b, 0xac, 0x72, 0x53, 0x38, 0x79, 0x8e,
+	0x98, 0x43, 0xb5, 0x40, 0x27, 0xeb, 0xa9, 0xd4, 0x58, 0x20, 0x19, 0x12, 0x65, 0x65, 0x9d, 0x0d,
+	0xf7, 0x6f, 0x55, 0xe2, 0x56, 0x25, 0xea, 0xe9, 0xc1, 0xab, 0x3b, 0xdc, 0x1b, 0x91, 0xf7, 0x1f,
+	0xf0, 0xd4, 0x52, 0x6e, 0x49, 0x2a, 0x20, 0x94, 0xf5, 0x54, 0xa1, 0x83, 0xa9, 0x4c, 0xad, 0x29,
+	0xba, 0xff, 0x27, 0xda, 0x6a, 0xeb, 0x4b, 0xd9, 0x54, 0x6d, 0xf7, 0xf9, 0xcf, 0x3e, 0x7b, 0x74,
+	0xd2, 0xee, 0xf1, 0xd1, 0x81, 0xc3, 0xf0, 0x3d, 0x1b, 0x96, 0x50, 0x41, 0x4e, 0x51, 0x30, 0x0e,
+	0x26, 0xbb, 0x47, 0x5c, 0xfc, 0x7f, 0x2f, 0x71, 0xe6, 0x55, 0xf1, 0xe0, 0xfa, 0xf7, 0x61, 0x6f,
+	0xd6, 0x79, 0xc2, 0x67, 0x6c, 0x47, 0x65, 0x36, 0x5d, 0x24, 0x1a, 0x28, 0xea, 0x8f, 0x83, 0xc9,
+	0x60, 0x36, 0xf2, 0x8d, 0x13, 0xa0, 0xf0, 0x94, 0x31, 0x0d, 0x94, 0x5c, 0x9a, 0x62, 0x6e, 0x2f,
+	0xa3, 0xc1, 0xb8, 0x3f, 0xd9, 0x3d, 0x7a, 0x79, 0xd7, 0xf8, 0xb8, 0x73, 0x7d, 0x22, 0xd0, 0xd8,
+	0x51, 0x76, 0x34, 0xd0, 0x67, 0xef, 0x0e, 0xaf, 0xd8, 0xe3, 0x74, 0x99, 0x2f, 0x33, 0x70, 0xa6,
+	0xc6, 0x44, 0x2d, 0xab, 0x02, 0xe7, 0xd1, 0x43, 0x3f, 0xf2, 0xa9, 0x68, 0x93, 0x10, 0x4d, 0x12,
+	0xa2, 0x4b, 0x42, 0x1c, 0x5b, 0x53, 0xc4, 0x6f, 0x9b, 0x31, 0x3f, 0xfe, 0x1c, 0x4e, 0xb4, 0x71,
+	0x17, 0x4b, 0x25, 0x52, 0x9b, 0xcb, 0x2e, 0xb6, 0xf6, 0x79, 0x43, 0xf3, 0x85, 0x74, 0x5f, 0x4b,
+	0x24, 0x6f, 0xa0, 0xd9, 0xde, 0x86, 0x12, 0x7b, 0x48, 0xf8, 0x2d, 0x60, 0xd1, 0x16, 0x3a, 0x85,
+	0xd2, 0x81, 0x29, 0x28, 0x29, 0xad, 0xcd, 0xa2, 0xe1, 0xfd, 0x6f, 0xb0, 0xbf, 0x81, 0x1d, 0x77,
+	0xac, 0x33, 0x6b, 0xb3, 0xd3, 0xc1, 0xe8, 0xc1, 0x5e, 0x7f, 0x36, 0x6a, 0x10, 0xc9, 0x39, 0x62,
+	0xfc, 0xe1, 0x7a, 0xc5, 0x83, 0x9b, 0x15, 0x0f, 0xfe, 0xae, 0x78, 0xf0, 0x7d, 0xcd, 0x7b, 0x37,
+	0x6b, 0xde, 0xfb, 0xb5, 0xe6, 0xbd, 0x2f, 0xaf, 0xb7, 0x58, 0x0e, 0x94, 0xc9, 0x40, 0x91, 0x2f,
+	0xe4, 0xd5, 0xd6, 0x49, 0x79, 0xa6, 0x1a, 0xfa, 0xb3, 0x78, 0xf7, 0x6f, 0x00, 0x99, 0xda, 0xec,
+	0x02, 0xb4, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +168,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CumulativeCaptainsPool) > 0 {
+		for iNdEx := len(m.CumulativeCaptainsPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeCaptainsPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CumulativeBurned) > 0 {
+		for iNdEx := len(m.CumulativeBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.GasWindow) > 0 {
+		for iNdEx := len(m.GasWindow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasWindow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -167,6 +250,24 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.GasWindow) > 0 {
+		for _, e := range m.GasWindow {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CumulativeBurned) > 0 {
+		for _, e := range m.CumulativeBurned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CumulativeCaptainsPool) > 0 {
+		for _, e := range m.CumulativeCaptainsPool {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -257,6 +358,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasWindow = append(m.GasWindow, BlockGasUsage{})
+			if err := m.GasWindow[len(m.GasWindow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeBurned = append(m.CumulativeBurned, types.Coin{})
+			if err := m.CumulativeBurned[len(m.CumulativeBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeCaptainsPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeCaptainsPool = append(m.CumulativeCaptainsPool, types.Coin{})
+			if err := m.CumulativeCaptainsPool[len(m.CumulativeCaptainsPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -30,6 +31,9 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				[]BlockGasUsage{{Height: 1, GasUsed: 10, GasTarget: 20}},
+				sdk.NewCoins(sdk.NewInt64Coin("atabi", 100)),
+				sdk.NewCoins(sdk.NewInt64Coin("atabi", 50)),
 			},
 			true,
 		},
@@ -41,6 +45,46 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			),
 			true,
 		},
+		{
+			"gas window longer than the window size",
+			&GenesisState{
+				Params:    DefaultParams(),
+				GasWindow: make([]BlockGasUsage, DefaultControllerWindowSize+1),
+			},
+			false,
+		},
+		{
+			"gas window with invalid height",
+			&GenesisState{
+				Params:    DefaultParams(),
+				GasWindow: []BlockGasUsage{{Height: 0}},
+			},
+			false,
+		},
+		{
+			"gas window with duplicate slot",
+			&GenesisState{
+				Params:    DefaultParams(),
+				GasWindow: []BlockGasUsage{{Height: 1}, {Height: 1 + int64(DefaultControllerWindowSize)}},
+			},
+			false,
+		},
+		{
+			"invalid cumulative burned",
+			&GenesisState{
+				Params:           DefaultParams(),
+				CumulativeBurned: sdk.Coins{{Denom: "atabi", Amount: sdk.NewInt(-1)}},
+			},
+			false,
+		},
+		{
+			"invalid cumulative captains pool",
+			&GenesisState{
+				Params:                 DefaultParams(),
+				CumulativeCaptainsPool: sdk.Coins{{Denom: "", Amount: sdk.NewInt(1)}},
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
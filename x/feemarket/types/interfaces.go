@@ -5,6 +5,19 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+// BankKeeper defines the expected bank keeper used to split the collected fees.
+type BankKeeper interface {
+	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// CaptainsKeeper defines the expected captains keeper the fees redirected to
+// the captains reward pool are credited to.
+type CaptainsKeeper interface {
+	IncrFeePoolEmission(ctx sdk.Context, amount sdk.Dec) sdk.Dec
+}
+
 type (
 	LegacyParams = paramtypes.ParamSet
 	// Subspace defines an interface that implements the legacy Cosmos SDK x/params Subspace type.
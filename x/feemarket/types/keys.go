@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName string name of module
 	ModuleName = "feemarket"
@@ -20,6 +24,10 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixGasWindow
+	prefixControllerOutput
+	prefixCumulativeBurned
+	prefixCumulativeCaptainsPool
 )
 
 const (
@@ -28,9 +36,20 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted   = []byte{prefixBlockGasWanted}
+	KeyPrefixGasWindow        = []byte{prefixGasWindow}
+	KeyPrefixControllerOutput = []byte{prefixControllerOutput}
+
+	KeyPrefixCumulativeBurned       = []byte{prefixCumulativeBurned}
+	KeyPrefixCumulativeCaptainsPool = []byte{prefixCumulativeCaptainsPool}
 )
 
+// GasWindowKey returns the key of a slot of the min gas price controller
+// utilization window.
+func GasWindowKey(slot uint64) []byte {
+	return append(KeyPrefixGasWindow, sdk.Uint64ToBigEndian(slot)...)
+}
+
 // Transient Store key prefixes
 var (
 	KeyPrefixTransientBlockGasWanted = []byte{prefixTransientBlockGasUsed}
@@ -18,8 +18,24 @@ var (
 	DefaultEnableHeight = int64(0)
 	// DefaultNoBaseFee is false
 	DefaultNoBaseFee = false
+	// DefaultControllerWindowSize is 100 blocks
+	DefaultControllerWindowSize = uint32(100)
+	// DefaultControllerAdjustmentRate is 0.125 or 12.5%, the EIP-1559 maximum base fee change
+	DefaultControllerAdjustmentRate = sdk.NewDecWithPrec(125, 3)
+	// DefaultControllerMinBaseFeeChangeDenominator is 4, doubling the EIP-1559 base fee change
+	DefaultControllerMinBaseFeeChangeDenominator = uint32(4)
+	// DefaultControllerMaxBaseFeeChangeDenominator is 16, halving the EIP-1559 base fee change
+	DefaultControllerMaxBaseFeeChangeDenominator = uint32(16)
+	// DefaultFeeBurnRatio is 0 (i.e all the fees go to the validators)
+	DefaultFeeBurnRatio = sdk.ZeroDec()
+	// DefaultFeeCaptainsPoolRatio is 0 (i.e all the fees go to the validators)
+	DefaultFeeCaptainsPoolRatio = sdk.ZeroDec()
 )
 
+// MaxControllerWindowSize bounds the number of blocks kept in the utilization
+// window of the min gas price controller.
+const MaxControllerWindowSize = 10_000
+
 // Parameter keys
 var (
 	ParamsKey                             = []byte("Params")
@@ -30,6 +46,8 @@ var (
 	ParamStoreKeyEnableHeight             = []byte("EnableHeight")
 	ParamStoreKeyMinGasPrice              = []byte("MinGasPrice")
 	ParamStoreKeyMinGasMultiplier         = []byte("MinGasMultiplier")
+	ParamStoreKeyMinGasPriceController    = []byte("MinGasPriceController")
+	ParamStoreKeyFeeSplit                 = []byte("FeeSplit")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -47,6 +65,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableHeight, &p.EnableHeight, validateEnableHeight),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPrice, &p.MinGasPrice, validateMinGasPrice),
 		paramtypes.NewParamSetPair(ParamStoreKeyMinGasMultiplier, &p.MinGasMultiplier, validateMinGasPrice),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinGasPriceController, &p.MinGasPriceController, validateMinGasPriceController),
+		paramtypes.NewParamSetPair(ParamStoreKeyFeeSplit, &p.FeeSplit, validateFeeSplit),
 	}
 }
 
@@ -68,6 +88,8 @@ func NewParams(
 		EnableHeight:             enableHeight,
 		MinGasPrice:              minGasPrice,
 		MinGasMultiplier:         minGasPriceMultiplier,
+		MinGasPriceController:    DefaultMinGasPriceController(),
+		FeeSplit:                 DefaultFeeSplit(),
 	}
 }
 
@@ -81,6 +103,31 @@ func DefaultParams() Params {
 		EnableHeight:             DefaultEnableHeight,
 		MinGasPrice:              DefaultMinGasPrice,
 		MinGasMultiplier:         DefaultMinGasMultiplier,
+		MinGasPriceController:    DefaultMinGasPriceController(),
+		FeeSplit:                 DefaultFeeSplit(),
+	}
+}
+
+// DefaultMinGasPriceController returns the default min gas price controller
+// parameters. The controller is disabled by default.
+func DefaultMinGasPriceController() MinGasPriceController {
+	return MinGasPriceController{
+		Enabled:                     false,
+		WindowSize:                  DefaultControllerWindowSize,
+		MinGasPriceFloor:            DefaultMinGasPrice,
+		MinGasPriceCeiling:          DefaultMinGasPrice,
+		AdjustmentRate:              DefaultControllerAdjustmentRate,
+		MinBaseFeeChangeDenominator: DefaultControllerMinBaseFeeChangeDenominator,
+		MaxBaseFeeChangeDenominator: DefaultControllerMaxBaseFeeChangeDenominator,
+	}
+}
+
+// DefaultFeeSplit returns the default fee split, which leaves all the
+// collected fees to the validators.
+func DefaultFeeSplit() FeeSplit {
+	return FeeSplit{
+		BurnRatio:         DefaultFeeBurnRatio,
+		CaptainsPoolRatio: DefaultFeeCaptainsPoolRatio,
 	}
 }
 
@@ -102,6 +149,14 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateMinGasPriceController(p.MinGasPriceController); err != nil {
+		return err
+	}
+
+	if err := validateFeeSplit(p.FeeSplit); err != nil {
+		return err
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -202,3 +257,87 @@ func validateMinGasMultiplier(i interface{}) error {
 	}
 	return nil
 }
+
+func validateMinGasPriceController(i interface{}) error {
+	c, ok := i.(MinGasPriceController)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return c.Validate()
+}
+
+// Validate performs basic validation on the min gas price controller parameters.
+func (c MinGasPriceController) Validate() error {
+	if c.WindowSize == 0 || c.WindowSize > MaxControllerWindowSize {
+		return fmt.Errorf("controller window size must be between 1 and %d: %d", MaxControllerWindowSize, c.WindowSize)
+	}
+
+	if err := validateMinGasPrice(c.MinGasPriceFloor); err != nil {
+		return fmt.Errorf("invalid controller min gas price floor: %w", err)
+	}
+
+	if err := validateMinGasPrice(c.MinGasPriceCeiling); err != nil {
+		return fmt.Errorf("invalid controller min gas price ceiling: %w", err)
+	}
+
+	if c.MinGasPriceCeiling.LT(c.MinGasPriceFloor) {
+		return fmt.Errorf("controller min gas price ceiling %s cannot be lower than the floor %s", c.MinGasPriceCeiling, c.MinGasPriceFloor)
+	}
+
+	if err := validateMinGasMultiplier(c.AdjustmentRate); err != nil {
+		return fmt.Errorf("invalid controller adjustment rate: %w", err)
+	}
+
+	if c.MinBaseFeeChangeDenominator == 0 {
+		return fmt.Errorf("controller min base fee change denominator cannot be 0")
+	}
+
+	if c.MaxBaseFeeChangeDenominator < c.MinBaseFeeChangeDenominator {
+		return fmt.Errorf(
+			"controller max base fee change denominator %d cannot be lower than the min %d",
+			c.MaxBaseFeeChangeDenominator, c.MinBaseFeeChangeDenominator,
+		)
+	}
+
+	if !c.Enabled {
+		return nil
+	}
+
+	// the min gas price is adjusted multiplicatively, so it can't start from zero
+	if !c.MinGasPriceFloor.IsPositive() {
+		return fmt.Errorf("controller min gas price floor must be positive when enabled: %s", c.MinGasPriceFloor)
+	}
+
+	if !c.AdjustmentRate.IsPositive() {
+		return fmt.Errorf("controller adjustment rate must be positive when enabled: %s", c.AdjustmentRate)
+	}
+
+	return nil
+}
+
+func validateFeeSplit(i interface{}) error {
+	fs, ok := i.(FeeSplit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return fs.Validate()
+}
+
+// Validate performs basic validation on the fee split parameters.
+func (fs FeeSplit) Validate() error {
+	if err := validateMinGasMultiplier(fs.BurnRatio); err != nil {
+		return fmt.Errorf("invalid fee burn ratio: %w", err)
+	}
+
+	if err := validateMinGasMultiplier(fs.CaptainsPoolRatio); err != nil {
+		return fmt.Errorf("invalid fee captains pool ratio: %w", err)
+	}
+
+	if sum := fs.BurnRatio.Add(fs.CaptainsPoolRatio); sum.GT(sdk.OneDec()) {
+		return fmt.Errorf("fee burn and captains pool ratios cannot sum to more than 1: %s", sum)
+	}
+
+	return nil
+}
@@ -119,3 +119,80 @@ func (suite *ParamsTestSuite) TestParamsValidateMinGasPrice() {
 		}
 	}
 }
+
+func (suite *ParamsTestSuite) TestParamsValidateMinGasPriceController() {
+	enabled := func(malleate func(c *MinGasPriceController)) MinGasPriceController {
+		c := DefaultMinGasPriceController()
+		c.Enabled = true
+		c.MinGasPriceFloor = sdk.NewDec(10)
+		c.MinGasPriceCeiling = sdk.NewDec(1000)
+		if malleate != nil {
+			malleate(&c)
+		}
+		return c
+	}
+
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expError bool
+	}{
+		{"default", DefaultMinGasPriceController(), false},
+		{"valid - enabled", enabled(nil), false},
+		{"invalid - wrong type", DefaultParams(), true},
+		{"invalid - empty", MinGasPriceController{}, true},
+		{"invalid - window size is 0", enabled(func(c *MinGasPriceController) { c.WindowSize = 0 }), true},
+		{"invalid - window size too big", enabled(func(c *MinGasPriceController) { c.WindowSize = MaxControllerWindowSize + 1 }), true},
+		{"invalid - ceiling lower than floor", enabled(func(c *MinGasPriceController) { c.MinGasPriceCeiling = sdk.NewDec(5) }), true},
+		{"invalid - negative floor", enabled(func(c *MinGasPriceController) { c.MinGasPriceFloor = sdk.NewDec(-1) }), true},
+		{"invalid - zero floor when enabled", enabled(func(c *MinGasPriceController) { c.MinGasPriceFloor = sdk.ZeroDec() }), true},
+		{"invalid - adjustment rate greater than 1", enabled(func(c *MinGasPriceController) { c.AdjustmentRate = sdk.NewDec(2) }), true},
+		{"invalid - zero adjustment rate when enabled", enabled(func(c *MinGasPriceController) { c.AdjustmentRate = sdk.ZeroDec() }), true},
+		{"invalid - min denominator is 0", enabled(func(c *MinGasPriceController) { c.MinBaseFeeChangeDenominator = 0 }), true},
+		{
+			"invalid - max denominator lower than min",
+			enabled(func(c *MinGasPriceController) {
+				c.MinBaseFeeChangeDenominator = 8
+				c.MaxBaseFeeChangeDenominator = 4
+			}),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := validateMinGasPriceController(tc.value)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
+
+func (suite *ParamsTestSuite) TestParamsValidateFeeSplit() {
+	testCases := []struct {
+		name     string
+		value    interface{}
+		expError bool
+	}{
+		{"default", DefaultFeeSplit(), false},
+		{"valid", FeeSplit{BurnRatio: sdk.NewDecWithPrec(5, 1), CaptainsPoolRatio: sdk.NewDecWithPrec(3, 1)}, false},
+		{"valid - all burned", FeeSplit{BurnRatio: sdk.OneDec(), CaptainsPoolRatio: sdk.ZeroDec()}, false},
+		{"invalid - wrong type", DefaultParams(), true},
+		{"invalid - empty", FeeSplit{}, true},
+		{"invalid - negative burn ratio", FeeSplit{BurnRatio: sdk.NewDec(-1), CaptainsPoolRatio: sdk.ZeroDec()}, true},
+		{"invalid - captains pool ratio greater than 1", FeeSplit{BurnRatio: sdk.ZeroDec(), CaptainsPoolRatio: sdk.NewDec(2)}, true},
+		{"invalid - ratios sum greater than 1", FeeSplit{BurnRatio: sdk.NewDecWithPrec(6, 1), CaptainsPoolRatio: sdk.NewDecWithPrec(5, 1)}, true},
+	}
+
+	for _, tc := range testCases {
+		err := validateFeeSplit(tc.value)
+
+		if tc.expError {
+			suite.Require().Error(err, tc.name)
+		} else {
+			suite.Require().NoError(err, tc.name)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -274,6 +275,194 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryMinGasPriceControllerRequest defines the request type for querying the
+// min gas price controller.
+type QueryMinGasPriceControllerRequest struct {
+}
+
+func (m *QueryMinGasPriceControllerRequest) Reset()         { *m = QueryMinGasPriceControllerRequest{} }
+func (m *QueryMinGasPriceControllerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPriceControllerRequest) ProtoMessage()    {}
+func (*QueryMinGasPriceControllerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryMinGasPriceControllerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinGasPriceControllerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPriceControllerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPriceControllerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPriceControllerRequest.Merge(m, src)
+}
+func (m *QueryMinGasPriceControllerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPriceControllerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPriceControllerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinGasPriceControllerRequest proto.InternalMessageInfo
+
+// QueryMinGasPriceControllerResponse returns the utilization window and the
+// last output of the min gas price controller.
+type QueryMinGasPriceControllerResponse struct {
+	// window is the gas usage of the recent blocks, ordered by height
+	Window []BlockGasUsage `protobuf:"bytes,1,rep,name=window,proto3" json:"window"`
+	// output is the last output of the controller
+	Output MinGasPriceControllerOutput `protobuf:"bytes,2,opt,name=output,proto3" json:"output"`
+}
+
+func (m *QueryMinGasPriceControllerResponse) Reset()         { *m = QueryMinGasPriceControllerResponse{} }
+func (m *QueryMinGasPriceControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinGasPriceControllerResponse) ProtoMessage()    {}
+func (*QueryMinGasPriceControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryMinGasPriceControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinGasPriceControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinGasPriceControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinGasPriceControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinGasPriceControllerResponse.Merge(m, src)
+}
+func (m *QueryMinGasPriceControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinGasPriceControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinGasPriceControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinGasPriceControllerResponse proto.InternalMessageInfo
+
+func (m *QueryMinGasPriceControllerResponse) GetWindow() []BlockGasUsage {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+func (m *QueryMinGasPriceControllerResponse) GetOutput() MinGasPriceControllerOutput {
+	if m != nil {
+		return m.Output
+	}
+	return MinGasPriceControllerOutput{}
+}
+
+// QueryFeeDistributionRequest defines the request type for querying the
+// cumulative fee distribution.
+type QueryFeeDistributionRequest struct {
+}
+
+func (m *QueryFeeDistributionRequest) Reset()         { *m = QueryFeeDistributionRequest{} }
+func (m *QueryFeeDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDistributionRequest) ProtoMessage()    {}
+func (*QueryFeeDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{8}
+}
+func (m *QueryFeeDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDistributionRequest.Merge(m, src)
+}
+func (m *QueryFeeDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDistributionRequest proto.InternalMessageInfo
+
+// QueryFeeDistributionResponse returns the cumulative amounts of fees burned
+// and redirected to the captains reward pool.
+type QueryFeeDistributionResponse struct {
+	// burned is the total amount of fees burned
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// captains_pool is the total amount of fees redirected to the captains reward pool
+	CaptainsPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=captains_pool,json=captainsPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"captains_pool"`
+}
+
+func (m *QueryFeeDistributionResponse) Reset()         { *m = QueryFeeDistributionResponse{} }
+func (m *QueryFeeDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDistributionResponse) ProtoMessage()    {}
+func (*QueryFeeDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{9}
+}
+func (m *QueryFeeDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDistributionResponse.Merge(m, src)
+}
+func (m *QueryFeeDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryFeeDistributionResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *QueryFeeDistributionResponse) GetCaptainsPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CaptainsPool
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +470,10 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryMinGasPriceControllerRequest)(nil), "ethermint.feemarket.v1.QueryMinGasPriceControllerRequest")
+	proto.RegisterType((*QueryMinGasPriceControllerResponse)(nil), "ethermint.feemarket.v1.QueryMinGasPriceControllerResponse")
+	proto.RegisterType((*QueryFeeDistributionRequest)(nil), "ethermint.feemarket.v1.QueryFeeDistributionRequest")
+	proto.RegisterType((*QueryFeeDistributionResponse)(nil), "ethermint.feemarket.v1.QueryFeeDistributionResponse")
 }
 
 func init() {
@@ -288,35 +481,52 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xf5, 0x12, 0x48, 0xcb, 0x72, 0x41, 0x4b, 0x5a, 0x81, 0x5b, 0xb6, 0x60, 0x50, 0x05, 0x81,
-	0x7a, 0xd5, 0x72, 0xe5, 0x64, 0xf1, 0x21, 0x6e, 0x60, 0x6e, 0x48, 0xa8, 0x5a, 0x87, 0xa9, 0x6b,
-	0x25, 0xf6, 0xba, 0xde, 0x4d, 0x44, 0xae, 0x88, 0x0b, 0x07, 0x24, 0x24, 0xfe, 0x05, 0xbf, 0x24,
-	0xc7, 0x48, 0x5c, 0x10, 0x87, 0x08, 0x25, 0xfc, 0x10, 0xe4, 0xdd, 0x75, 0x88, 0x21, 0x81, 0x9c,
-	0x32, 0x9a, 0xbc, 0x79, 0xef, 0xcd, 0x9b, 0x35, 0xf6, 0x40, 0x9d, 0x42, 0x91, 0x26, 0x99, 0x62,
-	0x27, 0x00, 0x29, 0x2f, 0xba, 0xa0, 0xd8, 0xe0, 0x90, 0x9d, 0xf5, 0xa1, 0x18, 0xfa, 0x79, 0x21,
-	0x94, 0x20, 0xdb, 0x73, 0x8c, 0x3f, 0xc7, 0xf8, 0x83, 0x43, 0x77, 0x7f, 0xc5, 0xec, 0x6f, 0x90,
-	0x9e, 0x77, 0x5b, 0xb1, 0x88, 0x85, 0x2e, 0x59, 0x59, 0xd9, 0xee, 0x6e, 0x2c, 0x44, 0xdc, 0x03,
-	0xc6, 0xf3, 0x84, 0xf1, 0x2c, 0x13, 0x8a, 0xab, 0x44, 0x64, 0xd2, 0xfc, 0xeb, 0xb5, 0x30, 0x79,
-	0x51, 0x5a, 0x78, 0xce, 0x0b, 0x9e, 0xca, 0x10, 0xce, 0xfa, 0x20, 0x95, 0xf7, 0x12, 0x5f, 0xa9,
-	0x75, 0x65, 0x2e, 0x32, 0x09, 0xe4, 0x21, 0x6e, 0xe6, 0xba, 0x73, 0x15, 0xdd, 0x40, 0x77, 0x2e,
-	0x1d, 0x51, 0x7f, 0xb9, 0x63, 0xdf, 0xcc, 0x05, 0xe7, 0x47, 0x93, 0x3d, 0x27, 0xb4, 0x33, 0xde,
-	0x96, 0x25, 0x0d, 0xb8, 0x84, 0x27, 0x00, 0x95, 0xd6, 0x6b, 0xdc, 0xaa, 0xb7, 0xad, 0xd8, 0x63,
-	0xbc, 0x19, 0x71, 0x09, 0xc7, 0x27, 0x00, 0x5a, 0xee, 0x62, 0xd0, 0xfe, 0x3e, 0xd9, 0xdb, 0x8f,
-	0x13, 0x75, 0xda, 0x8f, 0xfc, 0x8e, 0x48, 0x59, 0x47, 0xc8, 0x54, 0x48, 0xfb, 0x73, 0x20, 0xdf,
-	0x74, 0x99, 0x1a, 0xe6, 0x20, 0xfd, 0x67, 0x99, 0x0a, 0x37, 0x22, 0x43, 0xe7, 0x6d, 0x57, 0xf4,
-	0x3d, 0xd1, 0xe9, 0x3e, 0xe5, 0xf3, 0x15, 0xef, 0xe2, 0xad, 0x3f, 0xfa, 0x56, 0xf7, 0x32, 0x6e,
-	0xc4, 0xdc, 0x6c, 0xd8, 0x08, 0xcb, 0xf2, 0xe8, 0x4b, 0x03, 0x5f, 0xd0, 0x58, 0xf2, 0x1e, 0xe1,
-	0xa6, 0xd9, 0x8d, 0xb4, 0x57, 0xed, 0xfe, 0x77, 0x9c, 0xee, 0xbd, 0xb5, 0xb0, 0x46, 0xdf, 0xbb,
-	0xf9, 0xee, 0xeb, 0xcf, 0xcf, 0xe7, 0x76, 0xc8, 0x35, 0xa6, 0x78, 0x94, 0xd4, 0x2f, 0x6e, 0x92,
-	0x24, 0x1f, 0x10, 0xde, 0xb0, 0x71, 0x91, 0x7f, 0x73, 0xd7, 0xb3, 0x76, 0xef, 0xaf, 0x07, 0xb6,
-	0x4e, 0x6e, 0x69, 0x27, 0xd7, 0xc9, 0xce, 0x12, 0x27, 0xd5, 0x69, 0xc8, 0x47, 0x84, 0x37, 0xab,
-	0x0c, 0xc9, 0x7f, 0xf8, 0xeb, 0x27, 0x70, 0x0f, 0xd6, 0x44, 0x5b, 0x3b, 0xb7, 0xb5, 0x1d, 0x4a,
-	0x76, 0x97, 0xd9, 0x29, 0xc1, 0xc7, 0x31, 0x97, 0xc1, 0xa3, 0xd1, 0x94, 0xa2, 0xf1, 0x94, 0xa2,
-	0x1f, 0x53, 0x8a, 0x3e, 0xcd, 0xa8, 0x33, 0x9e, 0x51, 0xe7, 0xdb, 0x8c, 0x3a, 0xaf, 0xda, 0x0b,
-	0x4f, 0xa7, 0x64, 0xe8, 0xf1, 0x48, 0x1a, 0xaa, 0xb7, 0x0b, 0x64, 0xfa, 0x09, 0x45, 0x4d, 0xfd,
-	0x75, 0x3c, 0xf8, 0x15, 0x00, 0x00, 0xff, 0xff, 0xda, 0xb9, 0x82, 0x11, 0xb7, 0x03, 0x00, 0x00,
+	// 717 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0x41, 0x4f, 0x14, 0x49,
+	0x14, 0xc7, 0xa7, 0x61, 0x77, 0x60, 0x8b, 0xdd, 0xac, 0x29, 0x81, 0x0c, 0x0d, 0x34, 0xd0, 0x08,
+	0x41, 0x90, 0x6e, 0x87, 0xf1, 0xa2, 0xf1, 0x34, 0x20, 0xc4, 0x83, 0x11, 0xc6, 0x78, 0x31, 0x31,
+	0x93, 0xea, 0x9e, 0xa2, 0xa9, 0xd0, 0x53, 0xd5, 0x74, 0x55, 0x83, 0x5c, 0x8d, 0x17, 0x0f, 0x26,
+	0x26, 0x5e, 0xfd, 0x02, 0xfa, 0x19, 0xbc, 0x79, 0xe1, 0x48, 0xe2, 0xc5, 0x78, 0x40, 0x03, 0x7e,
+	0x05, 0xef, 0xa6, 0xab, 0xaa, 0x81, 0xc1, 0x9e, 0x71, 0x34, 0x9e, 0xa6, 0x52, 0xfd, 0xde, 0xff,
+	0xfd, 0xde, 0xab, 0xf7, 0xcf, 0x00, 0x1b, 0x8b, 0x2d, 0x1c, 0x37, 0x09, 0x15, 0xee, 0x26, 0xc6,
+	0x4d, 0x14, 0x6f, 0x63, 0xe1, 0xee, 0x96, 0xdd, 0x9d, 0x04, 0xc7, 0xfb, 0x4e, 0x14, 0x33, 0xc1,
+	0xe0, 0xf0, 0x69, 0x8c, 0x73, 0x1a, 0xe3, 0xec, 0x96, 0x4d, 0xcb, 0x67, 0xbc, 0xc9, 0xb8, 0xeb,
+	0x21, 0x8e, 0xdd, 0xdd, 0xb2, 0x87, 0x05, 0x2a, 0xbb, 0x3e, 0x23, 0x54, 0xe5, 0x99, 0xb3, 0x6d,
+	0xb4, 0xcf, 0x44, 0x54, 0xdc, 0x60, 0xc0, 0x02, 0x26, 0x8f, 0x6e, 0x7a, 0xd2, 0xb7, 0x63, 0x01,
+	0x63, 0x41, 0x88, 0x5d, 0x14, 0x11, 0x17, 0x51, 0xca, 0x04, 0x12, 0x84, 0x51, 0xae, 0xbe, 0xda,
+	0x83, 0x00, 0x6e, 0xa4, 0x88, 0xeb, 0x28, 0x46, 0x4d, 0x5e, 0xc3, 0x3b, 0x09, 0xe6, 0xc2, 0x7e,
+	0x00, 0x2e, 0xb7, 0xdc, 0xf2, 0x88, 0x51, 0x8e, 0xe1, 0x6d, 0x50, 0x8c, 0xe4, 0x4d, 0xc9, 0x98,
+	0x34, 0xe6, 0x06, 0x96, 0x2c, 0x27, 0xbf, 0x23, 0x47, 0xe5, 0x55, 0xff, 0x3a, 0x38, 0x9a, 0x28,
+	0xd4, 0x74, 0x8e, 0x3d, 0xa4, 0x45, 0xab, 0x88, 0xe3, 0x55, 0x8c, 0xb3, 0x5a, 0x8f, 0xc1, 0x60,
+	0xeb, 0xb5, 0x2e, 0x76, 0x07, 0xf4, 0xa7, 0x03, 0xa9, 0x6f, 0x62, 0x2c, 0xcb, 0xfd, 0x53, 0x9d,
+	0xff, 0x74, 0x34, 0x31, 0x1b, 0x10, 0xb1, 0x95, 0x78, 0x8e, 0xcf, 0x9a, 0xae, 0x1e, 0x9b, 0xfa,
+	0x59, 0xe4, 0x8d, 0x6d, 0x57, 0xec, 0x47, 0x98, 0x3b, 0x77, 0xa9, 0xa8, 0xf5, 0x79, 0x4a, 0xce,
+	0x1e, 0xce, 0xe4, 0x43, 0xe6, 0x6f, 0xaf, 0xa1, 0xd3, 0x16, 0xaf, 0x82, 0xa1, 0x0b, 0xf7, 0xba,
+	0xee, 0x25, 0xd0, 0x1b, 0x20, 0xd5, 0x61, 0x6f, 0x2d, 0x3d, 0xda, 0xd3, 0x60, 0x4a, 0x86, 0xde,
+	0x23, 0x74, 0x0d, 0xf1, 0xf5, 0x98, 0xf8, 0x78, 0x99, 0x51, 0x11, 0xb3, 0x30, 0xc4, 0x71, 0xa6,
+	0xf7, 0xce, 0x00, 0x76, 0xa7, 0x28, 0xad, 0xbe, 0x0c, 0x8a, 0x7b, 0x84, 0x36, 0xd8, 0x5e, 0xc9,
+	0x98, 0xec, 0x9d, 0x1b, 0x58, 0x9a, 0x69, 0x37, 0xc2, 0x8c, 0xeb, 0x21, 0x47, 0x01, 0xce, 0x26,
+	0xa9, 0x52, 0xe1, 0x06, 0x28, 0xb2, 0x44, 0x44, 0x89, 0x28, 0xf5, 0xc8, 0x77, 0xa8, 0xb4, 0x13,
+	0xc9, 0x65, 0xb9, 0x2f, 0x53, 0x33, 0x49, 0x25, 0x64, 0x8f, 0x83, 0x51, 0x49, 0xbf, 0x8a, 0xf1,
+	0x0a, 0xe1, 0x22, 0x26, 0x5e, 0x92, 0xae, 0x49, 0xd6, 0xdd, 0x37, 0x03, 0x8c, 0xe5, 0x7f, 0xd7,
+	0x7d, 0xf9, 0xa0, 0xe8, 0x25, 0x31, 0xc5, 0x0d, 0xdd, 0xd7, 0x88, 0xa3, 0x9e, 0xc5, 0x49, 0xdf,
+	0xc1, 0xd1, 0x4b, 0xed, 0x2c, 0x33, 0x42, 0xab, 0xd7, 0xd3, 0xc2, 0x6f, 0x3f, 0x4f, 0xcc, 0x75,
+	0xf1, 0x94, 0x69, 0x02, 0xaf, 0x69, 0x69, 0x18, 0x81, 0xff, 0x7c, 0x14, 0x09, 0x44, 0x28, 0xaf,
+	0x47, 0x8c, 0x85, 0xa5, 0x9e, 0x3f, 0x5f, 0xeb, 0xdf, 0xac, 0xc2, 0x3a, 0x63, 0xe1, 0xd2, 0xeb,
+	0x22, 0xf8, 0x5b, 0xf6, 0x0d, 0x9f, 0x19, 0xa0, 0xa8, 0xd6, 0x1a, 0xce, 0xb7, 0x1b, 0xf7, 0x8f,
+	0x4e, 0x32, 0x17, 0xba, 0x8a, 0x55, 0x43, 0xb4, 0xa7, 0x9e, 0x7e, 0xf8, 0xfa, 0xaa, 0x67, 0x14,
+	0x8e, 0xb8, 0x02, 0x79, 0xa4, 0xd5, 0xec, 0xca, 0x44, 0xf0, 0xb9, 0x01, 0xfa, 0xb4, 0x53, 0x60,
+	0x67, 0xed, 0x56, 0x9b, 0x99, 0xd7, 0xba, 0x0b, 0xd6, 0x24, 0xd3, 0x92, 0x64, 0x1c, 0x8e, 0xe6,
+	0x90, 0x64, 0xae, 0x84, 0x2f, 0x0c, 0xd0, 0x9f, 0xad, 0x29, 0xfc, 0x89, 0x7e, 0xab, 0xfb, 0xcc,
+	0xc5, 0x2e, 0xa3, 0x35, 0xce, 0x15, 0x89, 0x63, 0xc1, 0xb1, 0x3c, 0x9c, 0x34, 0xb8, 0x1e, 0x20,
+	0x0e, 0xdf, 0x1b, 0x60, 0x28, 0x77, 0xe3, 0xe1, 0xcd, 0x8e, 0xe5, 0x3a, 0xf9, 0xda, 0xbc, 0xf5,
+	0x3b, 0xa9, 0x1a, 0xbb, 0x22, 0xb1, 0x17, 0xe1, 0x42, 0x0e, 0x76, 0x93, 0xd0, 0x14, 0xba, 0x1e,
+	0xa5, 0xb9, 0x75, 0xff, 0x8c, 0xf5, 0x8d, 0x01, 0xfe, 0xbf, 0xe0, 0x32, 0x58, 0xe9, 0x08, 0x91,
+	0xef, 0x59, 0xf3, 0xc6, 0xaf, 0x25, 0x69, 0xe6, 0x05, 0xc9, 0x3c, 0x03, 0xa7, 0x73, 0x98, 0x37,
+	0x31, 0xae, 0x37, 0xce, 0x25, 0x55, 0x57, 0x0e, 0x8e, 0x2d, 0xe3, 0xf0, 0xd8, 0x32, 0xbe, 0x1c,
+	0x5b, 0xc6, 0xcb, 0x13, 0xab, 0x70, 0x78, 0x62, 0x15, 0x3e, 0x9e, 0x58, 0x85, 0x47, 0xf3, 0xe7,
+	0x0c, 0x97, 0x0a, 0x85, 0xc8, 0xe3, 0x4a, 0xf1, 0xc9, 0x39, 0x4d, 0x69, 0x3c, 0xaf, 0x28, 0xff,
+	0x8a, 0x2a, 0xdf, 0x07, 0x00, 0x25, 0xa6, 0x73, 0x0b, 0x44, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +547,12 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// MinGasPriceController queries the utilization window and the last output
+	// of the min gas price controller.
+	MinGasPriceController(ctx context.Context, in *QueryMinGasPriceControllerRequest, opts ...grpc.CallOption) (*QueryMinGasPriceControllerResponse, error)
+	// FeeDistribution queries the cumulative amounts of fees burned and
+	// redirected to the captains reward pool by the fee split.
+	FeeDistribution(ctx context.Context, in *QueryFeeDistributionRequest, opts ...grpc.CallOption) (*QueryFeeDistributionResponse, error)
 }
 
 type queryClient struct {
@@ -374,6 +590,24 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) MinGasPriceController(ctx context.Context, in *QueryMinGasPriceControllerRequest, opts ...grpc.CallOption) (*QueryMinGasPriceControllerResponse, error) {
+	out := new(QueryMinGasPriceControllerResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/MinGasPriceController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeDistribution(ctx context.Context, in *QueryFeeDistributionRequest, opts ...grpc.CallOption) (*QueryFeeDistributionResponse, error) {
+	out := new(QueryFeeDistributionResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/FeeDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -382,6 +616,12 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// MinGasPriceController queries the utilization window and the last output
+	// of the min gas price controller.
+	MinGasPriceController(context.Context, *QueryMinGasPriceControllerRequest) (*QueryMinGasPriceControllerResponse, error)
+	// FeeDistribution queries the cumulative amounts of fees burned and
+	// redirected to the captains reward pool by the fee split.
+	FeeDistribution(context.Context, *QueryFeeDistributionRequest) (*QueryFeeDistributionResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +637,12 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) MinGasPriceController(ctx context.Context, req *QueryMinGasPriceControllerRequest) (*QueryMinGasPriceControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinGasPriceController not implemented")
+}
+func (*UnimplementedQueryServer) FeeDistribution(ctx context.Context, req *QueryFeeDistributionRequest) (*QueryFeeDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDistribution not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +702,42 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MinGasPriceController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinGasPriceControllerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinGasPriceController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/MinGasPriceController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinGasPriceController(ctx, req.(*QueryMinGasPriceControllerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/FeeDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDistribution(ctx, req.(*QueryFeeDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +754,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "MinGasPriceController",
+			Handler:    _Query_MinGasPriceController_Handler,
+		},
+		{
+			MethodName: "FeeDistribution",
+			Handler:    _Query_FeeDistribution_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -642,6 +932,150 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryMinGasPriceControllerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPriceControllerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPriceControllerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinGasPriceControllerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinGasPriceControllerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinGasPriceControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Output.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Window) > 0 {
+		for iNdEx := len(m.Window) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Window[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CaptainsPool) > 0 {
+		for iNdEx := len(m.CaptainsPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CaptainsPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -716,10 +1150,66 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryMinGasPriceControllerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
+
+func (m *QueryMinGasPriceControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Window) > 0 {
+		for _, e := range m.Window {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Output.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryFeeDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CaptainsPool) > 0 {
+		for _, e := range m.CaptainsPool {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
 	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
@@ -1110,6 +1600,341 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryMinGasPriceControllerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceControllerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceControllerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinGasPriceControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinGasPriceControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinGasPriceControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = append(m.Window, BlockGasUsage{})
+			if err := m.Window[len(m.Window)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeDistributionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeDistributionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CaptainsPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CaptainsPool = append(m.CaptainsPool, types.Coin{})
+			if err := m.CaptainsPool[len(m.CaptainsPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -35,7 +35,9 @@ type AppModuleBasic struct {
 func (a AppModuleBasic) Name() string { return types.ModuleName }
 
 // RegisterLegacyAminoCodec registers the limiter module's types on the LegacyAmino codec.
-func (a AppModuleBasic) RegisterLegacyAminoCodec(amino *codec.LegacyAmino) {}
+func (a AppModuleBasic) RegisterLegacyAminoCodec(amino *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(amino)
+}
 
 // RegisterInterfaces registers the module's interface types.
 func (a AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
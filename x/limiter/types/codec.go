@@ -1,11 +1,33 @@
 package types
 
 import (
+	cryptocodec "github.com/tabilabs/tabi/crypto/codec"
+
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	updateParamsName          = "limiter/MsgUpdateParams"
+	limiterSwitchName         = "limiter/MsgLimiterSwitch"
+	addAllowListMemberName    = "limiter/MsgAddAllowListMember"
+	removeAllowListMemberName = "limiter/MsgRemoveAllowListMember"
+)
+
+func init() {
+	cryptocodec.RegisterCrypto(amino)
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
@@ -16,3 +38,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgLimiterSwitch{}, limiterSwitchName, nil)
+	cdc.RegisterConcrete(&MsgAddAllowListMember{}, addAllowListMemberName, nil)
+	cdc.RegisterConcrete(&MsgRemoveAllowListMember{}, removeAllowListMemberName, nil)
+}
@@ -6,6 +6,13 @@ import (
 	errorsmod "cosmossdk.io/errors"
 )
 
+const (
+	TypeMsgUpdateParams          = "update_params"
+	TypeMsgLimiterSwitch         = "limiter_switch"
+	TypeMsgAddAllowListMember    = "add_allow_list_member"
+	TypeMsgRemoveAllowListMember = "remove_allow_list_member"
+)
+
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgLimiterSwitch{}
@@ -83,3 +90,47 @@ func (msg *MsgRemoveAllowListMember) GetSigners() []sdk.AccAddress {
 	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
 	return []sdk.AccAddress{fromAddr}
 }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgLimiterSwitch) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgLimiterSwitch) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgLimiterSwitch) Type() string { return TypeMsgLimiterSwitch }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgAddAllowListMember) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgAddAllowListMember) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgAddAllowListMember) Type() string { return TypeMsgAddAllowListMember }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgRemoveAllowListMember) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgRemoveAllowListMember) Route() string { return ModuleName }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgRemoveAllowListMember) Type() string { return TypeMsgRemoveAllowListMember }
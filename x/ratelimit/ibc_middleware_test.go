@@ -0,0 +1,232 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v6/testing"
+
+	"github.com/tabilabs/tabi/testutil"
+	ibctestutil "github.com/tabilabs/tabi/testutil/ibc"
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+const transferAmount = 100
+
+type MiddlewareTestSuite struct {
+	suite.Suite
+
+	coord  *ibctesting.Coordinator
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	path   *ibctesting.Path
+}
+
+func TestMiddlewareTestSuite(t *testing.T) {
+	suite.Run(t, new(MiddlewareTestSuite))
+}
+
+func (suite *MiddlewareTestSuite) SetupTest() {
+	suite.coord = ibctestutil.NewCoordinator(suite.T())
+	suite.chainA = suite.coord.GetChain(ibctestutil.ChainIDA)
+	suite.chainB = suite.coord.GetChain(ibctestutil.ChainIDB)
+
+	suite.path = ibctestutil.NewTransferPath(suite.chainA, suite.chainB)
+	suite.coord.Setup(suite.path)
+}
+
+// transferMsg returns a transfer of the coin from the sender of chain A to
+// the sender of chain B.
+func (suite *MiddlewareTestSuite) transferMsg(coin sdk.Coin, timeoutHeight clienttypes.Height) *transfertypes.MsgTransfer {
+	return transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID,
+		suite.path.EndpointA.ChannelID,
+		coin,
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight,
+		0,
+		"",
+	)
+}
+
+// sendTransfer sends the coin from chain A to chain B and returns the packet.
+func (suite *MiddlewareTestSuite) sendTransfer(coin sdk.Coin, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	res, err := suite.chainA.SendMsgs(suite.transferMsg(coin, timeoutHeight))
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return packet
+}
+
+// transfer sends the coin from chain A to chain B and relays the packet and
+// its acknowledgement.
+func (suite *MiddlewareTestSuite) transfer(coin sdk.Coin) {
+	packet := suite.sendTransfer(coin, clienttypes.NewHeight(1, 1000))
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+}
+
+// tryTransfer executes a transfer from chain A on a cached context, which is
+// discarded.
+func (suite *MiddlewareTestSuite) tryTransfer(coin sdk.Coin) error {
+	ctx, _ := suite.chainA.GetContext().CacheContext()
+	tabiApp := ibctestutil.GetTabiApp(suite.chainA)
+
+	_, err := tabiApp.TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), suite.transferMsg(coin, clienttypes.NewHeight(1, 1000)))
+	return err
+}
+
+func (suite *MiddlewareTestSuite) fundSenderA(coins sdk.Coins) {
+	tabiApp := ibctestutil.GetTabiApp(suite.chainA)
+	err := testutil.FundAccount(suite.chainA.GetContext(), tabiApp.BankKeeper, suite.chainA.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+	suite.coord.CommitBlock(suite.chainA)
+}
+
+func (suite *MiddlewareTestSuite) setRateLimit(chain *ibctesting.TestChain, channelID, denom string, quota types.Quota) {
+	ctx := chain.GetContext()
+	ibctestutil.GetTabiApp(chain).RateLimitKeeper.SetRateLimit(ctx, types.NewRateLimit(channelID, denom, quota, ctx.BlockTime()))
+	suite.coord.CommitBlock(chain)
+}
+
+func (suite *MiddlewareTestSuite) setDenomFilter(chain *ibctesting.TestChain, channelID, denom string, blocked bool) {
+	ibctestutil.GetTabiApp(chain).RateLimitKeeper.SetDenomFilter(chain.GetContext(), types.NewDenomFilter(channelID, denom, blocked))
+	suite.coord.CommitBlock(chain)
+}
+
+func (suite *MiddlewareTestSuite) windowFlow(chain *ibctesting.TestChain, channelID, denom string) (sdkmath.Int, sdkmath.Int) {
+	ctx := chain.GetContext()
+	rateLimit, found := ibctestutil.GetTabiApp(chain).RateLimitKeeper.GetRateLimit(ctx, channelID, denom)
+	suite.Require().True(found)
+
+	return rateLimit.WindowFlow(ctx.BlockTime())
+}
+
+func (suite *MiddlewareTestSuite) balance(chain *ibctesting.TestChain, denom string) sdkmath.Int {
+	return ibctestutil.GetTabiApp(chain).BankKeeper.GetBalance(chain.GetContext(), chain.SenderAccount.GetAddress(), denom).Amount
+}
+
+// voucherDenom returns the denom on chain B of the coins sent by chain A.
+func (suite *MiddlewareTestSuite) voucherDenom(baseDenom string) string {
+	endpoint := suite.path.EndpointB
+	return transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(endpoint.ChannelConfig.PortID, endpoint.ChannelID, baseDenom)).IBCDenom()
+}
+
+func (suite *MiddlewareTestSuite) TestSendBlockedDenom() {
+	veTabi := sdk.NewInt64Coin(tabitypes.AttoVeTabi, transferAmount)
+	suite.fundSenderA(sdk.NewCoins(veTabi))
+
+	err := suite.tryTransfer(veTabi)
+	suite.Require().ErrorIs(err, types.ErrDenomBlocked)
+	suite.Require().Equal(veTabi.Amount, suite.balance(suite.chainA, tabitypes.AttoVeTabi))
+
+	// a filter of the channel overrides the blocked denoms of the params
+	suite.setDenomFilter(suite.chainA, suite.path.EndpointA.ChannelID, tabitypes.AttoVeTabi, false)
+	suite.transfer(veTabi)
+
+	suite.Require().True(suite.balance(suite.chainA, tabitypes.AttoVeTabi).IsZero())
+	suite.Require().Equal(veTabi.Amount, suite.balance(suite.chainB, suite.voucherDenom(tabitypes.AttoVeTabi)))
+}
+
+func (suite *MiddlewareTestSuite) TestSendDenomBlockedByFilter() {
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, transferAmount)
+	suite.setDenomFilter(suite.chainA, suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom, true)
+
+	err := suite.tryTransfer(coin)
+	suite.Require().ErrorIs(err, types.ErrDenomBlocked)
+}
+
+func (suite *MiddlewareTestSuite) TestSendAllowedDenom() {
+	coin := sdk.NewInt64Coin(sdk.DefaultBondDenom, transferAmount)
+	suite.transfer(coin)
+
+	suite.Require().Equal(coin.Amount, suite.balance(suite.chainB, suite.voucherDenom(sdk.DefaultBondDenom)))
+}
+
+func (suite *MiddlewareTestSuite) TestOutflowQuota() {
+	channelID := suite.path.EndpointA.ChannelID
+	quota := types.NewQuota(sdkmath.ZeroInt(), sdkmath.NewInt(150), time.Hour)
+	suite.setRateLimit(suite.chainA, channelID, sdk.DefaultBondDenom, quota)
+
+	suite.transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, transferAmount))
+
+	_, outflow := suite.windowFlow(suite.chainA, channelID, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdkmath.NewInt(transferAmount), outflow)
+
+	err := suite.tryTransfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, transferAmount))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// the remaining quota can still be sent
+	suite.transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+
+	_, outflow = suite.windowFlow(suite.chainA, channelID, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdkmath.NewInt(150), outflow)
+	suite.Require().Equal(sdkmath.NewInt(150), suite.balance(suite.chainB, suite.voucherDenom(sdk.DefaultBondDenom)))
+}
+
+func (suite *MiddlewareTestSuite) TestInflowQuota() {
+	channelID := suite.path.EndpointB.ChannelID
+	voucher := suite.voucherDenom(sdk.DefaultBondDenom)
+	quota := types.NewQuota(sdkmath.NewInt(150), sdkmath.ZeroInt(), time.Hour)
+	suite.setRateLimit(suite.chainB, channelID, voucher, quota)
+
+	suite.transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, transferAmount))
+
+	inflow, _ := suite.windowFlow(suite.chainB, channelID, voucher)
+	suite.Require().Equal(sdkmath.NewInt(transferAmount), inflow)
+
+	// the transfer exceeding the quota is refunded on the error acknowledgement
+	balance := suite.balance(suite.chainA, sdk.DefaultBondDenom)
+	suite.transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, transferAmount))
+
+	suite.Require().Equal(balance, suite.balance(suite.chainA, sdk.DefaultBondDenom))
+	suite.Require().Equal(sdkmath.NewInt(transferAmount), suite.balance(suite.chainB, voucher))
+
+	inflow, _ = suite.windowFlow(suite.chainB, channelID, voucher)
+	suite.Require().Equal(sdkmath.NewInt(transferAmount), inflow)
+}
+
+func (suite *MiddlewareTestSuite) TestRecvBlockedDenomRevertsOutflow() {
+	channelID := suite.path.EndpointA.ChannelID
+	quota := types.NewQuota(sdkmath.ZeroInt(), sdkmath.NewInt(1000), time.Hour)
+	suite.setRateLimit(suite.chainA, channelID, sdk.DefaultBondDenom, quota)
+	suite.setDenomFilter(suite.chainB, suite.path.EndpointB.ChannelID, suite.voucherDenom(sdk.DefaultBondDenom), true)
+
+	balance := suite.balance(suite.chainA, sdk.DefaultBondDenom)
+	suite.transfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, transferAmount))
+
+	suite.Require().Equal(balance, suite.balance(suite.chainA, sdk.DefaultBondDenom))
+	suite.Require().True(suite.balance(suite.chainB, suite.voucherDenom(sdk.DefaultBondDenom)).IsZero())
+
+	_, outflow := suite.windowFlow(suite.chainA, channelID, sdk.DefaultBondDenom)
+	suite.Require().True(outflow.IsZero())
+}
+
+func (suite *MiddlewareTestSuite) TestTimeoutRevertsOutflow() {
+	channelID := suite.path.EndpointA.ChannelID
+	quota := types.NewQuota(sdkmath.ZeroInt(), sdkmath.NewInt(1000), time.Hour)
+	suite.setRateLimit(suite.chainA, channelID, sdk.DefaultBondDenom, quota)
+
+	timeoutHeight := clienttypes.NewHeight(1, uint64(suite.chainB.GetContext().BlockHeight())+1)
+	packet := suite.sendTransfer(sdk.NewInt64Coin(sdk.DefaultBondDenom, transferAmount), timeoutHeight)
+
+	_, outflow := suite.windowFlow(suite.chainA, channelID, sdk.DefaultBondDenom)
+	suite.Require().Equal(sdkmath.NewInt(transferAmount), outflow)
+
+	suite.coord.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	_, outflow = suite.windowFlow(suite.chainA, channelID, sdk.DefaultBondDenom)
+	suite.Require().True(outflow.IsZero())
+}
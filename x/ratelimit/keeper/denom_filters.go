@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+// GetDenomFilters returns the denom filters of all the channels.
+func (k Keeper) GetDenomFilters(ctx sdk.Context) []types.DenomFilter {
+	var filters []types.DenomFilter
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomFilterKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var filter types.DenomFilter
+		k.cdc.MustUnmarshal(iterator.Value(), &filter)
+		filters = append(filters, filter)
+	}
+
+	return filters
+}
+
+// GetDenomFilter returns the filter of the denom on the channel.
+func (k Keeper) GetDenomFilter(ctx sdk.Context, channelID, denom string) (types.DenomFilter, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.DenomFilterStoreKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.DenomFilter{}, false
+	}
+
+	var filter types.DenomFilter
+	k.cdc.MustUnmarshal(bz, &filter)
+	return filter, true
+}
+
+// SetDenomFilter stores a denom filter.
+func (k Keeper) SetDenomFilter(ctx sdk.Context, filter types.DenomFilter) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DenomFilterStoreKey(filter.ChannelId, filter.Denom), k.cdc.MustMarshal(&filter))
+}
+
+// DeleteDenomFilter removes the filter of the denom on the channel.
+func (k Keeper) DeleteDenomFilter(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DenomFilterStoreKey(channelID, denom))
+}
+
+// IsDenomBlocked returns true if the denom cannot be transferred over the
+// channel. The filter of the channel takes precedence over the blocked denoms
+// of the params.
+func (k Keeper) IsDenomBlocked(ctx sdk.Context, channelID, denom string) bool {
+	if filter, found := k.GetDenomFilter(ctx, channelID, denom); found {
+		return filter.Blocked
+	}
+
+	return k.GetParams(ctx).IsBlockedDenom(denom)
+}
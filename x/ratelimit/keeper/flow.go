@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+// CheckAndRecordInflow checks that the denom can be received over the channel
+// and records the amount against the inflow quota.
+func (k Keeper) CheckAndRecordInflow(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) error {
+	return k.checkAndRecordFlow(ctx, types.DirectionInflow, channelID, denom, amount)
+}
+
+// CheckAndRecordOutflow checks that the denom can be sent over the channel and
+// records the amount against the outflow quota.
+func (k Keeper) CheckAndRecordOutflow(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) error {
+	return k.checkAndRecordFlow(ctx, types.DirectionOutflow, channelID, denom, amount)
+}
+
+// RevertOutflow removes a refunded amount from the outflow of the denom on the
+// channel, so that failed and timed out transfers do not consume the quota.
+func (k Keeper) RevertOutflow(ctx sdk.Context, channelID, denom string, amount sdkmath.Int) {
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return
+	}
+
+	rateLimit.RemoveOutflow(ctx.BlockTime(), amount)
+	k.SetRateLimit(ctx, rateLimit)
+	k.emitFlowUpdated(ctx, rateLimit)
+}
+
+func (k Keeper) checkAndRecordFlow(ctx sdk.Context, direction, channelID, denom string, amount sdkmath.Int) error {
+	if k.IsDenomBlocked(ctx, channelID, denom) {
+		err := errorsmod.Wrapf(types.ErrDenomBlocked, "%s cannot be transferred over %s", denom, channelID)
+		k.emitTransferDenied(ctx, direction, channelID, denom, amount, err)
+		return err
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, channelID, denom)
+	if !found {
+		return nil
+	}
+
+	var err error
+	if direction == types.DirectionInflow {
+		err = rateLimit.AddInflow(ctx.BlockTime(), amount)
+	} else {
+		err = rateLimit.AddOutflow(ctx.BlockTime(), amount)
+	}
+	if err != nil {
+		k.emitTransferDenied(ctx, direction, channelID, denom, amount, err)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.emitFlowUpdated(ctx, rateLimit)
+	return nil
+}
+
+func (k Keeper) emitTransferDenied(ctx sdk.Context, direction, channelID, denom string, amount sdkmath.Int, reason error) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeTransferDenied,
+		sdk.NewAttribute(types.AttributeKeyChannel, channelID),
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyDirection, direction),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, reason.Error()),
+	))
+}
+
+func (k Keeper) emitFlowUpdated(ctx sdk.Context, rateLimit types.RateLimit) {
+	inflow, outflow := rateLimit.WindowFlow(ctx.BlockTime())
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFlowUpdated,
+		sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.ChannelId),
+		sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+		sdk.NewAttribute(types.AttributeKeyInflow, inflow.String()),
+		sdk.NewAttribute(types.AttributeKeyOutflow, outflow.String()),
+	))
+}
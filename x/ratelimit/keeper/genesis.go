@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+// InitGenesis sets the ratelimit module's parameters, denom filters and rate limits.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+
+	for _, filter := range gs.DenomFilters {
+		k.SetDenomFilter(ctx, filter)
+	}
+
+	for _, rateLimit := range gs.RateLimits {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+}
+
+// ExportGenesis returns the ratelimit module's parameters, denom filters and rate limits.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:       k.GetParams(ctx),
+		DenomFilters: k.GetDenomFilters(ctx),
+		RateLimits:   k.GetRateLimits(ctx),
+	}
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+type Querier struct {
+	k *Keeper
+}
+
+// NewQuerierImpl returns an implementation of the ratelimit QueryServer interface.
+func NewQuerierImpl(keeper *Keeper) Querier {
+	return Querier{keeper}
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the params of ratelimit.
+func (q Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DenomFilters queries the denom filters of all the channels.
+func (q Querier) DenomFilters(goCtx context.Context, req *types.QueryDenomFiltersRequest) (*types.QueryDenomFiltersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var filters []types.DenomFilter
+	filterStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.DenomFilterKey)
+	pageRes, err := query.Paginate(filterStore, req.Pagination, func(_, value []byte) error {
+		var filter types.DenomFilter
+		q.k.cdc.MustUnmarshal(value, &filter)
+		filters = append(filters, filter)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryDenomFiltersResponse{
+		DenomFilters: filters,
+		Pagination:   pageRes,
+	}, nil
+}
+
+// RateLimits queries the rate limits of all the channels.
+func (q Querier) RateLimits(goCtx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var rateLimits []types.RateLimit
+	rateLimitStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.RateLimitKey)
+	pageRes, err := query.Paginate(rateLimitStore, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		q.k.cdc.MustUnmarshal(value, &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit queries the rate limit of a denom on a channel and the amounts
+// transferred within the current rolling window.
+func (q Querier) RateLimit(goCtx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateChannelAndDenom(req.ChannelId, req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := q.k.GetRateLimit(ctx, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit not found for %s on %s", req.Denom, req.ChannelId)
+	}
+
+	inflow, outflow := rateLimit.WindowFlow(ctx.BlockTime())
+	return &types.QueryRateLimitResponse{
+		RateLimit: rateLimit,
+		Inflow:    inflow,
+		Outflow:   outflow,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+func (suite *IntegrationTestSuite) TestQueryParams() {
+	res, err := suite.QueryClient.Params(suite.Ctx, &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultParams(), res.Params)
+}
+
+func (suite *IntegrationTestSuite) TestQueryDenomFilters() {
+	filter := types.NewDenomFilter(channelID, tabitypes.AttoVeTabi, false)
+	suite.App.RateLimitKeeper.SetDenomFilter(suite.Ctx, filter)
+
+	res, err := suite.QueryClient.DenomFilters(suite.Ctx, &types.QueryDenomFiltersRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.DenomFilter{filter}, res.DenomFilters)
+}
+
+func (suite *IntegrationTestSuite) TestQueryRateLimits() {
+	quota := types.NewQuota(sdkmath.NewInt(1000), sdkmath.NewInt(1000), time.Hour)
+	rateLimit := types.NewRateLimit(channelID, tabitypes.AttoTabi, quota, suite.Ctx.BlockTime().Add(-time.Hour))
+	suite.Require().NoError(rateLimit.AddInflow(rateLimit.Flow.WindowStart, sdkmath.NewInt(400)))
+	suite.App.RateLimitKeeper.SetRateLimit(suite.Ctx, rateLimit)
+
+	res, err := suite.QueryClient.RateLimits(suite.Ctx, &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.RateLimits, 1)
+	suite.Require().Equal(rateLimit.Flow.Inflow, res.RateLimits[0].Flow.Inflow)
+
+	testCases := []struct {
+		name      string
+		req       *types.QueryRateLimitRequest
+		expPass   bool
+		expInflow sdkmath.Int
+	}{
+		{
+			"pass - rate limit with the inflow of the previous window",
+			&types.QueryRateLimitRequest{ChannelId: channelID, Denom: tabitypes.AttoTabi},
+			true,
+			sdkmath.NewInt(400),
+		},
+		{
+			"fail - rate limit not found",
+			&types.QueryRateLimitRequest{ChannelId: channelID, Denom: tabitypes.AttoVeTabi},
+			false,
+			sdkmath.Int{},
+		},
+		{
+			"fail - invalid channel",
+			&types.QueryRateLimitRequest{ChannelId: "invalid channel", Denom: tabitypes.AttoTabi},
+			false,
+			sdkmath.Int{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			res, err := suite.QueryClient.RateLimit(suite.Ctx, tc.req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expInflow, res.Inflow)
+				suite.Require().True(res.Outflow.IsZero())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
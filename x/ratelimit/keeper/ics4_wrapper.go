@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v6/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v6/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v6/modules/core/exported"
+
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket checks the outflow of the fungible token transfers before
+// sending the packet with the wrapped ICS4 wrapper. The transfer is rejected
+// if the denom is blocked on the source channel or its outflow quota is
+// exceeded.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if transfer, amount, ok := types.UnmarshalTransfer(data); ok {
+		if err := k.CheckAndRecordOutflow(ctx, sourceChannel, types.SentDenom(transfer), amount); err != nil {
+			return 0, err
+		}
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
+
+// WriteAcknowledgement writes the acknowledgement with the wrapped ICS4 wrapper.
+func (k Keeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion returns the application version of the channel from the
+// wrapped ICS4 wrapper.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+type msgServer struct {
+	k *Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the ratelimit MsgServer interface
+func NewMsgServerImpl(keeper *Keeper) msgServer {
+	return msgServer{keeper}
+}
+
+// SetDenomFilter blocks or allows a denom on a channel, overriding the
+// blocked denoms of the params
+func (m msgServer) SetDenomFilter(goCtx context.Context, msg *types.MsgSetDenomFilter) (*types.MsgSetDenomFilterResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	m.k.SetDenomFilter(ctx, types.NewDenomFilter(msg.ChannelId, msg.Denom, msg.Blocked))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetDenomFilter,
+		sdk.NewAttribute(types.AttributeKeyChannel, msg.ChannelId),
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+		sdk.NewAttribute(types.AttributeKeyBlocked, strconv.FormatBool(msg.Blocked)),
+	))
+
+	return &types.MsgSetDenomFilterResponse{}, nil
+}
+
+// RemoveDenomFilter removes the denom filter of a channel
+func (m msgServer) RemoveDenomFilter(goCtx context.Context, msg *types.MsgRemoveDenomFilter) (*types.MsgRemoveDenomFilterResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := m.k.GetDenomFilter(ctx, msg.ChannelId, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrDenomFilterNotFound, "%s on %s", msg.Denom, msg.ChannelId)
+	}
+
+	m.k.DeleteDenomFilter(ctx, msg.ChannelId, msg.Denom)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveDenomFilter,
+		sdk.NewAttribute(types.AttributeKeyChannel, msg.ChannelId),
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+	))
+
+	return &types.MsgRemoveDenomFilterResponse{}, nil
+}
+
+// SetRateLimit sets the quota of a denom on a channel. The flow tracked by an
+// existing rate limit is kept.
+func (m msgServer) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := m.k.GetRateLimit(ctx, msg.ChannelId, msg.Denom)
+	if found {
+		rateLimit.Quota = msg.Quota
+	} else {
+		rateLimit = types.NewRateLimit(msg.ChannelId, msg.Denom, msg.Quota, ctx.BlockTime())
+	}
+	m.k.SetRateLimit(ctx, rateLimit)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetRateLimit,
+		sdk.NewAttribute(types.AttributeKeyChannel, msg.ChannelId),
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+	))
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit removes the rate limit of a denom on a channel
+func (m msgServer) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := m.k.GetRateLimit(ctx, msg.ChannelId, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "%s on %s", msg.Denom, msg.ChannelId)
+	}
+
+	m.k.DeleteRateLimit(ctx, msg.ChannelId, msg.Denom)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRemoveRateLimit,
+		sdk.NewAttribute(types.AttributeKeyChannel, msg.ChannelId),
+		sdk.NewAttribute(types.AttributeKeyDenom, msg.Denom),
+	))
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// UpdateParams defines a method that allows to update the parameters of the module
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := m.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set params")
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// checkAuthority checks that the signer of a governance message is the keeper authority.
+func (m msgServer) checkAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", authority)
+	}
+
+	if m.k.authority.String() != authority {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"invalid authority: expected %s, got %s", m.k.authority, authority)
+	}
+
+	return nil
+}
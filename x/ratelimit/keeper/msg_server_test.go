@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+func (suite *IntegrationTestSuite) TestDenomFilters() {
+	keeper := suite.App.RateLimitKeeper
+
+	// vetabi is blocked on every channel by default
+	suite.Require().True(keeper.IsDenomBlocked(suite.Ctx, channelID, tabitypes.AttoVeTabi))
+	suite.Require().False(keeper.IsDenomBlocked(suite.Ctx, channelID, tabitypes.AttoTabi))
+
+	_, err := suite.MsgServer.SetDenomFilter(suite.Ctx, types.NewMsgSetDenomFilter(authority, channelID, tabitypes.AttoVeTabi, false))
+	suite.Require().NoError(err)
+	_, err = suite.MsgServer.SetDenomFilter(suite.Ctx, types.NewMsgSetDenomFilter(authority, channelID, tabitypes.AttoTabi, true))
+	suite.Require().NoError(err)
+
+	suite.Require().False(keeper.IsDenomBlocked(suite.Ctx, channelID, tabitypes.AttoVeTabi))
+	suite.Require().True(keeper.IsDenomBlocked(suite.Ctx, channelID, tabitypes.AttoTabi))
+	suite.Require().True(keeper.IsDenomBlocked(suite.Ctx, "channel-1", tabitypes.AttoVeTabi))
+	suite.Require().False(keeper.IsDenomBlocked(suite.Ctx, "channel-1", tabitypes.AttoTabi))
+
+	_, err = suite.MsgServer.RemoveDenomFilter(suite.Ctx, types.NewMsgRemoveDenomFilter(authority, channelID, tabitypes.AttoVeTabi))
+	suite.Require().NoError(err)
+	suite.Require().True(keeper.IsDenomBlocked(suite.Ctx, channelID, tabitypes.AttoVeTabi))
+
+	_, err = suite.MsgServer.RemoveDenomFilter(suite.Ctx, types.NewMsgRemoveDenomFilter(authority, channelID, tabitypes.AttoVeTabi))
+	suite.Require().ErrorIs(err, types.ErrDenomFilterNotFound)
+
+	_, err = suite.MsgServer.SetDenomFilter(suite.Ctx, types.NewMsgSetDenomFilter(sdk.AccAddress("unauthorized").String(), channelID, tabitypes.AttoVeTabi, false))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
+
+func (suite *IntegrationTestSuite) TestSetRateLimit() {
+	keeper := suite.App.RateLimitKeeper
+	quota := types.NewQuota(sdkmath.NewInt(1000), sdkmath.NewInt(500), time.Hour)
+
+	_, err := suite.MsgServer.SetRateLimit(suite.Ctx, types.NewMsgSetRateLimit(authority, channelID, tabitypes.AttoTabi, quota))
+	suite.Require().NoError(err)
+
+	rateLimit, found := keeper.GetRateLimit(suite.Ctx, channelID, tabitypes.AttoTabi)
+	suite.Require().True(found)
+	suite.Require().Equal(quota, rateLimit.Quota)
+	suite.Require().Equal(suite.Ctx.BlockTime(), rateLimit.Flow.WindowStart)
+
+	suite.Require().NoError(keeper.CheckAndRecordOutflow(suite.Ctx, channelID, tabitypes.AttoTabi, sdkmath.NewInt(400)))
+	err = keeper.CheckAndRecordOutflow(suite.Ctx, channelID, tabitypes.AttoTabi, sdkmath.NewInt(200))
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	// updating the quota keeps the tracked flow
+	quota.MaxOutflow = sdkmath.NewInt(600)
+	_, err = suite.MsgServer.SetRateLimit(suite.Ctx, types.NewMsgSetRateLimit(authority, channelID, tabitypes.AttoTabi, quota))
+	suite.Require().NoError(err)
+	suite.Require().NoError(keeper.CheckAndRecordOutflow(suite.Ctx, channelID, tabitypes.AttoTabi, sdkmath.NewInt(200)))
+
+	rateLimit, _ = keeper.GetRateLimit(suite.Ctx, channelID, tabitypes.AttoTabi)
+	suite.Require().Equal(sdkmath.NewInt(600), rateLimit.Flow.Outflow)
+
+	keeper.RevertOutflow(suite.Ctx, channelID, tabitypes.AttoTabi, sdkmath.NewInt(200))
+	rateLimit, _ = keeper.GetRateLimit(suite.Ctx, channelID, tabitypes.AttoTabi)
+	suite.Require().Equal(sdkmath.NewInt(400), rateLimit.Flow.Outflow)
+
+	_, err = suite.MsgServer.RemoveRateLimit(suite.Ctx, types.NewMsgRemoveRateLimit(authority, channelID, tabitypes.AttoTabi))
+	suite.Require().NoError(err)
+	_, found = keeper.GetRateLimit(suite.Ctx, channelID, tabitypes.AttoTabi)
+	suite.Require().False(found)
+
+	_, err = suite.MsgServer.RemoveRateLimit(suite.Ctx, types.NewMsgRemoveRateLimit(authority, channelID, tabitypes.AttoTabi))
+	suite.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+}
+
+func (suite *IntegrationTestSuite) TestUpdateParams() {
+	params := types.NewParams([]string{tabitypes.AttoTabi})
+
+	_, err := suite.MsgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(authority, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.App.RateLimitKeeper.GetParams(suite.Ctx))
+
+	_, err = suite.MsgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(sdk.AccAddress("unauthorized").String(), params))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+// GetRateLimits returns the rate limits of all the channels.
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RateLimitKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// GetRateLimit returns the rate limit of the denom on the channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RateLimitStoreKey(channelID, denom))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores a rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.RateLimitStoreKey(rateLimit.ChannelId, rateLimit.Denom), k.cdc.MustMarshal(&rateLimit))
+}
+
+// DeleteRateLimit removes the rate limit of the denom on the channel.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RateLimitStoreKey(channelID, denom))
+}
@@ -0,0 +1,52 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/tabilabs/tabi/app"
+	feemarkettypes "github.com/tabilabs/tabi/x/feemarket/types"
+	ratelimitkeeper "github.com/tabilabs/tabi/x/ratelimit/keeper"
+	"github.com/tabilabs/tabi/x/ratelimit/types"
+)
+
+const channelID = "channel-0"
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	App *app.Tabi
+	Ctx sdk.Context
+
+	MsgServer   types.MsgServer
+	QueryClient types.QueryClient
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (suite *IntegrationTestSuite) SetupTest() {
+	suite.App = app.Setup(false, feemarkettypes.DefaultGenesisState())
+	suite.Ctx = suite.App.NewContext(false, tmproto.Header{
+		Height:  1,
+		ChainID: "tabi_9788-1",
+		Time:    time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	suite.MsgServer = ratelimitkeeper.NewMsgServerImpl(&suite.App.RateLimitKeeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, ratelimitkeeper.NewQuerierImpl(&suite.App.RateLimitKeeper))
+	suite.QueryClient = types.NewQueryClient(queryHelper)
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	cryptocodec "github.com/tabilabs/tabi/crypto/codec"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	setDenomFilterName    = "ratelimit/MsgSetDenomFilter"
+	removeDenomFilterName = "ratelimit/MsgRemoveDenomFilter"
+	setRateLimitName      = "ratelimit/MsgSetRateLimit"
+	removeRateLimitName   = "ratelimit/MsgRemoveRateLimit"
+	updateParamsName      = "ratelimit/MsgUpdateParams"
+)
+
+func init() {
+	cryptocodec.RegisterCrypto(amino)
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetDenomFilter{},
+		&MsgRemoveDenomFilter{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgSetDenomFilter{}, setDenomFilterName, nil)
+	cdc.RegisterConcrete(&MsgRemoveDenomFilter{}, removeDenomFilterName, nil)
+	cdc.RegisterConcrete(&MsgSetRateLimit{}, setRateLimitName, nil)
+	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, removeRateLimitName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+}
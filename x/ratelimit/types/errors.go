@@ -0,0 +1,12 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrDenomBlocked          = errorsmod.Register(ModuleName, 2, "denom is blocked on the channel")
+	ErrQuotaExceeded         = errorsmod.Register(ModuleName, 3, "transfer quota exceeded")
+	ErrDenomFilterNotFound   = errorsmod.Register(ModuleName, 4, "denom filter not found")
+	ErrRateLimitNotFound     = errorsmod.Register(ModuleName, 5, "rate limit not found")
+	ErrInvalidQuota          = errorsmod.Register(ModuleName, 6, "invalid quota")
+	ErrInvalidChannelOrDenom = errorsmod.Register(ModuleName, 7, "invalid channel or denom")
+)
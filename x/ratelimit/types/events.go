@@ -0,0 +1,24 @@
+package types
+
+const (
+	EventTypeTransferDenied    = "ibc_transfer_denied"
+	EventTypeFlowUpdated       = "ibc_flow_updated"
+	EventTypeSetDenomFilter    = "set_denom_filter"
+	EventTypeRemoveDenomFilter = "remove_denom_filter"
+	EventTypeSetRateLimit      = "set_rate_limit"
+	EventTypeRemoveRateLimit   = "remove_rate_limit"
+
+	AttributeKeyChannel   = "channel"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyDirection = "direction"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyReason    = "reason"
+	AttributeKeyBlocked   = "blocked"
+	AttributeKeyInflow    = "inflow"
+	AttributeKeyOutflow   = "outflow"
+
+	// DirectionInflow is the direction of the transfers received over a channel
+	DirectionInflow = "inflow"
+	// DirectionOutflow is the direction of the transfers sent over a channel
+	DirectionOutflow = "outflow"
+)
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, filters []DenomFilter, rateLimits []RateLimit) GenesisState {
+	return GenesisState{
+		Params:       params,
+		DenomFilters: filters,
+		RateLimits:   rateLimits,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis performs basic validation of genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seenFilters := make(map[string]bool)
+	for _, filter := range data.DenomFilters {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+
+		key := string(DenomFilterStoreKey(filter.ChannelId, filter.Denom))
+		if seenFilters[key] {
+			return fmt.Errorf("duplicate denom filter for %s on %s", filter.Denom, filter.ChannelId)
+		}
+		seenFilters[key] = true
+	}
+
+	seenRateLimits := make(map[string]bool)
+	for _, rateLimit := range data.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(RateLimitStoreKey(rateLimit.ChannelId, rateLimit.Denom))
+		if seenRateLimits[key] {
+			return fmt.Errorf("duplicate rate limit for %s on %s", rateLimit.Denom, rateLimit.ChannelId)
+		}
+		seenRateLimits[key] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	filter := NewDenomFilter("channel-0", "avetabi", false)
+	quota := NewQuota(sdkmath.NewInt(1000), sdkmath.NewInt(1000), time.Hour)
+	rateLimit := NewRateLimit("channel-0", "atabi", quota, time.Now().UTC())
+
+	testCases := []struct {
+		name      string
+		genState  GenesisState
+		expectErr bool
+	}{
+		{
+			name:     "success: default genesis state",
+			genState: *DefaultGenesisState(),
+		},
+		{
+			name:     "success: with filters and rate limits",
+			genState: NewGenesisState(DefaultParams(), []DenomFilter{filter}, []RateLimit{rateLimit}),
+		},
+		{
+			name:      "fail: invalid blocked denom",
+			genState:  NewGenesisState(NewParams([]string{"1"}), nil, nil),
+			expectErr: true,
+		},
+		{
+			name:      "fail: duplicate blocked denom",
+			genState:  NewGenesisState(NewParams([]string{"avetabi", "avetabi"}), nil, nil),
+			expectErr: true,
+		},
+		{
+			name:      "fail: invalid filter channel",
+			genState:  NewGenesisState(DefaultParams(), []DenomFilter{NewDenomFilter("invalid channel", "avetabi", false)}, nil),
+			expectErr: true,
+		},
+		{
+			name:      "fail: duplicate filter",
+			genState:  NewGenesisState(DefaultParams(), []DenomFilter{filter, filter}, nil),
+			expectErr: true,
+		},
+		{
+			name:      "fail: invalid rate limit quota",
+			genState:  NewGenesisState(DefaultParams(), nil, []RateLimit{NewRateLimit("channel-0", "atabi", Quota{}, time.Now().UTC())}),
+			expectErr: true,
+		},
+		{
+			name:      "fail: duplicate rate limit",
+			genState:  NewGenesisState(DefaultParams(), nil, []RateLimit{rateLimit, rateLimit}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := ValidateGenesis(tc.genState)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+const (
+	prefixParams = iota + 1
+	prefixDenomFilter
+	prefixRateLimit
+)
+
+// KVStore keys
+var (
+	ParamsKey      = []byte{prefixParams}
+	DenomFilterKey = []byte{prefixDenomFilter}
+	RateLimitKey   = []byte{prefixRateLimit}
+)
+
+// DenomFilterStoreKey returns the byte representation of the denom filter key
+// Items are stored with key as follows:
+// 0x02<len(channel)><channel><denom>
+func DenomFilterStoreKey(channelID, denom string) []byte {
+	return channelDenomKey(DenomFilterKey, channelID, denom)
+}
+
+// RateLimitStoreKey returns the byte representation of the rate limit key
+// Items are stored with key as follows:
+// 0x03<len(channel)><channel><denom>
+func RateLimitStoreKey(channelID, denom string) []byte {
+	return channelDenomKey(RateLimitKey, channelID, denom)
+}
+
+func channelDenomKey(prefix []byte, channelID, denom string) []byte {
+	key := append([]byte{}, prefix...)
+	key = append(key, address.MustLengthPrefix([]byte(channelID))...)
+	return append(key, denom...)
+}
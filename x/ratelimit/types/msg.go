@@ -0,0 +1,203 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	TypeMsgSetDenomFilter    = "set_denom_filter"
+	TypeMsgRemoveDenomFilter = "remove_denom_filter"
+	TypeMsgSetRateLimit      = "set_rate_limit"
+	TypeMsgRemoveRateLimit   = "remove_rate_limit"
+	TypeMsgUpdateParams      = "update_params"
+)
+
+var (
+	_ sdk.Msg = &MsgSetDenomFilter{}
+	_ sdk.Msg = &MsgRemoveDenomFilter{}
+	_ sdk.Msg = &MsgSetRateLimit{}
+	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgSetDenomFilter defines a message to block or allow a denom on a channel
+func NewMsgSetDenomFilter(authority string, channelID, denom string, blocked bool) *MsgSetDenomFilter {
+	return &MsgSetDenomFilter{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+		Blocked:   blocked,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetDenomFilter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateChannelAndDenom(msg.ChannelId, msg.Denom)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSetDenomFilter) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgRemoveDenomFilter defines a message to remove the denom filter of a channel
+func NewMsgRemoveDenomFilter(authority string, channelID, denom string) *MsgRemoveDenomFilter {
+	return &MsgRemoveDenomFilter{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRemoveDenomFilter) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateChannelAndDenom(msg.ChannelId, msg.Denom)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRemoveDenomFilter) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgSetRateLimit defines a message to set the quota of a denom on a channel
+func NewMsgSetRateLimit(authority string, channelID, denom string, quota Quota) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := ValidateChannelAndDenom(msg.ChannelId, msg.Denom); err != nil {
+		return err
+	}
+
+	return msg.Quota.Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgSetRateLimit) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgRemoveRateLimit defines a message to remove the rate limit of a denom on a channel
+func NewMsgRemoveRateLimit(authority string, channelID, denom string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Authority: authority,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return ValidateChannelAndDenom(msg.ChannelId, msg.Denom)
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRemoveRateLimit) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgUpdateParams defines a message to update the params of the ratelimit module
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgSetDenomFilter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgSetDenomFilter) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgSetDenomFilter) Type() string { return TypeMsgSetDenomFilter }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgRemoveDenomFilter) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgRemoveDenomFilter) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgRemoveDenomFilter) Type() string { return TypeMsgRemoveDenomFilter }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgSetRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgSetRateLimit) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgSetRateLimit) Type() string { return TypeMsgSetRateLimit }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgRemoveRateLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgRemoveRateLimit) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgRemoveRateLimit) Type() string { return TypeMsgRemoveRateLimit }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgsValidateBasic() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	quota := NewQuota(sdkmath.NewInt(1000), sdkmath.ZeroInt(), time.Hour)
+
+	testCases := []struct {
+		name    string
+		msg     interface{ ValidateBasic() error }
+		expPass bool
+	}{
+		{"pass - set denom filter", NewMsgSetDenomFilter(authority, "channel-0", "avetabi", false), true},
+		{"fail - set denom filter invalid authority", NewMsgSetDenomFilter("invalid", "channel-0", "avetabi", false), false},
+		{"fail - set denom filter invalid channel", NewMsgSetDenomFilter(authority, "invalid channel", "avetabi", false), false},
+		{"fail - set denom filter invalid denom", NewMsgSetDenomFilter(authority, "channel-0", "1", false), false},
+		{"pass - remove denom filter", NewMsgRemoveDenomFilter(authority, "channel-0", "avetabi"), true},
+		{"fail - remove denom filter invalid channel", NewMsgRemoveDenomFilter(authority, "", "avetabi"), false},
+		{"pass - set rate limit", NewMsgSetRateLimit(authority, "channel-0", "atabi", quota), true},
+		{"fail - set rate limit invalid quota", NewMsgSetRateLimit(authority, "channel-0", "atabi", NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0)), false},
+		{"pass - remove rate limit", NewMsgRemoveRateLimit(authority, "channel-0", "atabi"), true},
+		{"fail - remove rate limit invalid authority", NewMsgRemoveRateLimit("invalid", "channel-0", "atabi"), false},
+		{"pass - update params", NewMsgUpdateParams(authority, DefaultParams()), true},
+		{"fail - update params invalid blocked denom", NewMsgUpdateParams(authority, NewParams([]string{"1"})), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,54 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+)
+
+// DefaultBlockedDenoms blocks veTabi, which must not leave the chain outside
+// of the token-convert locking
+var DefaultBlockedDenoms = []string{tabitypes.AttoVeTabi}
+
+// NewParams returns a new Params object
+func NewParams(blockedDenoms []string) Params {
+	return Params{
+		BlockedDenoms: blockedDenoms,
+	}
+}
+
+// DefaultParams returns the default ratelimit module parameters
+func DefaultParams() Params {
+	return Params{
+		BlockedDenoms: append([]string{}, DefaultBlockedDenoms...),
+	}
+}
+
+// Validate performs basic validation on ratelimit parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]bool)
+	for _, denom := range p.BlockedDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate blocked denom %s", denom)
+		}
+		seen[denom] = true
+	}
+
+	return nil
+}
+
+// IsBlockedDenom returns true if the denom is blocked by default.
+func (p Params) IsBlockedDenom(denom string) bool {
+	for _, blocked := range p.BlockedDenoms {
+		if blocked == denom {
+			return true
+		}
+	}
+
+	return false
+}
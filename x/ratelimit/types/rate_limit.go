@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v6/modules/core/24-host"
+)
+
+// NewQuota returns a new Quota. A zero maximum amount does not limit the flow.
+func NewQuota(maxInflow, maxOutflow sdkmath.Int, window time.Duration) Quota {
+	return Quota{
+		MaxInflow:  maxInflow,
+		MaxOutflow: maxOutflow,
+		Window:     window,
+	}
+}
+
+// Validate performs a stateless validation of a Quota
+func (q Quota) Validate() error {
+	if q.MaxInflow.IsNil() || q.MaxInflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidQuota, "invalid max inflow %s", q.MaxInflow)
+	}
+
+	if q.MaxOutflow.IsNil() || q.MaxOutflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidQuota, "invalid max outflow %s", q.MaxOutflow)
+	}
+
+	if q.Window <= 0 {
+		return errorsmod.Wrapf(ErrInvalidQuota, "window must be positive: %s", q.Window)
+	}
+
+	return nil
+}
+
+// NewDenomFilter returns a new DenomFilter.
+func NewDenomFilter(channelID, denom string, blocked bool) DenomFilter {
+	return DenomFilter{
+		ChannelId: channelID,
+		Denom:     denom,
+		Blocked:   blocked,
+	}
+}
+
+// Validate performs a stateless validation of a DenomFilter
+func (df DenomFilter) Validate() error {
+	return ValidateChannelAndDenom(df.ChannelId, df.Denom)
+}
+
+// NewRateLimit returns a new RateLimit with an empty flow whose window starts
+// at the given time.
+func NewRateLimit(channelID, denom string, quota Quota, now time.Time) RateLimit {
+	return RateLimit{
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+		Flow: Flow{
+			Inflow:          sdkmath.ZeroInt(),
+			Outflow:         sdkmath.ZeroInt(),
+			PreviousInflow:  sdkmath.ZeroInt(),
+			PreviousOutflow: sdkmath.ZeroInt(),
+			WindowStart:     now,
+		},
+	}
+}
+
+// Validate performs a stateless validation of a RateLimit
+func (rl RateLimit) Validate() error {
+	if err := ValidateChannelAndDenom(rl.ChannelId, rl.Denom); err != nil {
+		return err
+	}
+
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	for _, amount := range []sdkmath.Int{rl.Flow.Inflow, rl.Flow.Outflow, rl.Flow.PreviousInflow, rl.Flow.PreviousOutflow} {
+		if amount.IsNil() || amount.IsNegative() {
+			return fmt.Errorf("invalid flow amount %s", amount)
+		}
+	}
+
+	return nil
+}
+
+// WindowFlow returns the amounts received and sent within the rolling window
+// ending at the given time.
+func (rl RateLimit) WindowFlow(now time.Time) (inflow, outflow sdkmath.Int) {
+	rl.advance(now)
+	return rl.weighted(now, rl.Flow.PreviousInflow, rl.Flow.Inflow),
+		rl.weighted(now, rl.Flow.PreviousOutflow, rl.Flow.Outflow)
+}
+
+// AddInflow records an amount received at the given time, failing if it
+// exceeds the inflow quota.
+func (rl *RateLimit) AddInflow(now time.Time, amount sdkmath.Int) error {
+	rl.advance(now)
+
+	inflow := rl.weighted(now, rl.Flow.PreviousInflow, rl.Flow.Inflow).Add(amount)
+	if rl.Quota.MaxInflow.IsPositive() && inflow.GT(rl.Quota.MaxInflow) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "inflow of %s%s on %s would reach %s, max %s", amount, rl.Denom, rl.ChannelId, inflow, rl.Quota.MaxInflow)
+	}
+
+	rl.Flow.Inflow = rl.Flow.Inflow.Add(amount)
+	return nil
+}
+
+// AddOutflow records an amount sent at the given time, failing if it exceeds
+// the outflow quota.
+func (rl *RateLimit) AddOutflow(now time.Time, amount sdkmath.Int) error {
+	rl.advance(now)
+
+	outflow := rl.weighted(now, rl.Flow.PreviousOutflow, rl.Flow.Outflow).Add(amount)
+	if rl.Quota.MaxOutflow.IsPositive() && outflow.GT(rl.Quota.MaxOutflow) {
+		return errorsmod.Wrapf(ErrQuotaExceeded, "outflow of %s%s on %s would reach %s, max %s", amount, rl.Denom, rl.ChannelId, outflow, rl.Quota.MaxOutflow)
+	}
+
+	rl.Flow.Outflow = rl.Flow.Outflow.Add(amount)
+	return nil
+}
+
+// RemoveOutflow reverts an amount sent which has been refunded. The amount
+// is taken from the current window first and the tracked amounts never go
+// below zero.
+func (rl *RateLimit) RemoveOutflow(now time.Time, amount sdkmath.Int) {
+	rl.advance(now)
+
+	removed := sdkmath.MinInt(amount, rl.Flow.Outflow)
+	rl.Flow.Outflow = rl.Flow.Outflow.Sub(removed)
+	removed = sdkmath.MinInt(amount.Sub(removed), rl.Flow.PreviousOutflow)
+	rl.Flow.PreviousOutflow = rl.Flow.PreviousOutflow.Sub(removed)
+}
+
+// advance moves the current window forward until it contains the given time.
+// The flow of the window before the current one is kept to weight it.
+func (rl *RateLimit) advance(now time.Time) {
+	window := rl.Quota.Window
+	elapsed := now.Sub(rl.Flow.WindowStart)
+	if elapsed < window {
+		return
+	}
+
+	if elapsed < 2*window {
+		rl.Flow.PreviousInflow = rl.Flow.Inflow
+		rl.Flow.PreviousOutflow = rl.Flow.Outflow
+	} else {
+		rl.Flow.PreviousInflow = sdkmath.ZeroInt()
+		rl.Flow.PreviousOutflow = sdkmath.ZeroInt()
+	}
+
+	rl.Flow.Inflow = sdkmath.ZeroInt()
+	rl.Flow.Outflow = sdkmath.ZeroInt()
+	rl.Flow.WindowStart = rl.Flow.WindowStart.Add(elapsed / window * window)
+}
+
+// weighted returns the amount within the rolling window, weighting the amount
+// of the previous window by the part of it still covered by the rolling window.
+func (rl RateLimit) weighted(now time.Time, previous, current sdkmath.Int) sdkmath.Int {
+	window := rl.Quota.Window
+	elapsed := now.Sub(rl.Flow.WindowStart)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+
+	overlap := sdkmath.NewInt(int64(window - elapsed))
+	return current.Add(previous.Mul(overlap).Quo(sdkmath.NewInt(int64(window))))
+}
+
+// ValidateChannelAndDenom checks the channel identifier and the denom of a
+// filter or a rate limit.
+func ValidateChannelAndDenom(channelID, denom string) error {
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrap(ErrInvalidChannelOrDenom, err.Error())
+	}
+
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrap(ErrInvalidChannelOrDenom, err.Error())
+	}
+
+	return nil
+}
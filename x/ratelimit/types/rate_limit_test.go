@@ -0,0 +1,113 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+func (suite *RateLimitTestSuite) TestRollingWindow() {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	quota := NewQuota(sdkmath.NewInt(1000), sdkmath.NewInt(1000), time.Hour)
+
+	testCases := []struct {
+		name       string
+		elapsed    time.Duration
+		expInflow  int64
+		expOutflow int64
+	}{
+		{"current window", 30 * time.Minute, 800, 400},
+		{"previous window fully weighted", time.Hour, 800, 400},
+		{"previous window weighted by the overlap", 75 * time.Minute, 600, 300},
+		{"previous window no longer overlapping", 2 * time.Hour, 0, 0},
+		{"several windows later", 5*time.Hour + 30*time.Minute, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			rateLimit := NewRateLimit("channel-0", "atabi", quota, start)
+			suite.Require().NoError(rateLimit.AddInflow(start, sdkmath.NewInt(800)))
+			suite.Require().NoError(rateLimit.AddOutflow(start, sdkmath.NewInt(400)))
+
+			inflow, outflow := rateLimit.WindowFlow(start.Add(tc.elapsed))
+			suite.Require().Equal(sdkmath.NewInt(tc.expInflow), inflow)
+			suite.Require().Equal(sdkmath.NewInt(tc.expOutflow), outflow)
+		})
+	}
+}
+
+func (suite *RateLimitTestSuite) TestQuota() {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	quota := NewQuota(sdkmath.NewInt(1000), sdkmath.ZeroInt(), time.Hour)
+	rateLimit := NewRateLimit("channel-0", "atabi", quota, start)
+
+	suite.Require().NoError(rateLimit.AddInflow(start, sdkmath.NewInt(1000)))
+	suite.Require().ErrorIs(rateLimit.AddInflow(start, sdkmath.OneInt()), ErrQuotaExceeded)
+
+	// a zero quota does not limit the flow
+	suite.Require().NoError(rateLimit.AddOutflow(start, sdkmath.NewInt(1_000_000)))
+
+	// a quarter of the previous window is still covered by the rolling window
+	now := start.Add(105 * time.Minute)
+	suite.Require().NoError(rateLimit.AddInflow(now, sdkmath.NewInt(750)))
+	suite.Require().ErrorIs(rateLimit.AddInflow(now, sdkmath.OneInt()), ErrQuotaExceeded)
+
+	inflow, _ := rateLimit.WindowFlow(now)
+	suite.Require().Equal(sdkmath.NewInt(1000), inflow)
+	suite.Require().Equal(start.Add(time.Hour), rateLimit.Flow.WindowStart)
+}
+
+func (suite *RateLimitTestSuite) TestRemoveOutflow() {
+	start := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	quota := NewQuota(sdkmath.ZeroInt(), sdkmath.NewInt(1000), time.Hour)
+	rateLimit := NewRateLimit("channel-0", "atabi", quota, start)
+
+	suite.Require().NoError(rateLimit.AddOutflow(start, sdkmath.NewInt(600)))
+	now := start.Add(time.Hour)
+	suite.Require().NoError(rateLimit.AddOutflow(now, sdkmath.NewInt(100)))
+
+	// the current window is emptied before the previous one
+	rateLimit.RemoveOutflow(now, sdkmath.NewInt(300))
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+	suite.Require().Equal(sdkmath.NewInt(400), rateLimit.Flow.PreviousOutflow)
+
+	// the outflow never goes below zero
+	rateLimit.RemoveOutflow(now, sdkmath.NewInt(1000))
+	_, outflow := rateLimit.WindowFlow(now)
+	suite.Require().True(outflow.IsZero())
+}
+
+func (suite *RateLimitTestSuite) TestQuotaValidate() {
+	testCases := []struct {
+		name    string
+		quota   Quota
+		expPass bool
+	}{
+		{"pass - valid quota", NewQuota(sdkmath.NewInt(1), sdkmath.ZeroInt(), time.Hour), true},
+		{"fail - nil max inflow", Quota{MaxOutflow: sdkmath.ZeroInt(), Window: time.Hour}, false},
+		{"fail - negative max outflow", NewQuota(sdkmath.ZeroInt(), sdkmath.NewInt(-1), time.Hour), false},
+		{"fail - zero window", NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.quota.Validate()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v6/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v6/modules/core/04-channel/types"
+)
+
+// UnmarshalTransfer returns the fungible token packet data and its amount. It
+// returns false if the data is not a valid fungible token transfer, which is
+// then left to the transfer application.
+func UnmarshalTransfer(bz []byte) (transfertypes.FungibleTokenPacketData, sdkmath.Int, bool) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(bz, &data); err != nil {
+		return data, sdkmath.Int{}, false
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok || !amount.IsPositive() {
+		return data, sdkmath.Int{}, false
+	}
+
+	return data, amount, true
+}
+
+// ReceivedDenom returns the denom of the coins minted or unescrowed on this
+// chain when receiving the transfer, following the transfer application.
+func ReceivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the coins return to this chain, so the prefix added by the sender is removed
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		return transfertypes.ParseDenomTrace(data.Denom[len(voucherPrefix):]).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// SentDenom returns the denom of the coins escrowed or burned on this chain
+// when sending the transfer.
+func SentDenom(data transfertypes.FungibleTokenPacketData) string {
+	return transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+}
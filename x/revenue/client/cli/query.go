@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+// GetQueryCmd returns the cli query commands for the revenue module.
+func GetQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for revenue",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		GetCmdQueryParams(),
+		GetCmdQueryRevenues(),
+		GetCmdQueryRevenue(),
+		GetCmdQueryDeployerRevenues(),
+		GetCmdQueryWithdrawerRevenues(),
+	)
+
+	return cmd
+}
+
+// GetCmdQueryParams implements a command to fetch revenue parameters.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Query the current revenue parameters",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryRevenues implements a command to fetch all the registered contracts.
+func GetCmdQueryRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revenues",
+		Short: "Query all the contracts registered for the developer revenue share",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Revenues(cmd.Context(), &types.QueryRevenuesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "revenues")
+	return cmd
+}
+
+// GetCmdQueryRevenue implements a command to fetch the registration of a contract.
+func GetCmdQueryRevenue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revenue [contract]",
+		Short: "Query the revenue registration of a contract",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Revenue(cmd.Context(), &types.QueryRevenueRequest{ContractAddress: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Revenue)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDeployerRevenues implements a command to fetch the contracts registered by a deployer.
+func GetCmdQueryDeployerRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployer-revenues [deployer]",
+		Short: "Query the contracts registered by a deployer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.DeployerRevenues(cmd.Context(), &types.QueryDeployerRevenuesRequest{
+				DeployerAddress: args[0],
+				Pagination:      pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "deployer-revenues")
+	return cmd
+}
+
+// GetCmdQueryWithdrawerRevenues implements a command to fetch the contracts paying their revenue to a withdrawer.
+func GetCmdQueryWithdrawerRevenues() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdrawer-revenues [withdrawer]",
+		Short: "Query the contracts paying their revenue to a withdrawer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.WithdrawerRevenues(cmd.Context(), &types.QueryWithdrawerRevenuesRequest{
+				WithdrawerAddress: args[0],
+				Pagination:        pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "withdrawer-revenues")
+	return cmd
+}
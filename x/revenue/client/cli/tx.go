@@ -0,0 +1,163 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/spf13/cobra"
+
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/revenue transaction commands.
+func NewTxCmd() *cobra.Command {
+	revenueTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Revenue transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	revenueTxCmd.AddCommand(
+		NewRegisterRevenueCmd(),
+		NewUpdateRevenueCmd(),
+		NewCancelRevenueCmd(),
+	)
+
+	return revenueTxCmd
+}
+
+// NewRegisterRevenueCmd returns a CLI command handler for creating a MsgRegisterRevenue transaction.
+func NewRegisterRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [contract] [nonces] [withdrawer]",
+		Short: "Register a contract for the developer revenue share",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Register a contract deployed by the sender for the developer revenue share.
+The nonces are the comma separated nonces of the deployer and of the factories that created the contract.
+The withdrawer defaults to the deployer when omitted.
+Example:
+$ %s tx revenue register 0x5f6659B6F712c729c46786bA9562eC50907c67CF 4 --from mykey
+$ %s tx revenue register 0x5f6659B6F712c729c46786bA9562eC50907c67CF 4,1 tabi1... --from mykey
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address %s", args[0])
+			}
+
+			var nonces []uint64
+			for _, s := range strings.Split(args[1], ",") {
+				nonce, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", s, err)
+				}
+				nonces = append(nonces, nonce)
+			}
+
+			var withdrawer sdk.AccAddress
+			if len(args) == 3 {
+				if withdrawer, err = sdk.AccAddressFromBech32(args[2]); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgRegisterRevenue(common.HexToAddress(args[0]), clientCtx.GetFromAddress(), withdrawer, nonces)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewUpdateRevenueCmd returns a CLI command handler for creating a MsgUpdateRevenue transaction.
+func NewUpdateRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update [contract] [withdrawer]",
+		Short: "Update the withdrawer of a registered contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Update the withdrawer of a contract registered by the sender.
+Example:
+$ %s tx revenue update 0x5f6659B6F712c729c46786bA9562eC50907c67CF tabi1... --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address %s", args[0])
+			}
+
+			withdrawer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateRevenue(common.HexToAddress(args[0]), clientCtx.GetFromAddress(), withdrawer)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewCancelRevenueCmd returns a CLI command handler for creating a MsgCancelRevenue transaction.
+func NewCancelRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel [contract]",
+		Short: "Cancel the revenue registration of a contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel the revenue registration of a contract registered by the sender.
+Example:
+$ %s tx revenue cancel 0x5f6659B6F712c729c46786bA9562eC50907c67CF --from mykey
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract address %s", args[0])
+			}
+
+			msg := types.NewMsgCancelRevenue(common.HexToAddress(args[0]), clientCtx.GetFromAddress())
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	evmtypes "github.com/tabilabs/tabi/x/evm/types"
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for the revenue keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct of the revenue keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. When the tx is a call
+// to a registered contract, the developer shares of the fees paid for the gas
+// used are sent from the fee collector to the withdrawer of the contract.
+func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	params := h.k.GetParams(ctx)
+	if !params.EnableRevenue || msg.To() == nil {
+		return nil
+	}
+
+	revenue, found := h.k.GetRevenue(ctx, *msg.To())
+	if !found {
+		return nil
+	}
+
+	txFee := sdk.NewIntFromUint64(receipt.GasUsed).Mul(sdk.NewIntFromBigInt(msg.GasPrice()))
+	developerFee := sdk.NewDecFromInt(txFee).Mul(params.DeveloperShares).TruncateInt()
+	if !developerFee.IsPositive() {
+		return nil
+	}
+
+	evmDenom := h.k.evmKeeper.GetParams(ctx).EvmDenom
+	fees := sdk.NewCoins(sdk.NewCoin(evmDenom, developerFee))
+
+	if err := h.k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, revenue.GetWithdrawerAddr(), fees); err != nil {
+		return errorsmod.Wrapf(err, "failed to distribute the developer revenue of contract %s", revenue.ContractAddress)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDistributeDevRevenue,
+		sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyWithdrawer, revenue.WithdrawerAddress),
+		sdk.NewAttribute(types.AttributeKeyAmount, fees.String()),
+	))
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/tabilabs/tabi/testutil"
+	utiltx "github.com/tabilabs/tabi/testutil/tx"
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+func (suite *IntegrationTestSuite) TestPostTxProcessing() {
+	const (
+		gasUsed  = 100_000
+		gasPrice = 1_000_000_000
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		to        func() *common.Address
+		expAmount int64
+	}{
+		{
+			name:      "success: developer shares paid to the withdrawer",
+			malleate:  suite.registerContract,
+			to:        func() *common.Address { return &suite.contract },
+			expAmount: gasUsed * gasPrice / 2,
+		},
+		{
+			name: "no revenue: disabled",
+			malleate: func() {
+				suite.registerContract()
+				suite.Require().NoError(suite.App.RevenueKeeper.SetParams(suite.Ctx, types.DefaultParams()))
+			},
+			to: func() *common.Address { return &suite.contract },
+		},
+		{
+			name:     "no revenue: contract not registered",
+			malleate: func() {},
+			to:       func() *common.Address { return &suite.contract },
+		},
+		{
+			name:     "no revenue: contract creation",
+			malleate: suite.registerContract,
+			to:       func() *common.Address { return nil },
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			denom := suite.App.EvmKeeper.GetParams(suite.Ctx).EvmDenom
+			fees := sdk.NewCoins(sdk.NewInt64Coin(denom, gasUsed*gasPrice))
+			suite.Require().NoError(testutil.FundModuleAccount(suite.Ctx, suite.App.BankKeeper, authtypes.FeeCollectorName, fees))
+
+			from := utiltx.GenerateAddress()
+			msg := ethtypes.NewMessage(from, tc.to(), 0, big.NewInt(0), gasUsed, big.NewInt(gasPrice), nil, nil, nil, nil, true)
+			receipt := &ethtypes.Receipt{GasUsed: gasUsed}
+
+			err := suite.App.RevenueKeeper.Hooks().PostTxProcessing(suite.Ctx, msg, receipt)
+			suite.Require().NoError(err)
+
+			balance := suite.App.BankKeeper.GetBalance(suite.Ctx, suite.withdrawer, denom)
+			suite.Require().Equal(sdk.NewInt(tc.expAmount), balance.Amount)
+		})
+	}
+}
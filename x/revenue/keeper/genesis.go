@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+// InitGenesis sets the revenue module's parameters and registered contracts.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs *types.GenesisState) {
+	if err := k.SetParams(ctx, gs.Params); err != nil {
+		panic(err)
+	}
+
+	for _, revenue := range gs.Revenues {
+		contract := revenue.GetContractAddr()
+		k.SetRevenue(ctx, revenue)
+		k.SetDeployerMap(ctx, revenue.GetDeployerAddr(), contract)
+		k.SetWithdrawerMap(ctx, revenue.GetWithdrawerAddr(), contract)
+	}
+}
+
+// ExportGenesis returns the revenue module's parameters and registered contracts.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:   k.GetParams(ctx),
+		Revenues: k.GetRevenues(ctx),
+	}
+}
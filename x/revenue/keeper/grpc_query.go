@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+type Querier struct {
+	k *Keeper
+}
+
+// NewQuerierImpl returns an implementation of the revenue QueryServer interface.
+func NewQuerierImpl(keeper *Keeper) Querier {
+	return Querier{keeper}
+}
+
+var _ types.QueryServer = Querier{}
+
+// Params queries the params of revenue.
+func (q Querier) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := q.k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Revenues queries all the registered contracts.
+func (q Querier) Revenues(goCtx context.Context, req *types.QueryRevenuesRequest) (*types.QueryRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var revenues []types.Revenue
+	revenueStore := prefix.NewStore(ctx.KVStore(q.k.storeKey), types.RevenueKey)
+	pageRes, err := query.Paginate(revenueStore, req.Pagination, func(_, value []byte) error {
+		var revenue types.Revenue
+		q.k.cdc.MustUnmarshal(value, &revenue)
+		revenues = append(revenues, revenue)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return &types.QueryRevenuesResponse{
+		Revenues:   revenues,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Revenue queries the registration of a contract.
+func (q Querier) Revenue(goCtx context.Context, req *types.QueryRevenueRequest) (*types.QueryRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if !common.IsHexAddress(req.ContractAddress) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid contract address %s", req.ContractAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	revenue, found := q.k.GetRevenue(ctx, common.HexToAddress(req.ContractAddress))
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "revenue registration not found for contract %s", req.ContractAddress)
+	}
+
+	return &types.QueryRevenueResponse{Revenue: revenue}, nil
+}
+
+// DeployerRevenues queries the contracts registered by a deployer.
+func (q Querier) DeployerRevenues(goCtx context.Context, req *types.QueryDeployerRevenuesRequest) (*types.QueryDeployerRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(req.DeployerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid deployer address %s", req.DeployerAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contracts, pageRes, err := q.paginateContracts(ctx, types.DeployerStorePrefixKey(deployer), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDeployerRevenuesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// WithdrawerRevenues queries the contracts paying their revenue to a withdrawer.
+func (q Querier) WithdrawerRevenues(goCtx context.Context, req *types.QueryWithdrawerRevenuesRequest) (*types.QueryWithdrawerRevenuesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(req.WithdrawerAddress)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid withdrawer address %s", req.WithdrawerAddress)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contracts, pageRes, err := q.paginateContracts(ctx, types.WithdrawerStorePrefixKey(withdrawer), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryWithdrawerRevenuesResponse{
+		ContractAddresses: contracts,
+		Pagination:        pageRes,
+	}, nil
+}
+
+// paginateContracts returns the contracts indexed under the given prefix.
+func (q Querier) paginateContracts(ctx sdk.Context, prefixKey []byte, pageReq *query.PageRequest) ([]string, *query.PageResponse, error) {
+	var contracts []string
+	store := prefix.NewStore(ctx.KVStore(q.k.storeKey), prefixKey)
+	pageRes, err := query.Paginate(store, pageReq, func(key, _ []byte) error {
+		contracts = append(contracts, common.BytesToAddress(key).Hex())
+		return nil
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "paginate: %v", err)
+	}
+
+	return contracts, pageRes, nil
+}
@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+func (suite *IntegrationTestSuite) TestQueryRevenues() {
+	suite.registerContract()
+
+	res, err := suite.QueryClient.Revenues(suite.Ctx, &types.QueryRevenuesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.Revenue{types.NewRevenue(suite.contract, suite.deployer, suite.withdrawer)}, res.Revenues)
+
+	revenueRes, err := suite.QueryClient.Revenue(suite.Ctx, &types.QueryRevenueRequest{ContractAddress: suite.contract.Hex()})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.NewRevenue(suite.contract, suite.deployer, suite.withdrawer), revenueRes.Revenue)
+
+	_, err = suite.QueryClient.Revenue(suite.Ctx, &types.QueryRevenueRequest{ContractAddress: suite.deployContract(suite.contract, 3).Hex()})
+	suite.Require().Error(err)
+
+	deployerRes, err := suite.QueryClient.DeployerRevenues(suite.Ctx, &types.QueryDeployerRevenuesRequest{DeployerAddress: suite.deployer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{suite.contract.Hex()}, deployerRes.ContractAddresses)
+
+	withdrawerRes, err := suite.QueryClient.WithdrawerRevenues(suite.Ctx, &types.QueryWithdrawerRevenuesRequest{WithdrawerAddress: suite.withdrawer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{suite.contract.Hex()}, withdrawerRes.ContractAddresses)
+
+	withdrawerRes, err = suite.QueryClient.WithdrawerRevenues(suite.Ctx, &types.QueryWithdrawerRevenuesRequest{WithdrawerAddress: suite.deployer.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(withdrawerRes.ContractAddresses)
+}
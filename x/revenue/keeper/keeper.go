@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+// Keeper of the revenue store
+type Keeper struct {
+	cdc      codec.BinaryCodec
+	storeKey storetypes.StoreKey
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	bankKeeper types.BankKeeper
+	evmKeeper  types.EvmKeeper
+}
+
+// NewKeeper creates a new revenue Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	key storetypes.StoreKey,
+	authority sdk.AccAddress,
+	bankKeeper types.BankKeeper,
+	evmKeeper types.EvmKeeper,
+) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   key,
+		authority:  authority,
+		bankKeeper: bankKeeper,
+		evmKeeper:  evmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the parameters of the revenue module
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	store.Set(types.ParamsKey, bz)
+	return nil
+}
+
+// GetParams returns the total set of revenue parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	var params types.Params
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.ParamsKey)
+	if len(bz) == 0 {
+		return params
+	}
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
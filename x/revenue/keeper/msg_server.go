@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+type msgServer struct {
+	k *Keeper
+}
+
+var _ types.MsgServer = msgServer{}
+
+// NewMsgServerImpl returns an implementation of the revenue MsgServer interface
+func NewMsgServerImpl(keeper *Keeper) msgServer {
+	return msgServer{keeper}
+}
+
+// RegisterRevenue registers a contract deployed by the sender for the developer revenue share
+func (m msgServer) RegisterRevenue(goCtx context.Context, msg *types.MsgRegisterRevenue) (*types.MsgRegisterRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params := m.k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if m.k.IsRevenueRegistered(ctx, contract) {
+		return nil, errorsmod.Wrapf(types.ErrRevenueAlreadyRegistered, "contract %s", contract)
+	}
+
+	deployer, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid deployer address: %s", msg.DeployerAddress)
+	}
+
+	deployerAccount := m.k.evmKeeper.GetAccountWithoutBalance(ctx, common.BytesToAddress(deployer))
+	if deployerAccount != nil && deployerAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueDeployerIsNotEOA, "deployer %s", msg.DeployerAddress)
+	}
+
+	// the withdrawer defaults to the deployer
+	withdrawer := deployer
+	if msg.WithdrawerAddress != "" {
+		if withdrawer, err = sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %s", msg.WithdrawerAddress)
+		}
+	}
+
+	if m.k.bankKeeper.BlockedAddr(withdrawer) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", withdrawer)
+	}
+
+	// the contract must have been created by the deployer, either directly or
+	// through a chain of factories, each nonce deriving the next address.
+	derived := common.BytesToAddress(deployer)
+	for _, nonce := range msg.Nonces {
+		ctx.GasMeter().ConsumeGas(params.AddrDerivationCostCreate, "revenue registration: address derivation CREATE opcode")
+		derived = crypto.CreateAddress(derived, nonce)
+	}
+
+	if derived != contract {
+		return nil, errorsmod.Wrapf(types.ErrRevenueAddressDerivation, "expected %s, got %s", contract, derived)
+	}
+
+	contractAccount := m.k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if contractAccount == nil || !contractAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueNoContractCode, "contract %s", contract)
+	}
+
+	revenue := types.NewRevenue(contract, deployer, withdrawer)
+	m.k.SetRevenue(ctx, revenue)
+	m.k.SetDeployerMap(ctx, deployer, contract)
+	m.k.SetWithdrawerMap(ctx, withdrawer, contract)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterRevenue,
+		sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+		sdk.NewAttribute(types.AttributeKeyWithdrawer, revenue.WithdrawerAddress),
+	))
+
+	return &types.MsgRegisterRevenueResponse{}, nil
+}
+
+// UpdateRevenue updates the withdrawer of a registered contract
+func (m msgServer) UpdateRevenue(goCtx context.Context, msg *types.MsgUpdateRevenue) (*types.MsgUpdateRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := m.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid withdrawer address: %s", msg.WithdrawerAddress)
+	}
+
+	if revenue.WithdrawerAddress == withdrawer.String() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "withdrawer is already %s", withdrawer)
+	}
+
+	if m.k.bankKeeper.BlockedAddr(withdrawer) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", withdrawer)
+	}
+
+	contract := revenue.GetContractAddr()
+	m.k.DeleteWithdrawerMap(ctx, revenue.GetWithdrawerAddr(), contract)
+	m.k.SetWithdrawerMap(ctx, withdrawer, contract)
+
+	revenue.WithdrawerAddress = withdrawer.String()
+	m.k.SetRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateRevenue,
+		sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+		sdk.NewAttribute(types.AttributeKeyWithdrawer, revenue.WithdrawerAddress),
+	))
+
+	return &types.MsgUpdateRevenueResponse{}, nil
+}
+
+// CancelRevenue removes the registration of a contract
+func (m msgServer) CancelRevenue(goCtx context.Context, msg *types.MsgCancelRevenue) (*types.MsgCancelRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if !m.k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := m.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	contract := revenue.GetContractAddr()
+	m.k.DeleteRevenue(ctx, revenue)
+	m.k.DeleteDeployerMap(ctx, revenue.GetDeployerAddr(), contract)
+	m.k.DeleteWithdrawerMap(ctx, revenue.GetWithdrawerAddr(), contract)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCancelRevenue,
+		sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeyDeployer, revenue.DeployerAddress),
+	))
+
+	return &types.MsgCancelRevenueResponse{}, nil
+}
+
+// UpdateParams defines a method that allows to update the parameters of the module
+func (m msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", msg.Authority)
+	}
+
+	if m.k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"invalid authority: expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.k.SetParams(ctx, msg.Params); err != nil {
+		return nil, errorsmod.Wrap(err, "failed to set params")
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// getDeployerRevenue returns the registration of the contract, checking that it was registered by the deployer.
+func (m msgServer) getDeployerRevenue(ctx sdk.Context, contractAddress, deployerAddress string) (types.Revenue, error) {
+	contract := common.HexToAddress(contractAddress)
+	revenue, found := m.k.GetRevenue(ctx, contract)
+	if !found {
+		return types.Revenue{}, errorsmod.Wrapf(types.ErrRevenueNotFound, "contract %s", contract)
+	}
+
+	if revenue.DeployerAddress != deployerAddress {
+		return types.Revenue{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized,
+			"invalid deployer: expected %s, got %s", revenue.DeployerAddress, deployerAddress)
+	}
+
+	return revenue, nil
+}
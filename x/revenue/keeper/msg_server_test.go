@@ -0,0 +1,187 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	utiltx "github.com/tabilabs/tabi/testutil/tx"
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+func (suite *IntegrationTestSuite) TestRegisterRevenue() {
+	testCases := []struct {
+		name          string
+		malleate      func() *types.MsgRegisterRevenue
+		expErr        error
+		expWithdrawer func() sdk.AccAddress
+	}{
+		{
+			name: "success: withdrawer defaults to the deployer",
+			malleate: func() *types.MsgRegisterRevenue {
+				return types.NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, []uint64{1})
+			},
+			expWithdrawer: func() sdk.AccAddress { return suite.deployer },
+		},
+		{
+			name: "success: with withdrawer",
+			malleate: func() *types.MsgRegisterRevenue {
+				return types.NewMsgRegisterRevenue(suite.contract, suite.deployer, suite.withdrawer, []uint64{1})
+			},
+			expWithdrawer: func() sdk.AccAddress { return suite.withdrawer },
+		},
+		{
+			name: "success: contract created by a factory",
+			malleate: func() *types.MsgRegisterRevenue {
+				contract := suite.deployContract(suite.contract, 3)
+				return types.NewMsgRegisterRevenue(contract, suite.deployer, nil, []uint64{1, 3})
+			},
+			expWithdrawer: func() sdk.AccAddress { return suite.deployer },
+		},
+		{
+			name: "failure: revenue disabled",
+			malleate: func() *types.MsgRegisterRevenue {
+				suite.Require().NoError(suite.App.RevenueKeeper.SetParams(suite.Ctx, types.DefaultParams()))
+				return types.NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, []uint64{1})
+			},
+			expErr: types.ErrRevenueDisabled,
+		},
+		{
+			name: "failure: already registered",
+			malleate: func() *types.MsgRegisterRevenue {
+				suite.registerContract()
+				return types.NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, []uint64{1})
+			},
+			expErr: types.ErrRevenueAlreadyRegistered,
+		},
+		{
+			name: "failure: not the deployer",
+			malleate: func() *types.MsgRegisterRevenue {
+				return types.NewMsgRegisterRevenue(suite.contract, suite.withdrawer, nil, []uint64{1})
+			},
+			expErr: types.ErrRevenueAddressDerivation,
+		},
+		{
+			name: "failure: wrong nonce",
+			malleate: func() *types.MsgRegisterRevenue {
+				return types.NewMsgRegisterRevenue(suite.contract, suite.deployer, nil, []uint64{2})
+			},
+			expErr: types.ErrRevenueAddressDerivation,
+		},
+		{
+			name: "failure: deployer is a contract",
+			malleate: func() *types.MsgRegisterRevenue {
+				contract := suite.deployContract(suite.contract, 3)
+				return types.NewMsgRegisterRevenue(contract, sdk.AccAddress(suite.contract.Bytes()), nil, []uint64{3})
+			},
+			expErr: types.ErrRevenueDeployerIsNotEOA,
+		},
+		{
+			name: "failure: no contract code",
+			malleate: func() *types.MsgRegisterRevenue {
+				contract := crypto.CreateAddress(suite.contract, 3)
+				return types.NewMsgRegisterRevenue(contract, suite.deployer, nil, []uint64{1, 3})
+			},
+			expErr: types.ErrRevenueNoContractCode,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg := tc.malleate()
+			_, err := suite.MsgServer.RegisterRevenue(suite.Ctx, msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			contract := common.HexToAddress(msg.ContractAddress)
+			revenue, found := suite.App.RevenueKeeper.GetRevenue(suite.Ctx, contract)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.deployer.String(), revenue.DeployerAddress)
+			suite.Require().Equal(tc.expWithdrawer().String(), revenue.WithdrawerAddress)
+			suite.Require().True(suite.App.RevenueKeeper.IsDeployerMapSet(suite.Ctx, suite.deployer, contract))
+			suite.Require().True(suite.App.RevenueKeeper.IsWithdrawerMapSet(suite.Ctx, tc.expWithdrawer(), contract))
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestUpdateRevenue() {
+	newWithdrawer := sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		deployer func() sdk.AccAddress
+		expErr   bool
+	}{
+		{
+			name:     "success",
+			malleate: suite.registerContract,
+			deployer: func() sdk.AccAddress { return suite.deployer },
+		},
+		{
+			name:     "failure: not registered",
+			malleate: func() {},
+			deployer: func() sdk.AccAddress { return suite.deployer },
+			expErr:   true,
+		},
+		{
+			name:     "failure: not the deployer",
+			malleate: suite.registerContract,
+			deployer: func() sdk.AccAddress { return suite.withdrawer },
+			expErr:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			_, err := suite.MsgServer.UpdateRevenue(suite.Ctx, types.NewMsgUpdateRevenue(suite.contract, tc.deployer(), newWithdrawer))
+			if tc.expErr {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			revenue, found := suite.App.RevenueKeeper.GetRevenue(suite.Ctx, suite.contract)
+			suite.Require().True(found)
+			suite.Require().Equal(newWithdrawer.String(), revenue.WithdrawerAddress)
+			suite.Require().False(suite.App.RevenueKeeper.IsWithdrawerMapSet(suite.Ctx, suite.withdrawer, suite.contract))
+			suite.Require().True(suite.App.RevenueKeeper.IsWithdrawerMapSet(suite.Ctx, newWithdrawer, suite.contract))
+		})
+	}
+}
+
+func (suite *IntegrationTestSuite) TestCancelRevenue() {
+	suite.registerContract()
+
+	_, err := suite.MsgServer.CancelRevenue(suite.Ctx, types.NewMsgCancelRevenue(suite.contract, suite.withdrawer))
+	suite.Require().Error(err)
+
+	_, err = suite.MsgServer.CancelRevenue(suite.Ctx, types.NewMsgCancelRevenue(suite.contract, suite.deployer))
+	suite.Require().NoError(err)
+
+	suite.Require().False(suite.App.RevenueKeeper.IsRevenueRegistered(suite.Ctx, suite.contract))
+	suite.Require().False(suite.App.RevenueKeeper.IsDeployerMapSet(suite.Ctx, suite.deployer, suite.contract))
+	suite.Require().False(suite.App.RevenueKeeper.IsWithdrawerMapSet(suite.Ctx, suite.withdrawer, suite.contract))
+
+	_, err = suite.MsgServer.CancelRevenue(suite.Ctx, types.NewMsgCancelRevenue(suite.contract, suite.deployer))
+	suite.Require().ErrorIs(err, types.ErrRevenueNotFound)
+}
+
+func (suite *IntegrationTestSuite) TestUpdateParams() {
+	params := types.NewParams(true, sdk.NewDecWithPrec(2, 1), 100)
+
+	_, err := suite.MsgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(suite.deployer.String(), params))
+	suite.Require().Error(err)
+
+	_, err = suite.MsgServer.UpdateParams(suite.Ctx, types.NewMsgUpdateParams(authority, params))
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.App.RevenueKeeper.GetParams(suite.Ctx))
+}
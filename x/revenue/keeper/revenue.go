@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+// GetRevenues returns all the registered contracts.
+func (k Keeper) GetRevenues(ctx sdk.Context) []types.Revenue {
+	var revenues []types.Revenue
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RevenueKey)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.Revenue
+		k.cdc.MustUnmarshal(iterator.Value(), &revenue)
+		revenues = append(revenues, revenue)
+	}
+
+	return revenues
+}
+
+// GetRevenue returns the registration of the given contract.
+func (k Keeper) GetRevenue(ctx sdk.Context, contract common.Address) (types.Revenue, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.RevenueStoreKey(contract))
+	if len(bz) == 0 {
+		return types.Revenue{}, false
+	}
+
+	var revenue types.Revenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue, true
+}
+
+// SetRevenue stores the registration of a contract.
+func (k Keeper) SetRevenue(ctx sdk.Context, revenue types.Revenue) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&revenue)
+	store.Set(types.RevenueStoreKey(revenue.GetContractAddr()), bz)
+}
+
+// DeleteRevenue removes the registration of a contract.
+func (k Keeper) DeleteRevenue(ctx sdk.Context, revenue types.Revenue) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.RevenueStoreKey(revenue.GetContractAddr()))
+}
+
+// IsRevenueRegistered checks if the contract is registered for the revenue share.
+func (k Keeper) IsRevenueRegistered(ctx sdk.Context, contract common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.RevenueStoreKey(contract))
+}
+
+// SetDeployerMap indexes the contract under its deployer.
+func (k Keeper) SetDeployerMap(ctx sdk.Context, deployer sdk.AccAddress, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.DeployerStoreKey(deployer, contract), []byte{0x1})
+}
+
+// DeleteDeployerMap removes the contract from its deployer index.
+func (k Keeper) DeleteDeployerMap(ctx sdk.Context, deployer sdk.AccAddress, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.DeployerStoreKey(deployer, contract))
+}
+
+// IsDeployerMapSet checks if the contract is indexed under the deployer.
+func (k Keeper) IsDeployerMapSet(ctx sdk.Context, deployer sdk.AccAddress, contract common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.DeployerStoreKey(deployer, contract))
+}
+
+// SetWithdrawerMap indexes the contract under its withdrawer.
+func (k Keeper) SetWithdrawerMap(ctx sdk.Context, withdrawer sdk.AccAddress, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.WithdrawerStoreKey(withdrawer, contract), []byte{0x1})
+}
+
+// DeleteWithdrawerMap removes the contract from its withdrawer index.
+func (k Keeper) DeleteWithdrawerMap(ctx sdk.Context, withdrawer sdk.AccAddress, contract common.Address) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.WithdrawerStoreKey(withdrawer, contract))
+}
+
+// IsWithdrawerMapSet checks if the contract is indexed under the withdrawer.
+func (k Keeper) IsWithdrawerMapSet(ctx sdk.Context, withdrawer sdk.AccAddress, contract common.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.WithdrawerStoreKey(withdrawer, contract))
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/tabilabs/tabi/app"
+	"github.com/tabilabs/tabi/crypto/ethsecp256k1"
+	"github.com/tabilabs/tabi/testutil"
+	utiltx "github.com/tabilabs/tabi/testutil/tx"
+	"github.com/tabilabs/tabi/x/evm/statedb"
+	feemarkettypes "github.com/tabilabs/tabi/x/feemarket/types"
+	revenuekeeper "github.com/tabilabs/tabi/x/revenue/keeper"
+	"github.com/tabilabs/tabi/x/revenue/types"
+)
+
+var authority = authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+type IntegrationTestSuite struct {
+	suite.Suite
+
+	App *app.Tabi
+	Ctx sdk.Context
+
+	MsgServer   types.MsgServer
+	QueryClient types.QueryClient
+
+	deployer   sdk.AccAddress
+	withdrawer sdk.AccAddress
+	contract   common.Address
+}
+
+func TestIntegrationTestSuite(t *testing.T) {
+	suite.Run(t, new(IntegrationTestSuite))
+}
+
+func (suite *IntegrationTestSuite) SetupTest() {
+	t := suite.T()
+
+	// consensus key
+	privCons, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	consAddress := sdk.ConsAddress(privCons.PubKey().Address())
+
+	suite.App = app.Setup(false, feemarkettypes.DefaultGenesisState())
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), "tabi_9788-1", consAddress, nil, nil,
+	)
+	suite.Ctx = suite.App.NewContext(false, header)
+
+	suite.MsgServer = revenuekeeper.NewMsgServerImpl(&suite.App.RevenueKeeper)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.Ctx, suite.App.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, revenuekeeper.NewQuerierImpl(&suite.App.RevenueKeeper))
+	suite.QueryClient = types.NewQueryClient(queryHelper)
+
+	params := types.DefaultParams()
+	params.EnableRevenue = true
+	require.NoError(t, suite.App.RevenueKeeper.SetParams(suite.Ctx, params))
+
+	suite.deployer = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.withdrawer = sdk.AccAddress(utiltx.GenerateAddress().Bytes())
+	suite.contract = suite.deployContract(common.BytesToAddress(suite.deployer), 1)
+}
+
+// deployContract sets a contract account at the address created by the deployer with the given nonce.
+func (suite *IntegrationTestSuite) deployContract(deployer common.Address, nonce uint64) common.Address {
+	contract := crypto.CreateAddress(deployer, nonce)
+	code := []byte("contract code")
+	codeHash := crypto.Keccak256(code)
+
+	suite.App.EvmKeeper.SetCode(suite.Ctx, codeHash, code)
+	err := suite.App.EvmKeeper.SetAccount(suite.Ctx, contract, statedb.Account{
+		Nonce:    1,
+		Balance:  big.NewInt(0),
+		CodeHash: codeHash,
+	})
+	suite.Require().NoError(err)
+
+	return contract
+}
+
+// registerContract registers the contract of the suite for the revenue share.
+func (suite *IntegrationTestSuite) registerContract() {
+	_, err := suite.MsgServer.RegisterRevenue(suite.Ctx, types.NewMsgRegisterRevenue(suite.contract, suite.deployer, suite.withdrawer, []uint64{1}))
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	cryptocodec "github.com/tabilabs/tabi/crypto/codec"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+var (
+	amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewAminoCodec(amino)
+)
+
+const (
+	// Amino names
+	registerRevenueName = "revenue/MsgRegisterRevenue"
+	updateRevenueName   = "revenue/MsgUpdateRevenue"
+	cancelRevenueName   = "revenue/MsgCancelRevenue"
+	updateParamsName    = "revenue/MsgUpdateParams"
+)
+
+func init() {
+	cryptocodec.RegisterCrypto(amino)
+	RegisterLegacyAminoCodec(amino)
+	amino.Seal()
+}
+
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegisterRevenue{},
+		&MsgUpdateRevenue{},
+		&MsgCancelRevenue{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+// RegisterLegacyAminoCodec required for EIP-712
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgRegisterRevenue{}, registerRevenueName, nil)
+	cdc.RegisterConcrete(&MsgUpdateRevenue{}, updateRevenueName, nil)
+	cdc.RegisterConcrete(&MsgCancelRevenue{}, cancelRevenueName, nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+}
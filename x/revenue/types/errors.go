@@ -0,0 +1,12 @@
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+var (
+	ErrRevenueDisabled          = errorsmod.Register(ModuleName, 2, "revenue module is disabled by governance")
+	ErrRevenueAlreadyRegistered = errorsmod.Register(ModuleName, 3, "contract is already registered for revenue")
+	ErrRevenueNotFound          = errorsmod.Register(ModuleName, 4, "contract is not registered for revenue")
+	ErrRevenueDeployerIsNotEOA  = errorsmod.Register(ModuleName, 5, "deployer is not an externally owned account")
+	ErrRevenueNoContractCode    = errorsmod.Register(ModuleName, 6, "no contract code found at the contract address")
+	ErrRevenueAddressDerivation = errorsmod.Register(ModuleName, 7, "contract address is not derived from the deployer and nonces")
+)
@@ -0,0 +1,13 @@
+package types
+
+const (
+	EventTypeRegisterRevenue      = "register_revenue"
+	EventTypeUpdateRevenue        = "update_revenue"
+	EventTypeCancelRevenue        = "cancel_revenue"
+	EventTypeDistributeDevRevenue = "distribute_dev_revenue"
+
+	AttributeKeyContract   = "contract"
+	AttributeKeyDeployer   = "deployer"
+	AttributeKeyWithdrawer = "withdrawer"
+	AttributeKeyAmount     = "amount"
+)
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/tabilabs/tabi/x/evm/statedb"
+	evmtypes "github.com/tabilabs/tabi/x/evm/types"
+)
+
+// BankKeeper defines the expected bank keeper used to pay the developer shares.
+type BankKeeper interface {
+	BlockedAddr(addr sdk.AccAddress) bool
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EvmKeeper defines the expected evm keeper used to verify the registered contracts.
+type EvmKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+}
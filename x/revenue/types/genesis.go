@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, revenues []Revenue) GenesisState {
+	return GenesisState{
+		Params:   params,
+		Revenues: revenues,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis performs basic validation of genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[common.Address]bool)
+	for _, revenue := range data.Revenues {
+		if err := revenue.Validate(); err != nil {
+			return err
+		}
+
+		contract := revenue.GetContractAddr()
+		if seen[contract] {
+			return fmt.Errorf("duplicate revenue registration for contract %s", contract)
+		}
+		seen[contract] = true
+	}
+
+	return nil
+}
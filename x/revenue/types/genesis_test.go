@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type GenesisTestSuite struct {
+	suite.Suite
+}
+
+func TestGenesisTestSuite(t *testing.T) {
+	suite.Run(t, new(GenesisTestSuite))
+}
+
+func (suite *GenesisTestSuite) TestValidateGenesis() {
+	revenue := NewRevenue(
+		common.HexToAddress("0x5f6659B6F712c729c46786bA9562eC50907c67CF"),
+		sdk.AccAddress("deployer____________"),
+		sdk.AccAddress("withdrawer__________"),
+	)
+
+	testCases := []struct {
+		name      string
+		genState  GenesisState
+		expectErr bool
+	}{
+		{
+			name:     "success: default genesis state",
+			genState: *DefaultGenesisState(),
+		},
+		{
+			name:     "success: with revenues",
+			genState: NewGenesisState(DefaultParams(), []Revenue{revenue}),
+		},
+		{
+			name:      "fail: invalid params",
+			genState:  NewGenesisState(NewParams(true, sdk.NewDec(-1), 50), nil),
+			expectErr: true,
+		},
+		{
+			name:      "fail: duplicate revenue",
+			genState:  NewGenesisState(DefaultParams(), []Revenue{revenue, revenue}),
+			expectErr: true,
+		},
+		{
+			name: "fail: invalid withdrawer",
+			genState: NewGenesisState(DefaultParams(), []Revenue{{
+				ContractAddress:   revenue.ContractAddress,
+				DeployerAddress:   revenue.DeployerAddress,
+				WithdrawerAddress: "invalid",
+			}}),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := ValidateGenesis(tc.genState)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "revenue"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey defines the module's message routing key
+	RouterKey = ModuleName
+)
+
+const (
+	prefixParams = iota + 1
+	prefixRevenue
+	prefixDeployer
+	prefixWithdrawer
+)
+
+// KVStore keys
+var (
+	ParamsKey     = []byte{prefixParams}
+	RevenueKey    = []byte{prefixRevenue}
+	DeployerKey   = []byte{prefixDeployer}
+	WithdrawerKey = []byte{prefixWithdrawer}
+)
+
+// RevenueStoreKey returns the byte representation of the revenue key
+// Items are stored with key as follows:
+// 0x02<contract>
+func RevenueStoreKey(contract common.Address) []byte {
+	bz := make([]byte, len(RevenueKey)+common.AddressLength)
+
+	copy(bz, RevenueKey)
+	copy(bz[len(RevenueKey):], contract.Bytes())
+
+	return bz
+}
+
+// DeployerStoreKey returns the byte representation of the contract by deployer key
+// Items are stored with key as follows:
+// 0x03<deployer(length prefixed)><contract>
+func DeployerStoreKey(deployer sdk.AccAddress, contract common.Address) []byte {
+	return append(DeployerStorePrefixKey(deployer), contract.Bytes()...)
+}
+
+// DeployerStorePrefixKey returns the byte representation of the contract by deployer prefix key
+// Items are stored with key as follows:
+// 0x03<deployer(length prefixed)>
+func DeployerStorePrefixKey(deployer sdk.AccAddress) []byte {
+	deployer = address.MustLengthPrefix(deployer)
+
+	bz := make([]byte, len(DeployerKey)+len(deployer))
+
+	copy(bz, DeployerKey)
+	copy(bz[len(DeployerKey):], deployer)
+
+	return bz
+}
+
+// WithdrawerStoreKey returns the byte representation of the contract by withdrawer key
+// Items are stored with key as follows:
+// 0x04<withdrawer(length prefixed)><contract>
+func WithdrawerStoreKey(withdrawer sdk.AccAddress, contract common.Address) []byte {
+	return append(WithdrawerStorePrefixKey(withdrawer), contract.Bytes()...)
+}
+
+// WithdrawerStorePrefixKey returns the byte representation of the contract by withdrawer prefix key
+// Items are stored with key as follows:
+// 0x04<withdrawer(length prefixed)>
+func WithdrawerStorePrefixKey(withdrawer sdk.AccAddress) []byte {
+	withdrawer = address.MustLengthPrefix(withdrawer)
+
+	bz := make([]byte, len(WithdrawerKey)+len(withdrawer))
+
+	copy(bz, WithdrawerKey)
+	copy(bz[len(WithdrawerKey):], withdrawer)
+
+	return bz
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	TypeMsgRegisterRevenue = "register_revenue"
+	TypeMsgUpdateRevenue   = "update_revenue"
+	TypeMsgCancelRevenue   = "cancel_revenue"
+	TypeMsgUpdateParams    = "update_params"
+
+	// MaxNonces bounds the length of the contract creation chain that can be
+	// registered, i.e the deployer nonce and the nonces of the factories.
+	MaxNonces = 20
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterRevenue{}
+	_ sdk.Msg = &MsgUpdateRevenue{}
+	_ sdk.Msg = &MsgCancelRevenue{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgRegisterRevenue defines a message to register a contract for the developer revenue share
+func NewMsgRegisterRevenue(contract common.Address, deployer, withdrawer sdk.AccAddress, nonces []uint64) *MsgRegisterRevenue {
+	withdrawerAddress := ""
+	if withdrawer != nil {
+		withdrawerAddress = withdrawer.String()
+	}
+
+	return &MsgRegisterRevenue{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddress,
+		Nonces:            nonces,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgRegisterRevenue) ValidateBasic() error {
+	if err := validateContractAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	if msg.WithdrawerAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+			return errorsmod.Wrap(err, "invalid withdrawer address")
+		}
+	}
+
+	if len(msg.Nonces) == 0 || len(msg.Nonces) > MaxNonces {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "nonces length must be between 1 and %d: %d", MaxNonces, len(msg.Nonces))
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgRegisterRevenue) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgUpdateRevenue defines a message to update the withdrawer of a registered contract
+func NewMsgUpdateRevenue(contract common.Address, deployer, withdrawer sdk.AccAddress) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgUpdateRevenue) ValidateBasic() error {
+	if err := validateContractAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid withdrawer address")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgUpdateRevenue) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgCancelRevenue defines a message to cancel the registration of a contract
+func NewMsgCancelRevenue(contract common.Address, deployer sdk.AccAddress) *MsgCancelRevenue {
+	return &MsgCancelRevenue{
+		ContractAddress: contract.Hex(),
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgCancelRevenue) ValidateBasic() error {
+	if err := validateContractAddress(msg.ContractAddress); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.DeployerAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid deployer address")
+	}
+
+	return nil
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgCancelRevenue) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// NewMsgUpdateParams defines a message to update the params of the revenue module
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return msg.Params.Validate()
+}
+
+// GetSigners implements sdk.Msg
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	fromAddr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{fromAddr}
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgRegisterRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgRegisterRevenue) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgRegisterRevenue) Type() string { return TypeMsgRegisterRevenue }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgUpdateRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgUpdateRevenue) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgUpdateRevenue) Type() string { return TypeMsgUpdateRevenue }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgCancelRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgCancelRevenue) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgCancelRevenue) Type() string { return TypeMsgCancelRevenue }
+
+// GetSignBytes implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// Route implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Route() string { return RouterKey }
+
+// Type implements the LegacyMsg interface.
+func (msg *MsgUpdateParams) Type() string { return TypeMsgUpdateParams }
@@ -0,0 +1,112 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/suite"
+)
+
+type MsgsTestSuite struct {
+	suite.Suite
+}
+
+func TestMsgsTestSuite(t *testing.T) {
+	suite.Run(t, new(MsgsTestSuite))
+}
+
+func (suite *MsgsTestSuite) TestMsgRegisterRevenueValidateBasic() {
+	contract := common.HexToAddress("0x5f6659B6F712c729c46786bA9562eC50907c67CF")
+	deployer := sdk.AccAddress("deployer____________")
+
+	testCases := []struct {
+		name    string
+		msg     *MsgRegisterRevenue
+		expPass bool
+	}{
+		{
+			"pass - valid msg",
+			NewMsgRegisterRevenue(contract, deployer, nil, []uint64{1}),
+			true,
+		},
+		{
+			"pass - with withdrawer",
+			NewMsgRegisterRevenue(contract, deployer, sdk.AccAddress("withdrawer__________"), []uint64{1, 2}),
+			true,
+		},
+		{
+			"fail - zero contract address",
+			NewMsgRegisterRevenue(common.Address{}, deployer, nil, []uint64{1}),
+			false,
+		},
+		{
+			"fail - invalid deployer address",
+			&MsgRegisterRevenue{ContractAddress: contract.Hex(), DeployerAddress: "invalid", Nonces: []uint64{1}},
+			false,
+		},
+		{
+			"fail - invalid withdrawer address",
+			&MsgRegisterRevenue{ContractAddress: contract.Hex(), DeployerAddress: deployer.String(), WithdrawerAddress: "invalid", Nonces: []uint64{1}},
+			false,
+		},
+		{
+			"fail - no nonces",
+			NewMsgRegisterRevenue(contract, deployer, nil, nil),
+			false,
+		},
+		{
+			"fail - too many nonces",
+			NewMsgRegisterRevenue(contract, deployer, nil, make([]uint64, MaxNonces+1)),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
+
+func (suite *MsgsTestSuite) TestMsgUpdateParamsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *MsgUpdateParams
+		expPass bool
+	}{
+		{
+			"fail - invalid authority address",
+			NewMsgUpdateParams("invalid", DefaultParams()),
+			false,
+		},
+		{
+			"fail - invalid developer shares",
+			NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), NewParams(true, sdk.NewDec(2), 50)),
+			false,
+		},
+		{
+			"pass - valid msg",
+			NewMsgUpdateParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), DefaultParams()),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+			if tc.expPass {
+				suite.NoError(err)
+			} else {
+				suite.Error(err)
+			}
+		})
+	}
+}
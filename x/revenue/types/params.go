@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// DefaultEnableRevenue is false, the revenue share is enabled by governance
+	DefaultEnableRevenue = false
+	// DefaultDeveloperShares is 0.5 or 50%
+	DefaultDeveloperShares = sdk.NewDecWithPrec(50, 2)
+	// DefaultAddrDerivationCostCreate is the gas charged by the CREATE opcode
+	// on top of the contract creation cost
+	DefaultAddrDerivationCostCreate = uint64(50)
+)
+
+// NewParams returns a new Params object
+func NewParams(enableRevenue bool, developerShares sdk.Dec, addrDerivationCostCreate uint64) Params {
+	return Params{
+		EnableRevenue:            enableRevenue,
+		DeveloperShares:          developerShares,
+		AddrDerivationCostCreate: addrDerivationCostCreate,
+	}
+}
+
+// DefaultParams returns the default revenue module parameters
+func DefaultParams() Params {
+	return Params{
+		EnableRevenue:            DefaultEnableRevenue,
+		DeveloperShares:          DefaultDeveloperShares,
+		AddrDerivationCostCreate: DefaultAddrDerivationCostCreate,
+	}
+}
+
+// Validate performs basic validation on revenue parameters.
+func (p Params) Validate() error {
+	if p.DeveloperShares.IsNil() {
+		return fmt.Errorf("developer shares cannot be nil")
+	}
+
+	if p.DeveloperShares.IsNegative() {
+		return fmt.Errorf("developer shares cannot be negative: %s", p.DeveloperShares)
+	}
+
+	if p.DeveloperShares.GT(sdk.OneDec()) {
+		return fmt.Errorf("developer shares cannot be greater than 1: %s", p.DeveloperShares)
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewRevenue returns a new Revenue registration of a contract.
+func NewRevenue(contract common.Address, deployer, withdrawer sdk.AccAddress) Revenue {
+	return Revenue{
+		ContractAddress:   contract.Hex(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// GetContractAddr returns the contract address
+func (r Revenue) GetContractAddr() common.Address {
+	return common.HexToAddress(r.ContractAddress)
+}
+
+// GetDeployerAddr returns the deployer address
+func (r Revenue) GetDeployerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.DeployerAddress)
+}
+
+// GetWithdrawerAddr returns the withdrawer address
+func (r Revenue) GetWithdrawerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of a Revenue
+func (r Revenue) Validate() error {
+	if err := validateContractAddress(r.ContractAddress); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.DeployerAddress); err != nil {
+		return fmt.Errorf("invalid deployer address %s: %w", r.DeployerAddress, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.WithdrawerAddress); err != nil {
+		return fmt.Errorf("invalid withdrawer address %s: %w", r.WithdrawerAddress, err)
+	}
+
+	return nil
+}
+
+// validateContractAddress checks that the contract address is a non zero hex address.
+func validateContractAddress(contract string) error {
+	if !common.IsHexAddress(contract) {
+		return fmt.Errorf("invalid contract address %s", contract)
+	}
+
+	if common.HexToAddress(contract) == (common.Address{}) {
+		return fmt.Errorf("contract address cannot be the zero address")
+	}
+
+	return nil
+}
@@ -0,0 +1,685 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: tabi/revenue/v1/revenue.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the revenue module.
+type Params struct {
+	// enable_revenue toggles the payment of the developer shares of the tx fees.
+	EnableRevenue bool `protobuf:"varint,1,opt,name=enable_revenue,json=enableRevenue,proto3" json:"enable_revenue,omitempty"`
+	// developer_shares is the share of the EVM tx fees paid to the withdrawer
+	// of the called contract.
+	DeveloperShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=developer_shares,json=developerShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_shares"`
+	// addr_derivation_cost_create is the gas charged for each nonce used to
+	// derive the contract address on registration.
+	AddrDerivationCostCreate uint64 `protobuf:"varint,3,opt,name=addr_derivation_cost_create,json=addrDerivationCostCreate,proto3" json:"addr_derivation_cost_create,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5717e85d6b9cff5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnableRevenue() bool {
+	if m != nil {
+		return m.EnableRevenue
+	}
+	return false
+}
+
+func (m *Params) GetAddrDerivationCostCreate() uint64 {
+	if m != nil {
+		return m.AddrDerivationCostCreate
+	}
+	return 0
+}
+
+// Revenue defines a contract registered for the developer revenue share.
+type Revenue struct {
+	// contract_address is the hex address of the registered contract
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// deployer_address is the bech32 address of the contract deployer
+	DeployerAddress string `protobuf:"bytes,2,opt,name=deployer_address,json=deployerAddress,proto3" json:"deployer_address,omitempty"`
+	// withdrawer_address is the bech32 address receiving the developer shares
+	WithdrawerAddress string `protobuf:"bytes,3,opt,name=withdrawer_address,json=withdrawerAddress,proto3" json:"withdrawer_address,omitempty"`
+}
+
+func (m *Revenue) Reset()         { *m = Revenue{} }
+func (m *Revenue) String() string { return proto.CompactTextString(m) }
+func (*Revenue) ProtoMessage()    {}
+func (*Revenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5717e85d6b9cff5, []int{1}
+}
+func (m *Revenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Revenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Revenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Revenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Revenue.Merge(m, src)
+}
+func (m *Revenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *Revenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_Revenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Revenue proto.InternalMessageInfo
+
+func (m *Revenue) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Revenue) GetDeployerAddress() string {
+	if m != nil {
+		return m.DeployerAddress
+	}
+	return ""
+}
+
+func (m *Revenue) GetWithdrawerAddress() string {
+	if m != nil {
+		return m.WithdrawerAddress
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "tabi.revenue.v1.Params")
+	proto.RegisterType((*Revenue)(nil), "tabi.revenue.v1.Revenue")
+}
+
+func init() { proto.RegisterFile("tabi/revenue/v1/revenue.proto", fileDescriptor_e5717e85d6b9cff5) }
+
+var fileDescriptor_e5717e85d6b9cff5 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0xb1, 0x8e, 0xda, 0x40,
+	0x10, 0xf5, 0x86, 0x88, 0x84, 0x95, 0x12, 0x13, 0x8b, 0xc2, 0x21, 0x8a, 0x41, 0x48, 0x89, 0x9c,
+	0x02, 0x5b, 0x28, 0x6d, 0x52, 0x04, 0x90, 0xd2, 0x46, 0xa6, 0x4b, 0x63, 0xad, 0xd7, 0x23, 0x63,
+	0xc5, 0x78, 0xad, 0xdd, 0xc5, 0x84, 0xbf, 0xb8, 0x8f, 0xe1, 0x0b, 0xae, 0xa2, 0x44, 0x34, 0x77,
+	0xba, 0x02, 0x9d, 0xe0, 0x47, 0x4e, 0xf6, 0xda, 0x3e, 0xba, 0xab, 0x76, 0xf6, 0xbd, 0x37, 0x6f,
+	0x67, 0x66, 0x07, 0x7f, 0x96, 0x24, 0x88, 0x5d, 0x0e, 0x39, 0xa4, 0x6b, 0x70, 0xf3, 0x49, 0x1d,
+	0x3a, 0x19, 0x67, 0x92, 0x19, 0x7a, 0x41, 0x3b, 0x35, 0x96, 0x4f, 0xfa, 0xbd, 0x88, 0x45, 0xac,
+	0xe4, 0xdc, 0x22, 0x52, 0xb2, 0xfe, 0x47, 0xca, 0xc4, 0x8a, 0x09, 0x5f, 0x11, 0xea, 0xa2, 0xa8,
+	0xd1, 0x1d, 0xc2, 0xed, 0x3f, 0x84, 0x93, 0x95, 0x30, 0xbe, 0xe0, 0xf7, 0x90, 0x92, 0x20, 0x01,
+	0xbf, 0x32, 0x34, 0xd1, 0x10, 0xd9, 0x6f, 0xbd, 0x77, 0x0a, 0xf5, 0x14, 0x68, 0x44, 0xb8, 0x1b,
+	0x42, 0x0e, 0x09, 0xcb, 0x80, 0xfb, 0x62, 0x49, 0x38, 0x08, 0xf3, 0xd5, 0x10, 0xd9, 0x9d, 0xe9,
+	0x8f, 0xfd, 0x69, 0xa0, 0x3d, 0x9c, 0x06, 0x5f, 0xa3, 0x58, 0x2e, 0xd7, 0x81, 0x43, 0xd9, 0xaa,
+	0x7a, 0xac, 0x3a, 0xc6, 0x22, 0xfc, 0xe7, 0xca, 0x6d, 0x06, 0xc2, 0x99, 0x03, 0x3d, 0xee, 0xc6,
+	0xb8, 0xaa, 0x65, 0x0e, 0xd4, 0xd3, 0x1b, 0xd7, 0x45, 0x69, 0x6a, 0xfc, 0xc4, 0x9f, 0x48, 0x18,
+	0x72, 0x3f, 0x04, 0x1e, 0xe7, 0x44, 0xc6, 0x2c, 0xf5, 0x29, 0x13, 0xd2, 0xa7, 0x1c, 0x88, 0x04,
+	0xb3, 0x35, 0x44, 0xf6, 0x6b, 0xcf, 0x2c, 0x24, 0xf3, 0x46, 0x31, 0x63, 0x42, 0xce, 0x4a, 0x7e,
+	0x74, 0x8b, 0xf0, 0x9b, 0xba, 0xe6, 0x6f, 0xb8, 0x4b, 0x59, 0x2a, 0x39, 0xa1, 0xd2, 0x2f, 0x12,
+	0x40, 0x88, 0xb2, 0xb9, 0x8e, 0xa7, 0xd7, 0xf8, 0x2f, 0x05, 0x1b, 0xb3, 0xa2, 0xbd, 0x2c, 0x61,
+	0x5b, 0xe0, 0x8d, 0x54, 0xb5, 0x67, 0x1e, 0x77, 0xe3, 0x5e, 0x55, 0x70, 0xa5, 0x5e, 0x48, 0x1e,
+	0xa7, 0x91, 0xa7, 0xd7, 0x19, 0xb5, 0xc9, 0x6f, 0x6c, 0x6c, 0x62, 0xb9, 0x0c, 0x39, 0xd9, 0x5c,
+	0xd9, 0xb4, 0x5e, 0xb0, 0xf9, 0xf0, 0x9c, 0x53, 0x11, 0xd3, 0xe9, 0xfe, 0x6c, 0xa1, 0xc3, 0xd9,
+	0x42, 0x8f, 0x67, 0x0b, 0xdd, 0x5c, 0x2c, 0xed, 0x70, 0xb1, 0xb4, 0xfb, 0x8b, 0xa5, 0xfd, 0xb5,
+	0xaf, 0x86, 0x5c, 0x6c, 0x41, 0x42, 0x02, 0x51, 0x06, 0xee, 0xff, 0x66, 0x5f, 0xca, 0x51, 0x07,
+	0xed, 0xf2, 0xa7, 0xbf, 0x3f, 0x0d, 0x00, 0xcb, 0x8a, 0x6e, 0xcd, 0x4c, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AddrDerivationCostCreate != 0 {
+		i = encodeVarintRevenue(dAtA, i, uint64(m.AddrDerivationCostCreate))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.DeveloperShares.Size()
+		i -= size
+		if _, err := m.DeveloperShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRevenue(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.EnableRevenue {
+		i--
+		if m.EnableRevenue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Revenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Revenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Revenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawerAddress) > 0 {
+		i -= len(m.WithdrawerAddress)
+		copy(dAtA[i:], m.WithdrawerAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.WithdrawerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.DeployerAddress) > 0 {
+		i -= len(m.DeployerAddress)
+		copy(dAtA[i:], m.DeployerAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.DeployerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRevenue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRevenue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableRevenue {
+		n += 2
+	}
+	l = m.DeveloperShares.Size()
+	n += 1 + l + sovRevenue(uint64(l))
+	if m.AddrDerivationCostCreate != 0 {
+		n += 1 + sovRevenue(uint64(m.AddrDerivationCostCreate))
+	}
+	return n
+}
+
+func (m *Revenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	l = len(m.DeployerAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	l = len(m.WithdrawerAddress)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	return n
+}
+
+func sovRevenue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRevenue(x uint64) (n int) {
+	return sovRevenue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableRevenue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableRevenue = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddrDerivationCostCreate", wireType)
+			}
+			m.AddrDerivationCostCreate = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddrDerivationCostCreate |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Revenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Revenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Revenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeployerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeployerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WithdrawerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRevenue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRevenue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRevenue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRevenue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRevenue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRevenue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRevenue = fmt.Errorf("proto: unexpected end of group")
+)
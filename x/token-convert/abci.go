@@ -0,0 +1,21 @@
+package token_convert
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/token-convert/keeper"
+	"github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+// EndBlocker runs at the end of each block
+func EndBlocker(ctx sdk.Context, _ abci.RequestEndBlock, k keeper.Keeper) []abci.ValidatorUpdate {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	k.EndBlocker(ctx)
+	return []abci.ValidatorUpdate{}
+}
@@ -5,7 +5,8 @@ import (
 )
 
 const (
-	FlagOwner = "owner"
+	FlagOwner      = "owner"
+	FlagAutoSettle = "auto-settle"
 )
 
 var FlagSetVouchers = flag.NewFlagSet("", flag.ContinueOnError)
@@ -26,6 +26,8 @@ func NewQueryCmd() *cobra.Command {
 		NewQueryCmdVoucher(),
 		NewQueryCmdVouchers(),
 		NewQueryCmdVoucherStatus(),
+		NewQueryCmdLockedByStrategy(),
+		NewQueryCmdSettleQueue(),
 	)
 
 	return cmd
@@ -203,3 +205,61 @@ func NewQueryCmdVoucherStatus() *cobra.Command {
 
 	return cmd
 }
+
+// NewQueryCmdLockedByStrategy is the cli cmd for QueryLockedByStrategy
+func NewQueryCmdLockedByStrategy() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "locked-by-strategy",
+		Short: "Query the total locked vetabi and pending tabi per strategy",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.LockedByStrategy(
+				context.Background(),
+				&types.QueryLockedByStrategyRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewQueryCmdSettleQueue is the cli cmd for QuerySettleQueue
+func NewQueryCmdSettleQueue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "settle-queue",
+		Short: "Query the number of vouchers pending auto-settlement",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.SettleQueue(
+				context.Background(),
+				&types.QuerySettleQueueRequest{},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	tabitypes "github.com/tabilabs/tabi/types"
@@ -30,6 +32,8 @@ func NewTxCmd() *cobra.Command {
 		NewTxCmdConvertVetabi(),
 		NewTxCmdWithdrawTabi(),
 		NewTxCmdCancelConvert(),
+		NewTxCmdTransferVoucher(),
+		NewTxCmdSetAutoSettle(),
 	)
 
 	return cmd
@@ -88,12 +92,19 @@ func NewTxCmdConvertVetabi() *cobra.Command {
 				return errorsmod.Wrapf(types.ErrInvalidCoin, "invalid coin denom: %s", coin.Denom)
 			}
 
+			autoSettle, err := cmd.Flags().GetBool(FlagAutoSettle)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgConvertVetabi(coin, clientCtx.GetFromAddress(), args[1])
+			msg.AutoSettle = autoSettle
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().Bool(FlagAutoSettle, false, "Withdraw the voucher automatically once it matures")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -144,3 +155,59 @@ func NewTxCmdCancelConvert() *cobra.Command {
 
 	return cmd
 }
+
+// NewTxCmdTransferVoucher is the cli cmd for TransferVoucher
+func NewTxCmdTransferVoucher() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-voucher [voucher-id] [recipient]",
+		Short: "Transfer a voucher to another account",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipient, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransferVoucher(args[0], clientCtx.GetFromAddress(), recipient)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewTxCmdSetAutoSettle is the cli cmd for SetAutoSettle
+func NewTxCmdSetAutoSettle() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-settle [voucher-id] [true|false]",
+		Short: "Enable or disable withdrawing a voucher automatically once it matures",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			enabled, err := strconv.ParseBool(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoSettle(args[0], clientCtx.GetFromAddress(), enabled)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
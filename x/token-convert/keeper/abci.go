@@ -0,0 +1,10 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker called every block, settles the matured auto-settle vouchers.
+func (k Keeper) EndBlocker(ctx sdk.Context) {
+	k.SettleMaturedVouchers(ctx)
+}
@@ -13,12 +13,21 @@ func (k Keeper) InitGenesis(ctx sdktypes.Context, state types.GenesisState) {
 	}
 
 	for _, s := range state.Strategies {
-		k.createStrategy(ctx, s.Name, s.Period, s.ConversionRate)
+		k.setStrategy(ctx, s)
 	}
 
 	for _, v := range state.Vouchers {
-		k.setVoucher(ctx, v.Id, v.Owner, v.Strategy, v.Amount)
+		// snapshot the strategy for vouchers exported before snapshots were recorded
+		if v.ConversionRate.IsNil() {
+			strategy, _ := k.GetStrategy(ctx, v.Strategy)
+			v.Period = strategy.Period
+			v.ConversionRate = strategy.ConversionRate
+		}
+		k.setVoucher(ctx, v)
 		k.setVoucherByOwner(ctx, v.Owner, v.Id)
+		if v.AutoSettle {
+			k.enqueueSettlement(ctx, v)
+		}
 	}
 
 	k.setVoucherSeq(ctx, state.VoucherSequence)
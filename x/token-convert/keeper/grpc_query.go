@@ -44,6 +44,7 @@ func (q Querier) Strategy(goCtx context.Context, req *types.QueryStrategyRequest
 		Name:           strategy.Name,
 		Period:         strategy.Period,
 		ConversionRate: strategy.ConversionRate.String(),
+		Disabled:       strategy.Disabled,
 	}, nil
 }
 
@@ -168,7 +169,7 @@ func (q Querier) VoucherStatus(goCtx context.Context, req *types.QueryVoucherSta
 		return nil, status.Error(codes.NotFound, "voucher not found")
 	}
 
-	strategy, found := q.GetStrategy(ctx, voucher.Strategy)
+	strategy, found := q.GetVoucherStrategy(ctx, voucher)
 	if !found {
 		return nil, status.Error(codes.NotFound, "strategy not found")
 	}
@@ -180,3 +181,29 @@ func (q Querier) VoucherStatus(goCtx context.Context, req *types.QueryVoucherSta
 		VetabiReturnable: returnableVetabi,
 	}, nil
 }
+
+// LockedByStrategy queries the total locked vetabi and pending tabi per strategy
+func (q Querier) LockedByStrategy(goCtx context.Context, req *types.QueryLockedByStrategyRequest) (*types.QueryLockedByStrategyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	return &types.QueryLockedByStrategyResponse{
+		Locked: q.GetLockedByStrategy(ctx),
+	}, nil
+}
+
+// SettleQueue queries the number of vouchers pending auto-settlement
+func (q Querier) SettleQueue(goCtx context.Context, req *types.QuerySettleQueueRequest) (*types.QuerySettleQueueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	depth, matured := q.GetSettleQueueDepth(ctx)
+	return &types.QuerySettleQueueResponse{
+		Depth:   depth,
+		Matured: matured,
+	}, nil
+}
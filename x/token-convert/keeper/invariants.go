@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+// RegisterInvariants registers the token-convert module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "module-account-vetabi", ModuleAccountVetabiInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "voucher-index", VoucherIndexInvariant(k))
+}
+
+// AllInvariants runs all invariants of the token-convert module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := ModuleAccountVetabiInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return VoucherIndexInvariant(k)(ctx)
+	}
+}
+
+// ModuleAccountVetabiInvariant checks that the vetabi balance of the module account equals
+// the sum of the outstanding vouchers.
+func ModuleAccountVetabiInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		locked := sdk.NewCoin(tabitypes.AttoVeTabi, sdk.ZeroInt())
+		for _, voucher := range k.GetVouchers(ctx) {
+			locked = locked.Add(voucher.Amount)
+		}
+
+		moduleAcc := k.authKeeper.GetModuleAddress(types.ModuleName)
+		balance := k.bankKeeper.GetBalance(ctx, moduleAcc, tabitypes.AttoVeTabi)
+		broken := !balance.IsEqual(locked)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-account-vetabi",
+			fmt.Sprintf(
+				"\tmodule account vetabi balance: %s\n"+
+					"\tsum of vouchers amount: %s\n",
+				balance, locked,
+			),
+		), broken
+	}
+}
+
+// VoucherIndexInvariant checks that every voucher is indexed under its owner and
+// refers to an existing strategy.
+func VoucherIndexInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg    string
+			broken bool
+		)
+
+		for _, voucher := range k.GetVouchers(ctx) {
+			owner, err := sdk.AccAddressFromBech32(voucher.Owner)
+			if err != nil || !k.HasVoucherByOwner(ctx, owner, voucher.Id) {
+				broken = true
+				msg += fmt.Sprintf("\tvoucher %s is not indexed under owner %s\n", voucher.Id, voucher.Owner)
+			}
+			if !k.HasStrategy(ctx, voucher.Strategy) {
+				broken = true
+				msg += fmt.Sprintf("\tvoucher %s refers to missing strategy %s\n", voucher.Id, voucher.Strategy)
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "voucher-index", msg), broken
+	}
+}
@@ -0,0 +1,112 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/token-convert/keeper"
+	"github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+func (suite *TokenConvertTestSuite) TestInvariants() {
+	sender := accounts[0].String()
+
+	testCases := []struct {
+		name      string
+		malleate  func(voucherID string)
+		invariant func(k keeper.Keeper) sdk.Invariant
+		expBroken bool
+	}{
+		{
+			name:      "all invariants hold with outstanding vouchers",
+			malleate:  func(string) {},
+			invariant: keeper.AllInvariants,
+			expBroken: false,
+		},
+		{
+			name: "module account vetabi broken by minted vetabi",
+			malleate: func(string) {
+				coins := sdk.NewCoins(tabitypes.NewVeTabiCoinInt64(1))
+				suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins))
+			},
+			invariant: keeper.ModuleAccountVetabiInvariant,
+			expBroken: true,
+		},
+		{
+			name: "voucher index broken by missing owner index",
+			malleate: func(voucherID string) {
+				store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+				store.Delete(types.VoucherByOwnerStoreKey(accounts[0], voucherID))
+			},
+			invariant: keeper.VoucherIndexInvariant,
+			expBroken: true,
+		},
+		{
+			name: "voucher index broken by missing strategy",
+			malleate: func(string) {
+				store := suite.ctx.KVStore(suite.app.GetKey(types.StoreKey))
+				store.Delete(types.StrategyStoreKey([]byte(types.Strategy90Days)))
+			},
+			invariant: keeper.VoucherIndexInvariant,
+			expBroken: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.Require().NoError(suite.utilsFundToken(accounts[0], 1_000, tabitypes.AttoVeTabi))
+
+			resp, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+				Coin:     tabitypes.NewVeTabiCoinInt64(1_000),
+				Strategy: types.Strategy90Days,
+				Sender:   sender,
+			})
+			suite.Require().NoError(err)
+
+			tc.malleate(resp.VoucherId)
+
+			msg, broken := tc.invariant(suite.keeper)(suite.ctx)
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func (suite *TokenConvertTestSuite) TestLockedByStrategy() {
+	suite.SetupTest()
+	sender := accounts[0].String()
+	suite.Require().NoError(suite.utilsFundToken(accounts[0], 11_000, tabitypes.AttoVeTabi))
+
+	for _, msg := range []*types.MsgConvertVetabi{
+		{Coin: tabitypes.NewVeTabiCoinInt64(1_000), Strategy: types.Strategy90Days, Sender: sender},
+		{Coin: tabitypes.NewVeTabiCoinInt64(3_000), Strategy: types.Strategy90Days, Sender: sender},
+		{Coin: tabitypes.NewVeTabiCoinInt64(2_000), Strategy: types.Strategy180Days, Sender: sender},
+		{Coin: tabitypes.NewVeTabiCoinInt64(5_000), Strategy: types.StrategyInstant, Sender: sender},
+	} {
+		_, err := suite.msgServer.ConvertVetabi(suite.ctx, msg)
+		suite.Require().NoError(err)
+	}
+
+	resp, err := suite.queryClient.LockedByStrategy(suite.ctx, &types.QueryLockedByStrategyRequest{})
+	suite.Require().NoError(err)
+
+	expected := map[string]struct {
+		count  uint64
+		locked int64
+	}{
+		types.StrategyInstant: {0, 0},
+		types.Strategy90Days:  {2, 4_000},
+		types.Strategy180Days: {1, 2_000},
+	}
+	suite.Require().Len(resp.Locked, len(expected))
+	for _, locked := range resp.Locked {
+		exp := expected[locked.Strategy]
+		strategy, found := suite.keeper.GetStrategy(suite.ctx, locked.Strategy)
+		suite.Require().True(found)
+
+		pending := sdk.NewDec(exp.locked).Mul(strategy.ConversionRate).TruncateInt()
+		suite.Require().Equal(exp.count, locked.VoucherCount, locked.Strategy)
+		suite.Require().Equal(tabitypes.NewVeTabiCoinInt64(exp.locked), locked.VetabiLocked, locked.Strategy)
+		suite.Require().Equal(sdk.NewCoin(tabitypes.AttoTabi, pending), locked.TabiPending, locked.Strategy)
+	}
+}
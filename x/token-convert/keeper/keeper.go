@@ -1,8 +1,11 @@
 package keeper
 
 import (
+	"fmt"
 	"time"
 
+	"github.com/tendermint/tendermint/libs/log"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -16,6 +19,8 @@ import (
 type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
+	// the address capable of executing strategy lifecycle messages. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
 
 	authKeeper      types.AccountKeeper
 	bankKeeper      types.BankKeeper
@@ -24,6 +29,7 @@ type Keeper struct {
 
 func NewKeeper(cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
+	authority sdk.AccAddress,
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	instant string,
@@ -31,12 +37,18 @@ func NewKeeper(cdc codec.BinaryCodec,
 	return Keeper{
 		storeKey:        key,
 		cdc:             cdc,
+		authority:       authority,
 		authKeeper:      ak,
 		bankKeeper:      bk,
 		instantStrategy: instant,
 	}
 }
 
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
 // ConvertTabi converts tabi to vetabi.
 func (k Keeper) ConvertTabi(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) error {
 	// send tabi to the module
@@ -81,7 +93,7 @@ func (k Keeper) LockVetabiAndCreateVoucher(ctx sdk.Context, sender sdk.AccAddres
 		return "", "", err
 	}
 
-	voucherID := k.createVoucher(ctx, sender.String(), strategy.Name, coin)
+	voucherID := k.createVoucher(ctx, sender.String(), strategy, coin)
 	k.setVoucherByOwner(ctx, sender.String(), voucherID)
 
 	expiryTime := ctx.BlockTime().Add(time.Duration(strategy.Period) * time.Second).String()
@@ -123,7 +135,7 @@ func (k Keeper) InstantWithdrawVetabi(ctx sdk.Context, sender sdk.AccAddress, co
 
 // WithdrawTabi withdraws tabi according to the given voucher.
 func (k Keeper) WithdrawTabi(ctx sdk.Context, sender sdk.AccAddress, voucher types.Voucher) (sdk.Coin, sdk.Coin, error) {
-	strategy, found := k.GetStrategy(ctx, voucher.Strategy)
+	strategy, found := k.GetVoucherStrategy(ctx, voucher)
 	if !found {
 		return sdk.Coin{}, sdk.Coin{}, sdkerrors.Wrapf(types.ErrInvalidStrategy, "strategy-%s not found", voucher.Strategy)
 	}
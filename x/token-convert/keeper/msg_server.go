@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -52,6 +53,13 @@ func (m msgServer) ConvertTabi(goCtx context.Context, msg *types.MsgConvertTabi)
 		),
 	)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventConvertTabi{
+		Sender: msg.Sender,
+		Amount: msg.Coin,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgConvertTabiResponse{}, nil
 }
 
@@ -78,11 +86,23 @@ func (m msgServer) ConvertVetabi(goCtx context.Context, msg *types.MsgConvertVet
 		return nil, sdkerrors.Wrapf(types.ErrInvalidStrategy, "strategy-%s not found", msg.Strategy)
 	}
 
+	if strategy.Disabled {
+		return nil, sdkerrors.Wrapf(types.ErrStrategyDisabled, "strategy-%s is disabled", msg.Strategy)
+	}
+
 	expiryTime, voucherID, err := m.LockVetabiAndCreateVoucher(ctx, sender, strategy, msg.Coin)
 	if err != nil {
 		return nil, err
 	}
 
+	// instant strategy creates no voucher
+	if msg.AutoSettle && len(voucherID) != 0 {
+		voucher, _ := m.GetVoucher(ctx, voucherID)
+		if err := m.Keeper.SetAutoSettle(ctx, voucher, true); err != nil {
+			return nil, err
+		}
+	}
+
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeConvertVetabi,
@@ -93,6 +113,16 @@ func (m msgServer) ConvertVetabi(goCtx context.Context, msg *types.MsgConvertVet
 		),
 	)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventConvertVetabi{
+		Sender:     msg.Sender,
+		Amount:     msg.Coin,
+		Strategy:   msg.Strategy,
+		VoucherId:  voucherID,
+		ExpiryTime: expiryTime,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgConvertVetabiResponse{
 		VoucherId:  voucherID,
 		ExpiryTime: expiryTime,
@@ -124,6 +154,7 @@ func (m msgServer) WithdrawTabi(goCtx context.Context, msg *types.MsgWithdrawTab
 	}
 
 	// delete voucher
+	m.dequeueSettlement(ctx, voucher)
 	m.deleteVoucher(ctx, msg.VoucherId)
 	m.deleteVoucherByOwner(ctx, sender, msg.VoucherId)
 
@@ -136,6 +167,15 @@ func (m msgServer) WithdrawTabi(goCtx context.Context, msg *types.MsgWithdrawTab
 		),
 	)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventWithdrawTabi{
+		Sender:         msg.Sender,
+		VoucherId:      msg.VoucherId,
+		TabiWithdrawn:  tabiWithdrawn,
+		VetabiReturned: vetabiReturned,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgWithdrawTabiResponse{
 		TabiWithdrawn:  tabiWithdrawn,
 		VetabiReturned: vetabiReturned,
@@ -176,6 +216,7 @@ func (m msgServer) CancelConvert(goCtx context.Context, msg *types.MsgCancelConv
 	}
 
 	// delete voucher
+	m.dequeueSettlement(ctx, voucher)
 	m.deleteVoucher(ctx, msg.VoucherId)
 	m.deleteVoucherByOwner(ctx, sender, msg.VoucherId)
 
@@ -187,7 +228,162 @@ func (m msgServer) CancelConvert(goCtx context.Context, msg *types.MsgCancelConv
 		),
 	)
 
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCancelConvert{
+		Sender:         msg.Sender,
+		VoucherId:      msg.VoucherId,
+		VetabiUnlocked: voucher.Amount,
+	}); err != nil {
+		return nil, err
+	}
+
 	return &types.MsgCancelConvertResponse{
 		VetabiUnlocked: voucher.Amount,
 	}, nil
 }
+
+// TransferVoucher transfers the ownership of a voucher to the recipient.
+func (m msgServer) TransferVoucher(goCtx context.Context, msg *types.MsgTransferVoucher) (*types.MsgTransferVoucherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := sdk.AccAddressFromBech32(msg.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	voucher, found := m.GetVoucher(ctx, msg.VoucherId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVoucher, "voucher %s not found", msg.VoucherId)
+	}
+
+	if voucher.Owner != msg.Sender {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVoucherOwner,
+			"voucher %s is not owned by %s", msg.VoucherId, msg.Sender)
+	}
+
+	m.Keeper.TransferVoucher(ctx, voucher, recipient)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTransferVoucher,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyVoucherID, msg.VoucherId),
+		),
+	)
+
+	return &types.MsgTransferVoucherResponse{}, nil
+}
+
+// SetAutoSettle enables or disables the auto-settlement of a voucher.
+func (m msgServer) SetAutoSettle(goCtx context.Context, msg *types.MsgSetAutoSettle) (*types.MsgSetAutoSettleResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	voucher, found := m.GetVoucher(ctx, msg.VoucherId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVoucher, "voucher %s not found", msg.VoucherId)
+	}
+
+	if voucher.Owner != msg.Sender {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidVoucherOwner,
+			"voucher %s is not owned by %s", msg.VoucherId, msg.Sender)
+	}
+
+	if err := m.Keeper.SetAutoSettle(ctx, voucher, msg.Enabled); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSetAutoSettle,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyVoucherID, msg.VoucherId),
+			sdk.NewAttribute(types.AttributeKeyAutoSettle, strconv.FormatBool(msg.Enabled)),
+		),
+	)
+
+	return &types.MsgSetAutoSettleResponse{}, nil
+}
+
+// CreateStrategy creates a new conversion strategy.
+func (m msgServer) CreateStrategy(goCtx context.Context, msg *types.MsgCreateStrategy) (*types.MsgCreateStrategyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := m.createStrategy(ctx, msg.Name, msg.Period, msg.ConversionRate); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreateStrategy,
+			sdk.NewAttribute(types.AttributeKeyStrategy, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyPeriod, strconv.FormatInt(msg.Period, 10)),
+			sdk.NewAttribute(types.AttributeKeyConversionRate, msg.ConversionRate.String()),
+		),
+	)
+
+	return &types.MsgCreateStrategyResponse{}, nil
+}
+
+// UpdateStrategy updates the period and conversion rate of a strategy for new vouchers.
+func (m msgServer) UpdateStrategy(goCtx context.Context, msg *types.MsgUpdateStrategy) (*types.MsgUpdateStrategyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := m.updateStrategy(ctx, msg.Name, msg.Period, msg.ConversionRate); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateStrategy,
+			sdk.NewAttribute(types.AttributeKeyStrategy, msg.Name),
+			sdk.NewAttribute(types.AttributeKeyPeriod, strconv.FormatInt(msg.Period, 10)),
+			sdk.NewAttribute(types.AttributeKeyConversionRate, msg.ConversionRate.String()),
+		),
+	)
+
+	return &types.MsgUpdateStrategyResponse{}, nil
+}
+
+// DisableStrategy disables a strategy so that no new vouchers can be created with it.
+func (m msgServer) DisableStrategy(goCtx context.Context, msg *types.MsgDisableStrategy) (*types.MsgDisableStrategyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := m.disableStrategy(ctx, msg.Name); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisableStrategy,
+			sdk.NewAttribute(types.AttributeKeyStrategy, msg.Name),
+		),
+	)
+
+	return &types.MsgDisableStrategyResponse{}, nil
+}
+
+// validateAuthority checks the msg authority against the keeper authority.
+func (m msgServer) validateAuthority(authority string) error {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address: %s", authority)
+	}
+
+	if m.authority.String() != authority {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized,
+			"invalid authority: expected %s, got %s", m.authority, authority)
+	}
+
+	return nil
+}
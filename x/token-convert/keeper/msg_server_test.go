@@ -4,6 +4,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	tabitypes "github.com/tabilabs/tabi/types"
 	"github.com/tabilabs/tabi/x/token-convert/types"
@@ -523,3 +525,266 @@ func (suite *TokenConvertTestSuite) TestCancelConvert() {
 		})
 	}
 }
+
+func (suite *TokenConvertTestSuite) TestTransferVoucher() {
+	sender := accounts[0].String()
+	recipient := accounts[1].String()
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgTransferVoucher
+		melleate  func(*types.MsgTransferVoucher)
+		expectErr bool
+	}{
+		{
+			name: "success - transfer voucher to recipient",
+			msg: &types.MsgTransferVoucher{
+				Sender:    sender,
+				Recipient: recipient,
+			},
+			melleate: func(msg *types.MsgTransferVoucher) {
+				resp, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+					Coin:     tabitypes.NewVeTabiCoinInt64(1_000_000),
+					Strategy: types.Strategy90Days,
+					Sender:   sender,
+				})
+				suite.Require().NoError(err)
+				msg.VoucherId = resp.VoucherId
+			},
+			expectErr: false,
+		},
+		{
+			name: "success - transfer resets the auto settlement",
+			msg: &types.MsgTransferVoucher{
+				Sender:    sender,
+				Recipient: recipient,
+			},
+			melleate: func(msg *types.MsgTransferVoucher) {
+				resp, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+					Coin:       tabitypes.NewVeTabiCoinInt64(1_000_000),
+					Strategy:   types.Strategy90Days,
+					Sender:     sender,
+					AutoSettle: true,
+				})
+				suite.Require().NoError(err)
+				msg.VoucherId = resp.VoucherId
+			},
+			expectErr: false,
+		},
+		{
+			name: "fail - not voucher owner",
+			msg: &types.MsgTransferVoucher{
+				Sender:    recipient,
+				Recipient: accounts[2].String(),
+			},
+			melleate: func(msg *types.MsgTransferVoucher) {
+				resp, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+					Coin:     tabitypes.NewVeTabiCoinInt64(1_000_000),
+					Strategy: types.Strategy90Days,
+					Sender:   sender,
+				})
+				suite.Require().NoError(err)
+				msg.VoucherId = resp.VoucherId
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - voucher not found",
+			msg: &types.MsgTransferVoucher{
+				Sender:    sender,
+				Recipient: recipient,
+			},
+			melleate: func(msg *types.MsgTransferVoucher) {
+				msg.VoucherId = "voucher-not-found"
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// fund token
+			suite.utilsFundToken(accounts[0], 1_000_000, tabitypes.AttoVeTabi)
+
+			tc.melleate(tc.msg)
+
+			_, err := suite.msgServer.TransferVoucher(suite.ctx, tc.msg)
+
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				voucher, found := suite.keeper.GetVoucher(suite.ctx, tc.msg.VoucherId)
+				suite.Require().True(found)
+				suite.Require().Equal(recipient, voucher.Owner)
+				suite.Require().False(voucher.AutoSettle)
+				depth, _ := suite.keeper.GetSettleQueueDepth(suite.ctx)
+				suite.Require().Zero(depth)
+				suite.Require().False(suite.keeper.HasVoucherByOwner(suite.ctx, accounts[0], tc.msg.VoucherId))
+				suite.Require().True(suite.keeper.HasVoucherByOwner(suite.ctx, accounts[1], tc.msg.VoucherId))
+
+				// the recipient can now cancel the conversion
+				_, err = suite.msgServer.CancelConvert(suite.ctx, types.NewMsgCancelConvert(tc.msg.VoucherId, accounts[1]))
+				suite.Require().NoError(err)
+				vetabiCoin := suite.bankKeeper.GetBalance(suite.ctx, accounts[1], tabitypes.AttoVeTabi)
+				suite.Require().Equal(tabitypes.NewVeTabiCoinInt64(1_000_000), vetabiCoin)
+			}
+		})
+	}
+}
+
+func (suite *TokenConvertTestSuite) TestCreateStrategy() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgCreateStrategy
+		expectErr bool
+	}{
+		{
+			name:      "success - create 30 days strategy",
+			msg:       types.NewMsgCreateStrategy(authority, "30days", 30*24*60*60, sdk.NewDecWithPrec(3, 1)),
+			expectErr: false,
+		},
+		{
+			name:      "fail - invalid authority",
+			msg:       types.NewMsgCreateStrategy(accounts[0].String(), "30days", 30*24*60*60, sdk.NewDecWithPrec(3, 1)),
+			expectErr: true,
+		},
+		{
+			name:      "fail - strategy already exists",
+			msg:       types.NewMsgCreateStrategy(authority, types.Strategy90Days, 30*24*60*60, sdk.NewDecWithPrec(3, 1)),
+			expectErr: true,
+		},
+		{
+			name:      "fail - zero period",
+			msg:       types.NewMsgCreateStrategy(authority, "30days", 0, sdk.NewDecWithPrec(3, 1)),
+			expectErr: true,
+		},
+		{
+			name:      "fail - conversion rate greater than one",
+			msg:       types.NewMsgCreateStrategy(authority, "30days", 30*24*60*60, sdk.NewDecWithPrec(11, 1)),
+			expectErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			// the msg is validated before being routed to the msg server
+			err := tc.msg.ValidateBasic()
+			if err == nil {
+				_, err = suite.msgServer.CreateStrategy(suite.ctx, tc.msg)
+			}
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				strategy, found := suite.keeper.GetStrategy(suite.ctx, tc.msg.Name)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.msg.Period, strategy.Period)
+				suite.Require().Equal(tc.msg.ConversionRate, strategy.ConversionRate)
+				suite.Require().False(strategy.Disabled)
+			}
+		})
+	}
+}
+
+func (suite *TokenConvertTestSuite) TestUpdateStrategy() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sender := accounts[0].String()
+
+	suite.SetupTest() // reset
+
+	suite.utilsFundToken(accounts[0], 2_000_000, tabitypes.AttoVeTabi)
+
+	// voucher created before the update keeps the old rate
+	resp, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+		Coin:     tabitypes.NewVeTabiCoinInt64(1_000_000),
+		Strategy: types.Strategy90Days,
+		Sender:   sender,
+	})
+	suite.Require().NoError(err)
+	oldVoucherID := resp.VoucherId
+
+	_, err = suite.msgServer.UpdateStrategy(suite.ctx,
+		types.NewMsgUpdateStrategy(accounts[0].String(), types.Strategy90Days, 90*24*60*60, sdk.NewDecWithPrec(8, 1)))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.UpdateStrategy(suite.ctx,
+		types.NewMsgUpdateStrategy(authority, "unknown", 90*24*60*60, sdk.NewDecWithPrec(8, 1)))
+	suite.Require().Error(err)
+
+	err = types.NewMsgUpdateStrategy(authority, types.Strategy90Days, 90*24*60*60, sdk.NewDecWithPrec(11, 1)).ValidateBasic()
+	suite.Require().ErrorIs(err, types.ErrInvalidStrategy)
+
+	_, err = suite.msgServer.UpdateStrategy(suite.ctx,
+		types.NewMsgUpdateStrategy(authority, types.Strategy90Days, 90*24*60*60, sdk.NewDecWithPrec(8, 1)))
+	suite.Require().NoError(err)
+
+	// voucher created after the update uses the new rate
+	resp, err = suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+		Coin:     tabitypes.NewVeTabiCoinInt64(1_000_000),
+		Strategy: types.Strategy90Days,
+		Sender:   sender,
+	})
+	suite.Require().NoError(err)
+	newVoucherID := resp.VoucherId
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(90 * 24 * time.Hour))
+
+	withdrawn, err := suite.msgServer.WithdrawTabi(suite.ctx, &types.MsgWithdrawTabi{Sender: sender, VoucherId: oldVoucherID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(tabitypes.NewTabiCoinInt64(500_000), withdrawn.TabiWithdrawn)
+
+	withdrawn, err = suite.msgServer.WithdrawTabi(suite.ctx, &types.MsgWithdrawTabi{Sender: sender, VoucherId: newVoucherID})
+	suite.Require().NoError(err)
+	suite.Require().Equal(tabitypes.NewTabiCoinInt64(800_000), withdrawn.TabiWithdrawn)
+}
+
+func (suite *TokenConvertTestSuite) TestDisableStrategy() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	sender := accounts[0].String()
+
+	suite.SetupTest() // reset
+
+	suite.utilsFundToken(accounts[0], 2_000_000, tabitypes.AttoVeTabi)
+
+	resp, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+		Coin:     tabitypes.NewVeTabiCoinInt64(1_000_000),
+		Strategy: types.Strategy180Days,
+		Sender:   sender,
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.DisableStrategy(suite.ctx, types.NewMsgDisableStrategy(accounts[0].String(), types.Strategy180Days))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.DisableStrategy(suite.ctx, types.NewMsgDisableStrategy(authority, types.Strategy180Days))
+	suite.Require().NoError(err)
+
+	// disabling twice fails
+	_, err = suite.msgServer.DisableStrategy(suite.ctx, types.NewMsgDisableStrategy(authority, types.Strategy180Days))
+	suite.Require().ErrorIs(err, types.ErrStrategyDisabled)
+
+	res, err := suite.queryClient.Strategy(suite.ctx, &types.QueryStrategyRequest{Name: types.Strategy180Days})
+	suite.Require().NoError(err)
+	suite.Require().True(res.Disabled)
+
+	// no new conversion with the disabled strategy
+	_, err = suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+		Coin:     tabitypes.NewVeTabiCoinInt64(1_000_000),
+		Strategy: types.Strategy180Days,
+		Sender:   sender,
+	})
+	suite.Require().ErrorIs(err, types.ErrStrategyDisabled)
+
+	// existing vouchers can still be withdrawn
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(180 * 24 * time.Hour))
+	withdrawn, err := suite.msgServer.WithdrawTabi(suite.ctx, &types.MsgWithdrawTabi{Sender: sender, VoucherId: resp.VoucherId})
+	suite.Require().NoError(err)
+	suite.Require().Equal(tabitypes.NewTabiCoinInt64(1_000_000), withdrawn.TabiWithdrawn)
+}
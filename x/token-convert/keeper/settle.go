@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"github.com/gogo/protobuf/proto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	sdkerrors "cosmossdk.io/errors"
+
+	"github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+// maxSettlementsPerBlock caps the number of vouchers settled in one EndBlocker
+// so that a burst of matured vouchers can not stall block production.
+const maxSettlementsPerBlock = 100
+
+// voucherExpiryTime returns the unix time when the voucher is fully released.
+func (k Keeper) voucherExpiryTime(ctx sdk.Context, voucher types.Voucher) int64 {
+	strategy, _ := k.GetVoucherStrategy(ctx, voucher)
+	return voucher.CreatedTime + strategy.Period
+}
+
+// enqueueSettlement puts the voucher in the settle queue ordered by its expiry time.
+func (k Keeper) enqueueSettlement(ctx sdk.Context, voucher types.Voucher) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.SettleQueueStoreKey(k.voucherExpiryTime(ctx, voucher), voucher.Id), types.PlaceHolder)
+}
+
+// dequeueSettlement removes the voucher from the settle queue.
+func (k Keeper) dequeueSettlement(ctx sdk.Context, voucher types.Voucher) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SettleQueueStoreKey(k.voucherExpiryTime(ctx, voucher), voucher.Id))
+}
+
+// SetAutoSettle enables or disables the auto-settlement of a voucher.
+func (k Keeper) SetAutoSettle(ctx sdk.Context, voucher types.Voucher, enabled bool) error {
+	if voucher.AutoSettle == enabled {
+		return sdkerrors.Wrapf(types.ErrInvalidVoucher, "voucher %s auto settle is already %t", voucher.Id, enabled)
+	}
+
+	voucher.AutoSettle = enabled
+	k.setVoucher(ctx, voucher)
+
+	if enabled {
+		k.enqueueSettlement(ctx, voucher)
+	} else {
+		k.dequeueSettlement(ctx, voucher)
+	}
+
+	return nil
+}
+
+// GetSettleQueueDepth returns the number of queued vouchers and how many of them have matured.
+func (k Keeper) GetSettleQueueDepth(ctx sdk.Context) (depth uint64, matured uint64) {
+	store := ctx.KVStore(k.storeKey)
+	now := ctx.BlockTime().Unix()
+
+	iterator := sdk.KVStorePrefixIterator(store, types.SettleQueueKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		expiryTime, _ := types.ParseSettleQueueStoreKey(iterator.Key())
+		if expiryTime <= now {
+			matured++
+		}
+		depth++
+	}
+
+	return depth, matured
+}
+
+// SettleMaturedVouchers withdraws the matured auto-settle vouchers on behalf of their owners,
+// processing at most maxSettlementsPerBlock entries per call.
+func (k Keeper) SettleMaturedVouchers(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+
+	// collect the matured entries first as the store can not be written while iterating
+	var keys [][]byte
+	iterator := store.Iterator(types.SettleQueueKey, types.SettleQueueByTimePrefixKey(ctx.BlockTime().Unix()+1))
+	for ; iterator.Valid() && len(keys) < maxSettlementsPerBlock; iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+		_, voucherID := types.ParseSettleQueueStoreKey(key)
+		k.settleVoucher(ctx, voucherID)
+	}
+}
+
+// settleVoucher withdraws a single voucher, the queue entry must have been removed by the caller.
+func (k Keeper) settleVoucher(ctx sdk.Context, voucherID string) {
+	voucher, found := k.GetVoucher(ctx, voucherID)
+	if !found || !voucher.AutoSettle {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSettleVoucherSkipped,
+				sdk.NewAttribute(types.AttributeKeyVoucherID, voucherID),
+				sdk.NewAttribute(types.AttributeKeyReason, "voucher not found or auto settle disabled"),
+			),
+		)
+		k.emitSettlementEvent(ctx, &types.EventSettleVoucherSkipped{
+			VoucherId: voucherID,
+			Reason:    "voucher not found or auto settle disabled",
+		})
+		return
+	}
+
+	owner := sdk.MustAccAddressFromBech32(voucher.Owner)
+
+	// settle in a cached context so that a failed withdrawal leaves no partial state
+	cacheCtx, write := ctx.CacheContext()
+	tabiWithdrawn, vetabiReturned, err := k.WithdrawTabi(cacheCtx, owner, voucher)
+	if err != nil {
+		// the voucher stays with its owner for a manual withdrawal
+		voucher.AutoSettle = false
+		k.setVoucher(ctx, voucher)
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeSettleVoucherFailed,
+				sdk.NewAttribute(types.AttributeKeyVoucherID, voucher.Id),
+				sdk.NewAttribute(types.AttributeKeyOwner, voucher.Owner),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			),
+		)
+		k.emitSettlementEvent(ctx, &types.EventSettleVoucherFailed{
+			VoucherId: voucher.Id,
+			Owner:     voucher.Owner,
+			Reason:    err.Error(),
+		})
+		return
+	}
+	write()
+
+	k.deleteVoucher(ctx, voucher.Id)
+	k.deleteVoucherByOwner(ctx, owner, voucher.Id)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSettleVoucher,
+			sdk.NewAttribute(types.AttributeKeyVoucherID, voucher.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, voucher.Owner),
+			sdk.NewAttribute(types.AttributeKeyTabiWithdrawn, tabiWithdrawn.String()),
+			sdk.NewAttribute(types.AttributeKeyVetabiReturned, vetabiReturned.String()),
+		),
+	)
+	k.emitSettlementEvent(ctx, &types.EventSettleVoucher{
+		VoucherId:      voucher.Id,
+		Owner:          voucher.Owner,
+		TabiWithdrawn:  tabiWithdrawn,
+		VetabiReturned: vetabiReturned,
+	})
+}
+
+// emitSettlementEvent emits the typed event of a settlement, the settlement runs
+// from the block processing so a failure is only logged.
+func (k Keeper) emitSettlementEvent(ctx sdk.Context, event proto.Message) {
+	if err := ctx.EventManager().EmitTypedEvent(event); err != nil {
+		k.Logger(ctx).Error("failed to emit settlement event", "event", proto.MessageName(event), "error", err.Error())
+	}
+}
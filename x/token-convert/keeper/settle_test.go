@@ -0,0 +1,212 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	tabitypes "github.com/tabilabs/tabi/types"
+	"github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+func (suite *TokenConvertTestSuite) TestSettleMaturedVouchers() {
+	sender := accounts[0].String()
+
+	testCases := []struct {
+		name          string
+		amount        int64
+		autoSettle    bool
+		melleate      func(voucherID string)
+		timeAfter     time.Duration
+		expectTabi    sdk.Coin
+		expectVoucher bool
+		expectDepth   uint64
+		expectEvent   string
+	}{
+		{
+			name:          "success - matured voucher settled",
+			amount:        1_000_000,
+			autoSettle:    true,
+			melleate:      func(string) {},
+			timeAfter:     90 * 24 * time.Hour,
+			expectTabi:    tabitypes.NewTabiCoinInt64(500_000),
+			expectVoucher: false,
+			expectDepth:   0,
+			expectEvent:   types.EventTypeSettleVoucher,
+		},
+		{
+			name:          "success - voucher not matured yet",
+			amount:        1_000_000,
+			autoSettle:    true,
+			melleate:      func(string) {},
+			timeAfter:     45 * 24 * time.Hour,
+			expectTabi:    tabitypes.NewTabiCoinInt64(0),
+			expectVoucher: true,
+			expectDepth:   1,
+		},
+		{
+			name:          "success - voucher without auto settle",
+			amount:        1_000_000,
+			autoSettle:    false,
+			melleate:      func(string) {},
+			timeAfter:     90 * 24 * time.Hour,
+			expectTabi:    tabitypes.NewTabiCoinInt64(0),
+			expectVoucher: true,
+			expectDepth:   0,
+		},
+		{
+			name:       "success - auto settle disabled before maturity",
+			amount:     1_000_000,
+			autoSettle: true,
+			melleate: func(voucherID string) {
+				_, err := suite.msgServer.SetAutoSettle(suite.ctx, types.NewMsgSetAutoSettle(voucherID, accounts[0], false))
+				suite.Require().NoError(err)
+			},
+			timeAfter:     90 * 24 * time.Hour,
+			expectTabi:    tabitypes.NewTabiCoinInt64(0),
+			expectVoucher: true,
+			expectDepth:   0,
+		},
+		{
+			name:       "success - queue entry removed on manual withdrawal",
+			amount:     1_000_000,
+			autoSettle: true,
+			melleate: func(voucherID string) {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(45 * 24 * time.Hour))
+				_, err := suite.msgServer.WithdrawTabi(suite.ctx, types.NewMsgWithdrawTabi(voucherID, accounts[0]))
+				suite.Require().NoError(err)
+			},
+			timeAfter:     45 * 24 * time.Hour,
+			expectTabi:    tabitypes.NewTabiCoinInt64(250_000),
+			expectVoucher: false,
+			expectDepth:   0,
+		},
+		{
+			name:          "fail - nothing withdrawable, voucher kept for the owner",
+			amount:        1,
+			autoSettle:    true,
+			melleate:      func(string) {},
+			timeAfter:     90 * 24 * time.Hour,
+			expectTabi:    tabitypes.NewTabiCoinInt64(0),
+			expectVoucher: true,
+			expectDepth:   0,
+			expectEvent:   types.EventTypeSettleVoucherFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			suite.utilsFundToken(accounts[0], tc.amount, tabitypes.AttoVeTabi)
+
+			resp, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+				Coin:       tabitypes.NewVeTabiCoinInt64(tc.amount),
+				Strategy:   types.Strategy90Days,
+				Sender:     sender,
+				AutoSettle: tc.autoSettle,
+			})
+			suite.Require().NoError(err)
+
+			tc.melleate(resp.VoucherId)
+
+			suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(tc.timeAfter))
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			suite.keeper.EndBlocker(suite.ctx)
+
+			tabiCoin := suite.bankKeeper.GetBalance(suite.ctx, accounts[0], tabitypes.AttoTabi)
+			suite.Require().Equal(tc.expectTabi, tabiCoin)
+
+			_, found := suite.keeper.GetVoucher(suite.ctx, resp.VoucherId)
+			suite.Require().Equal(tc.expectVoucher, found)
+
+			depth, _ := suite.keeper.GetSettleQueueDepth(suite.ctx)
+			suite.Require().Equal(tc.expectDepth, depth)
+
+			if len(tc.expectEvent) != 0 {
+				var emitted bool
+				for _, event := range suite.ctx.EventManager().Events() {
+					emitted = emitted || event.Type == tc.expectEvent
+					if event.Type == types.EventTypeSettleVoucher {
+						attributes := make(map[string]string)
+						for _, attr := range event.Attributes {
+							attributes[string(attr.Key)] = string(attr.Value)
+						}
+						suite.Require().Equal(tc.expectTabi.String(), attributes[types.AttributeKeyTabiWithdrawn])
+						suite.Require().Contains(attributes, types.AttributeKeyVetabiReturned)
+					}
+				}
+				suite.Require().True(emitted)
+			}
+		})
+	}
+}
+
+func (suite *TokenConvertTestSuite) TestSettleMaturedVouchersCap() {
+	suite.SetupTest() // reset
+
+	const total = 150
+	suite.utilsFundToken(accounts[0], total*1_000, tabitypes.AttoVeTabi)
+	for i := 0; i < total; i++ {
+		_, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+			Coin:       tabitypes.NewVeTabiCoinInt64(1_000),
+			Strategy:   types.Strategy90Days,
+			Sender:     accounts[0].String(),
+			AutoSettle: true,
+		})
+		suite.Require().NoError(err)
+	}
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(90 * 24 * time.Hour))
+
+	res, err := suite.queryClient.SettleQueue(suite.ctx, &types.QuerySettleQueueRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(total), res.Depth)
+
+	// the first block settles up to the cap
+	suite.keeper.EndBlocker(suite.ctx)
+	depth, matured := suite.keeper.GetSettleQueueDepth(suite.ctx)
+	suite.Require().Equal(uint64(50), depth)
+	suite.Require().Equal(uint64(50), matured)
+
+	// the next block drains the rest
+	suite.keeper.EndBlocker(suite.ctx)
+	depth, _ = suite.keeper.GetSettleQueueDepth(suite.ctx)
+	suite.Require().Zero(depth)
+	suite.Require().Empty(suite.keeper.GetVouchers(suite.ctx))
+}
+
+func (suite *TokenConvertTestSuite) TestSettleVoucherTypedEvent() {
+	suite.SetupTest() // reset
+
+	suite.utilsFundToken(accounts[0], 1_000_000, tabitypes.AttoVeTabi)
+	resp, err := suite.msgServer.ConvertVetabi(suite.ctx, &types.MsgConvertVetabi{
+		Coin:       tabitypes.NewVeTabiCoinInt64(1_000_000),
+		Strategy:   types.Strategy90Days,
+		Sender:     accounts[0].String(),
+		AutoSettle: true,
+	})
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(90 * 24 * time.Hour))
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.keeper.EndBlocker(suite.ctx)
+
+	var settled []*types.EventSettleVoucher
+	for _, event := range suite.ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+		if e, ok := msg.(*types.EventSettleVoucher); ok {
+			settled = append(settled, e)
+		}
+	}
+
+	suite.Require().Equal([]*types.EventSettleVoucher{{
+		VoucherId:      resp.VoucherId,
+		Owner:          accounts[0].String(),
+		TabiWithdrawn:  tabitypes.NewTabiCoinInt64(500_000),
+		VetabiReturned: tabitypes.NewVeTabiCoinInt64(0),
+	}}, settled)
+}
@@ -10,23 +10,66 @@ import (
 
 // createStrategy sets a strategy with the given name, period, and conversion rate.
 func (k Keeper) createStrategy(ctx sdk.Context, name string, period int64, conversionRate sdk.Dec) error {
-	store := ctx.KVStore(k.storeKey)
-
 	if k.HasStrategy(ctx, name) {
 		return sdkerrors.Wrapf(types.ErrInvalidStrategy, "strategy-%s already exists", name)
 	}
 
-	strategy := types.Strategy{
+	if period == 0 {
+		return sdkerrors.Wrapf(types.ErrInvalidStrategy, "strategy-%s period must be positive", name)
+	}
+
+	k.setStrategy(ctx, types.Strategy{
 		Name:           name,
 		Period:         period,
 		ConversionRate: conversionRate,
+	})
+
+	return nil
+}
+
+// updateStrategy updates the period and conversion rate of a strategy. Existing vouchers
+// keep the snapshot they were created under.
+func (k Keeper) updateStrategy(ctx sdk.Context, name string, period int64, conversionRate sdk.Dec) error {
+	strategy, found := k.GetStrategy(ctx, name)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidStrategy, "strategy-%s not found", name)
 	}
-	bz := k.cdc.MustMarshal(&strategy)
-	store.Set(types.StrategyStoreKey([]byte(name)), bz)
+
+	if period == 0 && name != k.instantStrategy {
+		return sdkerrors.Wrapf(types.ErrInvalidStrategy, "strategy-%s period must be positive", name)
+	}
+
+	strategy.Period = period
+	strategy.ConversionRate = conversionRate
+	k.setStrategy(ctx, strategy)
 
 	return nil
 }
 
+// disableStrategy disables a strategy for new conversions.
+func (k Keeper) disableStrategy(ctx sdk.Context, name string) error {
+	strategy, found := k.GetStrategy(ctx, name)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrInvalidStrategy, "strategy-%s not found", name)
+	}
+
+	if strategy.Disabled {
+		return sdkerrors.Wrapf(types.ErrStrategyDisabled, "strategy-%s already disabled", name)
+	}
+
+	strategy.Disabled = true
+	k.setStrategy(ctx, strategy)
+
+	return nil
+}
+
+// setStrategy sets a strategy.
+func (k Keeper) setStrategy(ctx sdk.Context, strategy types.Strategy) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&strategy)
+	store.Set(types.StrategyStoreKey([]byte(strategy.Name)), bz)
+}
+
 // HasStrategy checks if a strategy exists
 func (k Keeper) HasStrategy(ctx sdk.Context, name string) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	tabitypes "github.com/tabilabs/tabi/types"
 	"github.com/tabilabs/tabi/x/token-convert/types"
 )
 
@@ -26,16 +27,18 @@ func (k Keeper) setVoucherSeq(ctx sdk.Context, seq uint64) {
 	store.Set(types.VoucherSeqKey, bz)
 }
 
-// createVoucher creates and sets a voucher.
-func (k Keeper) createVoucher(ctx sdk.Context, owner string, strategy string, amount sdk.Coin) string {
+// createVoucher creates and sets a voucher with a snapshot of the strategy.
+func (k Keeper) createVoucher(ctx sdk.Context, owner string, strategy types.Strategy, amount sdk.Coin) string {
 	store := ctx.KVStore(k.storeKey)
 
 	voucher := types.Voucher{
-		Id:          k.genVoucherID(ctx),
-		Owner:       owner,
-		Amount:      amount,
-		CreatedTime: ctx.BlockTime().Unix(),
-		Strategy:    strategy,
+		Id:             k.genVoucherID(ctx),
+		Owner:          owner,
+		Amount:         amount,
+		CreatedTime:    ctx.BlockTime().Unix(),
+		Strategy:       strategy.Name,
+		Period:         strategy.Period,
+		ConversionRate: strategy.ConversionRate,
 	}
 	bz := k.cdc.MustMarshal(&voucher)
 
@@ -45,18 +48,9 @@ func (k Keeper) createVoucher(ctx sdk.Context, owner string, strategy string, am
 }
 
 // setVoucher sets a voucher.
-// WARN: this func should be called except in init genesis
-func (k Keeper) setVoucher(ctx sdk.Context, voucherID, owner, strategy string, amount sdk.Coin) {
+// WARN: this func should be called only in init genesis or voucher transfer
+func (k Keeper) setVoucher(ctx sdk.Context, voucher types.Voucher) {
 	store := ctx.KVStore(k.storeKey)
-
-	voucher := types.Voucher{
-		Id:          voucherID,
-		Owner:       owner,
-		Amount:      amount,
-		CreatedTime: ctx.BlockTime().Unix(),
-		Strategy:    strategy,
-	}
-
 	bz := k.cdc.MustMarshal(&voucher)
 	store.Set(types.VoucherStoreKey(voucher.Id), bz)
 }
@@ -82,7 +76,22 @@ func (k Keeper) GetVoucher(ctx sdk.Context, voucherID string) (types.Voucher, bo
 // setVoucherByOwner sets the VoucherByOwnerStore.
 func (k Keeper) setVoucherByOwner(ctx sdk.Context, owner, voucherID string) {
 	store := ctx.KVStore(k.storeKey)
-	store.Set(types.VoucherByOwnerStoreKey([]byte(owner), voucherID), types.PlaceHolder)
+	store.Set(types.VoucherByOwnerStoreKey(sdk.MustAccAddressFromBech32(owner), voucherID), types.PlaceHolder)
+}
+
+// TransferVoucher moves the voucher to the recipient and re-indexes its ownership.
+// The auto-settlement is an opt-in of the previous owner, so it is reset.
+func (k Keeper) TransferVoucher(ctx sdk.Context, voucher types.Voucher, recipient sdk.AccAddress) {
+	k.deleteVoucherByOwner(ctx, sdk.MustAccAddressFromBech32(voucher.Owner), voucher.Id)
+
+	if voucher.AutoSettle {
+		k.dequeueSettlement(ctx, voucher)
+		voucher.AutoSettle = false
+	}
+
+	voucher.Owner = recipient.String()
+	k.setVoucher(ctx, voucher)
+	k.setVoucherByOwner(ctx, voucher.Owner, voucher.Id)
 }
 
 // deleteVoucherByOwner deletes the VoucherByOwnerStore.
@@ -114,3 +123,58 @@ func (k Keeper) GetVouchers(ctx sdk.Context) (vouchers []types.Voucher) {
 	}
 	return
 }
+
+// GetVoucherStrategy returns the strategy snapshot the voucher was created under.
+// Vouchers created before snapshots were recorded fall back to the current strategy.
+func (k Keeper) GetVoucherStrategy(ctx sdk.Context, voucher types.Voucher) (types.Strategy, bool) {
+	if voucher.ConversionRate.IsNil() {
+		return k.GetStrategy(ctx, voucher.Strategy)
+	}
+
+	return types.Strategy{
+		Name:           voucher.Strategy,
+		Period:         voucher.Period,
+		ConversionRate: voucher.ConversionRate,
+	}, true
+}
+
+// HasVoucherByOwner checks if the voucher is indexed under the owner.
+func (k Keeper) HasVoucherByOwner(ctx sdk.Context, owner sdk.AccAddress, voucherID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.VoucherByOwnerStoreKey(owner, voucherID))
+}
+
+// GetLockedByStrategy sums the outstanding vouchers per strategy.
+func (k Keeper) GetLockedByStrategy(ctx sdk.Context) []types.StrategyLocked {
+	var names []string
+	lockedByStrategy := make(map[string]*types.StrategyLocked)
+	for _, strategy := range k.GetStrategies(ctx) {
+		names = append(names, strategy.Name)
+		lockedByStrategy[strategy.Name] = &types.StrategyLocked{
+			Strategy:     strategy.Name,
+			VetabiLocked: sdk.NewCoin(tabitypes.AttoVeTabi, sdk.ZeroInt()),
+			TabiPending:  sdk.NewCoin(tabitypes.AttoTabi, sdk.ZeroInt()),
+		}
+	}
+
+	for _, voucher := range k.GetVouchers(ctx) {
+		locked, found := lockedByStrategy[voucher.Strategy]
+		if !found {
+			// the strategy is gone, which is reported by the invariants
+			continue
+		}
+
+		strategy, _ := k.GetVoucherStrategy(ctx, voucher)
+		pending := sdk.NewDecFromInt(voucher.Amount.Amount).Mul(strategy.ConversionRate).TruncateInt()
+
+		locked.VoucherCount++
+		locked.VetabiLocked = locked.VetabiLocked.Add(voucher.Amount)
+		locked.TabiPending = locked.TabiPending.Add(sdk.NewCoin(tabitypes.AttoTabi, pending))
+	}
+
+	locked := make([]types.StrategyLocked, 0, len(names))
+	for _, name := range names {
+		locked = append(locked, *lockedByStrategy[name])
+	}
+	return locked
+}
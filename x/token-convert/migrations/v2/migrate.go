@@ -0,0 +1,71 @@
+package v2
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+// MigrateStore migrates the x/token-convert module state from the consensus
+// version 1 to version 2. Specifically, it:
+//   - snapshots the period and conversion rate of the strategy onto every
+//     voucher created before vouchers recorded the strategy they were created under.
+//   - re-keys the vouchers by owner index from the bech32 owner string to the
+//     owner account address bytes.
+func MigrateStore(
+	ctx sdk.Context,
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+) error {
+	store := ctx.KVStore(storeKey)
+
+	var vouchers []types.Voucher
+	iterator := sdk.KVStorePrefixIterator(store, types.VoucherKey)
+	for ; iterator.Valid(); iterator.Next() {
+		var voucher types.Voucher
+		cdc.MustUnmarshal(iterator.Value(), &voucher)
+		vouchers = append(vouchers, voucher)
+	}
+	iterator.Close()
+
+	for _, voucher := range vouchers {
+		if err := migrateVoucherByOwner(store, voucher); err != nil {
+			return err
+		}
+
+		if !voucher.ConversionRate.IsNil() {
+			continue
+		}
+		bz := store.Get(types.StrategyStoreKey([]byte(voucher.Strategy)))
+		if len(bz) == 0 {
+			return types.ErrInvalidStrategy.Wrapf("strategy-%s of voucher-%s not found", voucher.Strategy, voucher.Id)
+		}
+
+		var strategy types.Strategy
+		cdc.MustUnmarshal(bz, &strategy)
+
+		voucher.Period = strategy.Period
+		voucher.ConversionRate = strategy.ConversionRate
+		store.Set(types.VoucherStoreKey(voucher.Id), cdc.MustMarshal(&voucher))
+	}
+
+	return nil
+}
+
+// migrateVoucherByOwner moves the vouchers by owner index entry of a voucher
+// from the legacy key, built on the bech32 owner string, to the owner address.
+func migrateVoucherByOwner(store sdk.KVStore, voucher types.Voucher) error {
+	owner, err := sdk.AccAddressFromBech32(voucher.Owner)
+	if err != nil {
+		return err
+	}
+
+	legacyKey := types.VoucherByOwnerStoreKey([]byte(voucher.Owner), voucher.Id)
+	if store.Has(legacyKey) {
+		store.Delete(legacyKey)
+	}
+	store.Set(types.VoucherByOwnerStoreKey(owner, voucher.Id), types.PlaceHolder)
+	return nil
+}
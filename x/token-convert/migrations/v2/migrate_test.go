@@ -0,0 +1,105 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"github.com/tabilabs/tabi/app"
+	"github.com/tabilabs/tabi/encoding"
+	tabitypes "github.com/tabilabs/tabi/types"
+	v2 "github.com/tabilabs/tabi/x/token-convert/migrations/v2"
+	"github.com/tabilabs/tabi/x/token-convert/types"
+)
+
+// stripSnapshot drops the period and conversion rate fields from an encoded
+// voucher, reproducing the layout written at consensus version 1.
+func stripSnapshot(t *testing.T, bz []byte) []byte {
+	var legacy []byte
+	for len(bz) > 0 {
+		num, _, n := protowire.ConsumeField(bz)
+		require.GreaterOrEqual(t, n, 0)
+		if num != 6 && num != 7 {
+			legacy = append(legacy, bz[:n]...)
+		}
+		bz = bz[n:]
+	}
+	return legacy
+}
+
+func TestMigrate(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	kvStore := ctx.KVStore(storeKey)
+
+	strategy := types.Strategy{
+		Name:           types.Strategy90Days,
+		Period:         90 * 24 * 60 * 60,
+		ConversionRate: sdk.NewDecWithPrec(5, 1),
+	}
+	kvStore.Set(types.StrategyStoreKey([]byte(strategy.Name)), cdc.MustMarshal(&strategy))
+
+	legacy := types.Voucher{
+		Id:       "legacy",
+		Owner:    sdk.AccAddress("owner").String(),
+		Amount:   tabitypes.NewVeTabiCoinInt64(100),
+		Strategy: strategy.Name,
+	}
+	kvStore.Set(types.VoucherStoreKey(legacy.Id), stripSnapshot(t, cdc.MustMarshal(&legacy)))
+	legacyByOwnerKey := types.VoucherByOwnerStoreKey([]byte(legacy.Owner), legacy.Id)
+	kvStore.Set(legacyByOwnerKey, types.PlaceHolder)
+
+	// vouchers that already carry a snapshot must be left untouched
+	snapshotted := types.Voucher{
+		Id:             "snapshotted",
+		Owner:          sdk.AccAddress("owner").String(),
+		Amount:         tabitypes.NewVeTabiCoinInt64(100),
+		Strategy:       strategy.Name,
+		Period:         30 * 24 * 60 * 60,
+		ConversionRate: sdk.NewDecWithPrec(25, 2),
+	}
+	kvStore.Set(types.VoucherStoreKey(snapshotted.Id), cdc.MustMarshal(&snapshotted))
+
+	err := v2.MigrateStore(ctx, storeKey, cdc)
+	require.NoError(t, err)
+
+	var voucher types.Voucher
+	cdc.MustUnmarshal(kvStore.Get(types.VoucherStoreKey(legacy.Id)), &voucher)
+	require.Equal(t, strategy.Period, voucher.Period)
+	require.Equal(t, strategy.ConversionRate, voucher.ConversionRate)
+
+	cdc.MustUnmarshal(kvStore.Get(types.VoucherStoreKey(snapshotted.Id)), &voucher)
+	require.Equal(t, snapshotted, voucher)
+
+	// the vouchers by owner index is keyed by the owner address
+	require.False(t, kvStore.Has(legacyByOwnerKey))
+	require.True(t, kvStore.Has(types.VoucherByOwnerStoreKey(sdk.AccAddress("owner"), legacy.Id)))
+	require.True(t, kvStore.Has(types.VoucherByOwnerStoreKey(sdk.AccAddress("owner"), snapshotted.Id)))
+}
+
+func TestMigrateMissingStrategy(t *testing.T) {
+	encCfg := encoding.MakeConfig(app.ModuleBasics)
+	cdc := encCfg.Codec
+
+	storeKey := sdk.NewKVStoreKey(types.ModuleName)
+	tKey := sdk.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+
+	legacy := types.Voucher{
+		Id:       "legacy",
+		Owner:    sdk.AccAddress("owner").String(),
+		Amount:   tabitypes.NewVeTabiCoinInt64(100),
+		Strategy: "unknown",
+	}
+	ctx.KVStore(storeKey).Set(types.VoucherStoreKey(legacy.Id), stripSnapshot(t, cdc.MustMarshal(&legacy)))
+
+	err := v2.MigrateStore(ctx, storeKey, cdc)
+	require.ErrorIs(t, err, types.ErrInvalidStrategy)
+}
@@ -22,6 +22,13 @@ const (
 	cancelConvertName = "tokenconvert/MsgCancelConvert"
 
 	withdrawName = "tokenconvert/MsgWithdrawTabi"
+
+	transferVoucherName = "tokenconvert/MsgTransferVoucher"
+	setAutoSettleName   = "tokenconvert/MsgSetAutoSettle"
+
+	createStrategyName  = "tokenconvert/MsgCreateStrategy"
+	updateStrategyName  = "tokenconvert/MsgUpdateStrategy"
+	disableStrategyName = "tokenconvert/MsgDisableStrategy"
 )
 
 func init() {
@@ -36,6 +43,11 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgConvertVetabi{},
 		&MsgWithdrawTabi{},
 		&MsgCancelConvert{},
+		&MsgTransferVoucher{},
+		&MsgSetAutoSettle{},
+		&MsgCreateStrategy{},
+		&MsgUpdateStrategy{},
+		&MsgDisableStrategy{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -45,4 +57,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgConvertVetabi{}, convertVetabiName, nil)
 	cdc.RegisterConcrete(&MsgCancelConvert{}, cancelConvertName, nil)
 	cdc.RegisterConcrete(&MsgWithdrawTabi{}, withdrawName, nil)
+	cdc.RegisterConcrete(&MsgTransferVoucher{}, transferVoucherName, nil)
+	cdc.RegisterConcrete(&MsgSetAutoSettle{}, setAutoSettleName, nil)
+	cdc.RegisterConcrete(&MsgCreateStrategy{}, createStrategyName, nil)
+	cdc.RegisterConcrete(&MsgUpdateStrategy{}, updateStrategyName, nil)
+	cdc.RegisterConcrete(&MsgDisableStrategy{}, disableStrategyName, nil)
 }
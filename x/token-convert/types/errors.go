@@ -8,4 +8,5 @@ var (
 	ErrInvalidVoucherOwner = sdkerrors.Register(ModuleName, 3, "invalid voucher owner")
 	ErrInsufficientFunds   = sdkerrors.Register(ModuleName, 4, "insufficient funds")
 	ErrInvalidCoin         = sdkerrors.Register(ModuleName, 5, "invalid coin")
+	ErrStrategyDisabled    = sdkerrors.Register(ModuleName, 6, "strategy disabled")
 )
@@ -1,15 +1,39 @@
 package types
 
+// The events below are the legacy untyped events, kept for a deprecation window
+// next to the typed events of events.proto.
 const (
 	EventTypeConvertTabi   = "convert_tabi"
 	EventTypeConvertVetabi = "convert_vetabi"
 	EventTypeWithdrawTabi  = "withdraw_tabi"
 	EventTypeCancelConvert = "cancel_convert"
 
+	EventTypeTransferVoucher = "transfer_voucher"
+
+	EventTypeSetAutoSettle        = "set_auto_settle"
+	EventTypeSettleVoucher        = "settle_voucher"
+	EventTypeSettleVoucherSkipped = "settle_voucher_skipped"
+	EventTypeSettleVoucherFailed  = "settle_voucher_failed"
+
+	EventTypeCreateStrategy  = "create_strategy"
+	EventTypeUpdateStrategy  = "update_strategy"
+	EventTypeDisableStrategy = "disable_strategy"
+
 	AttributeValueCategory = ModuleName
 
 	AttributeKeySender     = "sender"
 	AttributeKeyAmount     = "amount"
 	AttributeKeyVoucherID  = "voucher_id"
 	AttributeKeyExpiryTime = "expiry_time"
+	AttributeKeyRecipient  = "recipient"
+	AttributeKeyOwner      = "owner"
+	AttributeKeyAutoSettle = "auto_settle"
+	AttributeKeyReason     = "reason"
+
+	AttributeKeyTabiWithdrawn  = "tabi_withdrawn"
+	AttributeKeyVetabiReturned = "vetabi_returned"
+
+	AttributeKeyStrategy       = "strategy"
+	AttributeKeyPeriod         = "period"
+	AttributeKeyConversionRate = "conversion_rate"
 )
@@ -102,10 +102,19 @@ func validateStrategy(strategy Strategy) error {
 		return errors.Wrapf(ErrInvalidStrategy, "strategy period is negative")
 	}
 
+	if strategy.ConversionRate.IsNil() {
+		return errors.Wrapf(ErrInvalidStrategy, "conversion rate is empty")
+	}
+
 	if strategy.ConversionRate.IsNegative() {
 		return errors.Wrapf(ErrInvalidStrategy, "conversion rate is negative")
 	}
 
+	// a voucher can never be settled for more tabi than it locks vetabi
+	if strategy.ConversionRate.GT(sdk.OneDec()) {
+		return errors.Wrapf(ErrInvalidStrategy, "conversion rate is greater than one")
+	}
+
 	return nil
 }
 
@@ -130,5 +139,18 @@ func validateVoucher(voucher Voucher) error {
 		return errors.Wrapf(ErrInvalidCoin, "invalid coin in voucher")
 	}
 
+	// vouchers created before strategy snapshots have no conversion rate
+	if !voucher.ConversionRate.IsNil() {
+		if voucher.Period <= 0 {
+			return errors.Wrapf(ErrInvalidVoucher, "voucher period is non-positive")
+		}
+		if voucher.ConversionRate.IsNegative() {
+			return errors.Wrapf(ErrInvalidVoucher, "voucher conversion rate is negative")
+		}
+		if voucher.ConversionRate.GT(sdk.OneDec()) {
+			return errors.Wrapf(ErrInvalidVoucher, "voucher conversion rate is greater than one")
+		}
+	}
+
 	return nil
 }
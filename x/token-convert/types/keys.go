@@ -21,6 +21,7 @@ var (
 	VoucherKey        = []byte{0x02}
 	VoucherByOwnerKey = []byte{0x03}
 	VoucherSeqKey     = []byte{0x04}
+	SettleQueueKey    = []byte{0x05}
 
 	Delimiter   = []byte{0x00}
 	PlaceHolder = []byte{0x01}
@@ -80,3 +81,35 @@ func VoucherByOwnerStorePrefixKey(owner sdktypes.AccAddress) []byte {
 
 	return bz
 }
+
+// SettleQueueStoreKey returns the byte representation of the settle queue key
+// Items are stored with key as follows:
+// 0x05<expiryTime(8 Bytes)><voucherID>
+func SettleQueueStoreKey(expiryTime int64, voucherID string) []byte {
+	bz := make([]byte, len(SettleQueueKey)+8+len(voucherID))
+
+	copy(bz, SettleQueueKey)
+	copy(bz[len(SettleQueueKey):], sdktypes.Uint64ToBigEndian(uint64(expiryTime)))
+	copy(bz[len(SettleQueueKey)+8:], voucherID)
+
+	return bz
+}
+
+// SettleQueueByTimePrefixKey returns the byte representation of the settle queue prefix
+// up to the given expiry time.
+// Items are stored with key as follows:
+// 0x05<expiryTime(8 Bytes)>
+func SettleQueueByTimePrefixKey(expiryTime int64) []byte {
+	bz := make([]byte, len(SettleQueueKey)+8)
+
+	copy(bz, SettleQueueKey)
+	copy(bz[len(SettleQueueKey):], sdktypes.Uint64ToBigEndian(uint64(expiryTime)))
+
+	return bz
+}
+
+// ParseSettleQueueStoreKey parses the expiry time and the voucher id from the settle queue key
+func ParseSettleQueueStoreKey(key []byte) (int64, string) {
+	expiryTime := int64(sdktypes.BigEndianToUint64(key[len(SettleQueueKey) : len(SettleQueueKey)+8]))
+	return expiryTime, string(key[len(SettleQueueKey)+8:])
+}
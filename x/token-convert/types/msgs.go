@@ -14,6 +14,13 @@ const (
 	TypeMsgConvertVetabi = "convert_vetabi"
 	TypeMsgWithdrawTabi  = "withdraw_tabi"
 	TypeMsgCancelConvert = "cancel_convert"
+
+	TypeMsgTransferVoucher = "transfer_voucher"
+	TypeMsgSetAutoSettle   = "set_auto_settle"
+
+	TypeMsgCreateStrategy  = "create_strategy"
+	TypeMsgUpdateStrategy  = "update_strategy"
+	TypeMsgDisableStrategy = "disable_strategy"
 )
 
 // NOTE: we don't impl legacy msg anymore
@@ -22,6 +29,13 @@ var (
 	_ sdk.Msg = &MsgConvertVetabi{}
 	_ sdk.Msg = &MsgWithdrawTabi{}
 	_ sdk.Msg = &MsgCancelConvert{}
+
+	_ sdk.Msg = &MsgTransferVoucher{}
+	_ sdk.Msg = &MsgSetAutoSettle{}
+
+	_ sdk.Msg = &MsgCreateStrategy{}
+	_ sdk.Msg = &MsgUpdateStrategy{}
+	_ sdk.Msg = &MsgDisableStrategy{}
 )
 
 // NewMsgConvertTabi is a constructor function for MsgConvertTabi
@@ -149,6 +163,152 @@ func (m *MsgCancelConvert) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{from}
 }
 
+// NewMsgTransferVoucher is a constructor function for MsgTransferVoucher
+func NewMsgTransferVoucher(voucherId string, sender, recipient sdk.AccAddress) *MsgTransferVoucher {
+	return &MsgTransferVoucher{
+		VoucherId: voucherId,
+		Sender:    sender.String(),
+		Recipient: recipient.String(),
+	}
+}
+
+func (m *MsgTransferVoucher) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address: %s", m.Sender)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errorsmod.Wrapf(err, "invalid recipient address: %s", m.Recipient)
+	}
+
+	if m.Sender == m.Recipient {
+		return errorsmod.Wrapf(ErrInvalidVoucherOwner, "recipient is the same as sender")
+	}
+
+	if len(m.VoucherId) == 0 {
+		return errorsmod.Wrapf(ErrInvalidVoucher, "voucher id is empty")
+	}
+
+	return nil
+}
+
+func (m *MsgTransferVoucher) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgSetAutoSettle is a constructor function for MsgSetAutoSettle
+func NewMsgSetAutoSettle(voucherId string, sender sdk.AccAddress, enabled bool) *MsgSetAutoSettle {
+	return &MsgSetAutoSettle{
+		VoucherId: voucherId,
+		Sender:    sender.String(),
+		Enabled:   enabled,
+	}
+}
+
+func (m *MsgSetAutoSettle) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrapf(err, "invalid sender address: %s", m.Sender)
+	}
+
+	if len(m.VoucherId) == 0 {
+		return errorsmod.Wrapf(ErrInvalidVoucher, "voucher id is empty")
+	}
+
+	return nil
+}
+
+func (m *MsgSetAutoSettle) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgCreateStrategy is a constructor function for MsgCreateStrategy
+func NewMsgCreateStrategy(authority, name string, period int64, conversionRate sdk.Dec) *MsgCreateStrategy {
+	return &MsgCreateStrategy{
+		Authority:      authority,
+		Name:           name,
+		Period:         period,
+		ConversionRate: conversionRate,
+	}
+}
+
+func (m *MsgCreateStrategy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateStrategy(Strategy{Name: m.Name, Period: m.Period, ConversionRate: m.ConversionRate})
+}
+
+func (m *MsgCreateStrategy) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgUpdateStrategy is a constructor function for MsgUpdateStrategy
+func NewMsgUpdateStrategy(authority, name string, period int64, conversionRate sdk.Dec) *MsgUpdateStrategy {
+	return &MsgUpdateStrategy{
+		Authority:      authority,
+		Name:           name,
+		Period:         period,
+		ConversionRate: conversionRate,
+	}
+}
+
+func (m *MsgUpdateStrategy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return validateStrategy(Strategy{Name: m.Name, Period: m.Period, ConversionRate: m.ConversionRate})
+}
+
+func (m *MsgUpdateStrategy) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
+// NewMsgDisableStrategy is a constructor function for MsgDisableStrategy
+func NewMsgDisableStrategy(authority, name string) *MsgDisableStrategy {
+	return &MsgDisableStrategy{
+		Authority: authority,
+		Name:      name,
+	}
+}
+
+func (m *MsgDisableStrategy) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if len(m.Name) == 0 {
+		return errorsmod.Wrap(ErrInvalidStrategy, "strategy name is empty")
+	}
+
+	return nil
+}
+
+func (m *MsgDisableStrategy) GetSigners() []sdk.AccAddress {
+	from, err := sdk.AccAddressFromBech32(m.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{from}
+}
+
 func (m *MsgCancelConvert) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
 }
@@ -157,6 +317,22 @@ func (m *MsgCancelConvert) Route() string { return RouterKey }
 
 func (m *MsgCancelConvert) Type() string { return TypeMsgCancelConvert }
 
+func (m *MsgTransferVoucher) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgTransferVoucher) Route() string { return RouterKey }
+
+func (m *MsgTransferVoucher) Type() string { return TypeMsgTransferVoucher }
+
+func (m *MsgSetAutoSettle) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgSetAutoSettle) Route() string { return RouterKey }
+
+func (m *MsgSetAutoSettle) Type() string { return TypeMsgSetAutoSettle }
+
 func (m *MsgConvertTabi) GetSignBytes() []byte {
 	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
 }
@@ -180,3 +356,27 @@ func (m *MsgWithdrawTabi) GetSignBytes() []byte {
 func (m *MsgWithdrawTabi) Route() string { return RouterKey }
 
 func (m *MsgWithdrawTabi) Type() string { return TypeMsgWithdrawTabi }
+
+func (m *MsgCreateStrategy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgCreateStrategy) Route() string { return RouterKey }
+
+func (m *MsgCreateStrategy) Type() string { return TypeMsgCreateStrategy }
+
+func (m *MsgUpdateStrategy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgUpdateStrategy) Route() string { return RouterKey }
+
+func (m *MsgUpdateStrategy) Type() string { return TypeMsgUpdateStrategy }
+
+func (m *MsgDisableStrategy) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m *MsgDisableStrategy) Route() string { return RouterKey }
+
+func (m *MsgDisableStrategy) Type() string { return TypeMsgDisableStrategy }
@@ -86,6 +86,8 @@ type QueryStrategyResponse struct {
 	Period int64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
 	// conversion_rate
 	ConversionRate string `protobuf:"bytes,3,opt,name=conversion_rate,json=conversionRate,proto3" json:"conversion_rate,omitempty"`
+	// disabled
+	Disabled bool `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
 }
 
 func (m *QueryStrategyResponse) Reset()         { *m = QueryStrategyResponse{} }
@@ -142,6 +144,13 @@ func (m *QueryStrategyResponse) GetConversionRate() string {
 	return ""
 }
 
+func (m *QueryStrategyResponse) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 // QueryStrategiesRequest is the request type for the Query/Voucher RPC
 type QueryStrategiesRequest struct {
 	// pagination
@@ -582,6 +591,254 @@ func (m *QueryVoucherStatusResponse) GetVetabiReturnable() types.Coin {
 	return types.Coin{}
 }
 
+// QueryLockedByStrategyRequest is the request type for the Query/LockedByStrategy RPC
+type QueryLockedByStrategyRequest struct {
+}
+
+func (m *QueryLockedByStrategyRequest) Reset()         { *m = QueryLockedByStrategyRequest{} }
+func (m *QueryLockedByStrategyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedByStrategyRequest) ProtoMessage()    {}
+func (*QueryLockedByStrategyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ad330f982db981, []int{10}
+}
+func (m *QueryLockedByStrategyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedByStrategyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedByStrategyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedByStrategyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedByStrategyRequest.Merge(m, src)
+}
+func (m *QueryLockedByStrategyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedByStrategyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedByStrategyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedByStrategyRequest proto.InternalMessageInfo
+
+// QueryLockedByStrategyResponse is the response type for the Query/LockedByStrategy RPC
+type QueryLockedByStrategyResponse struct {
+	// locked
+	Locked []StrategyLocked `protobuf:"bytes,1,rep,name=locked,proto3" json:"locked"`
+}
+
+func (m *QueryLockedByStrategyResponse) Reset()         { *m = QueryLockedByStrategyResponse{} }
+func (m *QueryLockedByStrategyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLockedByStrategyResponse) ProtoMessage()    {}
+func (*QueryLockedByStrategyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ad330f982db981, []int{11}
+}
+func (m *QueryLockedByStrategyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLockedByStrategyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLockedByStrategyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLockedByStrategyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLockedByStrategyResponse.Merge(m, src)
+}
+func (m *QueryLockedByStrategyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLockedByStrategyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLockedByStrategyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLockedByStrategyResponse proto.InternalMessageInfo
+
+func (m *QueryLockedByStrategyResponse) GetLocked() []StrategyLocked {
+	if m != nil {
+		return m.Locked
+	}
+	return nil
+}
+
+// QuerySettleQueueRequest is the request type for the Query/SettleQueue RPC
+type QuerySettleQueueRequest struct {
+}
+
+func (m *QuerySettleQueueRequest) Reset()         { *m = QuerySettleQueueRequest{} }
+func (m *QuerySettleQueueRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySettleQueueRequest) ProtoMessage()    {}
+func (*QuerySettleQueueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ad330f982db981, []int{12}
+}
+func (m *QuerySettleQueueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettleQueueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettleQueueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettleQueueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettleQueueRequest.Merge(m, src)
+}
+func (m *QuerySettleQueueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettleQueueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettleQueueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettleQueueRequest proto.InternalMessageInfo
+
+// QuerySettleQueueResponse is the response type for the Query/SettleQueue RPC
+type QuerySettleQueueResponse struct {
+	// depth is the number of vouchers waiting in the queue
+	Depth uint64 `protobuf:"varint,1,opt,name=depth,proto3" json:"depth,omitempty"`
+	// matured is the number of queued vouchers that have already matured
+	Matured uint64 `protobuf:"varint,2,opt,name=matured,proto3" json:"matured,omitempty"`
+}
+
+func (m *QuerySettleQueueResponse) Reset()         { *m = QuerySettleQueueResponse{} }
+func (m *QuerySettleQueueResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySettleQueueResponse) ProtoMessage()    {}
+func (*QuerySettleQueueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ad330f982db981, []int{13}
+}
+func (m *QuerySettleQueueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySettleQueueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySettleQueueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySettleQueueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySettleQueueResponse.Merge(m, src)
+}
+func (m *QuerySettleQueueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySettleQueueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySettleQueueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySettleQueueResponse proto.InternalMessageInfo
+
+func (m *QuerySettleQueueResponse) GetDepth() uint64 {
+	if m != nil {
+		return m.Depth
+	}
+	return 0
+}
+
+func (m *QuerySettleQueueResponse) GetMatured() uint64 {
+	if m != nil {
+		return m.Matured
+	}
+	return 0
+}
+
+// StrategyLocked defines the amounts held in the vouchers of a strategy
+type StrategyLocked struct {
+	// strategy
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// voucher_count is the number of outstanding vouchers
+	VoucherCount uint64 `protobuf:"varint,2,opt,name=voucher_count,json=voucherCount,proto3" json:"voucher_count,omitempty"`
+	// vetabi_locked is the vetabi locked in the module account
+	VetabiLocked types.Coin `protobuf:"bytes,3,opt,name=vetabi_locked,json=vetabiLocked,proto3" json:"vetabi_locked"`
+	// tabi_pending is the tabi to be minted once all vouchers are fully released
+	TabiPending types.Coin `protobuf:"bytes,4,opt,name=tabi_pending,json=tabiPending,proto3" json:"tabi_pending"`
+}
+
+func (m *StrategyLocked) Reset()         { *m = StrategyLocked{} }
+func (m *StrategyLocked) String() string { return proto.CompactTextString(m) }
+func (*StrategyLocked) ProtoMessage()    {}
+func (*StrategyLocked) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e2ad330f982db981, []int{14}
+}
+func (m *StrategyLocked) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StrategyLocked) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StrategyLocked.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *StrategyLocked) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategyLocked.Merge(m, src)
+}
+func (m *StrategyLocked) XXX_Size() int {
+	return m.Size()
+}
+func (m *StrategyLocked) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategyLocked.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategyLocked proto.InternalMessageInfo
+
+func (m *StrategyLocked) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *StrategyLocked) GetVoucherCount() uint64 {
+	if m != nil {
+		return m.VoucherCount
+	}
+	return 0
+}
+
+func (m *StrategyLocked) GetVetabiLocked() types.Coin {
+	if m != nil {
+		return m.VetabiLocked
+	}
+	return types.Coin{}
+}
+
+func (m *StrategyLocked) GetTabiPending() types.Coin {
+	if m != nil {
+		return m.TabiPending
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*QueryStrategyRequest)(nil), "tabi.token_convert.v1.QueryStrategyRequest")
 	proto.RegisterType((*QueryStrategyResponse)(nil), "tabi.token_convert.v1.QueryStrategyResponse")
@@ -593,65 +850,84 @@ func init() {
 	proto.RegisterType((*QueryVouchersResponse)(nil), "tabi.token_convert.v1.QueryVouchersResponse")
 	proto.RegisterType((*QueryVoucherStatusRequest)(nil), "tabi.token_convert.v1.QueryVoucherStatusRequest")
 	proto.RegisterType((*QueryVoucherStatusResponse)(nil), "tabi.token_convert.v1.QueryVoucherStatusResponse")
+	proto.RegisterType((*QueryLockedByStrategyRequest)(nil), "tabi.token_convert.v1.QueryLockedByStrategyRequest")
+	proto.RegisterType((*QueryLockedByStrategyResponse)(nil), "tabi.token_convert.v1.QueryLockedByStrategyResponse")
+	proto.RegisterType((*QuerySettleQueueRequest)(nil), "tabi.token_convert.v1.QuerySettleQueueRequest")
+	proto.RegisterType((*QuerySettleQueueResponse)(nil), "tabi.token_convert.v1.QuerySettleQueueResponse")
+	proto.RegisterType((*StrategyLocked)(nil), "tabi.token_convert.v1.StrategyLocked")
 }
 
 func init() { proto.RegisterFile("tabi/token-convert/v1/query.proto", fileDescriptor_e2ad330f982db981) }
 
 var fileDescriptor_e2ad330f982db981 = []byte{
-	// 837 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x13, 0x47,
-	0x1c, 0xf5, 0xd8, 0x49, 0xea, 0x8c, 0xdb, 0xb4, 0x9d, 0x3a, 0xa9, 0xb3, 0x4a, 0x37, 0xce, 0xaa,
-	0xcd, 0xdf, 0x7a, 0xb7, 0x4e, 0x2b, 0x55, 0xea, 0xa9, 0x04, 0x11, 0x40, 0xe2, 0x00, 0x1b, 0x04,
-	0x12, 0x17, 0x33, 0xf6, 0x8e, 0x36, 0x23, 0xec, 0x1d, 0x67, 0x67, 0xec, 0x60, 0x45, 0xb9, 0x70,
-	0x04, 0x09, 0x21, 0x05, 0xbe, 0x00, 0x37, 0x0e, 0xdc, 0xf8, 0x10, 0x39, 0x46, 0x70, 0x41, 0x42,
-	0x42, 0x28, 0xe1, 0x83, 0xa0, 0x9d, 0x9d, 0x5d, 0x7b, 0x13, 0xc7, 0x2c, 0x88, 0xdb, 0xce, 0xcc,
-	0x7b, 0xf3, 0x7b, 0xbf, 0xf7, 0x66, 0x46, 0x0b, 0x17, 0x04, 0xae, 0x53, 0x4b, 0xb0, 0x7b, 0xc4,
-	0xab, 0x34, 0x98, 0xd7, 0x25, 0xbe, 0xb0, 0xba, 0x55, 0x6b, 0xa7, 0x43, 0xfc, 0x9e, 0xd9, 0xf6,
-	0x99, 0x60, 0x68, 0x3a, 0x80, 0x98, 0x12, 0x52, 0x53, 0x10, 0xb3, 0x5b, 0xd5, 0x8a, 0x2e, 0x73,
-	0x99, 0x44, 0x58, 0xc1, 0x57, 0x08, 0xd6, 0xe6, 0x5c, 0xc6, 0xdc, 0x26, 0xb1, 0x70, 0x9b, 0x5a,
-	0xd8, 0xf3, 0x98, 0xc0, 0x82, 0x32, 0x8f, 0xab, 0xd5, 0xd9, 0x06, 0xe3, 0x2d, 0xc6, 0x6b, 0x21,
-	0x2d, 0x1c, 0xa8, 0x25, 0x3d, 0x1c, 0x59, 0x75, 0xcc, 0x89, 0xd5, 0xad, 0xd6, 0x89, 0xc0, 0x55,
-	0xab, 0xc1, 0xa8, 0xa7, 0xd6, 0x57, 0x07, 0xd7, 0xa5, 0xbc, 0x18, 0xd5, 0xc6, 0x2e, 0xf5, 0x64,
-	0x1d, 0x85, 0x5d, 0x19, 0xde, 0x54, 0xb2, 0x05, 0x09, 0x35, 0x56, 0x61, 0xf1, 0x46, 0xb0, 0xd9,
-	0x96, 0xf0, 0xb1, 0x20, 0x6e, 0xcf, 0x26, 0x3b, 0x1d, 0xc2, 0x05, 0x42, 0x70, 0xcc, 0xc3, 0x2d,
-	0x52, 0x02, 0x65, 0xb0, 0x3c, 0x69, 0xcb, 0x6f, 0xa3, 0x09, 0xa7, 0x4f, 0x61, 0x79, 0x9b, 0x79,
-	0x9c, 0x0c, 0x03, 0xa3, 0x19, 0x38, 0xd1, 0x26, 0x3e, 0x65, 0x4e, 0x29, 0x5b, 0x06, 0xcb, 0x39,
-	0x5b, 0x8d, 0xd0, 0x12, 0xfc, 0x31, 0x54, 0xc0, 0x29, 0xf3, 0x6a, 0xc1, 0x46, 0xa5, 0x9c, 0xa4,
-	0x4d, 0xf5, 0xa7, 0x6d, 0x2c, 0x88, 0x71, 0x17, 0xce, 0x0c, 0x56, 0xa3, 0x84, 0x47, 0xda, 0x36,
-	0x21, 0xec, 0xb7, 0x2c, 0x8b, 0x16, 0xd6, 0x17, 0x4d, 0xe5, 0x66, 0xe0, 0x8f, 0x19, 0xc6, 0xa7,
-	0xfc, 0x31, 0xaf, 0x63, 0x97, 0x28, 0xae, 0x3d, 0xc0, 0x34, 0x5e, 0x00, 0xf8, 0xeb, 0x99, 0x12,
-	0xaa, 0xa5, 0x4b, 0x10, 0xf2, 0x78, 0xb6, 0x04, 0xca, 0xb9, 0xe5, 0xc2, 0xfa, 0xbc, 0x39, 0xf4,
-	0x24, 0x98, 0x91, 0x1f, 0x1b, 0x63, 0x87, 0xef, 0xe7, 0x33, 0xf6, 0x00, 0x11, 0x5d, 0x4e, 0x48,
-	0xcd, 0x4a, 0xa9, 0x4b, 0x9f, 0x95, 0x1a, 0x6a, 0x48, 0x68, 0xfd, 0x07, 0xfe, 0x22, 0xa5, 0xde,
-	0x62, 0x9d, 0xc6, 0x36, 0xf1, 0x23, 0x2b, 0x7e, 0x83, 0xb0, 0x1b, 0xce, 0xd4, 0xa8, 0xa3, 0xfc,
-	0x9f, 0x54, 0x33, 0x57, 0x1d, 0x63, 0x4f, 0xa5, 0x1b, 0xb3, 0x54, 0x77, 0x53, 0x30, 0x1b, 0xc3,
-	0xb3, 0xd4, 0x41, 0x45, 0x38, 0xce, 0x76, 0x3d, 0xe2, 0x4b, 0x85, 0x93, 0x76, 0x38, 0x40, 0x0b,
-	0xf0, 0xfb, 0x86, 0x4f, 0xb0, 0x20, 0x4e, 0x4d, 0xd0, 0x56, 0x98, 0x53, 0xce, 0x2e, 0xa8, 0xb9,
-	0x9b, 0xb4, 0x45, 0x90, 0x06, 0xf3, 0xaa, 0xdb, 0x5e, 0x69, 0x4c, 0x72, 0xe3, 0xb1, 0xf1, 0x18,
-	0x24, 0xab, 0xc7, 0xf9, 0x99, 0x51, 0x35, 0x29, 0x60, 0xa3, 0xf4, 0xfa, 0x55, 0xa5, 0xa8, 0x2c,
-	0xb9, 0xe0, 0x38, 0x3e, 0xe1, 0x7c, 0x4b, 0xf8, 0xd4, 0x73, 0x23, 0x1d, 0x9b, 0x43, 0x4c, 0xfc,
-	0x9a, 0xbc, 0x9f, 0x03, 0x75, 0x80, 0xfb, 0x82, 0x94, 0x1f, 0xff, 0xc3, 0xbc, 0x32, 0x2d, 0xca,
-	0x5a, 0x3f, 0x27, 0x6b, 0x45, 0x55, 0x51, 0xc7, 0xac, 0x6f, 0x17, 0xf4, 0x7f, 0x70, 0x76, 0x50,
-	0xe3, 0x96, 0xc0, 0xa2, 0xc3, 0x53, 0xc6, 0xfd, 0x0e, 0x40, 0x6d, 0x18, 0x59, 0x75, 0x19, 0xe4,
-	0xd9, 0xf1, 0x7d, 0xe2, 0x89, 0x30, 0xcf, 0x90, 0x5f, 0x50, 0x73, 0x32, 0xcf, 0x6b, 0xf0, 0xe7,
-	0xa0, 0xef, 0xda, 0x2e, 0x15, 0xdb, 0x8e, 0x8f, 0x77, 0x71, 0xbd, 0x49, 0x54, 0x37, 0xb3, 0x89,
-	0x6e, 0xa2, 0x3e, 0x2e, 0x32, 0xea, 0x29, 0x33, 0x7e, 0x0a, 0x98, 0xb7, 0x07, 0x88, 0xc1, 0x6e,
-	0x5d, 0x22, 0xf7, 0xf3, 0x89, 0xe8, 0xf8, 0x9e, 0xdc, 0x2d, 0x97, 0x72, 0xb7, 0x90, 0x69, 0xc7,
-	0xc4, 0xf5, 0x87, 0x13, 0x70, 0x5c, 0x76, 0x87, 0x9e, 0x02, 0x98, 0x8f, 0x2e, 0x1d, 0x5a, 0x3b,
-	0x27, 0xa9, 0x61, 0xcf, 0x9a, 0xf6, 0x67, 0x3a, 0x70, 0x68, 0x98, 0x51, 0x79, 0xf0, 0xe6, 0xe3,
-	0x41, 0x76, 0x09, 0xfd, 0x61, 0xdd, 0x3f, 0xfb, 0x9a, 0xf6, 0x6f, 0xb9, 0xb5, 0x17, 0xbc, 0x78,
-	0xfb, 0xe8, 0x00, 0x40, 0xd8, 0x7f, 0x4a, 0x50, 0x25, 0x45, 0xad, 0xfe, 0xab, 0xa6, 0x99, 0x69,
-	0xe1, 0x4a, 0xdc, 0xa2, 0x14, 0x57, 0x46, 0xfa, 0x68, 0x71, 0xe8, 0x19, 0x80, 0xdf, 0xa9, 0xf3,
-	0x80, 0x56, 0x47, 0xd5, 0x48, 0x3e, 0x2d, 0xda, 0x5a, 0x2a, 0xac, 0x12, 0x53, 0x95, 0x62, 0xd6,
-	0xd0, 0xca, 0x30, 0x31, 0xd1, 0x25, 0xb1, 0xf6, 0xfa, 0x87, 0x77, 0x1f, 0x3d, 0x02, 0x30, 0x1f,
-	0x5d, 0x44, 0x94, 0xa6, 0x18, 0x4f, 0x15, 0xe2, 0xe9, 0xbb, 0x6d, 0xfc, 0x2e, 0xa5, 0xe9, 0x68,
-	0x6e, 0x94, 0x34, 0xf4, 0x12, 0xc0, 0x1f, 0x12, 0xb7, 0x06, 0xfd, 0x95, 0xa2, 0x4a, 0xe2, 0x76,
-	0x6a, 0xd5, 0x2f, 0x60, 0x28, 0x71, 0xff, 0x4a, 0x71, 0x55, 0x64, 0x8d, 0x10, 0x57, 0xe1, 0x92,
-	0x93, 0x70, 0x6f, 0xe3, 0xca, 0xe1, 0xb1, 0x0e, 0x8e, 0x8e, 0x75, 0xf0, 0xe1, 0x58, 0x07, 0x4f,
-	0x4e, 0xf4, 0xcc, 0xd1, 0x89, 0x9e, 0x79, 0x7b, 0xa2, 0x67, 0xee, 0x98, 0x2e, 0x15, 0xdb, 0x9d,
-	0xba, 0xd9, 0x60, 0x2d, 0x2b, 0xd0, 0xd3, 0xc4, 0x75, 0x2e, 0x3f, 0xce, 0x94, 0x10, 0xbd, 0x36,
-	0xe1, 0xf5, 0x09, 0xf9, 0x23, 0xf0, 0xf7, 0xa7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x09, 0xf3, 0xc1,
-	0xf0, 0x0a, 0x09, 0x00, 0x00,
+	// 1059 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xce, 0x24, 0x4e, 0xea, 0x3c, 0x27, 0xa1, 0x0c, 0x6e, 0x71, 0x56, 0xe9, 0xd6, 0x5d, 0x68,
+	0xe2, 0x26, 0x78, 0x17, 0xa7, 0x95, 0x90, 0x38, 0x41, 0x02, 0xe5, 0x87, 0x7a, 0x68, 0x37, 0x08,
+	0x24, 0x2e, 0x66, 0xed, 0x1d, 0x6d, 0x56, 0xb5, 0x77, 0x9c, 0xdd, 0x59, 0x07, 0x2b, 0xea, 0x85,
+	0x13, 0x12, 0x12, 0x42, 0x2a, 0x48, 0x9c, 0xb9, 0x71, 0xe8, 0x8d, 0x3f, 0xa2, 0xc7, 0x0a, 0x84,
+	0x84, 0x84, 0x84, 0x50, 0xc2, 0x1f, 0x82, 0x76, 0xe6, 0xad, 0xed, 0x8d, 0x1d, 0x77, 0x8b, 0xb8,
+	0xed, 0xbc, 0x79, 0xdf, 0x7b, 0xdf, 0x7b, 0xdf, 0xcc, 0x9b, 0x85, 0x1b, 0xc2, 0x69, 0xf9, 0x96,
+	0xe0, 0x0f, 0x59, 0x50, 0x6f, 0xf3, 0xa0, 0xcf, 0x42, 0x61, 0xf5, 0x1b, 0xd6, 0x51, 0xcc, 0xc2,
+	0x81, 0xd9, 0x0b, 0xb9, 0xe0, 0xf4, 0x4a, 0xe2, 0x62, 0x4a, 0x97, 0x26, 0xba, 0x98, 0xfd, 0x86,
+	0x56, 0xf6, 0xb8, 0xc7, 0xa5, 0x87, 0x95, 0x7c, 0x29, 0x67, 0x6d, 0xc3, 0xe3, 0xdc, 0xeb, 0x30,
+	0xcb, 0xe9, 0xf9, 0x96, 0x13, 0x04, 0x5c, 0x38, 0xc2, 0xe7, 0x41, 0x84, 0xbb, 0xeb, 0x6d, 0x1e,
+	0x75, 0x79, 0xd4, 0x54, 0x30, 0xb5, 0xc0, 0x2d, 0x5d, 0xad, 0xac, 0x96, 0x13, 0x31, 0xab, 0xdf,
+	0x68, 0x31, 0xe1, 0x34, 0xac, 0x36, 0xf7, 0x03, 0xdc, 0xdf, 0x1e, 0xdf, 0x97, 0xf4, 0x86, 0x5e,
+	0x3d, 0xc7, 0xf3, 0x03, 0x99, 0x07, 0x7d, 0x6f, 0x4d, 0x2f, 0x2a, 0x5b, 0x82, 0x74, 0x35, 0xb6,
+	0xa1, 0xfc, 0x20, 0x09, 0x76, 0x20, 0x42, 0x47, 0x30, 0x6f, 0x60, 0xb3, 0xa3, 0x98, 0x45, 0x82,
+	0x52, 0x28, 0x04, 0x4e, 0x97, 0x55, 0x48, 0x95, 0xd4, 0x96, 0x6d, 0xf9, 0x6d, 0x7c, 0x4d, 0xe0,
+	0xca, 0x39, 0xe7, 0xa8, 0xc7, 0x83, 0x88, 0x4d, 0xf3, 0xa6, 0x57, 0x61, 0xa9, 0xc7, 0x42, 0x9f,
+	0xbb, 0x95, 0xf9, 0x2a, 0xa9, 0x2d, 0xd8, 0xb8, 0xa2, 0x5b, 0xf0, 0x92, 0xa2, 0x10, 0xf9, 0x3c,
+	0x68, 0x26, 0x81, 0x2a, 0x0b, 0x12, 0xb6, 0x36, 0x32, 0xdb, 0x8e, 0x60, 0x54, 0x83, 0xa2, 0xeb,
+	0x47, 0x4e, 0xab, 0xc3, 0xdc, 0x4a, 0xa1, 0x4a, 0x6a, 0x45, 0x7b, 0xb8, 0x36, 0xbe, 0x80, 0xab,
+	0xe3, 0x4c, 0x7c, 0x16, 0xa5, 0xc4, 0xef, 0x02, 0x8c, 0xfa, 0x21, 0x09, 0x95, 0x76, 0x37, 0x4d,
+	0x6c, 0x75, 0xd2, 0x3c, 0x53, 0x69, 0x8b, 0xcd, 0x33, 0xef, 0x3b, 0x1e, 0x43, 0xac, 0x3d, 0x86,
+	0x34, 0x7e, 0x26, 0xf0, 0xea, 0x44, 0x0a, 0x2c, 0xf7, 0x7d, 0x80, 0x68, 0x68, 0xad, 0x90, 0xea,
+	0x42, 0xad, 0xb4, 0x7b, 0xdd, 0x9c, 0x7a, 0x4c, 0xcc, 0xb4, 0x57, 0x7b, 0x85, 0xa7, 0x7f, 0x5d,
+	0x9f, 0xb3, 0xc7, 0x80, 0xf4, 0x83, 0x0c, 0xd5, 0x79, 0x49, 0x75, 0xeb, 0xb9, 0x54, 0x15, 0x87,
+	0x0c, 0xd7, 0x3b, 0xf0, 0x8a, 0xa4, 0xfa, 0x29, 0x8f, 0xdb, 0x87, 0x2c, 0x4c, 0x5b, 0x71, 0x0d,
+	0xa0, 0xaf, 0x2c, 0x4d, 0xdf, 0x45, 0x6d, 0x96, 0xd1, 0xf2, 0x91, 0x6b, 0x9c, 0x40, 0x39, 0x8b,
+	0xc2, 0xea, 0xd6, 0x60, 0x7e, 0xe8, 0x3e, 0xef, 0xbb, 0xb4, 0x0c, 0x8b, 0xfc, 0x38, 0x60, 0xa1,
+	0x64, 0xb8, 0x6c, 0xab, 0x05, 0xbd, 0x01, 0x2b, 0xed, 0x90, 0x39, 0x82, 0xb9, 0x4d, 0xe1, 0x77,
+	0x95, 0x86, 0x0b, 0x76, 0x09, 0x6d, 0x9f, 0xf8, 0x5d, 0x29, 0x20, 0x56, 0x3b, 0x90, 0x02, 0x2e,
+	0xdb, 0xc3, 0xb5, 0xf1, 0x2d, 0xc9, 0x66, 0x1f, 0xea, 0x67, 0xa6, 0xd9, 0x24, 0x81, 0xbd, 0xca,
+	0xaf, 0xbf, 0xd4, 0xcb, 0xd8, 0x92, 0x77, 0x5d, 0x37, 0x64, 0x51, 0x74, 0x20, 0x42, 0x3f, 0xf0,
+	0x52, 0x1e, 0x77, 0xa7, 0x34, 0xf1, 0xbf, 0xe8, 0xfd, 0x53, 0x7a, 0xb8, 0x47, 0x84, 0xb0, 0x1f,
+	0xef, 0x40, 0x11, 0x9b, 0x96, 0x6a, 0xad, 0x5f, 0xa0, 0x35, 0x42, 0x51, 0xea, 0x21, 0xea, 0xff,
+	0x13, 0xfa, 0x6d, 0x58, 0x1f, 0xe7, 0x78, 0x20, 0x1c, 0x11, 0x47, 0x39, 0xe5, 0xfe, 0x93, 0x80,
+	0x36, 0x0d, 0x8c, 0x55, 0x26, 0x7a, 0xc6, 0x61, 0xc8, 0x02, 0xa1, 0xf4, 0x54, 0xf8, 0x12, 0xda,
+	0xa4, 0x9e, 0xf7, 0xe0, 0xe5, 0xa4, 0xee, 0xe6, 0xb1, 0x2f, 0x0e, 0xdd, 0xd0, 0x39, 0x4e, 0xae,
+	0x22, 0x56, 0xb3, 0x9e, 0xa9, 0x26, 0xad, 0x63, 0x9f, 0xfb, 0x01, 0x36, 0xe3, 0x72, 0x82, 0xfc,
+	0x6c, 0x0c, 0x98, 0x44, 0xeb, 0x33, 0x19, 0x2f, 0x64, 0x22, 0x0e, 0x03, 0x19, 0x6d, 0x21, 0x67,
+	0x34, 0x85, 0xb4, 0x87, 0x40, 0x43, 0x87, 0x0d, 0x59, 0xdc, 0x3d, 0xde, 0x7e, 0xc8, 0xdc, 0xbd,
+	0xf3, 0xf3, 0xcc, 0x70, 0xe1, 0xda, 0x05, 0xfb, 0x58, 0xff, 0x3e, 0x2c, 0x75, 0xe4, 0x1e, 0x6a,
+	0x7c, 0xf3, 0x39, 0xf7, 0x19, 0x03, 0x29, 0x3e, 0x08, 0x35, 0xd6, 0xd3, 0x99, 0xc1, 0x84, 0xe8,
+	0xb0, 0x07, 0x31, 0x8b, 0xd3, 0xb3, 0x66, 0x7c, 0x0c, 0x95, 0xc9, 0x2d, 0xcc, 0x5d, 0x86, 0x45,
+	0x97, 0xf5, 0xc4, 0xa1, 0x6c, 0x7a, 0xc1, 0x56, 0x0b, 0x5a, 0x81, 0x4b, 0x5d, 0x47, 0xc4, 0x21,
+	0x53, 0x13, 0xb4, 0x60, 0xa7, 0xcb, 0x44, 0xca, 0xb5, 0x2c, 0x8f, 0xcc, 0x5d, 0x23, 0xd9, 0xbb,
+	0x46, 0x5f, 0x83, 0xd5, 0xf4, 0x60, 0xb4, 0x79, 0x1c, 0x08, 0x0c, 0xb7, 0x82, 0xc6, 0xfd, 0xc4,
+	0x46, 0xdf, 0x83, 0x55, 0x94, 0x03, 0xdb, 0x90, 0x53, 0x8a, 0x15, 0x85, 0x42, 0x1a, 0x7b, 0xb0,
+	0x22, 0x63, 0xf4, 0x58, 0xe0, 0xfa, 0x81, 0x57, 0x29, 0xe4, 0x0b, 0x52, 0x4a, 0x40, 0xf7, 0x15,
+	0x66, 0xf7, 0xf7, 0x22, 0x2c, 0xca, 0x56, 0xd1, 0xef, 0x09, 0x14, 0xd3, 0x3a, 0xe9, 0xce, 0x05,
+	0x82, 0x4c, 0x7b, 0xbe, 0xb4, 0x37, 0xf2, 0x39, 0xab, 0xfe, 0x1b, 0xf5, 0xaf, 0x7e, 0xfb, 0xe7,
+	0xf1, 0xfc, 0x16, 0xbd, 0x69, 0x7d, 0x39, 0xf9, 0x6a, 0x8e, 0x06, 0xb6, 0x75, 0x92, 0x3c, 0x6c,
+	0x8f, 0xe8, 0x63, 0x02, 0x30, 0x7a, 0x15, 0x68, 0x3d, 0x47, 0xae, 0xd1, 0x03, 0xa5, 0x99, 0x79,
+	0xdd, 0x91, 0xdc, 0xa6, 0x24, 0x57, 0xa5, 0xfa, 0x6c, 0x72, 0xf4, 0x07, 0x02, 0x97, 0xf0, 0x6a,
+	0xd3, 0xed, 0x59, 0x39, 0xb2, 0xaf, 0x84, 0xb6, 0x93, 0xcb, 0x17, 0xc9, 0x34, 0x24, 0x99, 0x1d,
+	0x7a, 0x6b, 0x1a, 0x99, 0x74, 0xde, 0x59, 0x27, 0xa3, 0x39, 0xf4, 0x88, 0x7e, 0x43, 0xa0, 0x98,
+	0xce, 0x54, 0x9a, 0x27, 0x59, 0x94, 0x4b, 0xc4, 0xf3, 0x63, 0xda, 0x78, 0x5d, 0x52, 0xd3, 0xe9,
+	0xc6, 0x2c, 0x6a, 0xf4, 0x09, 0x81, 0xd5, 0xcc, 0x00, 0xa4, 0x6f, 0xe6, 0xc8, 0x92, 0x19, 0xb4,
+	0x5a, 0xe3, 0x05, 0x10, 0x48, 0xee, 0x2d, 0x49, 0xae, 0x41, 0xad, 0x19, 0xe4, 0xea, 0x91, 0xc4,
+	0x64, 0xbb, 0xf7, 0x84, 0xc0, 0xe5, 0xf3, 0x33, 0x8b, 0xde, 0x9e, 0x45, 0xe0, 0x82, 0x09, 0xa8,
+	0xdd, 0x79, 0x31, 0x10, 0x12, 0x37, 0x25, 0xf1, 0x1a, 0xdd, 0x9c, 0x46, 0x5c, 0x4d, 0x8a, 0x7a,
+	0x6b, 0x50, 0x1f, 0xce, 0x9a, 0x1f, 0x09, 0x94, 0xc6, 0x46, 0x1c, 0x9d, 0x7d, 0xda, 0x27, 0xc6,
+	0xa4, 0x66, 0xe5, 0xf6, 0x47, 0x82, 0x35, 0x49, 0xd0, 0xa0, 0xd5, 0xa9, 0xd7, 0x43, 0x02, 0xea,
+	0x47, 0x09, 0x62, 0xef, 0xc3, 0xa7, 0xa7, 0x3a, 0x79, 0x76, 0xaa, 0x93, 0xbf, 0x4f, 0x75, 0xf2,
+	0xdd, 0x99, 0x3e, 0xf7, 0xec, 0x4c, 0x9f, 0xfb, 0xe3, 0x4c, 0x9f, 0xfb, 0xdc, 0xf4, 0x7c, 0x71,
+	0x18, 0xb7, 0xcc, 0x36, 0xef, 0x5a, 0x49, 0xfa, 0x8e, 0xd3, 0x8a, 0xe4, 0xc7, 0x44, 0x4c, 0x31,
+	0xe8, 0xb1, 0xa8, 0xb5, 0x24, 0xff, 0x9d, 0x6f, 0xff, 0x3b, 0x00, 0x2a, 0x52, 0x0c, 0x13, 0x3d,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -676,6 +952,10 @@ type QueryClient interface {
 	Vouchers(ctx context.Context, in *QueryVouchersRequest, opts ...grpc.CallOption) (*QueryVouchersResponse, error)
 	// VoucherStatus returns the info about the amount of tabi withdrawable and vetabi returnable
 	VoucherStatus(ctx context.Context, in *QueryVoucherStatusRequest, opts ...grpc.CallOption) (*QueryVoucherStatusResponse, error)
+	// LockedByStrategy returns the total vetabi locked and tabi pending in vouchers per strategy
+	LockedByStrategy(ctx context.Context, in *QueryLockedByStrategyRequest, opts ...grpc.CallOption) (*QueryLockedByStrategyResponse, error)
+	// SettleQueue returns the number of vouchers pending auto-settlement
+	SettleQueue(ctx context.Context, in *QuerySettleQueueRequest, opts ...grpc.CallOption) (*QuerySettleQueueResponse, error)
 }
 
 type queryClient struct {
@@ -731,6 +1011,24 @@ func (c *queryClient) VoucherStatus(ctx context.Context, in *QueryVoucherStatusR
 	return out, nil
 }
 
+func (c *queryClient) LockedByStrategy(ctx context.Context, in *QueryLockedByStrategyRequest, opts ...grpc.CallOption) (*QueryLockedByStrategyResponse, error) {
+	out := new(QueryLockedByStrategyResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Query/LockedByStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SettleQueue(ctx context.Context, in *QuerySettleQueueRequest, opts ...grpc.CallOption) (*QuerySettleQueueResponse, error) {
+	out := new(QuerySettleQueueResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Query/SettleQueue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Strategy
@@ -743,6 +1041,10 @@ type QueryServer interface {
 	Vouchers(context.Context, *QueryVouchersRequest) (*QueryVouchersResponse, error)
 	// VoucherStatus returns the info about the amount of tabi withdrawable and vetabi returnable
 	VoucherStatus(context.Context, *QueryVoucherStatusRequest) (*QueryVoucherStatusResponse, error)
+	// LockedByStrategy returns the total vetabi locked and tabi pending in vouchers per strategy
+	LockedByStrategy(context.Context, *QueryLockedByStrategyRequest) (*QueryLockedByStrategyResponse, error)
+	// SettleQueue returns the number of vouchers pending auto-settlement
+	SettleQueue(context.Context, *QuerySettleQueueRequest) (*QuerySettleQueueResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -764,6 +1066,12 @@ func (*UnimplementedQueryServer) Vouchers(ctx context.Context, req *QueryVoucher
 func (*UnimplementedQueryServer) VoucherStatus(ctx context.Context, req *QueryVoucherStatusRequest) (*QueryVoucherStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method VoucherStatus not implemented")
 }
+func (*UnimplementedQueryServer) LockedByStrategy(ctx context.Context, req *QueryLockedByStrategyRequest) (*QueryLockedByStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LockedByStrategy not implemented")
+}
+func (*UnimplementedQueryServer) SettleQueue(ctx context.Context, req *QuerySettleQueueRequest) (*QuerySettleQueueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SettleQueue not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -859,6 +1167,42 @@ func _Query_VoucherStatus_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_LockedByStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLockedByStrategyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LockedByStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Query/LockedByStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LockedByStrategy(ctx, req.(*QueryLockedByStrategyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SettleQueue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySettleQueueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SettleQueue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Query/SettleQueue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SettleQueue(ctx, req.(*QuerySettleQueueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "tabi.token_convert.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -883,6 +1227,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "VoucherStatus",
 			Handler:    _Query_VoucherStatus_Handler,
 		},
+		{
+			MethodName: "LockedByStrategy",
+			Handler:    _Query_LockedByStrategy_Handler,
+		},
+		{
+			MethodName: "SettleQueue",
+			Handler:    _Query_SettleQueue_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "tabi/token-convert/v1/query.proto",
@@ -938,6 +1290,16 @@ func (m *QueryStrategyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ConversionRate) > 0 {
 		i -= len(m.ConversionRate)
 		copy(dAtA[i:], m.ConversionRate)
@@ -1294,64 +1656,238 @@ func (m *QueryVoucherStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryLockedByStrategyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryStrategyRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryLockedByStrategyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryStrategyResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryLockedByStrategyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Period != 0 {
-		n += 1 + sovQuery(uint64(m.Period))
-	}
-	l = len(m.ConversionRate)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryStrategiesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryLockedByStrategyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryStrategiesResponse) Size() (n int) {
+func (m *QueryLockedByStrategyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLockedByStrategyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for iNdEx := len(m.Locked) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Locked[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettleQueueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettleQueueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettleQueueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySettleQueueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySettleQueueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySettleQueueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Matured != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Matured))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Depth != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Depth))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *StrategyLocked) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StrategyLocked) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StrategyLocked) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.TabiPending.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.VetabiLocked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.VoucherCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.VoucherCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryStrategyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStrategyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovQuery(uint64(m.Period))
+	}
+	l = len(m.ConversionRate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryStrategiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStrategiesResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1473,6 +2009,74 @@ func (m *QueryVoucherStatusResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryLockedByStrategyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryLockedByStrategyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Locked) > 0 {
+		for _, e := range m.Locked {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QuerySettleQueueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySettleQueueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Depth != 0 {
+		n += 1 + sovQuery(uint64(m.Depth))
+	}
+	if m.Matured != 0 {
+		n += 1 + sovQuery(uint64(m.Matured))
+	}
+	return n
+}
+
+func (m *StrategyLocked) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.VoucherCount != 0 {
+		n += 1 + sovQuery(uint64(m.VoucherCount))
+	}
+	l = m.VetabiLocked.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TabiPending.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1673,6 +2277,26 @@ func (m *QueryStrategyResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.ConversionRate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2615,6 +3239,445 @@ func (m *QueryVoucherStatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryLockedByStrategyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedByStrategyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedByStrategyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLockedByStrategyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLockedByStrategyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLockedByStrategyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Locked = append(m.Locked, StrategyLocked{})
+			if err := m.Locked[len(m.Locked)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettleQueueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettleQueueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettleQueueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySettleQueueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySettleQueueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySettleQueueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depth", wireType)
+			}
+			m.Depth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Depth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Matured", wireType)
+			}
+			m.Matured = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Matured |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StrategyLocked) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StrategyLocked: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StrategyLocked: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherCount", wireType)
+			}
+			m.VoucherCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoucherCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetabiLocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetabiLocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabiPending", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TabiPending.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
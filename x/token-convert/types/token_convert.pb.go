@@ -34,6 +34,8 @@ type Strategy struct {
 	Period int64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
 	// conversion_rate is the conversion rate from Vetabi to Tabi.
 	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate"`
+	// disabled marks the strategy as unavailable for new conversions.
+	Disabled bool `protobuf:"varint,4,opt,name=disabled,proto3" json:"disabled,omitempty"`
 }
 
 func (m *Strategy) Reset()         { *m = Strategy{} }
@@ -83,6 +85,13 @@ func (m *Strategy) GetPeriod() int64 {
 	return 0
 }
 
+func (m *Strategy) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
 // Voucher defines the voucher for redeeming locked token.
 type Voucher struct {
 	// id is the unique identifier of the voucher.
@@ -95,6 +104,12 @@ type Voucher struct {
 	CreatedTime int64 `protobuf:"varint,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
 	// strategy is the unique name of the strategy.
 	Strategy string `protobuf:"bytes,5,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	// period is the lock time in seconds of the strategy when the voucher was created.
+	Period int64 `protobuf:"varint,6,opt,name=period,proto3" json:"period,omitempty"`
+	// conversion_rate is the conversion rate of the strategy when the voucher was created.
+	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate"`
+	// auto_settle marks the voucher to be withdrawn automatically once it matures.
+	AutoSettle bool `protobuf:"varint,8,opt,name=auto_settle,json=autoSettle,proto3" json:"auto_settle,omitempty"`
 }
 
 func (m *Voucher) Reset()         { *m = Voucher{} }
@@ -165,6 +180,20 @@ func (m *Voucher) GetStrategy() string {
 	return ""
 }
 
+func (m *Voucher) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *Voucher) GetAutoSettle() bool {
+	if m != nil {
+		return m.AutoSettle
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Strategy)(nil), "tabi.token_convert.v1.Strategy")
 	proto.RegisterType((*Voucher)(nil), "tabi.token_convert.v1.Voucher")
@@ -175,33 +204,36 @@ func init() {
 }
 
 var fileDescriptor_faae8732882f4cd2 = []byte{
-	// 410 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xbd, 0x6e, 0x14, 0x31,
-	0x10, 0x3e, 0x5f, 0x2e, 0x47, 0xe2, 0xa0, 0x20, 0x59, 0x01, 0x6d, 0xae, 0xd8, 0x84, 0x14, 0x28,
-	0x14, 0x67, 0xeb, 0xa0, 0xa0, 0xa1, 0xe1, 0x48, 0x41, 0xbd, 0x41, 0x14, 0x34, 0x2b, 0xaf, 0x77,
-	0xb4, 0xb1, 0xc2, 0x7a, 0x4e, 0xb6, 0x6f, 0x21, 0x6f, 0xc1, 0x0b, 0xf0, 0x16, 0x79, 0x01, 0xba,
-	0x94, 0x51, 0x2a, 0x44, 0x71, 0x42, 0x77, 0x2f, 0x82, 0xd6, 0xb6, 0xf8, 0x49, 0xe5, 0x99, 0xf9,
-	0xbe, 0x19, 0x7f, 0xfe, 0xc6, 0xf4, 0xb9, 0x97, 0x95, 0x16, 0x1e, 0x2f, 0xc1, 0x4c, 0x15, 0x9a,
-	0x0e, 0xac, 0x17, 0xdd, 0x2c, 0x16, 0xca, 0x54, 0xe0, 0x0b, 0x8b, 0x1e, 0xd9, 0xe3, 0x9e, 0xca,
-	0xff, 0x47, 0xba, 0xd9, 0xe4, 0xa0, 0xc1, 0x06, 0x03, 0x43, 0xf4, 0x51, 0x24, 0x4f, 0x72, 0x85,
-	0xae, 0x45, 0x27, 0x2a, 0xe9, 0x40, 0x74, 0xb3, 0x0a, 0xbc, 0x9c, 0x09, 0x85, 0xda, 0x24, 0xfc,
-	0x30, 0xe2, 0x65, 0x6c, 0x8c, 0x49, 0x84, 0x4e, 0xbe, 0x11, 0xba, 0x73, 0xee, 0xad, 0xf4, 0xd0,
-	0x5c, 0x31, 0x46, 0x47, 0x46, 0xb6, 0x90, 0x91, 0x63, 0x72, 0xba, 0x5b, 0x84, 0x98, 0x3d, 0xa1,
-	0xe3, 0x05, 0x58, 0x8d, 0x75, 0x36, 0x3c, 0x26, 0xa7, 0x5b, 0x45, 0xca, 0x18, 0xd0, 0x47, 0x51,
-	0x97, 0xd3, 0x68, 0xca, 0x7e, 0x40, 0xb6, 0xd5, 0xb7, 0xcd, 0x5f, 0xdf, 0xac, 0x8e, 0x06, 0x3f,
-	0x57, 0x47, 0xcf, 0x1a, 0xed, 0x2f, 0x96, 0x15, 0x57, 0xd8, 0xa6, 0x2b, 0xd3, 0x31, 0x75, 0xf5,
-	0xa5, 0xf0, 0x57, 0x0b, 0x70, 0xfc, 0x0c, 0xd4, 0xdd, 0xf5, 0x94, 0x26, 0x45, 0x67, 0xa0, 0x8a,
-	0xfd, 0xbf, 0x43, 0x0b, 0xe9, 0xe1, 0xe4, 0x3b, 0xa1, 0x0f, 0x3e, 0xe0, 0x52, 0x5d, 0x80, 0x65,
-	0xfb, 0x74, 0xa8, 0xeb, 0x24, 0x6e, 0xa8, 0x6b, 0xc6, 0xe9, 0x36, 0x7e, 0x36, 0x60, 0x83, 0xb2,
-	0xdd, 0x79, 0x76, 0x77, 0x3d, 0x3d, 0x48, 0xa3, 0xde, 0xd4, 0xb5, 0x05, 0xe7, 0xce, 0xbd, 0xd5,
-	0xa6, 0x29, 0x22, 0x8d, 0xbd, 0xa2, 0x63, 0xd9, 0xe2, 0xd2, 0xf8, 0xa0, 0x74, 0xef, 0xc5, 0x21,
-	0x4f, 0xec, 0xde, 0x37, 0x9e, 0x7c, 0xe3, 0x6f, 0x51, 0x9b, 0xf9, 0xa8, 0x7f, 0x44, 0x91, 0xe8,
-	0xec, 0x29, 0x7d, 0xa8, 0x2c, 0x48, 0x0f, 0x75, 0xe9, 0x75, 0x0b, 0xd9, 0x28, 0x38, 0xb1, 0x97,
-	0x6a, 0xef, 0x75, 0x0b, 0x6c, 0x42, 0x77, 0x5c, 0xb2, 0x31, 0xdb, 0x0e, 0x0a, 0xff, 0xe4, 0xf3,
-	0x77, 0x37, 0xeb, 0x9c, 0xdc, 0xae, 0x73, 0xf2, 0x6b, 0x9d, 0x93, 0xaf, 0x9b, 0x7c, 0x70, 0xbb,
-	0xc9, 0x07, 0x3f, 0x36, 0xf9, 0xe0, 0x23, 0xff, 0xc7, 0xa3, 0x7e, 0xe1, 0x9f, 0x64, 0xe5, 0x42,
-	0x20, 0xbe, 0xdc, 0xfb, 0x26, 0xc1, 0xaf, 0x6a, 0x1c, 0x96, 0xf6, 0xf2, 0x77, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xb5, 0xe7, 0x58, 0x43, 0x49, 0x02, 0x00, 0x00,
+	// 449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xb1, 0x6e, 0x13, 0x41,
+	0x10, 0xf5, 0xd9, 0x8e, 0xe3, 0xac, 0x51, 0x90, 0x56, 0x01, 0x5d, 0x5c, 0x9c, 0x4d, 0x0a, 0x64,
+	0x0a, 0xdf, 0xc9, 0x50, 0xd0, 0xd0, 0x60, 0x52, 0x50, 0x9f, 0x11, 0x05, 0xcd, 0x69, 0xef, 0x76,
+	0x74, 0x59, 0xc5, 0xb7, 0x63, 0xed, 0x8e, 0x0d, 0xf9, 0x0b, 0x3e, 0x26, 0x0d, 0x7f, 0x90, 0x32,
+	0x4a, 0x85, 0x28, 0x22, 0xb0, 0x7f, 0x04, 0xdd, 0xde, 0x2a, 0x04, 0x1a, 0xaa, 0x54, 0x3b, 0xf3,
+	0xde, 0xdb, 0xdd, 0x37, 0x4f, 0xc3, 0x5e, 0x90, 0xc8, 0x55, 0x42, 0x78, 0x0e, 0x7a, 0x5a, 0xa0,
+	0xde, 0x80, 0xa1, 0x64, 0x33, 0x6b, 0x80, 0xcc, 0x03, 0xf1, 0xca, 0x20, 0x21, 0x7f, 0x52, 0x4b,
+	0xe3, 0xbf, 0x99, 0xcd, 0x6c, 0x78, 0x54, 0x62, 0x89, 0x4e, 0x91, 0xd4, 0x55, 0x23, 0x1e, 0x46,
+	0x05, 0xda, 0x0a, 0x6d, 0x92, 0x0b, 0x0b, 0xc9, 0x66, 0x96, 0x03, 0x89, 0x59, 0x52, 0xa0, 0xd2,
+	0x9e, 0x3f, 0x6e, 0xf8, 0xac, 0xb9, 0xd8, 0x34, 0x0d, 0x75, 0xf2, 0x2d, 0x60, 0xfd, 0x05, 0x19,
+	0x41, 0x50, 0x5e, 0x70, 0xce, 0xba, 0x5a, 0x54, 0x10, 0x06, 0xe3, 0x60, 0x72, 0x90, 0xba, 0x9a,
+	0x3f, 0x65, 0xbd, 0x15, 0x18, 0x85, 0x32, 0x6c, 0x8f, 0x83, 0x49, 0x27, 0xf5, 0x1d, 0x07, 0xf6,
+	0xb8, 0xf1, 0x65, 0x15, 0xea, 0xac, 0x7e, 0x20, 0xec, 0xd4, 0xd7, 0xe6, 0x6f, 0xae, 0x6e, 0x47,
+	0xad, 0x1f, 0xb7, 0xa3, 0xe7, 0xa5, 0xa2, 0xb3, 0x75, 0x1e, 0x17, 0x58, 0xf9, 0x2f, 0xfd, 0x31,
+	0xb5, 0xf2, 0x3c, 0xa1, 0x8b, 0x15, 0xd8, 0xf8, 0x14, 0x8a, 0x9b, 0xcb, 0x29, 0xf3, 0x8e, 0x4e,
+	0xa1, 0x48, 0x0f, 0xff, 0x3c, 0x9a, 0x0a, 0x02, 0x3e, 0x64, 0x7d, 0xa9, 0xac, 0xc8, 0x97, 0x20,
+	0xc3, 0xee, 0x38, 0x98, 0xf4, 0xd3, 0xbb, 0xfe, 0xe4, 0x57, 0x9b, 0xed, 0x7f, 0xc4, 0x75, 0x71,
+	0x06, 0x86, 0x1f, 0xb2, 0xb6, 0x92, 0xde, 0x78, 0x5b, 0x49, 0x1e, 0xb3, 0x3d, 0xfc, 0xac, 0xc1,
+	0x38, 0xd7, 0x07, 0xf3, 0xf0, 0xe6, 0x72, 0x7a, 0xe4, 0xbf, 0x79, 0x2b, 0xa5, 0x01, 0x6b, 0x17,
+	0x64, 0x94, 0x2e, 0xd3, 0x46, 0xc6, 0x5f, 0xb3, 0x9e, 0xa8, 0x70, 0xad, 0xc9, 0x4d, 0x31, 0x78,
+	0x79, 0x1c, 0x7b, 0x75, 0x9d, 0x69, 0xec, 0x33, 0x8d, 0xdf, 0xa1, 0xd2, 0xf3, 0x6e, 0x3d, 0x60,
+	0xea, 0xe5, 0xfc, 0x19, 0x7b, 0x54, 0x18, 0x10, 0x04, 0x32, 0x23, 0x55, 0x81, 0x33, 0xd9, 0x49,
+	0x07, 0x1e, 0xfb, 0xa0, 0x2a, 0x37, 0x83, 0xf5, 0x11, 0x87, 0x7b, 0xce, 0xe1, 0x5d, 0x7f, 0x2f,
+	0xde, 0xde, 0xff, 0xe2, 0xdd, 0x7f, 0x80, 0x78, 0x47, 0x6c, 0x20, 0xd6, 0x84, 0x99, 0x05, 0xa2,
+	0x25, 0x84, 0x7d, 0x97, 0x30, 0xab, 0xa1, 0x85, 0x43, 0xe6, 0xef, 0xaf, 0xb6, 0x51, 0x70, 0xbd,
+	0x8d, 0x82, 0x9f, 0xdb, 0x28, 0xf8, 0xba, 0x8b, 0x5a, 0xd7, 0xbb, 0xa8, 0xf5, 0x7d, 0x17, 0xb5,
+	0x3e, 0xc5, 0xf7, 0x0c, 0xd4, 0xcb, 0xba, 0x14, 0xb9, 0x75, 0x45, 0xf2, 0xe5, 0x9f, 0x15, 0x77,
+	0x66, 0xf2, 0x9e, 0x5b, 0xb8, 0x57, 0xbf, 0x07, 0x00, 0x7c, 0xdb, 0xd4, 0x2b, 0x05, 0x03, 0x00,
+	0x00,
 }
 
 func (m *Strategy) Marshal() (dAtA []byte, err error) {
@@ -224,6 +256,16 @@ func (m *Strategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.ConversionRate.Size()
 		i -= size
@@ -269,6 +311,31 @@ func (m *Voucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoSettle {
+		i--
+		if m.AutoSettle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTokenConvert(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.Period != 0 {
+		i = encodeVarintTokenConvert(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Strategy) > 0 {
 		i -= len(m.Strategy)
 		copy(dAtA[i:], m.Strategy)
@@ -334,6 +401,9 @@ func (m *Strategy) Size() (n int) {
 	}
 	l = m.ConversionRate.Size()
 	n += 1 + l + sovTokenConvert(uint64(l))
+	if m.Disabled {
+		n += 2
+	}
 	return n
 }
 
@@ -360,6 +430,14 @@ func (m *Voucher) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTokenConvert(uint64(l))
 	}
+	if m.Period != 0 {
+		n += 1 + sovTokenConvert(uint64(m.Period))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovTokenConvert(uint64(l))
+	if m.AutoSettle {
+		n += 2
+	}
 	return n
 }
 
@@ -483,6 +561,26 @@ func (m *Strategy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenConvert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTokenConvert(dAtA[iNdEx:])
@@ -681,6 +779,79 @@ func (m *Voucher) Unmarshal(dAtA []byte) error {
 			}
 			m.Strategy = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenConvert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenConvert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTokenConvert
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenConvert
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenConvert
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoSettle = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTokenConvert(dAtA[iNdEx:])
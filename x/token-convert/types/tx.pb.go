@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -130,6 +131,8 @@ type MsgConvertVetabi struct {
 	Strategy string `protobuf:"bytes,2,opt,name=strategy,proto3" json:"strategy,omitempty"`
 	// sender
 	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	// auto_settle withdraws the voucher automatically once it matures
+	AutoSettle bool `protobuf:"varint,4,opt,name=auto_settle,json=autoSettle,proto3" json:"auto_settle,omitempty"`
 }
 
 func (m *MsgConvertVetabi) Reset()         { *m = MsgConvertVetabi{} }
@@ -186,6 +189,13 @@ func (m *MsgConvertVetabi) GetSender() string {
 	return ""
 }
 
+func (m *MsgConvertVetabi) GetAutoSettle() bool {
+	if m != nil {
+		return m.AutoSettle
+	}
+	return false
+}
+
 // MsgConvertVetabiResponse defines the Msg/ConvertVetabi response type.
 type MsgConvertVetabiResponse struct {
 	// voucher_id
@@ -452,691 +462,2986 @@ func (m *MsgCancelConvertResponse) GetVetabiUnlocked() types.Coin {
 	return types.Coin{}
 }
 
-func init() {
-	proto.RegisterType((*MsgConvertTabi)(nil), "tabi.token_convert.v1.MsgConvertTabi")
-	proto.RegisterType((*MsgConvertTabiResponse)(nil), "tabi.token_convert.v1.MsgConvertTabiResponse")
-	proto.RegisterType((*MsgConvertVetabi)(nil), "tabi.token_convert.v1.MsgConvertVetabi")
-	proto.RegisterType((*MsgConvertVetabiResponse)(nil), "tabi.token_convert.v1.MsgConvertVetabiResponse")
-	proto.RegisterType((*MsgWithdrawTabi)(nil), "tabi.token_convert.v1.MsgWithdrawTabi")
-	proto.RegisterType((*MsgWithdrawTabiResponse)(nil), "tabi.token_convert.v1.MsgWithdrawTabiResponse")
-	proto.RegisterType((*MsgCancelConvert)(nil), "tabi.token_convert.v1.MsgCancelConvert")
-	proto.RegisterType((*MsgCancelConvertResponse)(nil), "tabi.token_convert.v1.MsgCancelConvertResponse")
+// MsgTransferVoucher represents a message to transfer a voucher to another account.
+type MsgTransferVoucher struct {
+	// voucher_id
+	VoucherId string `protobuf:"bytes,1,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// recipient
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func init() { proto.RegisterFile("tabi/token-convert/v1/tx.proto", fileDescriptor_f967002ae4f42118) }
-
-var fileDescriptor_f967002ae4f42118 = []byte{
-	// 546 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0xce, 0x92, 0xaa, 0x22, 0x0e, 0x4d, 0xd1, 0xaa, 0x40, 0xba, 0x12, 0xdb, 0x2a, 0x12, 0xd0,
-	0x4b, 0x6c, 0xd2, 0x3e, 0x01, 0xa9, 0x84, 0xca, 0x21, 0x97, 0x50, 0x40, 0xea, 0x65, 0xb5, 0x3f,
-	0xc3, 0xc6, 0x6a, 0x62, 0x47, 0xb6, 0xb3, 0x49, 0xde, 0x82, 0x0b, 0xef, 0x80, 0xc4, 0x95, 0x87,
-	0xe8, 0xb1, 0xe2, 0xc4, 0x09, 0xa1, 0xe4, 0x45, 0xd0, 0xae, 0xdd, 0x74, 0x37, 0x2a, 0xed, 0x82,
-	0xb8, 0xd9, 0xfe, 0xbe, 0x99, 0xf9, 0xe6, 0xb3, 0xc7, 0xc8, 0x55, 0x7e, 0x40, 0x89, 0xe2, 0xe7,
-	0xc0, 0xda, 0x21, 0x67, 0x09, 0x08, 0x45, 0x92, 0x0e, 0x51, 0x33, 0x3c, 0x16, 0x5c, 0x71, 0xfb,
-	0x51, 0x8a, 0xe3, 0x0c, 0xf7, 0x0c, 0x8e, 0x93, 0x8e, 0xb3, 0x13, 0xf3, 0x98, 0x67, 0x0c, 0x92,
-	0xae, 0x34, 0xd9, 0x71, 0x43, 0x2e, 0x47, 0x5c, 0x92, 0xc0, 0x97, 0x40, 0x92, 0x4e, 0x00, 0xca,
-	0xef, 0x90, 0x90, 0x53, 0x66, 0xf0, 0x5d, 0x8d, 0x7b, 0x3a, 0x50, 0x6f, 0x34, 0xd4, 0x9a, 0xa2,
-	0x46, 0x4f, 0xc6, 0xc7, 0xba, 0xc2, 0xa9, 0x1f, 0x50, 0xfb, 0x08, 0x6d, 0xa4, 0xa1, 0x4d, 0x6b,
-	0xdf, 0x3a, 0xa8, 0x1f, 0xee, 0x62, 0x43, 0x4f, 0x73, 0x63, 0x93, 0x1b, 0x1f, 0x73, 0xca, 0xba,
-	0x1b, 0x17, 0x3f, 0xf7, 0x2a, 0xfd, 0x8c, 0x6c, 0xbf, 0x44, 0x9b, 0x12, 0x58, 0x04, 0xa2, 0x79,
-	0x6f, 0xdf, 0x3a, 0xa8, 0x75, 0x9b, 0xdf, 0xbf, 0xb5, 0x77, 0x4c, 0xe4, 0xab, 0x28, 0x12, 0x20,
-	0xe5, 0x5b, 0x25, 0x28, 0x8b, 0xfb, 0x86, 0xd7, 0x6a, 0xa2, 0xc7, 0xc5, 0xc2, 0x7d, 0x90, 0x63,
-	0xce, 0x24, 0xb4, 0x3e, 0x5b, 0xe8, 0xe1, 0x35, 0xf4, 0x1e, 0xd4, 0x3f, 0xab, 0x72, 0xd0, 0x7d,
-	0xa9, 0x84, 0xaf, 0x20, 0x9e, 0x6b, 0x5d, 0xfd, 0xd5, 0x3e, 0xa7, 0xb8, 0x5a, 0x52, 0xf1, 0x19,
-	0x6a, 0xae, 0xcb, 0xba, 0xd2, 0x6c, 0x3f, 0x45, 0x28, 0xe1, 0x93, 0x70, 0x00, 0xc2, 0xa3, 0x51,
-	0x26, 0xb2, 0xd6, 0xaf, 0x99, 0x93, 0x37, 0x91, 0xbd, 0x87, 0xea, 0x30, 0x1b, 0x53, 0x31, 0xf7,
-	0x14, 0x1d, 0x81, 0xd1, 0x82, 0xf4, 0xd1, 0x29, 0x1d, 0x41, 0x2b, 0x40, 0xdb, 0x3d, 0x19, 0x7f,
-	0xa0, 0x6a, 0x10, 0x09, 0x7f, 0x9a, 0xdd, 0xc3, 0x1d, 0x29, 0xff, 0xde, 0xf1, 0xaf, 0x16, 0x7a,
-	0xb2, 0x56, 0x64, 0xa5, 0xff, 0x35, 0x6a, 0xa4, 0xfd, 0x78, 0x53, 0x03, 0x96, 0x36, 0x7a, 0x2b,
-	0x0d, 0xbb, 0x4a, 0xc9, 0xec, 0x13, 0xb4, 0x9d, 0x64, 0xce, 0x78, 0x02, 0xd4, 0x44, 0x30, 0x88,
-	0x32, 0x79, 0x25, 0x12, 0x35, 0x12, 0xe3, 0xa8, 0x0e, 0x6b, 0x85, 0xfa, 0x11, 0xf8, 0x2c, 0x84,
-	0xa1, 0xf1, 0xfc, 0xff, 0x5b, 0x12, 0xe9, 0x2b, 0xcd, 0x17, 0x59, 0x59, 0x72, 0xdd, 0xca, 0x84,
-	0x0d, 0x79, 0x78, 0x0e, 0x51, 0x59, 0x4f, 0x4c, 0x2b, 0xef, 0x4c, 0xd8, 0xe1, 0x97, 0x2a, 0xaa,
-	0xf6, 0x64, 0x6c, 0x87, 0xa8, 0x9e, 0x1f, 0xb4, 0x67, 0xf8, 0xc6, 0x19, 0xc7, 0xc5, 0xb1, 0x70,
-	0xda, 0xa5, 0x68, 0x2b, 0xd9, 0x14, 0x6d, 0x15, 0x27, 0xe7, 0xc5, 0x9d, 0xf1, 0x9a, 0xe8, 0x90,
-	0x92, 0xc4, 0x55, 0xa9, 0x8f, 0xe8, 0x41, 0xe1, 0xc5, 0x3e, 0xff, 0x73, 0x82, 0x3c, 0xcf, 0xc1,
-	0xe5, 0x78, 0x85, 0x96, 0x0a, 0xef, 0xe0, 0xb6, 0x96, 0xf2, 0xc4, 0x5b, 0x5b, 0xba, 0xe9, 0xd2,
-	0xbb, 0x27, 0x17, 0x0b, 0xd7, 0xba, 0x5c, 0xb8, 0xd6, 0xaf, 0x85, 0x6b, 0x7d, 0x5a, 0xba, 0x95,
-	0xcb, 0xa5, 0x5b, 0xf9, 0xb1, 0x74, 0x2b, 0x67, 0x38, 0xa6, 0x6a, 0x30, 0x09, 0x70, 0xc8, 0x47,
-	0x24, 0x4d, 0x3a, 0xf4, 0x03, 0x99, 0x2d, 0xc8, 0x6c, 0xed, 0x1b, 0x57, 0xf3, 0x31, 0xc8, 0x60,
-	0x33, 0xfb, 0x5f, 0x8f, 0x7e, 0x07, 0x00, 0x00, 0xff, 0xff, 0x96, 0x52, 0xb0, 0xb3, 0xe9, 0x05,
-	0x00, 0x00,
+func (m *MsgTransferVoucher) Reset()         { *m = MsgTransferVoucher{} }
+func (m *MsgTransferVoucher) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVoucher) ProtoMessage()    {}
+func (*MsgTransferVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{8}
+}
+func (m *MsgTransferVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVoucher.Merge(m, src)
+}
+func (m *MsgTransferVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVoucher.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgTransferVoucher proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgTransferVoucher) GetVoucherId() string {
+	if m != nil {
+		return m.VoucherId
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// ConvertTabi converts Tabi to Vetabi at a 1:1 ratio.
-	ConvertTabi(ctx context.Context, in *MsgConvertTabi, opts ...grpc.CallOption) (*MsgConvertTabiResponse, error)
-	// ConvertVetabi converts Vetabi to Tabi with the given unlock strategy
-	// and return a voucher for redeeming Tabi.
-	ConvertVetabi(ctx context.Context, in *MsgConvertVetabi, opts ...grpc.CallOption) (*MsgConvertVetabiResponse, error)
-	// WithdrawTabi sends the unlocked Tabi to owner of the voucher and
-	// burns Vetabi as well as Tabi according to the unlock strategy.
-	WithdrawTabi(ctx context.Context, in *MsgWithdrawTabi, opts ...grpc.CallOption) (*MsgWithdrawTabiResponse, error)
-	// CancelConvert cancels the conversion of Vetabi to Tabi.
-	CancelConvert(ctx context.Context, in *MsgCancelConvert, opts ...grpc.CallOption) (*MsgCancelConvertResponse, error)
+func (m *MsgTransferVoucher) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferVoucher) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgTransferVoucherResponse defines the Msg/TransferVoucher response type.
+type MsgTransferVoucherResponse struct {
 }
 
-func (c *msgClient) ConvertTabi(ctx context.Context, in *MsgConvertTabi, opts ...grpc.CallOption) (*MsgConvertTabiResponse, error) {
-	out := new(MsgConvertTabiResponse)
-	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/ConvertTabi", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferVoucherResponse) Reset()         { *m = MsgTransferVoucherResponse{} }
+func (m *MsgTransferVoucherResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferVoucherResponse) ProtoMessage()    {}
+func (*MsgTransferVoucherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{9}
+}
+func (m *MsgTransferVoucherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferVoucherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferVoucherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTransferVoucherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferVoucherResponse.Merge(m, src)
+}
+func (m *MsgTransferVoucherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferVoucherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferVoucherResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConvertVetabi(ctx context.Context, in *MsgConvertVetabi, opts ...grpc.CallOption) (*MsgConvertVetabiResponse, error) {
-	out := new(MsgConvertVetabiResponse)
-	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/ConvertVetabi", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferVoucherResponse proto.InternalMessageInfo
+
+// MsgSetAutoSettle represents a message to toggle auto-settlement of a voucher.
+type MsgSetAutoSettle struct {
+	// voucher_id
+	VoucherId string `protobuf:"bytes,1,opt,name=voucher_id,json=voucherId,proto3" json:"voucher_id,omitempty"`
+	// sender
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// enabled
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgSetAutoSettle) Reset()         { *m = MsgSetAutoSettle{} }
+func (m *MsgSetAutoSettle) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoSettle) ProtoMessage()    {}
+func (*MsgSetAutoSettle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{10}
+}
+func (m *MsgSetAutoSettle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoSettle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoSettle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetAutoSettle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoSettle.Merge(m, src)
+}
+func (m *MsgSetAutoSettle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoSettle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoSettle.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawTabi(ctx context.Context, in *MsgWithdrawTabi, opts ...grpc.CallOption) (*MsgWithdrawTabiResponse, error) {
-	out := new(MsgWithdrawTabiResponse)
-	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/WithdrawTabi", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetAutoSettle proto.InternalMessageInfo
+
+func (m *MsgSetAutoSettle) GetVoucherId() string {
+	if m != nil {
+		return m.VoucherId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelConvert(ctx context.Context, in *MsgCancelConvert, opts ...grpc.CallOption) (*MsgCancelConvertResponse, error) {
-	out := new(MsgCancelConvertResponse)
-	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/CancelConvert", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetAutoSettle) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// ConvertTabi converts Tabi to Vetabi at a 1:1 ratio.
-	ConvertTabi(context.Context, *MsgConvertTabi) (*MsgConvertTabiResponse, error)
-	// ConvertVetabi converts Vetabi to Tabi with the given unlock strategy
-	// and return a voucher for redeeming Tabi.
-	ConvertVetabi(context.Context, *MsgConvertVetabi) (*MsgConvertVetabiResponse, error)
-	// WithdrawTabi sends the unlocked Tabi to owner of the voucher and
-	// burns Vetabi as well as Tabi according to the unlock strategy.
-	WithdrawTabi(context.Context, *MsgWithdrawTabi) (*MsgWithdrawTabiResponse, error)
-	// CancelConvert cancels the conversion of Vetabi to Tabi.
-	CancelConvert(context.Context, *MsgCancelConvert) (*MsgCancelConvertResponse, error)
+func (m *MsgSetAutoSettle) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgSetAutoSettleResponse defines the Msg/SetAutoSettle response type.
+type MsgSetAutoSettleResponse struct {
 }
 
-func (*UnimplementedMsgServer) ConvertTabi(ctx context.Context, req *MsgConvertTabi) (*MsgConvertTabiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertTabi not implemented")
+func (m *MsgSetAutoSettleResponse) Reset()         { *m = MsgSetAutoSettleResponse{} }
+func (m *MsgSetAutoSettleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoSettleResponse) ProtoMessage()    {}
+func (*MsgSetAutoSettleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{11}
 }
-func (*UnimplementedMsgServer) ConvertVetabi(ctx context.Context, req *MsgConvertVetabi) (*MsgConvertVetabiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertVetabi not implemented")
+func (m *MsgSetAutoSettleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WithdrawTabi(ctx context.Context, req *MsgWithdrawTabi) (*MsgWithdrawTabiResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTabi not implemented")
+func (m *MsgSetAutoSettleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoSettleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelConvert(ctx context.Context, req *MsgCancelConvert) (*MsgCancelConvertResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelConvert not implemented")
+func (m *MsgSetAutoSettleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoSettleResponse.Merge(m, src)
+}
+func (m *MsgSetAutoSettleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoSettleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoSettleResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgSetAutoSettleResponse proto.InternalMessageInfo
+
+// MsgCreateStrategy represents a message to create a strategy.
+type MsgCreateStrategy struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// period
+	Period int64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// conversion_rate
+	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate"`
 }
 
-func _Msg_ConvertTabi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertTabi)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertTabi(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.token_convert.v1.Msg/ConvertTabi",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertTabi(ctx, req.(*MsgConvertTabi))
+func (m *MsgCreateStrategy) Reset()         { *m = MsgCreateStrategy{} }
+func (m *MsgCreateStrategy) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStrategy) ProtoMessage()    {}
+func (*MsgCreateStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{12}
+}
+func (m *MsgCreateStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCreateStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStrategy.Merge(m, src)
+}
+func (m *MsgCreateStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStrategy.DiscardUnknown(m)
 }
 
-func _Msg_ConvertVetabi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConvertVetabi)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConvertVetabi(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.token_convert.v1.Msg/ConvertVetabi",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConvertVetabi(ctx, req.(*MsgConvertVetabi))
+var xxx_messageInfo_MsgCreateStrategy proto.InternalMessageInfo
+
+func (m *MsgCreateStrategy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_WithdrawTabi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawTabi)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawTabi(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.token_convert.v1.Msg/WithdrawTabi",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawTabi(ctx, req.(*MsgWithdrawTabi))
+func (m *MsgCreateStrategy) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_CancelConvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelConvert)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelConvert(ctx, in)
+func (m *MsgCreateStrategy) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/tabi.token_convert.v1.Msg/CancelConvert",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelConvert(ctx, req.(*MsgCancelConvert))
-	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "tabi.token_convert.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ConvertTabi",
-			Handler:    _Msg_ConvertTabi_Handler,
-		},
-		{
-			MethodName: "ConvertVetabi",
-			Handler:    _Msg_ConvertVetabi_Handler,
-		},
-		{
-			MethodName: "WithdrawTabi",
-			Handler:    _Msg_WithdrawTabi_Handler,
-		},
-		{
-			MethodName: "CancelConvert",
-			Handler:    _Msg_CancelConvert_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "tabi/token-convert/v1/tx.proto",
+// MsgCreateStrategyResponse defines the Msg/CreateStrategy response type.
+type MsgCreateStrategyResponse struct {
 }
 
-func (m *MsgConvertTabi) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateStrategyResponse) Reset()         { *m = MsgCreateStrategyResponse{} }
+func (m *MsgCreateStrategyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateStrategyResponse) ProtoMessage()    {}
+func (*MsgCreateStrategyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{13}
+}
+func (m *MsgCreateStrategyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateStrategyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateStrategyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCreateStrategyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateStrategyResponse.Merge(m, src)
+}
+func (m *MsgCreateStrategyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateStrategyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateStrategyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertTabi) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCreateStrategyResponse proto.InternalMessageInfo
+
+// MsgUpdateStrategy represents a message to update a strategy.
+type MsgUpdateStrategy struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// period
+	Period int64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// conversion_rate
+	ConversionRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=conversion_rate,json=conversionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"conversion_rate"`
 }
 
-func (m *MsgConvertTabi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateStrategy) Reset()         { *m = MsgUpdateStrategy{} }
+func (m *MsgUpdateStrategy) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateStrategy) ProtoMessage()    {}
+func (*MsgUpdateStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{14}
+}
+func (m *MsgUpdateStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgConvertTabiResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateStrategy.Merge(m, src)
+}
+func (m *MsgUpdateStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateStrategy.DiscardUnknown(m)
 }
 
-func (m *MsgConvertTabiResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateStrategy proto.InternalMessageInfo
+
+func (m *MsgUpdateStrategy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-func (m *MsgConvertTabiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgUpdateStrategy) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *MsgConvertVetabi) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateStrategy) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgConvertVetabi) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgUpdateStrategyResponse defines the Msg/UpdateStrategy response type.
+type MsgUpdateStrategyResponse struct {
 }
 
-func (m *MsgConvertVetabi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Strategy) > 0 {
-		i -= len(m.Strategy)
-		copy(dAtA[i:], m.Strategy)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Strategy)))
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgUpdateStrategyResponse) Reset()         { *m = MsgUpdateStrategyResponse{} }
+func (m *MsgUpdateStrategyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateStrategyResponse) ProtoMessage()    {}
+func (*MsgUpdateStrategyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{15}
+}
+func (m *MsgUpdateStrategyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateStrategyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateStrategyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgConvertVetabiResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgUpdateStrategyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateStrategyResponse.Merge(m, src)
 }
-
-func (m *MsgConvertVetabiResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateStrategyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateStrategyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateStrategyResponse.DiscardUnknown(m)
 }
 
-func (m *MsgConvertVetabiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExpiryTime) > 0 {
-		i -= len(m.ExpiryTime)
-		copy(dAtA[i:], m.ExpiryTime)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpiryTime)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.VoucherId) > 0 {
-		i -= len(m.VoucherId)
-		copy(dAtA[i:], m.VoucherId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VoucherId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgUpdateStrategyResponse proto.InternalMessageInfo
+
+// MsgDisableStrategy represents a message to disable a strategy.
+type MsgDisableStrategy struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// name
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func (m *MsgWithdrawTabi) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDisableStrategy) Reset()         { *m = MsgDisableStrategy{} }
+func (m *MsgDisableStrategy) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableStrategy) ProtoMessage()    {}
+func (*MsgDisableStrategy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{16}
+}
+func (m *MsgDisableStrategy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableStrategy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableStrategy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWithdrawTabi) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgDisableStrategy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableStrategy.Merge(m, src)
+}
+func (m *MsgDisableStrategy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableStrategy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableStrategy.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawTabi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.VoucherId) > 0 {
-		i -= len(m.VoucherId)
-		copy(dAtA[i:], m.VoucherId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VoucherId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgDisableStrategy proto.InternalMessageInfo
+
+func (m *MsgDisableStrategy) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawTabiResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgDisableStrategy) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawTabiResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgDisableStrategyResponse defines the Msg/DisableStrategy response type.
+type MsgDisableStrategyResponse struct {
 }
 
-func (m *MsgWithdrawTabiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VetabiReturned.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgDisableStrategyResponse) Reset()         { *m = MsgDisableStrategyResponse{} }
+func (m *MsgDisableStrategyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableStrategyResponse) ProtoMessage()    {}
+func (*MsgDisableStrategyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f967002ae4f42118, []int{17}
+}
+func (m *MsgDisableStrategyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableStrategyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableStrategyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.TabiWithdrawn.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgDisableStrategyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableStrategyResponse.Merge(m, src)
+}
+func (m *MsgDisableStrategyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableStrategyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableStrategyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableStrategyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgConvertTabi)(nil), "tabi.token_convert.v1.MsgConvertTabi")
+	proto.RegisterType((*MsgConvertTabiResponse)(nil), "tabi.token_convert.v1.MsgConvertTabiResponse")
+	proto.RegisterType((*MsgConvertVetabi)(nil), "tabi.token_convert.v1.MsgConvertVetabi")
+	proto.RegisterType((*MsgConvertVetabiResponse)(nil), "tabi.token_convert.v1.MsgConvertVetabiResponse")
+	proto.RegisterType((*MsgWithdrawTabi)(nil), "tabi.token_convert.v1.MsgWithdrawTabi")
+	proto.RegisterType((*MsgWithdrawTabiResponse)(nil), "tabi.token_convert.v1.MsgWithdrawTabiResponse")
+	proto.RegisterType((*MsgCancelConvert)(nil), "tabi.token_convert.v1.MsgCancelConvert")
+	proto.RegisterType((*MsgCancelConvertResponse)(nil), "tabi.token_convert.v1.MsgCancelConvertResponse")
+	proto.RegisterType((*MsgTransferVoucher)(nil), "tabi.token_convert.v1.MsgTransferVoucher")
+	proto.RegisterType((*MsgTransferVoucherResponse)(nil), "tabi.token_convert.v1.MsgTransferVoucherResponse")
+	proto.RegisterType((*MsgSetAutoSettle)(nil), "tabi.token_convert.v1.MsgSetAutoSettle")
+	proto.RegisterType((*MsgSetAutoSettleResponse)(nil), "tabi.token_convert.v1.MsgSetAutoSettleResponse")
+	proto.RegisterType((*MsgCreateStrategy)(nil), "tabi.token_convert.v1.MsgCreateStrategy")
+	proto.RegisterType((*MsgCreateStrategyResponse)(nil), "tabi.token_convert.v1.MsgCreateStrategyResponse")
+	proto.RegisterType((*MsgUpdateStrategy)(nil), "tabi.token_convert.v1.MsgUpdateStrategy")
+	proto.RegisterType((*MsgUpdateStrategyResponse)(nil), "tabi.token_convert.v1.MsgUpdateStrategyResponse")
+	proto.RegisterType((*MsgDisableStrategy)(nil), "tabi.token_convert.v1.MsgDisableStrategy")
+	proto.RegisterType((*MsgDisableStrategyResponse)(nil), "tabi.token_convert.v1.MsgDisableStrategyResponse")
+}
+
+func init() { proto.RegisterFile("tabi/token-convert/v1/tx.proto", fileDescriptor_f967002ae4f42118) }
+
+var fileDescriptor_f967002ae4f42118 = []byte{
+	// 855 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x63, 0xc3, 0xb1, 0xc6, 0x8d, 0xdc, 0x12, 0x69, 0x4a, 0xb3, 0x2d, 0x6d, 0x10, 0x68,
+	0xea, 0x1e, 0x44, 0x5a, 0x09, 0x90, 0x53, 0x2f, 0x51, 0x8c, 0x22, 0x3d, 0xe8, 0x42, 0x39, 0x29,
+	0x90, 0x0b, 0xcb, 0x9f, 0x09, 0xb5, 0xb0, 0xb4, 0x2b, 0xec, 0xae, 0x64, 0xeb, 0xd0, 0x77, 0xe8,
+	0x0b, 0xf4, 0x09, 0x7a, 0x0d, 0xfa, 0x0c, 0x39, 0xa6, 0x39, 0x15, 0x3d, 0x18, 0x85, 0xfd, 0x22,
+	0x05, 0xc9, 0x15, 0x45, 0x32, 0x8d, 0xc4, 0x14, 0xee, 0xa5, 0x27, 0x71, 0x77, 0xbe, 0x9d, 0xef,
+	0xfb, 0x76, 0x77, 0x66, 0x05, 0x96, 0x0c, 0x42, 0xe2, 0x4a, 0x76, 0x86, 0xb4, 0x13, 0x31, 0x3a,
+	0x43, 0x2e, 0xdd, 0x59, 0xd7, 0x95, 0x17, 0xce, 0x84, 0x33, 0xc9, 0xf4, 0x4f, 0xd3, 0xb8, 0x93,
+	0xc5, 0x7d, 0x15, 0x77, 0x66, 0x5d, 0xf3, 0x6e, 0xc2, 0x12, 0x96, 0x21, 0xdc, 0xf4, 0x2b, 0x07,
+	0x9b, 0x56, 0xc4, 0xc4, 0x98, 0x09, 0x37, 0x0c, 0x04, 0xba, 0xb3, 0x6e, 0x88, 0x32, 0xe8, 0xba,
+	0x11, 0x23, 0x54, 0xc5, 0xf7, 0xf3, 0xb8, 0x9f, 0x2f, 0xcc, 0x07, 0x79, 0xc8, 0x3e, 0x87, 0x76,
+	0x5f, 0x24, 0x4f, 0x72, 0x86, 0xd3, 0x20, 0x24, 0xfa, 0x43, 0xd8, 0x4a, 0x97, 0x1a, 0xda, 0xa1,
+	0x76, 0xb4, 0xfb, 0x60, 0xdf, 0x51, 0xf0, 0x34, 0xb7, 0xa3, 0x72, 0x3b, 0x4f, 0x18, 0xa1, 0xbd,
+	0xad, 0xd7, 0x97, 0x07, 0x1b, 0x5e, 0x06, 0xd6, 0x8f, 0x61, 0x5b, 0x20, 0x8d, 0x91, 0x1b, 0xb7,
+	0x0e, 0xb5, 0xa3, 0x56, 0xcf, 0x78, 0xfb, 0xaa, 0x73, 0x57, 0xad, 0x7c, 0x1c, 0xc7, 0x1c, 0x85,
+	0x18, 0x48, 0x4e, 0x68, 0xe2, 0x29, 0x9c, 0x6d, 0xc0, 0xbd, 0x2a, 0xb1, 0x87, 0x62, 0xc2, 0xa8,
+	0x40, 0xfb, 0x37, 0x0d, 0x3e, 0x5e, 0x86, 0x9e, 0xa3, 0xfc, 0xd7, 0xaa, 0x4c, 0xd8, 0x11, 0x92,
+	0x07, 0x12, 0x93, 0x79, 0xae, 0xcb, 0x2b, 0xc6, 0x25, 0xc5, 0x9b, 0xcd, 0x14, 0xeb, 0x07, 0xb0,
+	0x1b, 0x4c, 0x25, 0xf3, 0x05, 0x4a, 0x39, 0x42, 0x63, 0xeb, 0x50, 0x3b, 0xda, 0xf1, 0x20, 0x9d,
+	0x1a, 0x64, 0x33, 0xf6, 0x0b, 0x30, 0xea, 0xba, 0x17, 0xa6, 0xf4, 0x2f, 0x01, 0x66, 0x6c, 0x1a,
+	0x0d, 0x91, 0xfb, 0x24, 0xce, 0x5c, 0xb4, 0xbc, 0x96, 0x9a, 0xf9, 0x3e, 0x4e, 0x73, 0xe3, 0xc5,
+	0x84, 0xf0, 0xb9, 0x2f, 0xc9, 0x18, 0x95, 0x58, 0xc8, 0xa7, 0x4e, 0xc9, 0x18, 0xed, 0x10, 0xf6,
+	0xfa, 0x22, 0xf9, 0x81, 0xc8, 0x61, 0xcc, 0x83, 0xf3, 0xec, 0xa0, 0xd6, 0xa4, 0xfc, 0xf0, 0x23,
+	0xf9, 0x55, 0x83, 0xcf, 0x6a, 0x24, 0x85, 0xfe, 0xef, 0xa0, 0x9d, 0xfa, 0xf1, 0xcf, 0x55, 0xb0,
+	0xf1, 0x49, 0xdc, 0x49, 0x97, 0x2d, 0x52, 0x52, 0xfd, 0x29, 0xec, 0xcd, 0xb2, 0x9d, 0xf1, 0x39,
+	0xca, 0x29, 0xa7, 0x18, 0x1b, 0xb7, 0x9a, 0x25, 0x6a, 0xcf, 0xd4, 0x8e, 0xe6, 0xcb, 0xec, 0x28,
+	0xbf, 0x25, 0x01, 0x8d, 0x70, 0xa4, 0xf6, 0xfc, 0xe6, 0xb7, 0x24, 0x06, 0xa3, 0x4e, 0x52, 0x6c,
+	0xc9, 0xd2, 0xca, 0x94, 0x8e, 0x58, 0x74, 0x86, 0xb1, 0xa1, 0x7d, 0x90, 0x95, 0x67, 0x6a, 0x99,
+	0xfd, 0x8b, 0x06, 0x7a, 0x5f, 0x24, 0xa7, 0x3c, 0xa0, 0xe2, 0x25, 0xf2, 0xe7, 0xb9, 0xe0, 0x1b,
+	0x77, 0xa3, 0x3f, 0x82, 0x16, 0xc7, 0x88, 0x4c, 0x08, 0x52, 0xb9, 0xf6, 0xda, 0x2f, 0xa1, 0xf6,
+	0x17, 0x60, 0xbe, 0x2b, 0xaf, 0xa8, 0xd7, 0x9f, 0xb2, 0x83, 0x18, 0xa0, 0x7c, 0x5c, 0x94, 0xc2,
+	0xcd, 0x4b, 0x37, 0xe0, 0x36, 0xd2, 0x20, 0x1c, 0x61, 0x9c, 0x09, 0xdf, 0xf1, 0x16, 0x43, 0xdb,
+	0x04, 0xa3, 0x4e, 0x5f, 0x48, 0xbb, 0xd4, 0xe0, 0x93, 0xf4, 0xfc, 0x38, 0x06, 0x12, 0x07, 0x8b,
+	0xd2, 0x7f, 0x04, 0xad, 0x60, 0x2a, 0x87, 0x8c, 0x13, 0x39, 0x37, 0xb4, 0x35, 0x02, 0x96, 0x50,
+	0x5d, 0x87, 0x2d, 0x1a, 0x14, 0xd5, 0x99, 0x7d, 0xeb, 0xf7, 0x60, 0x7b, 0x82, 0x9c, 0xb0, 0x5c,
+	0xd6, 0xa6, 0xa7, 0x46, 0x3a, 0xc2, 0x5e, 0xde, 0xb6, 0x05, 0x61, 0xd4, 0x4f, 0x89, 0xb3, 0x86,
+	0xd1, 0xea, 0x7d, 0x9b, 0xde, 0x80, 0x3f, 0x2f, 0x0f, 0xee, 0x27, 0x44, 0x0e, 0xa7, 0xa1, 0x13,
+	0xb1, 0xb1, 0xea, 0xc8, 0xea, 0xa7, 0x23, 0xe2, 0x33, 0x57, 0xce, 0x27, 0x28, 0x9c, 0x13, 0x8c,
+	0xde, 0xbe, 0xea, 0x80, 0xd2, 0x75, 0x82, 0x91, 0xd7, 0x5e, 0x26, 0xf5, 0x02, 0x89, 0xf6, 0xe7,
+	0xb0, 0xff, 0x8e, 0xbf, 0xba, 0xfb, 0x67, 0x93, 0xf8, 0x7f, 0xed, 0xbe, 0xea, 0xaf, 0x70, 0xff,
+	0x63, 0x56, 0x53, 0x27, 0x44, 0xa4, 0xd7, 0xe4, 0xbf, 0x70, 0xaf, 0xca, 0xa2, 0xc6, 0xb0, 0xe0,
+	0x7f, 0xf0, 0xfb, 0x6d, 0xd8, 0xec, 0x8b, 0x44, 0x8f, 0x60, 0xb7, 0xfc, 0xbc, 0x7e, 0xe5, 0xfc,
+	0xe3, 0xcb, 0xee, 0x54, 0x1f, 0x43, 0xb3, 0xd3, 0x08, 0x56, 0xf4, 0x22, 0x02, 0x77, 0xaa, 0xef,
+	0xe5, 0xd7, 0x6b, 0xd7, 0xe7, 0x40, 0xd3, 0x6d, 0x08, 0x2c, 0xa8, 0x5e, 0xc2, 0x47, 0x95, 0x67,
+	0xe8, 0xfe, 0xfb, 0x13, 0x94, 0x71, 0xa6, 0xd3, 0x0c, 0x57, 0xb1, 0x54, 0x69, 0xee, 0xab, 0x2c,
+	0x95, 0x81, 0xa6, 0xdb, 0x10, 0x58, 0x50, 0x31, 0xd8, 0xab, 0xf7, 0xde, 0x6f, 0xde, 0x9f, 0xa3,
+	0x06, 0x35, 0xbb, 0x8d, 0xa1, 0x65, 0x6f, 0xd5, 0x7e, 0xb9, 0xc2, 0x5b, 0x05, 0x68, 0xba, 0x0d,
+	0x81, 0x05, 0xd5, 0x08, 0xda, 0xb5, 0xf6, 0x77, 0xb4, 0x62, 0x7b, 0x2a, 0x48, 0xf3, 0xb8, 0x29,
+	0xb2, 0xcc, 0x56, 0x6b, 0x37, 0x2b, 0xd8, 0xaa, 0x48, 0xf3, 0xb8, 0x29, 0xb2, 0x7c, 0x6e, 0xf5,
+	0xfa, 0x5e, 0x71, 0x6e, 0x35, 0xa8, 0xd9, 0x6d, 0x0c, 0x5d, 0x10, 0xf6, 0x9e, 0xbe, 0xbe, 0xb2,
+	0xb4, 0x37, 0x57, 0x96, 0xf6, 0xd7, 0x95, 0xa5, 0xfd, 0x7c, 0x6d, 0x6d, 0xbc, 0xb9, 0xb6, 0x36,
+	0xfe, 0xb8, 0xb6, 0x36, 0x5e, 0x38, 0xa5, 0x86, 0x96, 0xa6, 0x1d, 0x05, 0xa1, 0xc8, 0x3e, 0xdc,
+	0x8b, 0xda, 0xbf, 0xfc, 0xac, 0xb9, 0x85, 0xdb, 0xd9, 0xdf, 0xef, 0x87, 0x7f, 0x0f, 0x00, 0x93,
+	0x49, 0x7d, 0x6e, 0x08, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// ConvertTabi converts Tabi to Vetabi at a 1:1 ratio.
+	ConvertTabi(ctx context.Context, in *MsgConvertTabi, opts ...grpc.CallOption) (*MsgConvertTabiResponse, error)
+	// ConvertVetabi converts Vetabi to Tabi with the given unlock strategy
+	// and return a voucher for redeeming Tabi.
+	ConvertVetabi(ctx context.Context, in *MsgConvertVetabi, opts ...grpc.CallOption) (*MsgConvertVetabiResponse, error)
+	// WithdrawTabi sends the unlocked Tabi to owner of the voucher and
+	// burns Vetabi as well as Tabi according to the unlock strategy.
+	WithdrawTabi(ctx context.Context, in *MsgWithdrawTabi, opts ...grpc.CallOption) (*MsgWithdrawTabiResponse, error)
+	// CancelConvert cancels the conversion of Vetabi to Tabi.
+	CancelConvert(ctx context.Context, in *MsgCancelConvert, opts ...grpc.CallOption) (*MsgCancelConvertResponse, error)
+	// TransferVoucher transfers the ownership of a voucher to another account.
+	TransferVoucher(ctx context.Context, in *MsgTransferVoucher, opts ...grpc.CallOption) (*MsgTransferVoucherResponse, error)
+	// SetAutoSettle enables or disables auto-settlement of a voucher.
+	SetAutoSettle(ctx context.Context, in *MsgSetAutoSettle, opts ...grpc.CallOption) (*MsgSetAutoSettleResponse, error)
+	// CreateStrategy creates a new unlock strategy.
+	CreateStrategy(ctx context.Context, in *MsgCreateStrategy, opts ...grpc.CallOption) (*MsgCreateStrategyResponse, error)
+	// UpdateStrategy updates the period and conversion rate of a strategy for new vouchers.
+	UpdateStrategy(ctx context.Context, in *MsgUpdateStrategy, opts ...grpc.CallOption) (*MsgUpdateStrategyResponse, error)
+	// DisableStrategy disables a strategy for new conversions.
+	DisableStrategy(ctx context.Context, in *MsgDisableStrategy, opts ...grpc.CallOption) (*MsgDisableStrategyResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ConvertTabi(ctx context.Context, in *MsgConvertTabi, opts ...grpc.CallOption) (*MsgConvertTabiResponse, error) {
+	out := new(MsgConvertTabiResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/ConvertTabi", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelConvert) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConvertVetabi(ctx context.Context, in *MsgConvertVetabi, opts ...grpc.CallOption) (*MsgConvertVetabiResponse, error) {
+	out := new(MsgConvertVetabiResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/ConvertVetabi", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelConvert) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawTabi(ctx context.Context, in *MsgWithdrawTabi, opts ...grpc.CallOption) (*MsgWithdrawTabiResponse, error) {
+	out := new(MsgWithdrawTabiResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/WithdrawTabi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelConvert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+func (c *msgClient) CancelConvert(ctx context.Context, in *MsgCancelConvert, opts ...grpc.CallOption) (*MsgCancelConvertResponse, error) {
+	out := new(MsgCancelConvertResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/CancelConvert", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.VoucherId) > 0 {
-		i -= len(m.VoucherId)
-		copy(dAtA[i:], m.VoucherId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.VoucherId)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) TransferVoucher(ctx context.Context, in *MsgTransferVoucher, opts ...grpc.CallOption) (*MsgTransferVoucherResponse, error) {
+	out := new(MsgTransferVoucherResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/TransferVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCancelConvertResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAutoSettle(ctx context.Context, in *MsgSetAutoSettle, opts ...grpc.CallOption) (*MsgSetAutoSettleResponse, error) {
+	out := new(MsgSetAutoSettleResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/SetAutoSettle", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCancelConvertResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateStrategy(ctx context.Context, in *MsgCreateStrategy, opts ...grpc.CallOption) (*MsgCreateStrategyResponse, error) {
+	out := new(MsgCreateStrategyResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/CreateStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCancelConvertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.VetabiUnlocked.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (c *msgClient) UpdateStrategy(ctx context.Context, in *MsgUpdateStrategy, opts ...grpc.CallOption) (*MsgUpdateStrategyResponse, error) {
+	out := new(MsgUpdateStrategyResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/UpdateStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableStrategy(ctx context.Context, in *MsgDisableStrategy, opts ...grpc.CallOption) (*MsgDisableStrategyResponse, error) {
+	out := new(MsgDisableStrategyResponse)
+	err := c.cc.Invoke(ctx, "/tabi.token_convert.v1.Msg/DisableStrategy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// ConvertTabi converts Tabi to Vetabi at a 1:1 ratio.
+	ConvertTabi(context.Context, *MsgConvertTabi) (*MsgConvertTabiResponse, error)
+	// ConvertVetabi converts Vetabi to Tabi with the given unlock strategy
+	// and return a voucher for redeeming Tabi.
+	ConvertVetabi(context.Context, *MsgConvertVetabi) (*MsgConvertVetabiResponse, error)
+	// WithdrawTabi sends the unlocked Tabi to owner of the voucher and
+	// burns Vetabi as well as Tabi according to the unlock strategy.
+	WithdrawTabi(context.Context, *MsgWithdrawTabi) (*MsgWithdrawTabiResponse, error)
+	// CancelConvert cancels the conversion of Vetabi to Tabi.
+	CancelConvert(context.Context, *MsgCancelConvert) (*MsgCancelConvertResponse, error)
+	// TransferVoucher transfers the ownership of a voucher to another account.
+	TransferVoucher(context.Context, *MsgTransferVoucher) (*MsgTransferVoucherResponse, error)
+	// SetAutoSettle enables or disables auto-settlement of a voucher.
+	SetAutoSettle(context.Context, *MsgSetAutoSettle) (*MsgSetAutoSettleResponse, error)
+	// CreateStrategy creates a new unlock strategy.
+	CreateStrategy(context.Context, *MsgCreateStrategy) (*MsgCreateStrategyResponse, error)
+	// UpdateStrategy updates the period and conversion rate of a strategy for new vouchers.
+	UpdateStrategy(context.Context, *MsgUpdateStrategy) (*MsgUpdateStrategyResponse, error)
+	// DisableStrategy disables a strategy for new conversions.
+	DisableStrategy(context.Context, *MsgDisableStrategy) (*MsgDisableStrategyResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ConvertTabi(ctx context.Context, req *MsgConvertTabi) (*MsgConvertTabiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertTabi not implemented")
+}
+func (*UnimplementedMsgServer) ConvertVetabi(ctx context.Context, req *MsgConvertVetabi) (*MsgConvertVetabiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertVetabi not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawTabi(ctx context.Context, req *MsgWithdrawTabi) (*MsgWithdrawTabiResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawTabi not implemented")
+}
+func (*UnimplementedMsgServer) CancelConvert(ctx context.Context, req *MsgCancelConvert) (*MsgCancelConvertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelConvert not implemented")
+}
+func (*UnimplementedMsgServer) TransferVoucher(ctx context.Context, req *MsgTransferVoucher) (*MsgTransferVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferVoucher not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoSettle(ctx context.Context, req *MsgSetAutoSettle) (*MsgSetAutoSettleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoSettle not implemented")
+}
+func (*UnimplementedMsgServer) CreateStrategy(ctx context.Context, req *MsgCreateStrategy) (*MsgCreateStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateStrategy not implemented")
+}
+func (*UnimplementedMsgServer) UpdateStrategy(ctx context.Context, req *MsgUpdateStrategy) (*MsgUpdateStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateStrategy not implemented")
+}
+func (*UnimplementedMsgServer) DisableStrategy(ctx context.Context, req *MsgDisableStrategy) (*MsgDisableStrategyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableStrategy not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ConvertTabi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertTabi)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertTabi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/ConvertTabi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertTabi(ctx, req.(*MsgConvertTabi))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertVetabi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertVetabi)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertVetabi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/ConvertVetabi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertVetabi(ctx, req.(*MsgConvertVetabi))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawTabi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawTabi)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawTabi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/WithdrawTabi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawTabi(ctx, req.(*MsgWithdrawTabi))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelConvert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelConvert)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelConvert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/CancelConvert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelConvert(ctx, req.(*MsgCancelConvert))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferVoucher)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/TransferVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferVoucher(ctx, req.(*MsgTransferVoucher))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoSettle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoSettle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoSettle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/SetAutoSettle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoSettle(ctx, req.(*MsgSetAutoSettle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateStrategy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/CreateStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateStrategy(ctx, req.(*MsgCreateStrategy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateStrategy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/UpdateStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateStrategy(ctx, req.(*MsgUpdateStrategy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableStrategy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableStrategy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableStrategy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/tabi.token_convert.v1.Msg/DisableStrategy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableStrategy(ctx, req.(*MsgDisableStrategy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "tabi.token_convert.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ConvertTabi",
+			Handler:    _Msg_ConvertTabi_Handler,
+		},
+		{
+			MethodName: "ConvertVetabi",
+			Handler:    _Msg_ConvertVetabi_Handler,
+		},
+		{
+			MethodName: "WithdrawTabi",
+			Handler:    _Msg_WithdrawTabi_Handler,
+		},
+		{
+			MethodName: "CancelConvert",
+			Handler:    _Msg_CancelConvert_Handler,
+		},
+		{
+			MethodName: "TransferVoucher",
+			Handler:    _Msg_TransferVoucher_Handler,
+		},
+		{
+			MethodName: "SetAutoSettle",
+			Handler:    _Msg_SetAutoSettle_Handler,
+		},
+		{
+			MethodName: "CreateStrategy",
+			Handler:    _Msg_CreateStrategy_Handler,
+		},
+		{
+			MethodName: "UpdateStrategy",
+			Handler:    _Msg_UpdateStrategy_Handler,
+		},
+		{
+			MethodName: "DisableStrategy",
+			Handler:    _Msg_DisableStrategy_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "tabi/token-convert/v1/tx.proto",
+}
+
+func (m *MsgConvertTabi) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertTabi) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertTabi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertTabiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertTabiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertTabiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertVetabi) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertVetabi) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertVetabi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AutoSettle {
+		i--
+		if m.AutoSettle {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Strategy) > 0 {
+		i -= len(m.Strategy)
+		copy(dAtA[i:], m.Strategy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Strategy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Coin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConvertVetabiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConvertVetabiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConvertVetabiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpiryTime) > 0 {
+		i -= len(m.ExpiryTime)
+		copy(dAtA[i:], m.ExpiryTime)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpiryTime)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoucherId) > 0 {
+		i -= len(m.VoucherId)
+		copy(dAtA[i:], m.VoucherId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoucherId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTabi) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTabi) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTabi) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoucherId) > 0 {
+		i -= len(m.VoucherId)
+		copy(dAtA[i:], m.VoucherId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoucherId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawTabiResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawTabiResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawTabiResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VetabiReturned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.TabiWithdrawn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelConvert) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelConvert) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelConvert) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoucherId) > 0 {
+		i -= len(m.VoucherId)
+		copy(dAtA[i:], m.VoucherId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoucherId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelConvertResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelConvertResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelConvertResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.VetabiUnlocked.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoucherId) > 0 {
+		i -= len(m.VoucherId)
+		copy(dAtA[i:], m.VoucherId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoucherId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoSettle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoSettle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoSettle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.VoucherId) > 0 {
+		i -= len(m.VoucherId)
+		copy(dAtA[i:], m.VoucherId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.VoucherId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoSettleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoSettleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoSettleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Period != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateStrategyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateStrategyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateStrategyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ConversionRate.Size()
+		i -= size
+		if _, err := m.ConversionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Period != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateStrategyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateStrategyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateStrategyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableStrategy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableStrategy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableStrategy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableStrategyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableStrategyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableStrategyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgConvertTabi) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConvertTabiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConvertVetabi) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Coin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Strategy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.AutoSettle {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgConvertVetabiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoucherId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpiryTime)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTabi) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoucherId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawTabiResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TabiWithdrawn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.VetabiReturned.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCancelConvert) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoucherId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelConvertResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.VetabiUnlocked.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgTransferVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoucherId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoSettle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.VoucherId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoSettleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateStrategyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	l = m.ConversionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateStrategyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableStrategy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableStrategyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgConvertTabi) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertTabi: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertTabi: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertTabiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertTabiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertTabiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVetabi) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVetabi: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVetabi: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Strategy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoSettle", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoSettle = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConvertVetabiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConvertVetabiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConvertVetabiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiryTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTabi) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTabi: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTabi: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawTabiResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawTabiResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawTabiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TabiWithdrawn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TabiWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetabiReturned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetabiReturned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelConvert) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelConvert: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelConvert: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelConvertResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelConvertResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelConvertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VetabiUnlocked", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VetabiUnlocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferVoucher) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferVoucher: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgConvertTabi) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgConvertTabiResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgConvertVetabi) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Coin.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Strategy)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgConvertVetabiResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VoucherId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExpiryTime)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
-
-func (m *MsgWithdrawTabi) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VoucherId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgWithdrawTabiResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.TabiWithdrawn.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.VetabiReturned.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return nil
 }
-
-func (m *MsgCancelConvert) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.VoucherId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgTransferVoucherResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferVoucherResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgCancelConvertResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.VetabiUnlocked.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgConvertTabi) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoSettle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1159,17 +3464,17 @@ func (m *MsgConvertTabi) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertTabi: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoSettle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertTabi: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoSettle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1179,24 +3484,23 @@ func (m *MsgConvertTabi) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VoucherId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -1230,6 +3534,26 @@ func (m *MsgConvertTabi) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1251,7 +3575,7 @@ func (m *MsgConvertTabi) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertTabiResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoSettleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1274,10 +3598,10 @@ func (m *MsgConvertTabiResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertTabiResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoSettleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertTabiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoSettleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1301,7 +3625,7 @@ func (m *MsgConvertTabiResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVetabi) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1324,17 +3648,17 @@ func (m *MsgConvertVetabi) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVetabi: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVetabi: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1344,28 +3668,27 @@ func (m *MsgConvertVetabi) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Strategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1393,11 +3716,30 @@ func (m *MsgConvertVetabi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Strategy = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1425,7 +3767,9 @@ func (m *MsgConvertVetabi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1448,7 +3792,7 @@ func (m *MsgConvertVetabi) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConvertVetabiResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateStrategyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1471,76 +3815,12 @@ func (m *MsgConvertVetabiResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConvertVetabiResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateStrategyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConvertVetabiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateStrategyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VoucherId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExpiryTime = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1562,7 +3842,7 @@ func (m *MsgConvertVetabiResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawTabi) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1585,15 +3865,15 @@ func (m *MsgWithdrawTabi) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawTabi: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawTabi: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1621,11 +3901,11 @@ func (m *MsgWithdrawTabi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoucherId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1653,7 +3933,60 @@ func (m *MsgWithdrawTabi) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConversionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConversionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1676,7 +4009,7 @@ func (m *MsgWithdrawTabi) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawTabiResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateStrategyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1699,78 +4032,12 @@ func (m *MsgWithdrawTabiResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawTabiResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateStrategyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawTabiResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateStrategyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TabiWithdrawn", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TabiWithdrawn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetabiReturned", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VetabiReturned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1792,7 +4059,7 @@ func (m *MsgWithdrawTabiResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelConvert) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableStrategy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1815,15 +4082,15 @@ func (m *MsgCancelConvert) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelConvert: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableStrategy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelConvert: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableStrategy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VoucherId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1851,11 +4118,11 @@ func (m *MsgCancelConvert) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VoucherId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1883,7 +4150,7 @@ func (m *MsgCancelConvert) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1906,7 +4173,7 @@ func (m *MsgCancelConvert) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelConvertResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableStrategyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1929,45 +4196,12 @@ func (m *MsgCancelConvertResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelConvertResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableStrategyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelConvertResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableStrategyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VetabiUnlocked", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.VetabiUnlocked.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])